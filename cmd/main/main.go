@@ -7,6 +7,7 @@ import (
 	// "io/ioutil"
 	"log"
 	"net/http"
+	"time"
 	"yula/internal/config"
 
 	_ "github.com/jackc/pgx/stdlib"
@@ -26,9 +27,44 @@ import (
 	advtRep "yula/internal/pkg/advt/repository"
 	advtUse "yula/internal/pkg/advt/usecase"
 
+	admHttp "yula/internal/pkg/admin/delivery/http"
+	admUse "yula/internal/pkg/admin/usecase"
 	cartHttp "yula/internal/pkg/cart/delivery/http"
 	cartRep "yula/internal/pkg/cart/repository"
 	cartUse "yula/internal/pkg/cart/usecase"
+	cpnHttp "yula/internal/pkg/coupons/delivery/http"
+	cpnRep "yula/internal/pkg/coupons/repository"
+	cpnUse "yula/internal/pkg/coupons/usecase"
+
+	dispHttp "yula/internal/pkg/disputes/delivery/http"
+	dispRep "yula/internal/pkg/disputes/repository"
+	dispUse "yula/internal/pkg/disputes/usecase"
+	impHttp "yula/internal/pkg/imports/delivery/http"
+	impRep "yula/internal/pkg/imports/repository"
+	impUse "yula/internal/pkg/imports/usecase"
+	ntfHttp "yula/internal/pkg/notifications/delivery/http"
+	ntfRep "yula/internal/pkg/notifications/repository"
+	ntfUse "yula/internal/pkg/notifications/usecase"
+	orderHttp "yula/internal/pkg/orders/delivery/http"
+	orderRep "yula/internal/pkg/orders/repository"
+	orderUse "yula/internal/pkg/orders/usecase"
+	"yula/internal/pkg/payment"
+	payHttp "yula/internal/pkg/payment/delivery/http"
+	payProvider "yula/internal/pkg/payment/provider"
+	payRep "yula/internal/pkg/payment/repository"
+	payUse "yula/internal/pkg/payment/usecase"
+	"yula/internal/pkg/promotion"
+	promoHttp "yula/internal/pkg/promotion/delivery/http"
+	promoProvider "yula/internal/pkg/promotion/provider"
+	promoRep "yula/internal/pkg/promotion/repository"
+	promoUse "yula/internal/pkg/promotion/usecase"
+	rptHttp "yula/internal/pkg/reports/delivery/http"
+	rptRep "yula/internal/pkg/reports/repository"
+	rptUse "yula/internal/pkg/reports/usecase"
+
+	revHttp "yula/internal/pkg/reviews/delivery/http"
+	revRep "yula/internal/pkg/reviews/repository"
+	revUse "yula/internal/pkg/reviews/usecase"
 
 	srchHttp "yula/internal/pkg/search/delivery/http"
 	srchRep "yula/internal/pkg/search/repository"
@@ -40,6 +76,7 @@ import (
 	metrics "yula/internal/pkg/metrics"
 	metricsHttp "yula/internal/pkg/metrics/delivery"
 
+	"github.com/go-co-op/gocron"
 	"github.com/gorilla/mux"
 
 	authProto "yula/proto/generated/auth"
@@ -97,6 +134,12 @@ func main() {
 		return
 	}
 
+	// пустым ключом гостевой токен подпишет кто угодно
+	if config.Cfg.GetGuestCartSecret() == "" {
+		logger.Errorf("guest cart secret is not set")
+		return
+	}
+
 	sqlDB := getPostgres(config.Cfg.GetPostgresUrl())
 	defer sqlDB.Close()
 
@@ -118,27 +161,112 @@ func main() {
 	ar := advtRep.NewAdvtRepository(sqlDB)
 	ur := userRep.NewUserRepository(sqlDB)
 	rr := userRep.NewRatingRepository(sqlDB)
+	adr := userRep.NewAddressRepository(sqlDB)
 	cr := cartRep.NewCartRepository(sqlDB)
+	cpr := cpnRep.NewCouponRepository(sqlDB)
+	or := orderRep.NewOrderRepository(sqlDB)
+	rvr := revRep.NewReviewRepository(sqlDB)
+	pr := payRep.NewPaymentRepository(sqlDB)
 	serr := srchRep.NewSearchRepository(sqlDB)
+	ssr := srchRep.NewSavedSearchRepository(sqlDB)
+	dr := dispRep.NewDisputeRepository(sqlDB)
+	rptr := rptRep.NewReportRepository(sqlDB)
+	impr := impRep.NewImportRepository(sqlDB)
+	promor := promoRep.NewPromotionRepository(sqlDB)
+	ntfr := ntfRep.NewNotificationRepository(sqlDB)
+	// заглушки принимают любое уведомление об оплате, поэтому включаются только явным флагом,
+	// без флага и без ключей настоящих провайдеров сервер не запускается
+	var pp payment.PaymentProvider
+	var promop promotion.PromotionProvider
+	switch {
+	case config.Cfg.GetFakeProviders():
+		logger.Warn("fake payment providers are enabled, payment notifications are not verified")
+		pp = payProvider.NewFakeProvider()
+		promop = promoProvider.NewFakeProvider()
+	case config.Cfg.GetPaymentsShopId() == "" || config.Cfg.GetPaymentsSecretKey() == "":
+		logger.Errorf("payments shop id or secret key is not set")
+		return
+	case config.Cfg.GetPromotionSecret() == "":
+		logger.Errorf("promotion secret is not set")
+		return
+	default:
+		pp = payProvider.NewYooKassaProvider(config.Cfg.GetPaymentsShopId(), config.Cfg.GetPaymentsSecretKey(),
+			config.Cfg.GetPaymentsReturnUrl())
+		promop = promoProvider.NewYooMoneyProvider(config.Cfg.GetPromotionWallet(), config.Cfg.GetPromotionSecret())
+	}
 
+	// уведомления создаются раньше остальных, через них пишут заказы, чат, модерация и фоновые задачи
+	ntfu := ntfUse.NewNotificationUsecase(ntfr)
 	ilu := imageloaderUse.NewImageLoaderUsecase(ilr)
 	au := advtUse.NewAdvtUsecase(ar, ilu)
-	uu := userUse.NewUserUsecase(ur, rr, ilu)
-	cu := cartUse.NewCartUsecase(cr)
-	seru := srchUse.NewSearchUsecase(serr, ar)
+	uu := userUse.NewUserUsecase(ur, rr, adr, ilu)
+	cu := cartUse.NewCartUsecase(cr, cpr)
+	cpu := cpnUse.NewCouponUsecase(cpr)
+	pu := payUse.NewPaymentUsecase(pr, or, pp)
+	ou := orderUse.NewOrderUsecase(or, ntfu, pu)
+	rvu := revUse.NewReviewUsecase(rvr, or)
+	du := dispUse.NewDisputeUsecase(dr, or, pu, ilu)
+	seru := srchUse.NewSearchUsecase(serr, ar, ssr)
+	admu := admUse.NewAdminUsecase(ur, ar)
+	rptu := rptUse.NewReportUsecase(rptr, config.Cfg.GetReportsHideThreshold())
+	impu := impUse.NewImportUsecase(impr, au, ar, ilu)
+	promou := promoUse.NewPromotionUsecase(promor, ar, au, promop)
+
+	grpcChatClient := CreateGRPCClient(config.Cfg.GetChatEndPoint(), grpc.WithInsecure())
+	defer grpcChatClient.Close()
+
+	// фоновые задачи: снимаем истекшие резервы в корзинах, закрываем просроченные сделки и объявления,
+	// снимаем истекшее продвижение, уведомляем об изменениях в избранном и новых объявлениях по сохраненным поискам
+	scheduler := gocron.NewScheduler(time.UTC)
+	if _, err := scheduler.Every(1).Minute().Do(cu.ReleaseExpiredReservations); err != nil {
+		logger.Errorf("cannot schedule reservations release: %s", err.Error())
+		return
+	}
+	if _, err := scheduler.Every(1).Minute().Do(pu.ProcessTimeouts); err != nil {
+		logger.Errorf("cannot schedule payment timeouts: %s", err.Error())
+		return
+	}
+	ej := advtHttp.NewExpiryJob(au, ntfu, config.Cfg.GetExpiryWarnDays())
+	if _, err := scheduler.Every(1).Hour().Do(ej.Run); err != nil {
+		logger.Errorf("cannot schedule adverts expiry: %s", err.Error())
+		return
+	}
+	if _, err := scheduler.Every(1).Minute().Do(au.ExpirePromotions); err != nil {
+		logger.Errorf("cannot schedule promotions expiry: %s", err.Error())
+		return
+	}
+	fj := advtHttp.NewFavoritesJob(au, ntfu)
+	if _, err := scheduler.Every(1).Minute().Do(fj.Run); err != nil {
+		logger.Errorf("cannot schedule favorites notifications: %s", err.Error())
+		return
+	}
+	sj := srchHttp.NewSavedSearchJob(seru, ntfu)
+	if _, err := scheduler.Every(10).Minutes().Do(sj.Run); err != nil {
+		logger.Errorf("cannot schedule saved search alerts: %s", err.Error())
+		return
+	}
+	scheduler.StartAsync()
+	defer scheduler.Stop()
 
 	ah := advtHttp.NewAdvertHandler(au, uu)
-	ch := cartHttp.NewCartHandler(cu, uu, au)
+	ch := cartHttp.NewCartHandler(cu, uu, au, pu, cpu)
+	cph := cpnHttp.NewCouponHandler(cpu)
+	oh := orderHttp.NewOrderHandler(ou, uu)
+	rvh := revHttp.NewReviewHandler(rvu)
+	ph := payHttp.NewPaymentHandler(pu)
+	dh := dispHttp.NewDisputeHandler(du)
 	serh := srchHttp.NewSearchHandler(seru)
+	admh := admHttp.NewAdminHandler(admu)
+	rpth := rptHttp.NewReportHandler(rptu)
+	imph := impHttp.NewImportHandler(impu)
+	promoh := promoHttp.NewPromotionHandler(promou)
+	ntfh := ntfHttp.NewNotificationHandler(ntfu)
 
 	// pemServerCA, err := ioutil.ReadFile(config.Cfg.GetSelfSignedCrt())
 	// if err != nil {
 	// 	log.Fatal(err.Error())
 	// }
 
-	grpcChatClient := CreateGRPCClient(config.Cfg.GetChatEndPoint(), grpc.WithInsecure())
-	defer grpcChatClient.Close()
-
 	grpcAuthClient := CreateGRPCClient(config.Cfg.GetAuthEndPoint(), grpc.WithInsecure())
 	defer grpcAuthClient.Close()
 
@@ -146,20 +274,32 @@ func main() {
 	defer grpcCategoryClient.Close()
 
 	uh := userHttp.NewUserHandler(uu, authProto.NewAuthClient(grpcAuthClient))
-	sh := sessHttp.NewSessionHandler(authProto.NewAuthClient(grpcAuthClient), uu)
+	sh := sessHttp.NewSessionHandler(authProto.NewAuthClient(grpcAuthClient), uu, cu)
 	cath := categoryHttp.NewCategoryHandler(categoryProto.NewCategoryClient(grpcCategoryClient))
-	chth := chatHttp.NewChatHandler(chatProto.NewChatClient(grpcChatClient), au, uu)
+	chth := chatHttp.NewChatHandler(chatProto.NewChatClient(grpcChatClient), au, uu, ntfu)
+	mh := advtHttp.NewModerationHandler(au, ntfu)
 
-	sm := middleware.NewSessionMiddleware(authProto.NewAuthClient(grpcAuthClient))
+	sm := middleware.NewSessionMiddleware(authProto.NewAuthClient(grpcAuthClient), uu)
 
 	ah.Routing(api, sm)
 	uh.Routing(api, sm)
 	sh.Routing(api)
 	ch.Routing(api, sm)
-	serh.Routing(api)
-	cath.Routing(api)
+	cph.Routing(api, sm)
+	oh.Routing(api, sm)
+	rvh.Routing(api, sm)
+	ph.Routing(api, sm)
+	dh.Routing(api, sm)
+	serh.Routing(api, sm)
+	cath.Routing(api, sm)
 	middleware.Routing(api)
 	chth.Routing(api, sm)
+	mh.Routing(api, sm)
+	admh.Routing(api, sm)
+	rpth.Routing(api, sm)
+	imph.Routing(api, sm)
+	promoh.Routing(api, sm)
+	ntfh.Routing(api, sm)
 
 	port := config.Cfg.GetMainPort()
 	fmt.Printf("start serving ::%s\n", port)
@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 	"yula/internal/pkg/logging"
 
 	"github.com/spf13/viper"
@@ -54,6 +55,34 @@ type config struct {
 	Compressor struct {
 		StaticDirs []string
 	}
+
+	Cart struct {
+		GuestSecret string
+	}
+
+	Reports struct {
+		HideThreshold int64
+	}
+
+	Adverts struct {
+		ExpiryWarnDays int64
+		SiteUrl        string
+	}
+
+	Promotion struct {
+		Secret string
+		Wallet string
+	}
+
+	Payments struct {
+		ShopId    string
+		SecretKey string
+		ReturnUrl string
+	}
+
+	Debug struct {
+		FakeProviders bool
+	}
 }
 
 var (
@@ -165,3 +194,66 @@ func (c *config) GetCategoryEndPoint() string {
 func (c *config) GetStaticDirs() []string {
 	return c.Compressor.StaticDirs
 }
+
+// GetGuestCartSecret - ключ подписи токена гостевой корзины, без него сервер не запускается
+func (c *config) GetGuestCartSecret() string {
+	return c.Cart.GuestSecret
+}
+
+// GetExpiryWarnDays - за сколько дней до закрытия предупреждать продавца об истечении срока объявления
+func (c *config) GetExpiryWarnDays() int64 {
+	if c.Adverts.ExpiryWarnDays <= 0 {
+		return 3
+	}
+	return c.Adverts.ExpiryWarnDays
+}
+
+// GetSiteUrl - адрес сайта, от него строятся ссылки на объявления и картинки в выгрузках
+func (c *config) GetSiteUrl() string {
+	if c.Adverts.SiteUrl == "" {
+		return "https://volchock.ru"
+	}
+	return strings.TrimSuffix(c.Adverts.SiteUrl, "/")
+}
+
+// GetReportsHideThreshold - число жалоб от разных пользователей, после которого цель скрывается до проверки
+func (c *config) GetReportsHideThreshold() int64 {
+	if c.Reports.HideThreshold <= 0 {
+		return 5
+	}
+	return c.Reports.HideThreshold
+}
+
+// GetPromotionSecret - секрет для проверки подписи уведомлений об оплате продвижения
+func (c *config) GetPromotionSecret() string {
+	return c.Promotion.Secret
+}
+
+// GetPromotionWallet - кошелек, на который принимается оплата продвижения
+func (c *config) GetPromotionWallet() string {
+	return c.Promotion.Wallet
+}
+
+// GetPaymentsShopId - id магазина в ЮKassa, через который идет оплата заказов
+func (c *config) GetPaymentsShopId() string {
+	return c.Payments.ShopId
+}
+
+// GetPaymentsSecretKey - секретный ключ магазина ЮKassa
+func (c *config) GetPaymentsSecretKey() string {
+	return c.Payments.SecretKey
+}
+
+// GetPaymentsReturnUrl - куда ЮKassa вернет покупателя после оплаты
+func (c *config) GetPaymentsReturnUrl() string {
+	if c.Payments.ReturnUrl == "" {
+		return c.GetSiteUrl() + "/orders"
+	}
+	return c.Payments.ReturnUrl
+}
+
+// GetFakeProviders - включить заглушки платежных провайдеров для локального запуска,
+// заглушки не проверяют подписи уведомлений, поэтому на проде флаг должен быть выключен
+func (c *config) GetFakeProviders() bool {
+	return c.Debug.FakeProviders
+}
@@ -1,9 +1,23 @@
+-- DROP TABLE notifications;
+-- DROP TABLE saved_search;
+-- DROP TABLE payments;
+-- DROP TABLE import_job;
+-- DROP TABLE report;
+-- DROP TABLE order_event;
+-- DROP TABLE dispute_image;
+-- DROP TABLE dispute;
+-- DROP TABLE escrow_payment;
+-- DROP TABLE review;
+-- DROP TABLE address;
+-- DROP TABLE order_line;
+-- DROP TABLE orders;
 -- DROP TABLE promotion;
 -- DROP TABLE views_;
 -- DROP TABLE rating_statistics;
--- DROP TABLE rating;
+-- DROP TABLE guest_cart;
 -- DROP TABLE cart;
 -- DROP TABLE price_history;
+-- DROP TABLE coupon;
 -- DROP TABLE favorite;
 -- DROP TABLE advert_image;
 -- DROP TABLE advert;
@@ -36,13 +50,28 @@ CREATE TABLE IF NOT EXISTS users (
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     name text NOT NULL DEFAULT '',
     surname text NOT NULL DEFAULT '',
-    image text NOT NULL DEFAULT ''
+    image text NOT NULL DEFAULT '',
+	-- user, moderator, admin
+	role text NOT NULL DEFAULT 'user',
+	is_banned BOOLEAN NOT NULL DEFAULT FALSE,
+	is_hidden BOOLEAN NOT NULL DEFAULT FALSE
 );
 
 
 CREATE TABLE IF NOT EXISTS category (
 	id SERIAL PRIMARY KEY,
-	name text UNIQUE NOT NULL
+	-- NULL у корневых категорий
+	parent_id INT,
+	name text UNIQUE NOT NULL,
+	slug text UNIQUE NOT NULL,
+	icon text NOT NULL DEFAULT '',
+	sort_order int NOT NULL DEFAULT 0,
+	-- срок жизни объявлений категории, после него объявление закрывается как истекшее
+	lifetime_days int NOT NULL DEFAULT 30,
+	-- схема атрибутов объявлений: [{"name": "size", "type": "enum", "required": true, "values": ["S", "M"]}]
+	attributes jsonb NOT NULL DEFAULT '[]',
+
+	FOREIGN KEY (parent_id) REFERENCES category (id) ON DELETE RESTRICT
 );
 
 
@@ -60,6 +89,19 @@ CREATE TABLE IF NOT EXISTS advert (
 	views int NOT NULL DEFAULT 0,
 	amount int NOT NULL DEFAULT 1,
 	is_new BOOLEAN NOT NULL DEFAULT TRUE,
+	delivery_pickup BOOLEAN NOT NULL DEFAULT TRUE,
+	delivery_courier BOOLEAN NOT NULL DEFAULT FALSE,
+	delivery_post BOOLEAN NOT NULL DEFAULT FALSE,
+	-- draft, pending_review, published, rejected, closed
+	status text NOT NULL DEFAULT 'pending_review',
+	moderation_reason text NOT NULL DEFAULT '',
+	-- manual, sold_out, expired, moderator
+	close_reason text NOT NULL DEFAULT '',
+	expiry_warned BOOLEAN NOT NULL DEFAULT FALSE,
+	-- закрытое объявление изменено после проверки, при продлении оно уходит на модерацию
+	needs_review BOOLEAN NOT NULL DEFAULT FALSE,
+	-- значения атрибутов по схеме категории: {"size": "M"}
+	attributes jsonb NOT NULL DEFAULT '{}',
 
     publisher_id INT NOT NULL,
 	category_id INT NOT NULL,
@@ -80,6 +122,10 @@ CREATE TABLE IF NOT EXISTS advert_image (
 CREATE TABLE IF NOT EXISTS favorite (
 	user_id int NOT NULL,
 	advert_id int NOT NULL,
+	-- о какой цене и закрытии пользователь уже знает, по ним фоновая задача ищет, о чем уведомить
+	price_seen int NOT NULL DEFAULT 0,
+	target_price int NOT NULL DEFAULT 0,
+	closed_notified boolean NOT NULL DEFAULT false,
 
 	FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
 	FOREIGN KEY (advert_id) REFERENCES advert (id) ON DELETE CASCADE
@@ -94,22 +140,44 @@ CREATE TABLE IF NOT EXISTS price_history (
 	FOREIGN KEY (advert_id) REFERENCES advert (id) ON DELETE CASCADE
 );
 
+CREATE TABLE IF NOT EXISTS coupon (
+	id SERIAL PRIMARY KEY,
+	salesman_id int NOT NULL,
+	code text NOT NULL,
+	kind text NOT NULL,
+	value int NOT NULL,
+	min_price int NOT NULL DEFAULT 0,
+	usage_limit int NOT NULL DEFAULT 0,
+	used int NOT NULL DEFAULT 0,
+	valid_from TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	valid_until TIMESTAMP NOT NULL,
+
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+	UNIQUE (salesman_id, code),
+	FOREIGN KEY (salesman_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
 CREATE TABLE IF NOT EXISTS cart (
 	user_id int NOT NULL,
 	advert_id int NOT NULL,
 	amount int NOT NULL,
+	reserved_until TIMESTAMP,
+	coupon_id int,
 
 	FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
-	FOREIGN KEY (advert_id) REFERENCES advert (id) ON DELETE CASCADE
+	FOREIGN KEY (advert_id) REFERENCES advert (id) ON DELETE CASCADE,
+	FOREIGN KEY (coupon_id) REFERENCES coupon (id) ON DELETE SET NULL
 );
 
-CREATE TABLE IF NOT EXISTS rating (
-	user_from int NOT NULL,
-	user_to int NOT NULL,
-	rate int NOT NULL,
+CREATE TABLE IF NOT EXISTS guest_cart (
+	token text NOT NULL,
+	advert_id int NOT NULL,
+	amount int NOT NULL,
+
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
 
-	FOREIGN KEY (user_from) REFERENCES users (id) ON DELETE CASCADE,
-	FOREIGN KEY (user_to) REFERENCES users (id) ON DELETE CASCADE
+	FOREIGN KEY (advert_id) REFERENCES advert (id) ON DELETE CASCADE
 );
 
 CREATE TABLE IF NOT EXISTS rating_statistics (
@@ -128,10 +196,12 @@ CREATE TABLE IF NOT EXISTS views_ (
 );
 
 CREATE TABLE IF NOT EXISTS messages (
+	id SERIAL PRIMARY KEY,
 	user_from int NOT NULL,
 	user_to int NOT NULL,
 	adv_id int,
 	msg VARCHAR(255),
+	is_hidden BOOLEAN NOT NULL DEFAULT FALSE,
 
 	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
 
@@ -156,11 +226,196 @@ CREATE TABLE IF NOT EXISTS promotion (
 	advert_id int NOT NULL,
 	promo_level int NOT NULL DEFAULT 0,
 	promo_start TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	promo_until TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
 
 	FOREIGN KEY (advert_id) REFERENCES advert (id) ON DELETE CASCADE
 );
 
+-- сохраненный поиск хранит параметры запроса /search, last_run_at - время публикации самого нового уже найденного объявления
+CREATE TABLE IF NOT EXISTS saved_search (
+	id SERIAL PRIMARY KEY,
+	user_id int NOT NULL,
+	name text NOT NULL,
+	params text NOT NULL,
+	last_run_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+	FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+-- оплата продвижения, operation_id - id операции у провайдера, amount в копейках
+CREATE TABLE IF NOT EXISTS payments (
+	id SERIAL PRIMARY KEY,
+	operation_id text UNIQUE NOT NULL,
+	user_id int NOT NULL DEFAULT 0,
+	advert_id int NOT NULL DEFAULT 0,
+	package text NOT NULL DEFAULT '',
+	amount int NOT NULL DEFAULT 0,
+	status text NOT NULL DEFAULT 'pending',
+
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS notifications (
+	id SERIAL PRIMARY KEY,
+	user_id int NOT NULL,
+	kind text NOT NULL,
+	text text NOT NULL,
+	advert_id int NOT NULL DEFAULT 0,
+	order_id int NOT NULL DEFAULT 0,
+	sender_id int NOT NULL DEFAULT 0,
+	is_read boolean NOT NULL DEFAULT false,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+	FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS orders (
+	id SERIAL PRIMARY KEY,
+	buyer_id int NOT NULL,
+	salesman_id int NOT NULL,
+	status text NOT NULL DEFAULT 'created',
+	delivery_method text NOT NULL DEFAULT 'pickup',
+	delivery_address text NOT NULL DEFAULT '',
+
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+	FOREIGN KEY (buyer_id) REFERENCES users (id) ON DELETE CASCADE,
+	FOREIGN KEY (salesman_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS order_line (
+	order_id int NOT NULL,
+	advert_id int NOT NULL,
+	amount int NOT NULL,
+	price int NOT NULL,
+	list_price int NOT NULL DEFAULT 0,
+	coupon_id int,
+
+	FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE CASCADE,
+	FOREIGN KEY (advert_id) REFERENCES advert (id) ON DELETE CASCADE,
+	FOREIGN KEY (coupon_id) REFERENCES coupon (id) ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS review (
+	id SERIAL PRIMARY KEY,
+	order_id int NOT NULL,
+	author_id int NOT NULL,
+	target_id int NOT NULL,
+	score int NOT NULL CHECK (score BETWEEN 1 AND 5),
+	text text NOT NULL DEFAULT '',
+	reply text,
+
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	replied_at TIMESTAMP,
+
+	UNIQUE (order_id, author_id),
+	FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE CASCADE,
+	FOREIGN KEY (author_id) REFERENCES users (id) ON DELETE CASCADE,
+	FOREIGN KEY (target_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS escrow_payment (
+	id SERIAL PRIMARY KEY,
+	order_id int NOT NULL,
+	external_id text UNIQUE NOT NULL,
+	amount int NOT NULL,
+	refunded int NOT NULL DEFAULT 0,
+	status text NOT NULL DEFAULT 'pending',
+	confirmation_url text NOT NULL DEFAULT '',
+
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+	FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS dispute (
+	id SERIAL PRIMARY KEY,
+	order_id int UNIQUE NOT NULL,
+	reason text NOT NULL,
+	message text NOT NULL,
+	response text,
+	status text NOT NULL DEFAULT 'open',
+	refund int NOT NULL DEFAULT 0,
+	resolution text,
+
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+	FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS dispute_image (
+	dispute_id int NOT NULL,
+	img_path text UNIQUE NOT NULL,
+
+	FOREIGN KEY (dispute_id) REFERENCES dispute (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS order_event (
+	id SERIAL PRIMARY KEY,
+	order_id int NOT NULL,
+	actor_id int,
+	event text NOT NULL,
+	details text NOT NULL DEFAULT '',
+
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+	FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE CASCADE,
+	FOREIGN KEY (actor_id) REFERENCES users (id) ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS report (
+	id SERIAL PRIMARY KEY,
+	reporter_id int NOT NULL,
+	-- advert, user, message
+	target_type text NOT NULL,
+	target_id int NOT NULL,
+	reason text NOT NULL,
+	comment text NOT NULL DEFAULT '',
+	-- open, dismissed, confirmed
+	status text NOT NULL DEFAULT 'open',
+
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+	UNIQUE (reporter_id, target_type, target_id),
+	FOREIGN KEY (reporter_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS address (
+	id SERIAL PRIMARY KEY,
+	user_id int NOT NULL,
+	title text NOT NULL DEFAULT '',
+	recipient text NOT NULL,
+	phone text NOT NULL,
+	city text NOT NULL,
+	street text NOT NULL,
+	postal_code text NOT NULL DEFAULT '',
+
+	FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS import_job (
+	id SERIAL PRIMARY KEY,
+	user_id int NOT NULL,
+	-- pending, running, done
+	status text NOT NULL DEFAULT 'pending',
+	total int NOT NULL DEFAULT 0,
+	created int NOT NULL DEFAULT 0,
+	failed int NOT NULL DEFAULT 0,
+	-- построчный отчет: [{"row": 1, "advert_id": 10}, {"row": 2, "error": "invalid data"}]
+	report jsonb NOT NULL DEFAULT '[]',
+
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	finished_at TIMESTAMP NOT NULL DEFAULT to_timestamp(0),
+
+	FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
 
--- INSERT INTO category (name) values ('одежда'), ('обувь'), ('животные');
+-- INSERT INTO category (name, slug) values ('одежда', 'clothes'), ('обувь', 'shoes'), ('животные', 'animals');
 -- INSERT INTO advert (name, publisher_id, category_id) values ('Худи спортивная', 2, 1), ('Манчкин', 1, 3);
 -- INSERT INTO advert_image (advert_id, img_path) VALUES (2, 'hudi1'), (2, 'hudi2');
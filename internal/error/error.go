@@ -82,6 +82,131 @@ var (
 		Message: "not enough copies",
 	}
 
+	InvalidStatusTransition error = ServerAnswer{
+		Code:    http.StatusConflict,
+		Message: "invalid order status transition",
+	}
+
+	ReviewNotAllowed error = ServerAnswer{
+		Code:    http.StatusConflict,
+		Message: "order is not completed",
+	}
+
+	DeliveryNotSupported error = ServerAnswer{
+		Code:    http.StatusConflict,
+		Message: "delivery method is not supported",
+	}
+
+	AddressRequired error = ServerAnswer{
+		Code:    http.StatusBadRequest,
+		Message: "delivery address required",
+	}
+
+	PaymentNotAllowed error = ServerAnswer{
+		Code:    http.StatusConflict,
+		Message: "order can not be paid",
+	}
+
+	PaymentFailed error = ServerAnswer{
+		Code:    http.StatusBadGateway,
+		Message: "payment provider error",
+	}
+
+	DisputeNotAllowed error = ServerAnswer{
+		Code:    http.StatusConflict,
+		Message: "dispute can not be opened",
+	}
+
+	CouponNotFound error = ServerAnswer{
+		Code:    http.StatusNotFound,
+		Message: "coupon not found",
+	}
+
+	CouponExpired error = ServerAnswer{
+		Code:    http.StatusConflict,
+		Message: "coupon is not valid at this time",
+	}
+
+	CouponExhausted error = ServerAnswer{
+		Code:    http.StatusConflict,
+		Message: "coupon usage limit reached",
+	}
+
+	CouponMinPrice error = ServerAnswer{
+		Code:    http.StatusConflict,
+		Message: "price is below coupon minimum",
+	}
+
+	ModerationNotAllowed error = ServerAnswer{
+		Code:    http.StatusConflict,
+		Message: "advert is not awaiting moderation",
+	}
+
+	UserBanned error = ServerAnswer{
+		Code:    http.StatusForbidden,
+		Message: "user is banned",
+	}
+
+	CategoryNotEmpty error = ServerAnswer{
+		Code:    http.StatusConflict,
+		Message: "category has adverts or subcategories",
+	}
+
+	CategoryCycle error = ServerAnswer{
+		Code:    http.StatusConflict,
+		Message: "category can not be moved into its subcategory",
+	}
+
+	ReportDuplicate error = ServerAnswer{
+		Code:    http.StatusConflict,
+		Message: "report already sent",
+	}
+
+	AdminBanNotAllowed error = ServerAnswer{
+		Code:    http.StatusConflict,
+		Message: "admin can not be banned",
+	}
+
+	RenewNotAllowed error = ServerAnswer{
+		Code:    http.StatusConflict,
+		Message: "advert can not be renewed",
+	}
+
+	CloseNotAllowed error = ServerAnswer{
+		Code:    http.StatusConflict,
+		Message: "only published advert can be closed",
+	}
+
+	InvalidAttributes error = ServerAnswer{
+		Code:    http.StatusBadRequest,
+		Message: "advert attributes do not match category",
+	}
+
+	InvalidImportFile error = ServerAnswer{
+		Code:    http.StatusBadRequest,
+		Message: "import file can not be parsed",
+	}
+
+	SavedSearchLimit error = ServerAnswer{
+		Code:    http.StatusConflict,
+		Message: "too many saved searches",
+	}
+
+	PromotionPaymentDuplicate error = ServerAnswer{
+		Code:    http.StatusConflict,
+		Message: "payment already processed",
+	}
+
+	PromotionUnderpaid error = ServerAnswer{
+		Code:    http.StatusConflict,
+		Message: "paid amount is less than package price",
+	}
+
+	InvalidSignature error = ServerAnswer{
+		Code:    http.StatusForbidden,
+		Message: "invalid notification signature",
+	}
+
 	// определяем ошибки уровня http
 	BadRequest error = ServerAnswer{
 		Code:    http.StatusBadRequest,
@@ -93,6 +218,11 @@ var (
 		Message: "unauthorized",
 	}
 
+	Forbidden error = ServerAnswer{
+		Code:    http.StatusForbidden,
+		Message: "forbidden",
+	}
+
 	CSRFErrorToken error = ServerAnswer{
 		Code:    http.StatusConflict,
 		Message: "bad csrf token",
@@ -0,0 +1,50 @@
+package error
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var httpToGRPC = map[int]codes.Code{
+	http.StatusBadRequest:          codes.InvalidArgument,
+	http.StatusUnauthorized:        codes.Unauthenticated,
+	http.StatusForbidden:           codes.PermissionDenied,
+	http.StatusNotFound:            codes.NotFound,
+	http.StatusConflict:            codes.Aborted,
+	http.StatusInternalServerError: codes.Internal,
+}
+
+// ToGRPCStatus переносит код и сообщение ошибки в статус grpc,
+// иначе на стороне клиента любая ошибка сервиса превращается во внутреннюю
+func ToGRPCStatus(err error) error {
+	answer, ok := err.(ServerAnswer)
+	if !ok {
+		return err
+	}
+
+	code, ok := httpToGRPC[answer.Code]
+	if !ok {
+		code = codes.Unknown
+	}
+	return status.Error(code, answer.Message)
+}
+
+// FromGRPCStatus восстанавливает ошибку, пришедшую от сервиса по grpc
+func FromGRPCStatus(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() == codes.Unknown {
+		return err
+	}
+
+	for httpCode, grpcCode := range httpToGRPC {
+		if grpcCode == st.Code() {
+			return ServerAnswer{
+				Code:    httpCode,
+				Message: st.Message(),
+			}
+		}
+	}
+	return err
+}
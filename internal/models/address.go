@@ -0,0 +1,39 @@
+package models
+
+import "strings"
+
+const (
+	DeliveryPickup  string = "pickup"
+	DeliveryCourier string = "courier"
+	DeliveryPost    string = "post"
+)
+
+//easyjson:json
+type Address struct {
+	Id         int64  `json:"id" valid:"-" swaggerignore:"true"`
+	UserId     int64  `json:"user_id" valid:"-" swaggerignore:"true"`
+	Title      string `json:"title" valid:"optional,type(string),stringlength(0|50)" example:"home"`
+	Recipient  string `json:"recipient" valid:"type(string),stringlength(1|100)" example:"Ivan Ivanov"`
+	Phone      string `json:"phone" valid:"type(string),stringlength(1|20)" example:"+79990000000"`
+	City       string `json:"city" valid:"type(string),stringlength(1|100)" example:"Moscow"`
+	Street     string `json:"street" valid:"type(string),stringlength(1|200)" example:"Tverskaya st, 1, apt 1"`
+	PostalCode string `json:"postal_code" valid:"optional,numeric,stringlength(6|6)" example:"125009"`
+}
+
+// адрес одной строкой, в таком виде он сохраняется в заказе
+func (a *Address) String() string {
+	parts := []string{a.Recipient, a.Phone}
+	if a.PostalCode != "" {
+		parts = append(parts, a.PostalCode)
+	}
+	parts = append(parts, a.City, a.Street)
+	return strings.Join(parts, ", ")
+}
+
+//easyjson:json
+type DeliveryChoice struct {
+	Method    string `json:"method" valid:"in(pickup|courier|post)" example:"courier"`
+	AddressId int64  `json:"address_id" valid:"optional" example:"1"`
+	// оплата через площадку с удержанием денег до получения заказа
+	SafeDeal bool `json:"safe_deal" valid:"optional" example:"true"`
+}
@@ -0,0 +1,214 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonF4fdf71eDecodeYulaInternalModels(in *jlexer.Lexer, out *DeliveryChoice) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "method":
+			out.Method = string(in.String())
+		case "address_id":
+			out.AddressId = int64(in.Int64())
+		case "safe_deal":
+			out.SafeDeal = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF4fdf71eEncodeYulaInternalModels(out *jwriter.Writer, in DeliveryChoice) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"method\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Method))
+	}
+	{
+		const prefix string = ",\"address_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AddressId))
+	}
+	{
+		const prefix string = ",\"safe_deal\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.SafeDeal))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DeliveryChoice) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF4fdf71eEncodeYulaInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeliveryChoice) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF4fdf71eEncodeYulaInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DeliveryChoice) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF4fdf71eDecodeYulaInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeliveryChoice) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF4fdf71eDecodeYulaInternalModels(l, v)
+}
+func easyjsonF4fdf71eDecodeYulaInternalModels1(in *jlexer.Lexer, out *Address) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = int64(in.Int64())
+		case "user_id":
+			out.UserId = int64(in.Int64())
+		case "title":
+			out.Title = string(in.String())
+		case "recipient":
+			out.Recipient = string(in.String())
+		case "phone":
+			out.Phone = string(in.String())
+		case "city":
+			out.City = string(in.String())
+		case "street":
+			out.Street = string(in.String())
+		case "postal_code":
+			out.PostalCode = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF4fdf71eEncodeYulaInternalModels1(out *jwriter.Writer, in Address) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Id))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UserId))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"recipient\":"
+		out.RawString(prefix)
+		out.String(string(in.Recipient))
+	}
+	{
+		const prefix string = ",\"phone\":"
+		out.RawString(prefix)
+		out.String(string(in.Phone))
+	}
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		out.String(string(in.City))
+	}
+	{
+		const prefix string = ",\"street\":"
+		out.RawString(prefix)
+		out.String(string(in.Street))
+	}
+	{
+		const prefix string = ",\"postal_code\":"
+		out.RawString(prefix)
+		out.String(string(in.PostalCode))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Address) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF4fdf71eEncodeYulaInternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Address) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF4fdf71eEncodeYulaInternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Address) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF4fdf71eDecodeYulaInternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Address) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF4fdf71eDecodeYulaInternalModels1(l, v)
+}
@@ -6,6 +6,19 @@ import (
 	internalError "yula/internal/error"
 )
 
+const (
+	AdvertStatusDraft         string = "draft"
+	AdvertStatusPendingReview string = "pending_review"
+	AdvertStatusPublished     string = "published"
+	AdvertStatusRejected      string = "rejected"
+	AdvertStatusClosed        string = "closed"
+
+	CloseReasonManual    string = "manual"
+	CloseReasonSoldOut   string = "sold_out"
+	CloseReasonExpired   string = "expired"
+	CloseReasonModerator string = "moderator"
+)
+
 type Advert struct {
 	Id          int64     `json:"id" valid:"-" swaggerignore:"true"`
 	Name        string    `json:"name" valid:"type(string),stringlength(1|100)" example:"anime's t-shirt"`
@@ -24,6 +37,61 @@ type Advert struct {
 	Amount      int64     `json:"amount" valid:"int,optional" swaggerignore:"true"`
 	IsNew       bool      `json:"is_new" valid:"optional"`
 	PromoLevel  int64     `json:"promo_level" valid:"optional,numeric"`
+
+	// срок продвижения, оставшиеся секунды показываются только владельцу объявления
+	PromoUntil time.Time `json:"-" valid:"-"`
+	PromoLeft  int64     `json:"promo_left,omitempty" valid:"-" swaggerignore:"true"`
+
+	// цена, ниже которой пользователь ждет уведомления, заполняется только в списке избранного
+	TargetPrice int64 `json:"target_price,omitempty" valid:"-" swaggerignore:"true"`
+
+	DeliveryPickup  bool `json:"delivery_pickup" valid:"optional"`
+	DeliveryCourier bool `json:"delivery_courier" valid:"optional"`
+	DeliveryPost    bool `json:"delivery_post" valid:"optional"`
+
+	// в ленте и поиске показываются только опубликованные объявления,
+	// при создании можно оставить объявление черновиком, передав status = draft
+	Status           string `json:"status" valid:"-" example:"pending_review"`
+	ModerationReason string `json:"moderation_reason,omitempty" valid:"-" swaggerignore:"true"`
+
+	// причина закрытия показывается в архиве: вручную, распродано или истек срок
+	CloseReason string `json:"close_reason,omitempty" valid:"-" swaggerignore:"true"`
+	// закрытое объявление изменили после проверки, продление отправит его на модерацию
+	NeedsReview bool `json:"needs_review,omitempty" valid:"-" swaggerignore:"true"`
+
+	// значения полей схемы категории, проверяются при создании и изменении объявления
+	Attributes AdvertAttributes `json:"attributes,omitempty" valid:"-"`
+}
+
+// SetPromoLeft считает, сколько секунд осталось до конца продвижения
+func (a *Advert) SetPromoLeft(now time.Time) {
+	a.PromoLeft = 0
+	if a.PromoLevel > 0 && a.PromoUntil.After(now) {
+		a.PromoLeft = int64(a.PromoUntil.Sub(now).Seconds())
+	}
+}
+
+// IsVisible сообщает, можно ли показывать объявление не его владельцу
+func (a *Advert) IsVisible() bool {
+	return a.Status == AdvertStatusPublished || a.Status == AdvertStatusClosed
+}
+
+// объявления без указанных способов доставки считаются доступными только для самовывоза
+func (a *Advert) SupportsDelivery(method string) bool {
+	if !a.DeliveryPickup && !a.DeliveryCourier && !a.DeliveryPost {
+		return method == DeliveryPickup
+	}
+
+	switch method {
+	case DeliveryPickup:
+		return a.DeliveryPickup
+	case DeliveryCourier:
+		return a.DeliveryCourier
+	case DeliveryPost:
+		return a.DeliveryPost
+	default:
+		return false
+	}
 }
 
 type AdvertShort struct {
@@ -33,6 +101,7 @@ type AdvertShort struct {
 	Location   string `json:"location" example:"Moscow"`
 	Image      string `json:"image" example:"/static/advert_images/default_image.png"`
 	PromoLevel int64  `json:"promo_level" valid:"optional,numeric"`
+	PromoLeft  int64  `json:"promo_left,omitempty"`
 }
 
 func (a *Advert) ToShort() *AdvertShort {
@@ -44,6 +113,7 @@ func (a *Advert) ToShort() *AdvertShort {
 	}
 	return &AdvertShort{
 		Id: a.Id, Name: a.Name, Price: a.Price, Location: a.Location, Image: imageStr, PromoLevel: a.PromoLevel,
+		PromoLeft: a.PromoLeft,
 	}
 }
 
@@ -88,8 +158,14 @@ type AdvertPrice struct {
 	ChangeTime time.Time `json:"change_time" valid:"-"`
 }
 
+type ModerationDecision struct {
+	Reason string `json:"reason" valid:"type(string),stringlength(1|500),required" example:"prohibited goods"`
+}
+
 type Promotion struct {
 	AdvertId   int64     `json:"advert_id" valid:"-"`
+	Package    string    `json:"package" valid:"-"`
 	PromoLevel int64     `json:"promo_level" valid:"numeric"`
 	UpdateTime time.Time `json:"promo_updated" valid:"-"`
+	PromoUntil time.Time `json:"promo_until" valid:"-"`
 }
@@ -38,12 +38,18 @@ func easyjsonA66148a6DecodeYulaInternalModels(in *jlexer.Lexer, out *Promotion)
 		switch key {
 		case "advert_id":
 			out.AdvertId = int64(in.Int64())
+		case "package":
+			out.Package = string(in.String())
 		case "promo_level":
 			out.PromoLevel = int64(in.Int64())
 		case "promo_updated":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.UpdateTime).UnmarshalJSON(data))
 			}
+		case "promo_until":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PromoUntil).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -63,6 +69,11 @@ func easyjsonA66148a6EncodeYulaInternalModels(out *jwriter.Writer, in Promotion)
 		out.RawString(prefix[1:])
 		out.Int64(int64(in.AdvertId))
 	}
+	{
+		const prefix string = ",\"package\":"
+		out.RawString(prefix)
+		out.String(string(in.Package))
+	}
 	{
 		const prefix string = ",\"promo_level\":"
 		out.RawString(prefix)
@@ -73,6 +84,11 @@ func easyjsonA66148a6EncodeYulaInternalModels(out *jwriter.Writer, in Promotion)
 		out.RawString(prefix)
 		out.Raw((in.UpdateTime).MarshalJSON())
 	}
+	{
+		const prefix string = ",\"promo_until\":"
+		out.RawString(prefix)
+		out.Raw((in.PromoUntil).MarshalJSON())
+	}
 	out.RawByte('}')
 }
 
@@ -172,7 +188,73 @@ func (v *Page) UnmarshalJSON(data []byte) error {
 func (v *Page) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonA66148a6DecodeYulaInternalModels1(l, v)
 }
-func easyjsonA66148a6DecodeYulaInternalModels2(in *jlexer.Lexer, out *AdvertShort) {
+func easyjsonA66148a6DecodeYulaInternalModels2(in *jlexer.Lexer, out *ModerationDecision) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "reason":
+			out.Reason = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA66148a6EncodeYulaInternalModels2(out *jwriter.Writer, in ModerationDecision) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Reason))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModerationDecision) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA66148a6EncodeYulaInternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModerationDecision) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA66148a6EncodeYulaInternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModerationDecision) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA66148a6DecodeYulaInternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModerationDecision) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA66148a6DecodeYulaInternalModels2(l, v)
+}
+func easyjsonA66148a6DecodeYulaInternalModels3(in *jlexer.Lexer, out *AdvertShort) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -203,6 +285,8 @@ func easyjsonA66148a6DecodeYulaInternalModels2(in *jlexer.Lexer, out *AdvertShor
 			out.Image = string(in.String())
 		case "promo_level":
 			out.PromoLevel = int64(in.Int64())
+		case "promo_left":
+			out.PromoLeft = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -213,7 +297,7 @@ func easyjsonA66148a6DecodeYulaInternalModels2(in *jlexer.Lexer, out *AdvertShor
 		in.Consumed()
 	}
 }
-func easyjsonA66148a6EncodeYulaInternalModels2(out *jwriter.Writer, in AdvertShort) {
+func easyjsonA66148a6EncodeYulaInternalModels3(out *jwriter.Writer, in AdvertShort) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -247,33 +331,38 @@ func easyjsonA66148a6EncodeYulaInternalModels2(out *jwriter.Writer, in AdvertSho
 		out.RawString(prefix)
 		out.Int64(int64(in.PromoLevel))
 	}
+	if in.PromoLeft != 0 {
+		const prefix string = ",\"promo_left\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PromoLeft))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v AdvertShort) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonA66148a6EncodeYulaInternalModels2(&w, v)
+	easyjsonA66148a6EncodeYulaInternalModels3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AdvertShort) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonA66148a6EncodeYulaInternalModels2(w, v)
+	easyjsonA66148a6EncodeYulaInternalModels3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AdvertShort) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonA66148a6DecodeYulaInternalModels2(&r, v)
+	easyjsonA66148a6DecodeYulaInternalModels3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AdvertShort) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonA66148a6DecodeYulaInternalModels2(l, v)
+	easyjsonA66148a6DecodeYulaInternalModels3(l, v)
 }
-func easyjsonA66148a6DecodeYulaInternalModels3(in *jlexer.Lexer, out *AdvertPrice) {
+func easyjsonA66148a6DecodeYulaInternalModels4(in *jlexer.Lexer, out *AdvertPrice) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -310,7 +399,7 @@ func easyjsonA66148a6DecodeYulaInternalModels3(in *jlexer.Lexer, out *AdvertPric
 		in.Consumed()
 	}
 }
-func easyjsonA66148a6EncodeYulaInternalModels3(out *jwriter.Writer, in AdvertPrice) {
+func easyjsonA66148a6EncodeYulaInternalModels4(out *jwriter.Writer, in AdvertPrice) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -335,27 +424,27 @@ func easyjsonA66148a6EncodeYulaInternalModels3(out *jwriter.Writer, in AdvertPri
 // MarshalJSON supports json.Marshaler interface
 func (v AdvertPrice) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonA66148a6EncodeYulaInternalModels3(&w, v)
+	easyjsonA66148a6EncodeYulaInternalModels4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AdvertPrice) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonA66148a6EncodeYulaInternalModels3(w, v)
+	easyjsonA66148a6EncodeYulaInternalModels4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AdvertPrice) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonA66148a6DecodeYulaInternalModels3(&r, v)
+	easyjsonA66148a6DecodeYulaInternalModels4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AdvertPrice) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonA66148a6DecodeYulaInternalModels3(l, v)
+	easyjsonA66148a6DecodeYulaInternalModels4(l, v)
 }
-func easyjsonA66148a6DecodeYulaInternalModels4(in *jlexer.Lexer, out *AdvertImages) {
+func easyjsonA66148a6DecodeYulaInternalModels5(in *jlexer.Lexer, out *AdvertImages) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -407,7 +496,7 @@ func easyjsonA66148a6DecodeYulaInternalModels4(in *jlexer.Lexer, out *AdvertImag
 		in.Consumed()
 	}
 }
-func easyjsonA66148a6EncodeYulaInternalModels4(out *jwriter.Writer, in AdvertImages) {
+func easyjsonA66148a6EncodeYulaInternalModels5(out *jwriter.Writer, in AdvertImages) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -433,27 +522,27 @@ func easyjsonA66148a6EncodeYulaInternalModels4(out *jwriter.Writer, in AdvertIma
 // MarshalJSON supports json.Marshaler interface
 func (v AdvertImages) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonA66148a6EncodeYulaInternalModels4(&w, v)
+	easyjsonA66148a6EncodeYulaInternalModels5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AdvertImages) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonA66148a6EncodeYulaInternalModels4(w, v)
+	easyjsonA66148a6EncodeYulaInternalModels5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AdvertImages) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonA66148a6DecodeYulaInternalModels4(&r, v)
+	easyjsonA66148a6DecodeYulaInternalModels5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AdvertImages) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonA66148a6DecodeYulaInternalModels4(l, v)
+	easyjsonA66148a6DecodeYulaInternalModels5(l, v)
 }
-func easyjsonA66148a6DecodeYulaInternalModels5(in *jlexer.Lexer, out *Advert) {
+func easyjsonA66148a6DecodeYulaInternalModels6(in *jlexer.Lexer, out *Advert) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -531,6 +620,26 @@ func easyjsonA66148a6DecodeYulaInternalModels5(in *jlexer.Lexer, out *Advert) {
 			out.IsNew = bool(in.Bool())
 		case "promo_level":
 			out.PromoLevel = int64(in.Int64())
+		case "promo_left":
+			out.PromoLeft = int64(in.Int64())
+		case "target_price":
+			out.TargetPrice = int64(in.Int64())
+		case "delivery_pickup":
+			out.DeliveryPickup = bool(in.Bool())
+		case "delivery_courier":
+			out.DeliveryCourier = bool(in.Bool())
+		case "delivery_post":
+			out.DeliveryPost = bool(in.Bool())
+		case "status":
+			out.Status = string(in.String())
+		case "moderation_reason":
+			out.ModerationReason = string(in.String())
+		case "close_reason":
+			out.CloseReason = string(in.String())
+		case "needs_review":
+			out.NeedsReview = bool(in.Bool())
+		case "attributes":
+			(out.Attributes).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -541,7 +650,7 @@ func easyjsonA66148a6DecodeYulaInternalModels5(in *jlexer.Lexer, out *Advert) {
 		in.Consumed()
 	}
 }
-func easyjsonA66148a6EncodeYulaInternalModels5(out *jwriter.Writer, in Advert) {
+func easyjsonA66148a6EncodeYulaInternalModels6(out *jwriter.Writer, in Advert) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -641,29 +750,79 @@ func easyjsonA66148a6EncodeYulaInternalModels5(out *jwriter.Writer, in Advert) {
 		out.RawString(prefix)
 		out.Int64(int64(in.PromoLevel))
 	}
+	if in.PromoLeft != 0 {
+		const prefix string = ",\"promo_left\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PromoLeft))
+	}
+	if in.TargetPrice != 0 {
+		const prefix string = ",\"target_price\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TargetPrice))
+	}
+	{
+		const prefix string = ",\"delivery_pickup\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.DeliveryPickup))
+	}
+	{
+		const prefix string = ",\"delivery_courier\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.DeliveryCourier))
+	}
+	{
+		const prefix string = ",\"delivery_post\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.DeliveryPost))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.ModerationReason != "" {
+		const prefix string = ",\"moderation_reason\":"
+		out.RawString(prefix)
+		out.String(string(in.ModerationReason))
+	}
+	if in.CloseReason != "" {
+		const prefix string = ",\"close_reason\":"
+		out.RawString(prefix)
+		out.String(string(in.CloseReason))
+	}
+	if in.NeedsReview {
+		const prefix string = ",\"needs_review\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.NeedsReview))
+	}
+	if len(in.Attributes) != 0 {
+		const prefix string = ",\"attributes\":"
+		out.RawString(prefix)
+		(in.Attributes).MarshalEasyJSON(out)
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v Advert) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonA66148a6EncodeYulaInternalModels5(&w, v)
+	easyjsonA66148a6EncodeYulaInternalModels6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Advert) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonA66148a6EncodeYulaInternalModels5(w, v)
+	easyjsonA66148a6EncodeYulaInternalModels6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Advert) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonA66148a6DecodeYulaInternalModels5(&r, v)
+	easyjsonA66148a6DecodeYulaInternalModels6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Advert) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonA66148a6DecodeYulaInternalModels5(l, v)
+	easyjsonA66148a6DecodeYulaInternalModels6(l, v)
 }
@@ -1,10 +1,14 @@
 package models
 
+import "time"
+
 //easyjson:json
 type Cart struct {
-	UserId   int64 `json:"user_id" example:"1"`
-	AdvertId int64 `json:"advert_id" example:"1"`
-	Amount   int64 `json:"amount" example:"1"`
+	UserId        int64      `json:"user_id" example:"1"`
+	AdvertId      int64      `json:"advert_id" example:"1"`
+	Amount        int64      `json:"amount" example:"1"`
+	ReservedUntil *time.Time `json:"reserved_until,omitempty" swaggerignore:"true"`
+	CouponId      int64      `json:"coupon_id,omitempty" example:"1"`
 }
 
 //easyjson:json
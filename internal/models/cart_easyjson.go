@@ -7,6 +7,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -232,6 +233,20 @@ func easyjsonDdb0949aDecodeYulaInternalModels2(in *jlexer.Lexer, out *Cart) {
 			out.AdvertId = int64(in.Int64())
 		case "amount":
 			out.Amount = int64(in.Int64())
+		case "reserved_until":
+			if in.IsNull() {
+				in.Skip()
+				out.ReservedUntil = nil
+			} else {
+				if out.ReservedUntil == nil {
+					out.ReservedUntil = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ReservedUntil).UnmarshalJSON(data))
+				}
+			}
+		case "coupon_id":
+			out.CouponId = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -261,6 +276,16 @@ func easyjsonDdb0949aEncodeYulaInternalModels2(out *jwriter.Writer, in Cart) {
 		out.RawString(prefix)
 		out.Int64(int64(in.Amount))
 	}
+	if in.ReservedUntil != nil {
+		const prefix string = ",\"reserved_until\":"
+		out.RawString(prefix)
+		out.Raw((*in.ReservedUntil).MarshalJSON())
+	}
+	if in.CouponId != 0 {
+		const prefix string = ",\"coupon_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CouponId))
+	}
 	out.RawByte('}')
 }
 
@@ -1,5 +1,222 @@
 package models
 
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	internalError "yula/internal/error"
+)
+
+// срок жизни объявлений категории по умолчанию, в днях
+const DefaultCategoryLifetimeDays = 30
+
+const (
+	DefaultTrendingCategories int64 = 10
+	MaxTrendingCategories     int64 = 50
+	// популярность категории считается по объявлениям, опубликованным за это число дней
+	TrendingCategoriesDays int64 = 7
+)
+
+const (
+	AttributeEnum    string = "enum"
+	AttributeNumber  string = "number"
+	AttributeRange   string = "range"
+	AttributeBoolean string = "boolean"
+
+	// суффиксы фильтров по границам числового атрибута: attr.year_from, attr.year_to
+	AttributeFromSuffix string = "_from"
+	AttributeToSuffix   string = "_to"
+)
+
+// Category - узел дерева категорий, ParentId = 0 у корневых,
+// Children заполняется только при построении дерева
 type Category struct {
-	Name string `json:"name"`
+	Id        int64  `json:"id,omitempty" valid:"-"`
+	ParentId  int64  `json:"parent_id,omitempty" valid:"-"`
+	Name      string `json:"name" valid:"type(string),stringlength(1|50),required" example:"clothes"`
+	Slug      string `json:"slug" valid:"matches(^[a-z0-9]+(-[a-z0-9]+)*$),stringlength(1|50),required" example:"clothes"`
+	Icon      string `json:"icon,omitempty" valid:"optional,stringlength(0|200)" example:"t-shirt.svg"`
+	SortOrder int64  `json:"sort_order" valid:"optional" example:"1"`
+
+	LifetimeDays int64 `json:"lifetime_days,omitempty" valid:"range(1|365),optional" example:"30"`
+
+	// активные объявления категории вместе с подкатегориями
+	AdvertsCount int64 `json:"adverts_count" valid:"-" example:"1243"`
+
+	Children []*Category `json:"children,omitempty" valid:"-"`
+}
+
+type CategoryMove struct {
+	// 0 - сделать категорию корневой
+	ParentId int64 `json:"parent_id" valid:"optional" example:"1"`
+}
+
+// BuildCategoryTree собирает дерево из плоского списка, порядок детей сохраняется как в списке,
+// категории с несуществующим родителем считаются корневыми
+func BuildCategoryTree(categories []*Category) []*Category {
+	byId := make(map[int64]*Category, len(categories))
+	for _, category := range categories {
+		category.Children = nil
+		byId[category.Id] = category
+	}
+
+	roots := make([]*Category, 0)
+	for _, category := range categories {
+		parent, ok := byId[category.ParentId]
+		if category.ParentId == 0 || !ok {
+			roots = append(roots, category)
+			continue
+		}
+		parent.Children = append(parent.Children, category)
+	}
+	return roots
+}
+
+// CountCategoryAdverts проставляет категориям число объявлений вместе с подкатегориями,
+// counts - число объявлений непосредственно в категории, Children не трогается
+func CountCategoryAdverts(categories []*Category, counts map[int64]int64) {
+	byId := make(map[int64]*Category, len(categories))
+	for _, category := range categories {
+		category.AdvertsCount = 0
+		byId[category.Id] = category
+	}
+
+	for _, category := range categories {
+		count := counts[category.Id]
+		if count == 0 {
+			continue
+		}
+		// глубина дерева не больше числа категорий, это защищает от битых данных с циклом
+		node := category
+		for depth := 0; node != nil && depth < len(categories); depth++ {
+			node.AdvertsCount += count
+			node = byId[node.ParentId]
+		}
+	}
+}
+
+// CategoriesVersion - отпечаток списка категорий вместе с детьми и счетчиками,
+// совпадает у одинаковых списков и служит ETag для клиентов
+func CategoriesVersion(categories []*Category) string {
+	hash := sha1.New()
+	var write func(categories []*Category)
+	write = func(categories []*Category) {
+		for _, category := range categories {
+			fmt.Fprintf(hash, "%d|%d|%q|%q|%q|%d|%d|%d|%d[", category.Id, category.ParentId, category.Name, category.Slug,
+				category.Icon, category.SortOrder, category.LifetimeDays, category.AdvertsCount, len(category.Children))
+			write(category.Children)
+			fmt.Fprint(hash, "]")
+		}
+	}
+	write(categories)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// CategoryAttribute - поле схемы категории,
+// для enum допустимые значения перечислены в Values, значение range лежит в пределах [Min, Max]
+type CategoryAttribute struct {
+	Name     string   `json:"name" valid:"matches(^[a-z][a-z0-9_]*$),stringlength(1|30),required" example:"size"`
+	Type     string   `json:"type" valid:"in(enum|number|range|boolean),required" example:"enum"`
+	Required bool     `json:"required" valid:"optional" example:"true"`
+	Values   []string `json:"values,omitempty" valid:"-" example:"S,M,L"`
+	Min      float64  `json:"min,omitempty" valid:"-"`
+	Max      float64  `json:"max,omitempty" valid:"-"`
+}
+
+//easyjson:json
+type CategoryAttributeList []*CategoryAttribute
+
+type CategoryAttributes struct {
+	Attributes CategoryAttributeList `json:"attributes" valid:"-"`
+}
+
+// CheckSchema проверяет, что схема непротиворечива: имена не повторяются
+// и не конфликтуют с фильтрами по границам, у enum есть значения, у range корректные пределы
+func (ca *CategoryAttributes) CheckSchema() bool {
+	names := make(map[string]bool)
+	for _, attribute := range ca.Attributes {
+		if names[attribute.Name] ||
+			strings.HasSuffix(attribute.Name, AttributeFromSuffix) || strings.HasSuffix(attribute.Name, AttributeToSuffix) {
+			return false
+		}
+		names[attribute.Name] = true
+
+		switch attribute.Type {
+		case AttributeEnum:
+			if len(attribute.Values) == 0 {
+				return false
+			}
+		case AttributeRange:
+			if attribute.Min >= attribute.Max {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+//easyjson:json
+type AdvertAttributes map[string]string
+
+// ValidateAttributes сверяет атрибуты объявления со схемой категории,
+// числа и логические значения приводятся к единому виду, чтобы по ним можно было фильтровать
+func ValidateAttributes(schema CategoryAttributeList, values AdvertAttributes) error {
+	known := make(map[string]bool)
+	for _, attribute := range schema {
+		known[attribute.Name] = true
+
+		value, ok := values[attribute.Name]
+		if !ok || value == "" {
+			if attribute.Required {
+				return internalError.InvalidAttributes
+			}
+			delete(values, attribute.Name)
+			continue
+		}
+
+		normalized, ok := attribute.normalize(value)
+		if !ok {
+			return internalError.InvalidAttributes
+		}
+		values[attribute.Name] = normalized
+	}
+
+	for name := range values {
+		if !known[name] {
+			return internalError.InvalidAttributes
+		}
+	}
+	return nil
+}
+
+func (ca *CategoryAttribute) normalize(value string) (string, bool) {
+	switch ca.Type {
+	case AttributeEnum:
+		for _, allowed := range ca.Values {
+			if value == allowed {
+				return value, true
+			}
+		}
+		return "", false
+	case AttributeNumber, AttributeRange:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+			return "", false
+		}
+		if ca.Type == AttributeRange && (number < ca.Min || number > ca.Max) {
+			return "", false
+		}
+		return strconv.FormatFloat(number, 'f', -1, 64), true
+	case AttributeBoolean:
+		flag, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", false
+		}
+		return strconv.FormatBool(flag), true
+	default:
+		return "", false
+	}
 }
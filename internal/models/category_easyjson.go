@@ -17,7 +17,217 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson6a91a67cDecodeYulaInternalModels(in *jlexer.Lexer, out *Category) {
+func easyjson6a91a67cDecodeYulaInternalModels(in *jlexer.Lexer, out *CategoryMove) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "parent_id":
+			out.ParentId = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a91a67cEncodeYulaInternalModels(out *jwriter.Writer, in CategoryMove) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"parent_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ParentId))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CategoryMove) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a91a67cEncodeYulaInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CategoryMove) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a91a67cEncodeYulaInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CategoryMove) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a91a67cDecodeYulaInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CategoryMove) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a91a67cDecodeYulaInternalModels(l, v)
+}
+func easyjson6a91a67cDecodeYulaInternalModels1(in *jlexer.Lexer, out *CategoryAttributes) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attributes":
+			(out.Attributes).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a91a67cEncodeYulaInternalModels1(out *jwriter.Writer, in CategoryAttributes) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attributes\":"
+		out.RawString(prefix[1:])
+		(in.Attributes).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CategoryAttributes) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a91a67cEncodeYulaInternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CategoryAttributes) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a91a67cEncodeYulaInternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CategoryAttributes) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a91a67cDecodeYulaInternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CategoryAttributes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a91a67cDecodeYulaInternalModels1(l, v)
+}
+func easyjson6a91a67cDecodeYulaInternalModels2(in *jlexer.Lexer, out *CategoryAttributeList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(CategoryAttributeList, 0, 8)
+			} else {
+				*out = CategoryAttributeList{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v1 *CategoryAttribute
+			if in.IsNull() {
+				in.Skip()
+				v1 = nil
+			} else {
+				if v1 == nil {
+					v1 = new(CategoryAttribute)
+				}
+				(*v1).UnmarshalEasyJSON(in)
+			}
+			*out = append(*out, v1)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a91a67cEncodeYulaInternalModels2(out *jwriter.Writer, in CategoryAttributeList) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			if v3 == nil {
+				out.RawString("null")
+			} else {
+				(*v3).MarshalEasyJSON(out)
+			}
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CategoryAttributeList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a91a67cEncodeYulaInternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CategoryAttributeList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a91a67cEncodeYulaInternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CategoryAttributeList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a91a67cDecodeYulaInternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CategoryAttributeList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a91a67cDecodeYulaInternalModels2(l, v)
+}
+func easyjson6a91a67cDecodeYulaInternalModels3(in *jlexer.Lexer, out *CategoryAttribute) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -38,6 +248,37 @@ func easyjson6a91a67cDecodeYulaInternalModels(in *jlexer.Lexer, out *Category) {
 		switch key {
 		case "name":
 			out.Name = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "required":
+			out.Required = bool(in.Bool())
+		case "values":
+			if in.IsNull() {
+				in.Skip()
+				out.Values = nil
+			} else {
+				in.Delim('[')
+				if out.Values == nil {
+					if !in.IsDelim(']') {
+						out.Values = make([]string, 0, 4)
+					} else {
+						out.Values = []string{}
+					}
+				} else {
+					out.Values = (out.Values)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 string
+					v4 = string(in.String())
+					out.Values = append(out.Values, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "min":
+			out.Min = float64(in.Float64())
+		case "max":
+			out.Max = float64(in.Float64())
 		default:
 			in.SkipRecursive()
 		}
@@ -48,7 +289,7 @@ func easyjson6a91a67cDecodeYulaInternalModels(in *jlexer.Lexer, out *Category) {
 		in.Consumed()
 	}
 }
-func easyjson6a91a67cEncodeYulaInternalModels(out *jwriter.Writer, in Category) {
+func easyjson6a91a67cEncodeYulaInternalModels3(out *jwriter.Writer, in CategoryAttribute) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -57,29 +298,302 @@ func easyjson6a91a67cEncodeYulaInternalModels(out *jwriter.Writer, in Category)
 		out.RawString(prefix[1:])
 		out.String(string(in.Name))
 	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"required\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Required))
+	}
+	if len(in.Values) != 0 {
+		const prefix string = ",\"values\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.Values {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v6))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Min != 0 {
+		const prefix string = ",\"min\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Min))
+	}
+	if in.Max != 0 {
+		const prefix string = ",\"max\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Max))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CategoryAttribute) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a91a67cEncodeYulaInternalModels3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CategoryAttribute) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a91a67cEncodeYulaInternalModels3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CategoryAttribute) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a91a67cDecodeYulaInternalModels3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CategoryAttribute) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a91a67cDecodeYulaInternalModels3(l, v)
+}
+func easyjson6a91a67cDecodeYulaInternalModels4(in *jlexer.Lexer, out *Category) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = int64(in.Int64())
+		case "parent_id":
+			out.ParentId = int64(in.Int64())
+		case "name":
+			out.Name = string(in.String())
+		case "slug":
+			out.Slug = string(in.String())
+		case "icon":
+			out.Icon = string(in.String())
+		case "sort_order":
+			out.SortOrder = int64(in.Int64())
+		case "lifetime_days":
+			out.LifetimeDays = int64(in.Int64())
+		case "adverts_count":
+			out.AdvertsCount = int64(in.Int64())
+		case "children":
+			if in.IsNull() {
+				in.Skip()
+				out.Children = nil
+			} else {
+				in.Delim('[')
+				if out.Children == nil {
+					if !in.IsDelim(']') {
+						out.Children = make([]*Category, 0, 8)
+					} else {
+						out.Children = []*Category{}
+					}
+				} else {
+					out.Children = (out.Children)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 *Category
+					if in.IsNull() {
+						in.Skip()
+						v7 = nil
+					} else {
+						if v7 == nil {
+							v7 = new(Category)
+						}
+						(*v7).UnmarshalEasyJSON(in)
+					}
+					out.Children = append(out.Children, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a91a67cEncodeYulaInternalModels4(out *jwriter.Writer, in Category) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Id != 0 {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Id))
+	}
+	if in.ParentId != 0 {
+		const prefix string = ",\"parent_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.ParentId))
+	}
+	{
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"slug\":"
+		out.RawString(prefix)
+		out.String(string(in.Slug))
+	}
+	if in.Icon != "" {
+		const prefix string = ",\"icon\":"
+		out.RawString(prefix)
+		out.String(string(in.Icon))
+	}
+	{
+		const prefix string = ",\"sort_order\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.SortOrder))
+	}
+	if in.LifetimeDays != 0 {
+		const prefix string = ",\"lifetime_days\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LifetimeDays))
+	}
+	{
+		const prefix string = ",\"adverts_count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AdvertsCount))
+	}
+	if len(in.Children) != 0 {
+		const prefix string = ",\"children\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v8, v9 := range in.Children {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				if v9 == nil {
+					out.RawString("null")
+				} else {
+					(*v9).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v Category) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a91a67cEncodeYulaInternalModels(&w, v)
+	easyjson6a91a67cEncodeYulaInternalModels4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Category) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a91a67cEncodeYulaInternalModels(w, v)
+	easyjson6a91a67cEncodeYulaInternalModels4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Category) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a91a67cDecodeYulaInternalModels(&r, v)
+	easyjson6a91a67cDecodeYulaInternalModels4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Category) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a91a67cDecodeYulaInternalModels(l, v)
+	easyjson6a91a67cDecodeYulaInternalModels4(l, v)
+}
+func easyjson6a91a67cDecodeYulaInternalModels5(in *jlexer.Lexer, out *AdvertAttributes) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+	} else {
+		in.Delim('{')
+		*out = make(AdvertAttributes)
+		for !in.IsDelim('}') {
+			key := string(in.String())
+			in.WantColon()
+			var v10 string
+			v10 = string(in.String())
+			(*out)[key] = v10
+			in.WantComma()
+		}
+		in.Delim('}')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a91a67cEncodeYulaInternalModels5(out *jwriter.Writer, in AdvertAttributes) {
+	if in == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+		out.RawString(`null`)
+	} else {
+		out.RawByte('{')
+		v11First := true
+		for v11Name, v11Value := range in {
+			if v11First {
+				v11First = false
+			} else {
+				out.RawByte(',')
+			}
+			out.String(string(v11Name))
+			out.RawByte(':')
+			out.String(string(v11Value))
+		}
+		out.RawByte('}')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AdvertAttributes) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a91a67cEncodeYulaInternalModels5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AdvertAttributes) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a91a67cEncodeYulaInternalModels5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AdvertAttributes) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a91a67cDecodeYulaInternalModels5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AdvertAttributes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a91a67cDecodeYulaInternalModels5(l, v)
 }
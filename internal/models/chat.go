@@ -11,6 +11,7 @@ type IMessage struct {
 }
 
 type Message struct {
+	Id int64    `json:"id,omitempty" valid:"-"`
 	MI IMessage `json:"info"`
 
 	Msg string `json:"message" valid:"type(string)"`
@@ -36,6 +36,8 @@ func easyjson9b8f5552DecodeYulaInternalModels(in *jlexer.Lexer, out *Message) {
 			continue
 		}
 		switch key {
+		case "id":
+			out.Id = int64(in.Int64())
 		case "info":
 			(out.MI).UnmarshalEasyJSON(in)
 		case "message":
@@ -58,9 +60,20 @@ func easyjson9b8f5552EncodeYulaInternalModels(out *jwriter.Writer, in Message) {
 	out.RawByte('{')
 	first := true
 	_ = first
+	if in.Id != 0 {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Id))
+	}
 	{
 		const prefix string = ",\"info\":"
-		out.RawString(prefix[1:])
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
 		(in.MI).MarshalEasyJSON(out)
 	}
 	{
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"time"
+	internalError "yula/internal/error"
+)
+
+const (
+	CouponKindPercent string = "percent"
+	CouponKindFixed   string = "fixed"
+)
+
+type Coupon struct {
+	Id         int64     `json:"id" example:"1"`
+	SalesmanId int64     `json:"salesman_id" example:"2"`
+	Code       string    `json:"code" example:"SALE10"`
+	Kind       string    `json:"kind" example:"percent"`
+	Value      int64     `json:"value" example:"10"`
+	MinPrice   int64     `json:"min_price" example:"1000"`
+	UsageLimit int64     `json:"usage_limit" example:"100"`
+	Used       int64     `json:"used" example:"0"`
+	ValidFrom  time.Time `json:"valid_from" swaggerignore:"true"`
+	ValidUntil time.Time `json:"valid_until" swaggerignore:"true"`
+	CreatedAt  time.Time `json:"created_at" swaggerignore:"true"`
+}
+
+type CouponInput struct {
+	Code       string    `json:"code" valid:"matches(^[A-Za-z0-9_-]+$),stringlength(3|32),required" example:"SALE10"`
+	Kind       string    `json:"kind" valid:"in(percent|fixed),required" example:"percent"`
+	Value      int64     `json:"value" valid:"required" example:"10"`
+	MinPrice   int64     `json:"min_price" valid:"optional" example:"1000"`
+	UsageLimit int64     `json:"usage_limit" valid:"optional" example:"100"`
+	ValidFrom  time.Time `json:"valid_from" swaggertype:"string" example:"2021-12-01T00:00:00Z"`
+	ValidUntil time.Time `json:"valid_until" swaggertype:"string" example:"2021-12-31T00:00:00Z"`
+}
+
+type CouponApply struct {
+	Code string `json:"code" valid:"type(string),stringlength(3|32),required" example:"SALE10"`
+}
+
+// Check проверяет, что купон можно применить к товару с ценой price в момент now
+func (c *Coupon) Check(price int64, now time.Time) error {
+	if now.Before(c.ValidFrom) || now.After(c.ValidUntil) {
+		return internalError.CouponExpired
+	}
+
+	// нулевой лимит означает купон без ограничения числа использований
+	if c.UsageLimit > 0 && c.Used >= c.UsageLimit {
+		return internalError.CouponExhausted
+	}
+
+	if price < c.MinPrice {
+		return internalError.CouponMinPrice
+	}
+
+	return nil
+}
+
+// Apply возвращает цену единицы товара со скидкой, цена не уходит ниже нуля
+func (c *Coupon) Apply(price int64) int64 {
+	discounted := price
+	switch c.Kind {
+	case CouponKindPercent:
+		discounted = price - price*c.Value/100
+	case CouponKindFixed:
+		discounted = price - c.Value
+	}
+
+	if discounted < 0 {
+		return 0
+	}
+	return discounted
+}
@@ -0,0 +1,339 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson1c3847aeDecodeYulaInternalModels(in *jlexer.Lexer, out *CouponInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = string(in.String())
+		case "kind":
+			out.Kind = string(in.String())
+		case "value":
+			out.Value = int64(in.Int64())
+		case "min_price":
+			out.MinPrice = int64(in.Int64())
+		case "usage_limit":
+			out.UsageLimit = int64(in.Int64())
+		case "valid_from":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ValidFrom).UnmarshalJSON(data))
+			}
+		case "valid_until":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ValidUntil).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1c3847aeEncodeYulaInternalModels(out *jwriter.Writer, in CouponInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Code))
+	}
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Value))
+	}
+	{
+		const prefix string = ",\"min_price\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MinPrice))
+	}
+	{
+		const prefix string = ",\"usage_limit\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UsageLimit))
+	}
+	{
+		const prefix string = ",\"valid_from\":"
+		out.RawString(prefix)
+		out.Raw((in.ValidFrom).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"valid_until\":"
+		out.RawString(prefix)
+		out.Raw((in.ValidUntil).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CouponInput) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1c3847aeEncodeYulaInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CouponInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1c3847aeEncodeYulaInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CouponInput) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1c3847aeDecodeYulaInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CouponInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1c3847aeDecodeYulaInternalModels(l, v)
+}
+func easyjson1c3847aeDecodeYulaInternalModels1(in *jlexer.Lexer, out *CouponApply) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1c3847aeEncodeYulaInternalModels1(out *jwriter.Writer, in CouponApply) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Code))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CouponApply) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1c3847aeEncodeYulaInternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CouponApply) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1c3847aeEncodeYulaInternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CouponApply) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1c3847aeDecodeYulaInternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CouponApply) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1c3847aeDecodeYulaInternalModels1(l, v)
+}
+func easyjson1c3847aeDecodeYulaInternalModels2(in *jlexer.Lexer, out *Coupon) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = int64(in.Int64())
+		case "salesman_id":
+			out.SalesmanId = int64(in.Int64())
+		case "code":
+			out.Code = string(in.String())
+		case "kind":
+			out.Kind = string(in.String())
+		case "value":
+			out.Value = int64(in.Int64())
+		case "min_price":
+			out.MinPrice = int64(in.Int64())
+		case "usage_limit":
+			out.UsageLimit = int64(in.Int64())
+		case "used":
+			out.Used = int64(in.Int64())
+		case "valid_from":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ValidFrom).UnmarshalJSON(data))
+			}
+		case "valid_until":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ValidUntil).UnmarshalJSON(data))
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1c3847aeEncodeYulaInternalModels2(out *jwriter.Writer, in Coupon) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Id))
+	}
+	{
+		const prefix string = ",\"salesman_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.SalesmanId))
+	}
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix)
+		out.String(string(in.Code))
+	}
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Value))
+	}
+	{
+		const prefix string = ",\"min_price\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MinPrice))
+	}
+	{
+		const prefix string = ",\"usage_limit\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UsageLimit))
+	}
+	{
+		const prefix string = ",\"used\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Used))
+	}
+	{
+		const prefix string = ",\"valid_from\":"
+		out.RawString(prefix)
+		out.Raw((in.ValidFrom).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"valid_until\":"
+		out.RawString(prefix)
+		out.Raw((in.ValidUntil).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Coupon) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1c3847aeEncodeYulaInternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Coupon) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1c3847aeEncodeYulaInternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Coupon) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1c3847aeDecodeYulaInternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Coupon) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1c3847aeDecodeYulaInternalModels2(l, v)
+}
@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+const (
+	DisputeReasonNotReceived    string = "not_received"
+	DisputeReasonNotAsDescribed string = "not_as_described"
+)
+
+const (
+	DisputeStatusOpen     string = "open"
+	DisputeStatusAnswered string = "answered"
+	DisputeStatusResolved string = "resolved"
+)
+
+type Dispute struct {
+	Id         int64     `json:"id" example:"1"`
+	OrderId    int64     `json:"order_id" example:"1"`
+	Reason     string    `json:"reason" example:"not_received"`
+	Message    string    `json:"message" example:"parcel never arrived"`
+	Images     []string  `json:"images" example:"static/disputeimages/1"`
+	Response   string    `json:"response,omitempty" example:"sent it on monday"`
+	Status     string    `json:"status" example:"open"`
+	Refund     int64     `json:"refund" example:"0"`
+	Resolution string    `json:"resolution,omitempty" example:"full refund"`
+	CreatedAt  time.Time `json:"created_at" swaggerignore:"true"`
+	UpdatedAt  time.Time `json:"updated_at" swaggerignore:"true"`
+}
+
+type DisputeInput struct {
+	Reason  string `json:"reason" valid:"in(not_received|not_as_described),required" example:"not_received"`
+	Message string `json:"message" valid:"type(string),stringlength(1|2000),required" example:"parcel never arrived"`
+}
+
+type DisputeAnswer struct {
+	Message string `json:"message" valid:"type(string),stringlength(1|2000),required" example:"sent it on monday"`
+}
+
+type DisputeResolution struct {
+	Refund  int64  `json:"refund" valid:"optional" example:"100"`
+	Comment string `json:"comment" valid:"type(string),stringlength(0|2000)" example:"partial refund"`
+}
@@ -0,0 +1,16 @@
+package models
+
+const (
+	ExportFormatCSV  string = "csv"
+	ExportFormatJSON string = "json"
+	ExportFormatXML  string = "xml"
+
+	// выгрузка идет страницами, в памяти держится не больше одной страницы объявлений
+	ExportPageSize int64 = 100
+)
+
+//easyjson:json
+type AdvertExport struct {
+	Advert
+	PriceHistory []*AdvertPrice `json:"price_history"`
+}
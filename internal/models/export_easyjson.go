@@ -0,0 +1,333 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson4bb85eceDecodeYulaInternalModels(in *jlexer.Lexer, out *AdvertExport) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "price_history":
+			if in.IsNull() {
+				in.Skip()
+				out.PriceHistory = nil
+			} else {
+				in.Delim('[')
+				if out.PriceHistory == nil {
+					if !in.IsDelim(']') {
+						out.PriceHistory = make([]*AdvertPrice, 0, 8)
+					} else {
+						out.PriceHistory = []*AdvertPrice{}
+					}
+				} else {
+					out.PriceHistory = (out.PriceHistory)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 *AdvertPrice
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						if v1 == nil {
+							v1 = new(AdvertPrice)
+						}
+						(*v1).UnmarshalEasyJSON(in)
+					}
+					out.PriceHistory = append(out.PriceHistory, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "id":
+			out.Id = int64(in.Int64())
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "price":
+			out.Price = int(in.Int())
+		case "location":
+			out.Location = string(in.String())
+		case "latitude":
+			out.Latitude = float64(in.Float64())
+		case "longitude":
+			out.Longitude = float64(in.Float64())
+		case "published_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PublishedAt).UnmarshalJSON(data))
+			}
+		case "date_close":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DateClose).UnmarshalJSON(data))
+			}
+		case "is_active":
+			out.IsActive = bool(in.Bool())
+		case "publisher_id":
+			out.PublisherId = int64(in.Int64())
+		case "category":
+			out.Category = string(in.String())
+		case "images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				in.Delim('[')
+				if out.Images == nil {
+					if !in.IsDelim(']') {
+						out.Images = make([]string, 0, 4)
+					} else {
+						out.Images = []string{}
+					}
+				} else {
+					out.Images = (out.Images)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 string
+					v2 = string(in.String())
+					out.Images = append(out.Images, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "views":
+			out.Views = int64(in.Int64())
+		case "amount":
+			out.Amount = int64(in.Int64())
+		case "is_new":
+			out.IsNew = bool(in.Bool())
+		case "promo_level":
+			out.PromoLevel = int64(in.Int64())
+		case "delivery_pickup":
+			out.DeliveryPickup = bool(in.Bool())
+		case "delivery_courier":
+			out.DeliveryCourier = bool(in.Bool())
+		case "delivery_post":
+			out.DeliveryPost = bool(in.Bool())
+		case "status":
+			out.Status = string(in.String())
+		case "moderation_reason":
+			out.ModerationReason = string(in.String())
+		case "close_reason":
+			out.CloseReason = string(in.String())
+		case "attributes":
+			(out.Attributes).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4bb85eceEncodeYulaInternalModels(out *jwriter.Writer, in AdvertExport) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"price_history\":"
+		out.RawString(prefix[1:])
+		if in.PriceHistory == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v3, v4 := range in.PriceHistory {
+				if v3 > 0 {
+					out.RawByte(',')
+				}
+				if v4 == nil {
+					out.RawString("null")
+				} else {
+					(*v4).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Id))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Int(int(in.Price))
+	}
+	{
+		const prefix string = ",\"location\":"
+		out.RawString(prefix)
+		out.String(string(in.Location))
+	}
+	{
+		const prefix string = ",\"latitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Latitude))
+	}
+	{
+		const prefix string = ",\"longitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Longitude))
+	}
+	{
+		const prefix string = ",\"published_at\":"
+		out.RawString(prefix)
+		out.Raw((in.PublishedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"date_close\":"
+		out.RawString(prefix)
+		out.Raw((in.DateClose).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"is_active\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsActive))
+	}
+	{
+		const prefix string = ",\"publisher_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PublisherId))
+	}
+	{
+		const prefix string = ",\"category\":"
+		out.RawString(prefix)
+		out.String(string(in.Category))
+	}
+	{
+		const prefix string = ",\"images\":"
+		out.RawString(prefix)
+		if in.Images == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Images {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v6))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"views\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Views))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Amount))
+	}
+	{
+		const prefix string = ",\"is_new\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsNew))
+	}
+	{
+		const prefix string = ",\"promo_level\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PromoLevel))
+	}
+	{
+		const prefix string = ",\"delivery_pickup\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.DeliveryPickup))
+	}
+	{
+		const prefix string = ",\"delivery_courier\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.DeliveryCourier))
+	}
+	{
+		const prefix string = ",\"delivery_post\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.DeliveryPost))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.ModerationReason != "" {
+		const prefix string = ",\"moderation_reason\":"
+		out.RawString(prefix)
+		out.String(string(in.ModerationReason))
+	}
+	if in.CloseReason != "" {
+		const prefix string = ",\"close_reason\":"
+		out.RawString(prefix)
+		out.String(string(in.CloseReason))
+	}
+	if len(in.Attributes) != 0 {
+		const prefix string = ",\"attributes\":"
+		out.RawString(prefix)
+		(in.Attributes).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AdvertExport) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4bb85eceEncodeYulaInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AdvertExport) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4bb85eceEncodeYulaInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AdvertExport) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4bb85eceDecodeYulaInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AdvertExport) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4bb85eceDecodeYulaInternalModels(l, v)
+}
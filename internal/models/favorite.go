@@ -0,0 +1,62 @@
+package models
+
+const (
+	FavoriteNotifyPriceDrop   string = "price_drop"
+	FavoriteNotifyTargetPrice string = "target_price"
+	FavoriteNotifyClosed      string = "closed"
+	FavoriteNotifySoldOut     string = "sold_out"
+
+	// сколько избранных за раз проверяет фоновая задача
+	FavoriteChangesBatch int64 = 500
+)
+
+type FavoriteTarget struct {
+	// 0 снимает целевую цену
+	TargetPrice int64 `json:"target_price" valid:"range(0|1000000000)" example:"900"`
+}
+
+// FavoriteChange - запись избранного, у объявления которой цена или статус разошлись с тем,
+// о чем пользователь уже знает
+type FavoriteChange struct {
+	UserId      int64
+	AdvertId    int64
+	AdvertName  string
+	Price       int64
+	Status      string
+	CloseReason string
+
+	// цена, о которой пользователь уже знает: при добавлении в избранное или из последнего уведомления
+	PriceSeen      int64
+	TargetPrice    int64
+	ClosedNotified bool
+}
+
+// Notification решает, о чем сообщить пользователю, пустая строка - сообщать не о чем.
+// С заданной целевой ценой пользователь узнает только о снижении цены ниже нее
+func (fc *FavoriteChange) Notification() string {
+	closed := fc.Status == AdvertStatusClosed
+	switch {
+	case closed && !fc.ClosedNotified && fc.CloseReason == CloseReasonSoldOut:
+		return FavoriteNotifySoldOut
+	case closed && !fc.ClosedNotified:
+		return FavoriteNotifyClosed
+	case closed || fc.Price >= fc.PriceSeen:
+		return ""
+	case fc.TargetPrice == 0:
+		return FavoriteNotifyPriceDrop
+	case fc.Price < fc.TargetPrice && fc.PriceSeen >= fc.TargetPrice:
+		return FavoriteNotifyTargetPrice
+	}
+	return ""
+}
+
+// FavoriteNotification - уведомление пользователю об объявлении из избранного
+type FavoriteNotification struct {
+	UserId     int64
+	AdvertId   int64
+	AdvertName string
+	Kind       string
+	OldPrice   int64
+	Price      int64
+	Target     int64
+}
@@ -0,0 +1,315 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson404e7428DecodeYulaInternalModels(in *jlexer.Lexer, out *FavoriteTarget) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "target_price":
+			out.TargetPrice = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson404e7428EncodeYulaInternalModels(out *jwriter.Writer, in FavoriteTarget) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"target_price\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.TargetPrice))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FavoriteTarget) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson404e7428EncodeYulaInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FavoriteTarget) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson404e7428EncodeYulaInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FavoriteTarget) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson404e7428DecodeYulaInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FavoriteTarget) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson404e7428DecodeYulaInternalModels(l, v)
+}
+func easyjson404e7428DecodeYulaInternalModels1(in *jlexer.Lexer, out *FavoriteNotification) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "UserId":
+			out.UserId = int64(in.Int64())
+		case "AdvertId":
+			out.AdvertId = int64(in.Int64())
+		case "AdvertName":
+			out.AdvertName = string(in.String())
+		case "Kind":
+			out.Kind = string(in.String())
+		case "OldPrice":
+			out.OldPrice = int64(in.Int64())
+		case "Price":
+			out.Price = int64(in.Int64())
+		case "Target":
+			out.Target = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson404e7428EncodeYulaInternalModels1(out *jwriter.Writer, in FavoriteNotification) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"UserId\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.UserId))
+	}
+	{
+		const prefix string = ",\"AdvertId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AdvertId))
+	}
+	{
+		const prefix string = ",\"AdvertName\":"
+		out.RawString(prefix)
+		out.String(string(in.AdvertName))
+	}
+	{
+		const prefix string = ",\"Kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"OldPrice\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OldPrice))
+	}
+	{
+		const prefix string = ",\"Price\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Price))
+	}
+	{
+		const prefix string = ",\"Target\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Target))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FavoriteNotification) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson404e7428EncodeYulaInternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FavoriteNotification) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson404e7428EncodeYulaInternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FavoriteNotification) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson404e7428DecodeYulaInternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FavoriteNotification) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson404e7428DecodeYulaInternalModels1(l, v)
+}
+func easyjson404e7428DecodeYulaInternalModels2(in *jlexer.Lexer, out *FavoriteChange) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "UserId":
+			out.UserId = int64(in.Int64())
+		case "AdvertId":
+			out.AdvertId = int64(in.Int64())
+		case "AdvertName":
+			out.AdvertName = string(in.String())
+		case "Price":
+			out.Price = int64(in.Int64())
+		case "Status":
+			out.Status = string(in.String())
+		case "CloseReason":
+			out.CloseReason = string(in.String())
+		case "PriceSeen":
+			out.PriceSeen = int64(in.Int64())
+		case "TargetPrice":
+			out.TargetPrice = int64(in.Int64())
+		case "ClosedNotified":
+			out.ClosedNotified = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson404e7428EncodeYulaInternalModels2(out *jwriter.Writer, in FavoriteChange) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"UserId\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.UserId))
+	}
+	{
+		const prefix string = ",\"AdvertId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AdvertId))
+	}
+	{
+		const prefix string = ",\"AdvertName\":"
+		out.RawString(prefix)
+		out.String(string(in.AdvertName))
+	}
+	{
+		const prefix string = ",\"Price\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Price))
+	}
+	{
+		const prefix string = ",\"Status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"CloseReason\":"
+		out.RawString(prefix)
+		out.String(string(in.CloseReason))
+	}
+	{
+		const prefix string = ",\"PriceSeen\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PriceSeen))
+	}
+	{
+		const prefix string = ",\"TargetPrice\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TargetPrice))
+	}
+	{
+		const prefix string = ",\"ClosedNotified\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ClosedNotified))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FavoriteChange) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson404e7428EncodeYulaInternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FavoriteChange) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson404e7428EncodeYulaInternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FavoriteChange) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson404e7428DecodeYulaInternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FavoriteChange) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson404e7428DecodeYulaInternalModels2(l, v)
+}
@@ -0,0 +1,84 @@
+package models
+
+import "time"
+
+const (
+	ImportStatusPending string = "pending"
+	ImportStatusRunning string = "running"
+	ImportStatusDone    string = "done"
+
+	ImportFormatCSV  string = "csv"
+	ImportFormatJSON string = "json"
+
+	// объявления создаются пачками, после каждой пачки прогресс задачи сохраняется
+	ImportBatchSize int   = 50
+	ImportMaxRows   int   = 5000
+	ImportMaxImages int   = 10
+	ImportMaxFile   int64 = 8 << 20  // 8Мб
+	ImportMaxZip    int64 = 64 << 20 // 64Мб
+	ImportMaxImage  int64 = 8 << 20  // 8Мб, как у обычной загрузки картинок
+
+	// координаты по умолчанию, как у объявлений в базе
+	ImportDefaultLatitude  float64 = 55.751244
+	ImportDefaultLongitude float64 = 37.618423
+)
+
+// ImportRow - строка файла импорта, в CSV картинки перечисляются через ";",
+// картинка - это http(s) ссылка или имя файла в загруженном zip архиве
+type ImportRow struct {
+	Name        string   `json:"name" example:"anime's t-shirt"`
+	Description string   `json:"description" example:"advert's description"`
+	Price       int      `json:"price" example:"100"`
+	Category    string   `json:"category" example:"clothes"`
+	Amount      int64    `json:"amount" example:"10"`
+	Location    string   `json:"location" example:"Moscow"`
+	Latitude    float64  `json:"latitude" example:"55.751244"`
+	Longitude   float64  `json:"longitude" example:"37.618423"`
+	Images      []string `json:"images" example:"https://example.com/t-shirt.png,t-shirt-back.jpeg"`
+}
+
+//easyjson:json
+type ImportRows []*ImportRow
+
+func (row *ImportRow) ToAdvert() *Advert {
+	advert := &Advert{
+		Name:        row.Name,
+		Description: row.Description,
+		Price:       row.Price,
+		Category:    row.Category,
+		Amount:      row.Amount,
+		Location:    row.Location,
+		Latitude:    row.Latitude,
+		Longitude:   row.Longitude,
+		IsNew:       true,
+	}
+	if advert.Location == "" {
+		advert.Location = "Moscow"
+	}
+	if advert.Latitude == 0 && advert.Longitude == 0 {
+		advert.Latitude, advert.Longitude = ImportDefaultLatitude, ImportDefaultLongitude
+	}
+	return advert
+}
+
+// ImportRowResult - результат строки файла, строки нумеруются с 1 без учета заголовка CSV
+type ImportRowResult struct {
+	Row      int64  `json:"row" example:"1"`
+	AdvertId int64  `json:"advert_id,omitempty" example:"10"`
+	Error    string `json:"error,omitempty" example:"invalid data"`
+}
+
+//easyjson:json
+type ImportReport []*ImportRowResult
+
+type ImportJob struct {
+	Id         int64        `json:"id" example:"1"`
+	UserId     int64        `json:"user_id" example:"2"`
+	Status     string       `json:"status" example:"running"`
+	Total      int64        `json:"total" example:"300"`
+	Created    int64        `json:"created" example:"120"`
+	Failed     int64        `json:"failed" example:"3"`
+	Report     ImportReport `json:"report"`
+	CreatedAt  time.Time    `json:"created_at" swaggerignore:"true"`
+	FinishedAt time.Time    `json:"finished_at" swaggerignore:"true"`
+}
@@ -47,6 +47,14 @@ type HttpBodyProfile struct {
 	Rating  RatingStat `json:"rating"`
 }
 
+type HttpBodyAddresses struct {
+	Addresses []*Address `json:"addresses"`
+}
+
+type HttpBodyAddress struct {
+	Address Address `json:"address"`
+}
+
 type HttpBodyAdverts struct {
 	Advert []*Advert `json:"adverts"`
 }
@@ -84,9 +92,45 @@ type HttpBodyCart struct {
 	Adverts []*Advert `json:"adverts"`
 }
 
+type HttpBodyCartOne struct {
+	Cart Cart `json:"cart"`
+}
+
 type HttpBodyOrder struct {
-	Salesman Profile `json:"salesman"`
-	Order    Cart    `json:"order"`
+	Salesman Profile  `json:"salesman"`
+	Order    Order    `json:"order"`
+	Payment  *Payment `json:"payment,omitempty"`
+}
+
+// Cart - корзина после оформления, Hints[i] - почему не оформлена позиция Cart[i]
+type HttpBodyCheckout struct {
+	Orders []*HttpBodyOrder `json:"orders"`
+	Cart   []*Cart          `json:"cart"`
+	Hints  []string         `json:"hints"`
+}
+
+type HttpBodyOrders struct {
+	Orders []*Order `json:"orders"`
+}
+
+type HttpBodyReview struct {
+	Review Review `json:"review"`
+}
+
+type HttpBodyReviews struct {
+	Reviews []*Review `json:"reviews"`
+}
+
+type HttpBodyPayment struct {
+	Payment Payment `json:"payment"`
+}
+
+type HttpBodyDispute struct {
+	Dispute Dispute `json:"dispute"`
+}
+
+type HttpBodyOrderEvents struct {
+	Events []*OrderEvent `json:"events"`
 }
 
 type HttpBodyCategories struct {
@@ -114,3 +158,56 @@ type HttpBodyDialogs struct {
 type HttpBodyPriceHistory struct {
 	History []*AdvertPrice `json:"history"`
 }
+
+type HttpBodyCoupon struct {
+	Coupon Coupon `json:"coupon"`
+}
+
+type HttpBodyCoupons struct {
+	Coupons []*Coupon `json:"coupons"`
+}
+
+type HttpBodyCategoryAttributes struct {
+	Attributes CategoryAttributeList `json:"attributes"`
+}
+
+type HttpBodyCategory struct {
+	Category Category `json:"category"`
+}
+
+type HttpBodyReport struct {
+	Report Report `json:"report"`
+}
+
+type HttpBodyPromotionPackages struct {
+	Packages []*PromotionPackage `json:"packages"`
+}
+
+type HttpBodyPromotionCheckout struct {
+	PromotionCheckout
+}
+
+type HttpBodyImportJob struct {
+	ImportJob ImportJob `json:"import"`
+}
+
+type HttpBodyReports struct {
+	Reports []*Report `json:"reports"`
+}
+
+type HttpBodyReportTargets struct {
+	Targets []*ReportTarget `json:"targets"`
+}
+
+type HttpBodySavedSearch struct {
+	SavedSearch
+}
+
+type HttpBodySavedSearches struct {
+	SavedSearches []*SavedSearch `json:"saved_searches"`
+}
+
+type HttpBodyNotifications struct {
+	Notifications []*Notification `json:"notifications"`
+	Unread        int64           `json:"unread" example:"3"`
+}
@@ -186,7 +186,7 @@ func (v *HttpDialog) UnmarshalJSON(data []byte) error {
 func (v *HttpDialog) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonCd7c0adaDecodeYulaInternalModels1(l, v)
 }
-func easyjsonCd7c0adaDecodeYulaInternalModels2(in *jlexer.Lexer, out *HttpBodySalesmanPage) {
+func easyjsonCd7c0adaDecodeYulaInternalModels2(in *jlexer.Lexer, out *HttpBodySavedSearches) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -205,41 +205,37 @@ func easyjsonCd7c0adaDecodeYulaInternalModels2(in *jlexer.Lexer, out *HttpBodySa
 			continue
 		}
 		switch key {
-		case "salesman":
-			easyjsonCd7c0adaDecodeYulaInternalModels3(in, &out.Salesman)
-		case "adverts":
+		case "saved_searches":
 			if in.IsNull() {
 				in.Skip()
-				out.Adverts = nil
+				out.SavedSearches = nil
 			} else {
 				in.Delim('[')
-				if out.Adverts == nil {
+				if out.SavedSearches == nil {
 					if !in.IsDelim(']') {
-						out.Adverts = make([]*AdvertShort, 0, 8)
+						out.SavedSearches = make([]*SavedSearch, 0, 8)
 					} else {
-						out.Adverts = []*AdvertShort{}
+						out.SavedSearches = []*SavedSearch{}
 					}
 				} else {
-					out.Adverts = (out.Adverts)[:0]
+					out.SavedSearches = (out.SavedSearches)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 *AdvertShort
+					var v1 *SavedSearch
 					if in.IsNull() {
 						in.Skip()
 						v1 = nil
 					} else {
 						if v1 == nil {
-							v1 = new(AdvertShort)
+							v1 = new(SavedSearch)
 						}
 						(*v1).UnmarshalEasyJSON(in)
 					}
-					out.Adverts = append(out.Adverts, v1)
+					out.SavedSearches = append(out.SavedSearches, v1)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "rating":
-			easyjsonCd7c0adaDecodeYulaInternalModels4(in, &out.Rating)
 		default:
 			in.SkipRecursive()
 		}
@@ -250,23 +246,18 @@ func easyjsonCd7c0adaDecodeYulaInternalModels2(in *jlexer.Lexer, out *HttpBodySa
 		in.Consumed()
 	}
 }
-func easyjsonCd7c0adaEncodeYulaInternalModels2(out *jwriter.Writer, in HttpBodySalesmanPage) {
+func easyjsonCd7c0adaEncodeYulaInternalModels2(out *jwriter.Writer, in HttpBodySavedSearches) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"salesman\":"
+		const prefix string = ",\"saved_searches\":"
 		out.RawString(prefix[1:])
-		easyjsonCd7c0adaEncodeYulaInternalModels3(out, in.Salesman)
-	}
-	{
-		const prefix string = ",\"adverts\":"
-		out.RawString(prefix)
-		if in.Adverts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.SavedSearches == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v2, v3 := range in.Adverts {
+			for v2, v3 := range in.SavedSearches {
 				if v2 > 0 {
 					out.RawByte(',')
 				}
@@ -279,108 +270,33 @@ func easyjsonCd7c0adaEncodeYulaInternalModels2(out *jwriter.Writer, in HttpBodyS
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"rating\":"
-		out.RawString(prefix)
-		easyjsonCd7c0adaEncodeYulaInternalModels4(out, in.Rating)
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v HttpBodySalesmanPage) MarshalJSON() ([]byte, error) {
+func (v HttpBodySavedSearches) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonCd7c0adaEncodeYulaInternalModels2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v HttpBodySalesmanPage) MarshalEasyJSON(w *jwriter.Writer) {
+func (v HttpBodySavedSearches) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonCd7c0adaEncodeYulaInternalModels2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *HttpBodySalesmanPage) UnmarshalJSON(data []byte) error {
+func (v *HttpBodySavedSearches) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonCd7c0adaDecodeYulaInternalModels2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *HttpBodySalesmanPage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *HttpBodySavedSearches) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonCd7c0adaDecodeYulaInternalModels2(l, v)
 }
-func easyjsonCd7c0adaDecodeYulaInternalModels4(in *jlexer.Lexer, out *RatingStat) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "sum":
-			out.RatingSum = int64(in.Int64())
-		case "count":
-			out.RatingCount = int64(in.Int64())
-		case "avg":
-			out.RatingAvg = float32(in.Float32())
-		case "rate":
-			out.PersonalRate = int(in.Int())
-		case "is_rated":
-			out.IsRated = bool(in.Bool())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonCd7c0adaEncodeYulaInternalModels4(out *jwriter.Writer, in RatingStat) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"sum\":"
-		out.RawString(prefix[1:])
-		out.Int64(int64(in.RatingSum))
-	}
-	{
-		const prefix string = ",\"count\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.RatingCount))
-	}
-	{
-		const prefix string = ",\"avg\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.RatingAvg))
-	}
-	{
-		const prefix string = ",\"rate\":"
-		out.RawString(prefix)
-		out.Int(int(in.PersonalRate))
-	}
-	{
-		const prefix string = ",\"is_rated\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsRated))
-	}
-	out.RawByte('}')
-}
-func easyjsonCd7c0adaDecodeYulaInternalModels3(in *jlexer.Lexer, out *Profile) {
+func easyjsonCd7c0adaDecodeYulaInternalModels3(in *jlexer.Lexer, out *HttpBodySavedSearch) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -401,20 +317,18 @@ func easyjsonCd7c0adaDecodeYulaInternalModels3(in *jlexer.Lexer, out *Profile) {
 		switch key {
 		case "id":
 			out.Id = int64(in.Int64())
-		case "email":
-			out.Email = string(in.String())
-		case "phone":
-			out.Phone = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "params":
+			out.Params = string(in.String())
+		case "last_run_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.LastRunAt).UnmarshalJSON(data))
+			}
 		case "created_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
 			}
-		case "name":
-			out.Name = string(in.String())
-		case "surname":
-			out.Surname = string(in.String())
-		case "image":
-			out.Image = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -425,7 +339,7 @@ func easyjsonCd7c0adaDecodeYulaInternalModels3(in *jlexer.Lexer, out *Profile) {
 		in.Consumed()
 	}
 }
-func easyjsonCd7c0adaEncodeYulaInternalModels3(out *jwriter.Writer, in Profile) {
+func easyjsonCd7c0adaEncodeYulaInternalModels3(out *jwriter.Writer, in HttpBodySavedSearch) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -435,38 +349,52 @@ func easyjsonCd7c0adaEncodeYulaInternalModels3(out *jwriter.Writer, in Profile)
 		out.Int64(int64(in.Id))
 	}
 	{
-		const prefix string = ",\"email\":"
-		out.RawString(prefix)
-		out.String(string(in.Email))
-	}
-	{
-		const prefix string = ",\"phone\":"
-		out.RawString(prefix)
-		out.String(string(in.Phone))
-	}
-	{
-		const prefix string = ",\"created_at\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"params\":"
 		out.RawString(prefix)
-		out.String(string(in.Name))
+		out.String(string(in.Params))
 	}
 	{
-		const prefix string = ",\"surname\":"
+		const prefix string = ",\"last_run_at\":"
 		out.RawString(prefix)
-		out.String(string(in.Surname))
+		out.Raw((in.LastRunAt).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"image\":"
+		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
-		out.String(string(in.Image))
+		out.Raw((in.CreatedAt).MarshalJSON())
 	}
 	out.RawByte('}')
 }
-func easyjsonCd7c0adaDecodeYulaInternalModels5(in *jlexer.Lexer, out *HttpBodyProfile) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodySavedSearch) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodySavedSearch) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodySavedSearch) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodySavedSearch) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels3(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels4(in *jlexer.Lexer, out *HttpBodySalesmanPage) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -485,10 +413,41 @@ func easyjsonCd7c0adaDecodeYulaInternalModels5(in *jlexer.Lexer, out *HttpBodyPr
 			continue
 		}
 		switch key {
-		case "profile":
-			easyjsonCd7c0adaDecodeYulaInternalModels3(in, &out.Profile)
+		case "salesman":
+			(out.Salesman).UnmarshalEasyJSON(in)
+		case "adverts":
+			if in.IsNull() {
+				in.Skip()
+				out.Adverts = nil
+			} else {
+				in.Delim('[')
+				if out.Adverts == nil {
+					if !in.IsDelim(']') {
+						out.Adverts = make([]*AdvertShort, 0, 8)
+					} else {
+						out.Adverts = []*AdvertShort{}
+					}
+				} else {
+					out.Adverts = (out.Adverts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 *AdvertShort
+					if in.IsNull() {
+						in.Skip()
+						v4 = nil
+					} else {
+						if v4 == nil {
+							v4 = new(AdvertShort)
+						}
+						(*v4).UnmarshalEasyJSON(in)
+					}
+					out.Adverts = append(out.Adverts, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		case "rating":
-			easyjsonCd7c0adaDecodeYulaInternalModels4(in, &out.Rating)
+			(out.Rating).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -499,47 +458,67 @@ func easyjsonCd7c0adaDecodeYulaInternalModels5(in *jlexer.Lexer, out *HttpBodyPr
 		in.Consumed()
 	}
 }
-func easyjsonCd7c0adaEncodeYulaInternalModels5(out *jwriter.Writer, in HttpBodyProfile) {
+func easyjsonCd7c0adaEncodeYulaInternalModels4(out *jwriter.Writer, in HttpBodySalesmanPage) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"profile\":"
+		const prefix string = ",\"salesman\":"
 		out.RawString(prefix[1:])
-		easyjsonCd7c0adaEncodeYulaInternalModels3(out, in.Profile)
+		(in.Salesman).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"adverts\":"
+		out.RawString(prefix)
+		if in.Adverts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Adverts {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				if v6 == nil {
+					out.RawString("null")
+				} else {
+					(*v6).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
 	{
 		const prefix string = ",\"rating\":"
 		out.RawString(prefix)
-		easyjsonCd7c0adaEncodeYulaInternalModels4(out, in.Rating)
+		(in.Rating).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v HttpBodyProfile) MarshalJSON() ([]byte, error) {
+func (v HttpBodySalesmanPage) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCd7c0adaEncodeYulaInternalModels5(&w, v)
+	easyjsonCd7c0adaEncodeYulaInternalModels4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v HttpBodyProfile) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCd7c0adaEncodeYulaInternalModels5(w, v)
+func (v HttpBodySalesmanPage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *HttpBodyProfile) UnmarshalJSON(data []byte) error {
+func (v *HttpBodySalesmanPage) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCd7c0adaDecodeYulaInternalModels5(&r, v)
+	easyjsonCd7c0adaDecodeYulaInternalModels4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *HttpBodyProfile) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCd7c0adaDecodeYulaInternalModels5(l, v)
+func (v *HttpBodySalesmanPage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels4(l, v)
 }
-func easyjsonCd7c0adaDecodeYulaInternalModels6(in *jlexer.Lexer, out *HttpBodyPriceHistory) {
+func easyjsonCd7c0adaDecodeYulaInternalModels5(in *jlexer.Lexer, out *HttpBodyReviews) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -558,33 +537,33 @@ func easyjsonCd7c0adaDecodeYulaInternalModels6(in *jlexer.Lexer, out *HttpBodyPr
 			continue
 		}
 		switch key {
-		case "history":
+		case "reviews":
 			if in.IsNull() {
 				in.Skip()
-				out.History = nil
+				out.Reviews = nil
 			} else {
 				in.Delim('[')
-				if out.History == nil {
+				if out.Reviews == nil {
 					if !in.IsDelim(']') {
-						out.History = make([]*AdvertPrice, 0, 8)
+						out.Reviews = make([]*Review, 0, 8)
 					} else {
-						out.History = []*AdvertPrice{}
+						out.Reviews = []*Review{}
 					}
 				} else {
-					out.History = (out.History)[:0]
+					out.Reviews = (out.Reviews)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v4 *AdvertPrice
+					var v7 *Review
 					if in.IsNull() {
 						in.Skip()
-						v4 = nil
+						v7 = nil
 					} else {
-						if v4 == nil {
-							v4 = new(AdvertPrice)
+						if v7 == nil {
+							v7 = new(Review)
 						}
-						(*v4).UnmarshalEasyJSON(in)
+						(*v7).UnmarshalEasyJSON(in)
 					}
-					out.History = append(out.History, v4)
+					out.Reviews = append(out.Reviews, v7)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -599,25 +578,25 @@ func easyjsonCd7c0adaDecodeYulaInternalModels6(in *jlexer.Lexer, out *HttpBodyPr
 		in.Consumed()
 	}
 }
-func easyjsonCd7c0adaEncodeYulaInternalModels6(out *jwriter.Writer, in HttpBodyPriceHistory) {
+func easyjsonCd7c0adaEncodeYulaInternalModels5(out *jwriter.Writer, in HttpBodyReviews) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"history\":"
+		const prefix string = ",\"reviews\":"
 		out.RawString(prefix[1:])
-		if in.History == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Reviews == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v5, v6 := range in.History {
-				if v5 > 0 {
+			for v8, v9 := range in.Reviews {
+				if v8 > 0 {
 					out.RawByte(',')
 				}
-				if v6 == nil {
+				if v9 == nil {
 					out.RawString("null")
 				} else {
-					(*v6).MarshalEasyJSON(out)
+					(*v9).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -627,29 +606,29 @@ func easyjsonCd7c0adaEncodeYulaInternalModels6(out *jwriter.Writer, in HttpBodyP
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v HttpBodyPriceHistory) MarshalJSON() ([]byte, error) {
+func (v HttpBodyReviews) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCd7c0adaEncodeYulaInternalModels6(&w, v)
+	easyjsonCd7c0adaEncodeYulaInternalModels5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v HttpBodyPriceHistory) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCd7c0adaEncodeYulaInternalModels6(w, v)
+func (v HttpBodyReviews) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *HttpBodyPriceHistory) UnmarshalJSON(data []byte) error {
+func (v *HttpBodyReviews) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCd7c0adaDecodeYulaInternalModels6(&r, v)
+	easyjsonCd7c0adaDecodeYulaInternalModels5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *HttpBodyPriceHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCd7c0adaDecodeYulaInternalModels6(l, v)
+func (v *HttpBodyReviews) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels5(l, v)
 }
-func easyjsonCd7c0adaDecodeYulaInternalModels7(in *jlexer.Lexer, out *HttpBodyOrder) {
+func easyjsonCd7c0adaDecodeYulaInternalModels6(in *jlexer.Lexer, out *HttpBodyReview) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -668,10 +647,8 @@ func easyjsonCd7c0adaDecodeYulaInternalModels7(in *jlexer.Lexer, out *HttpBodyOr
 			continue
 		}
 		switch key {
-		case "salesman":
-			easyjsonCd7c0adaDecodeYulaInternalModels3(in, &out.Salesman)
-		case "order":
-			(out.Order).UnmarshalEasyJSON(in)
+		case "review":
+			(out.Review).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -682,47 +659,42 @@ func easyjsonCd7c0adaDecodeYulaInternalModels7(in *jlexer.Lexer, out *HttpBodyOr
 		in.Consumed()
 	}
 }
-func easyjsonCd7c0adaEncodeYulaInternalModels7(out *jwriter.Writer, in HttpBodyOrder) {
+func easyjsonCd7c0adaEncodeYulaInternalModels6(out *jwriter.Writer, in HttpBodyReview) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"salesman\":"
+		const prefix string = ",\"review\":"
 		out.RawString(prefix[1:])
-		easyjsonCd7c0adaEncodeYulaInternalModels3(out, in.Salesman)
-	}
-	{
-		const prefix string = ",\"order\":"
-		out.RawString(prefix)
-		(in.Order).MarshalEasyJSON(out)
+		(in.Review).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v HttpBodyOrder) MarshalJSON() ([]byte, error) {
+func (v HttpBodyReview) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCd7c0adaEncodeYulaInternalModels7(&w, v)
+	easyjsonCd7c0adaEncodeYulaInternalModels6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v HttpBodyOrder) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCd7c0adaEncodeYulaInternalModels7(w, v)
+func (v HttpBodyReview) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *HttpBodyOrder) UnmarshalJSON(data []byte) error {
+func (v *HttpBodyReview) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCd7c0adaDecodeYulaInternalModels7(&r, v)
+	easyjsonCd7c0adaDecodeYulaInternalModels6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *HttpBodyOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCd7c0adaDecodeYulaInternalModels7(l, v)
+func (v *HttpBodyReview) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels6(l, v)
 }
-func easyjsonCd7c0adaDecodeYulaInternalModels8(in *jlexer.Lexer, out *HttpBodyInterface) {
+func easyjsonCd7c0adaDecodeYulaInternalModels7(in *jlexer.Lexer, out *HttpBodyReports) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -741,17 +713,36 @@ func easyjsonCd7c0adaDecodeYulaInternalModels8(in *jlexer.Lexer, out *HttpBodyIn
 			continue
 		}
 		switch key {
-		case "code":
-			out.Code = int(in.Int())
-		case "message":
-			out.Message = string(in.String())
-		case "body":
-			if m, ok := out.Body.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.Body.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
+		case "reports":
+			if in.IsNull() {
+				in.Skip()
+				out.Reports = nil
 			} else {
-				out.Body = in.Interface()
+				in.Delim('[')
+				if out.Reports == nil {
+					if !in.IsDelim(']') {
+						out.Reports = make([]*Report, 0, 8)
+					} else {
+						out.Reports = []*Report{}
+					}
+				} else {
+					out.Reports = (out.Reports)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 *Report
+					if in.IsNull() {
+						in.Skip()
+						v10 = nil
+					} else {
+						if v10 == nil {
+							v10 = new(Report)
+						}
+						(*v10).UnmarshalEasyJSON(in)
+					}
+					out.Reports = append(out.Reports, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -763,58 +754,1621 @@ func easyjsonCd7c0adaDecodeYulaInternalModels8(in *jlexer.Lexer, out *HttpBodyIn
 		in.Consumed()
 	}
 }
-func easyjsonCd7c0adaEncodeYulaInternalModels8(out *jwriter.Writer, in HttpBodyInterface) {
+func easyjsonCd7c0adaEncodeYulaInternalModels7(out *jwriter.Writer, in HttpBodyReports) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"reports\":"
+		out.RawString(prefix[1:])
+		if in.Reports == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.Reports {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				if v12 == nil {
+					out.RawString("null")
+				} else {
+					(*v12).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodyReports) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodyReports) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodyReports) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodyReports) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels7(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels8(in *jlexer.Lexer, out *HttpBodyReportTargets) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "targets":
+			if in.IsNull() {
+				in.Skip()
+				out.Targets = nil
+			} else {
+				in.Delim('[')
+				if out.Targets == nil {
+					if !in.IsDelim(']') {
+						out.Targets = make([]*ReportTarget, 0, 8)
+					} else {
+						out.Targets = []*ReportTarget{}
+					}
+				} else {
+					out.Targets = (out.Targets)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 *ReportTarget
+					if in.IsNull() {
+						in.Skip()
+						v13 = nil
+					} else {
+						if v13 == nil {
+							v13 = new(ReportTarget)
+						}
+						(*v13).UnmarshalEasyJSON(in)
+					}
+					out.Targets = append(out.Targets, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCd7c0adaEncodeYulaInternalModels8(out *jwriter.Writer, in HttpBodyReportTargets) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"targets\":"
+		out.RawString(prefix[1:])
+		if in.Targets == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.Targets {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				if v15 == nil {
+					out.RawString("null")
+				} else {
+					(*v15).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodyReportTargets) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodyReportTargets) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodyReportTargets) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodyReportTargets) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels8(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels9(in *jlexer.Lexer, out *HttpBodyReport) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "report":
+			(out.Report).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCd7c0adaEncodeYulaInternalModels9(out *jwriter.Writer, in HttpBodyReport) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"report\":"
+		out.RawString(prefix[1:])
+		(in.Report).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodyReport) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodyReport) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodyReport) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodyReport) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels9(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels10(in *jlexer.Lexer, out *HttpBodyPromotionPackages) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "packages":
+			if in.IsNull() {
+				in.Skip()
+				out.Packages = nil
+			} else {
+				in.Delim('[')
+				if out.Packages == nil {
+					if !in.IsDelim(']') {
+						out.Packages = make([]*PromotionPackage, 0, 8)
+					} else {
+						out.Packages = []*PromotionPackage{}
+					}
+				} else {
+					out.Packages = (out.Packages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 *PromotionPackage
+					if in.IsNull() {
+						in.Skip()
+						v16 = nil
+					} else {
+						if v16 == nil {
+							v16 = new(PromotionPackage)
+						}
+						(*v16).UnmarshalEasyJSON(in)
+					}
+					out.Packages = append(out.Packages, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCd7c0adaEncodeYulaInternalModels10(out *jwriter.Writer, in HttpBodyPromotionPackages) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"packages\":"
+		out.RawString(prefix[1:])
+		if in.Packages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v17, v18 := range in.Packages {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				if v18 == nil {
+					out.RawString("null")
+				} else {
+					(*v18).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodyPromotionPackages) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodyPromotionPackages) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodyPromotionPackages) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodyPromotionPackages) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels10(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels11(in *jlexer.Lexer, out *HttpBodyPromotionCheckout) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "advert_id":
+			out.AdvertId = int64(in.Int64())
+		case "package":
+			out.Package = string(in.String())
+		case "price":
+			out.Price = int64(in.Int64())
+		case "label":
+			out.Label = string(in.String())
+		case "checkout_url":
+			out.CheckoutUrl = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCd7c0adaEncodeYulaInternalModels11(out *jwriter.Writer, in HttpBodyPromotionCheckout) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"advert_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.AdvertId))
+	}
+	{
+		const prefix string = ",\"package\":"
+		out.RawString(prefix)
+		out.String(string(in.Package))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Price))
+	}
+	{
+		const prefix string = ",\"label\":"
+		out.RawString(prefix)
+		out.String(string(in.Label))
+	}
+	{
+		const prefix string = ",\"checkout_url\":"
+		out.RawString(prefix)
+		out.String(string(in.CheckoutUrl))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodyPromotionCheckout) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodyPromotionCheckout) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodyPromotionCheckout) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodyPromotionCheckout) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels11(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels12(in *jlexer.Lexer, out *HttpBodyProfile) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "profile":
+			(out.Profile).UnmarshalEasyJSON(in)
+		case "rating":
+			(out.Rating).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCd7c0adaEncodeYulaInternalModels12(out *jwriter.Writer, in HttpBodyProfile) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"profile\":"
+		out.RawString(prefix[1:])
+		(in.Profile).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		(in.Rating).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodyProfile) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodyProfile) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodyProfile) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodyProfile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels12(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels13(in *jlexer.Lexer, out *HttpBodyPriceHistory) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "history":
+			if in.IsNull() {
+				in.Skip()
+				out.History = nil
+			} else {
+				in.Delim('[')
+				if out.History == nil {
+					if !in.IsDelim(']') {
+						out.History = make([]*AdvertPrice, 0, 8)
+					} else {
+						out.History = []*AdvertPrice{}
+					}
+				} else {
+					out.History = (out.History)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 *AdvertPrice
+					if in.IsNull() {
+						in.Skip()
+						v19 = nil
+					} else {
+						if v19 == nil {
+							v19 = new(AdvertPrice)
+						}
+						(*v19).UnmarshalEasyJSON(in)
+					}
+					out.History = append(out.History, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCd7c0adaEncodeYulaInternalModels13(out *jwriter.Writer, in HttpBodyPriceHistory) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"history\":"
+		out.RawString(prefix[1:])
+		if in.History == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.History {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				if v21 == nil {
+					out.RawString("null")
+				} else {
+					(*v21).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodyPriceHistory) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodyPriceHistory) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodyPriceHistory) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodyPriceHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels13(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels14(in *jlexer.Lexer, out *HttpBodyPayment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "payment":
+			(out.Payment).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCd7c0adaEncodeYulaInternalModels14(out *jwriter.Writer, in HttpBodyPayment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"payment\":"
+		out.RawString(prefix[1:])
+		(in.Payment).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodyPayment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodyPayment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodyPayment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodyPayment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels14(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels15(in *jlexer.Lexer, out *HttpBodyOrders) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "orders":
+			if in.IsNull() {
+				in.Skip()
+				out.Orders = nil
+			} else {
+				in.Delim('[')
+				if out.Orders == nil {
+					if !in.IsDelim(']') {
+						out.Orders = make([]*Order, 0, 8)
+					} else {
+						out.Orders = []*Order{}
+					}
+				} else {
+					out.Orders = (out.Orders)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 *Order
+					if in.IsNull() {
+						in.Skip()
+						v22 = nil
+					} else {
+						if v22 == nil {
+							v22 = new(Order)
+						}
+						(*v22).UnmarshalEasyJSON(in)
+					}
+					out.Orders = append(out.Orders, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCd7c0adaEncodeYulaInternalModels15(out *jwriter.Writer, in HttpBodyOrders) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"orders\":"
+		out.RawString(prefix[1:])
+		if in.Orders == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v23, v24 := range in.Orders {
+				if v23 > 0 {
+					out.RawByte(',')
+				}
+				if v24 == nil {
+					out.RawString("null")
+				} else {
+					(*v24).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodyOrders) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodyOrders) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodyOrders) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodyOrders) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels15(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels16(in *jlexer.Lexer, out *HttpBodyOrderEvents) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "events":
+			if in.IsNull() {
+				in.Skip()
+				out.Events = nil
+			} else {
+				in.Delim('[')
+				if out.Events == nil {
+					if !in.IsDelim(']') {
+						out.Events = make([]*OrderEvent, 0, 8)
+					} else {
+						out.Events = []*OrderEvent{}
+					}
+				} else {
+					out.Events = (out.Events)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v25 *OrderEvent
+					if in.IsNull() {
+						in.Skip()
+						v25 = nil
+					} else {
+						if v25 == nil {
+							v25 = new(OrderEvent)
+						}
+						(*v25).UnmarshalEasyJSON(in)
+					}
+					out.Events = append(out.Events, v25)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCd7c0adaEncodeYulaInternalModels16(out *jwriter.Writer, in HttpBodyOrderEvents) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"events\":"
+		out.RawString(prefix[1:])
+		if in.Events == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v26, v27 := range in.Events {
+				if v26 > 0 {
+					out.RawByte(',')
+				}
+				if v27 == nil {
+					out.RawString("null")
+				} else {
+					(*v27).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodyOrderEvents) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodyOrderEvents) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodyOrderEvents) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodyOrderEvents) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels16(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels17(in *jlexer.Lexer, out *HttpBodyOrder) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "salesman":
+			(out.Salesman).UnmarshalEasyJSON(in)
+		case "order":
+			(out.Order).UnmarshalEasyJSON(in)
+		case "payment":
+			if in.IsNull() {
+				in.Skip()
+				out.Payment = nil
+			} else {
+				if out.Payment == nil {
+					out.Payment = new(Payment)
+				}
+				(*out.Payment).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCd7c0adaEncodeYulaInternalModels17(out *jwriter.Writer, in HttpBodyOrder) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"salesman\":"
+		out.RawString(prefix[1:])
+		(in.Salesman).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"order\":"
+		out.RawString(prefix)
+		(in.Order).MarshalEasyJSON(out)
+	}
+	if in.Payment != nil {
+		const prefix string = ",\"payment\":"
+		out.RawString(prefix)
+		(*in.Payment).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodyOrder) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodyOrder) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodyOrder) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodyOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels17(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels18(in *jlexer.Lexer, out *HttpBodyNotifications) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "notifications":
+			if in.IsNull() {
+				in.Skip()
+				out.Notifications = nil
+			} else {
+				in.Delim('[')
+				if out.Notifications == nil {
+					if !in.IsDelim(']') {
+						out.Notifications = make([]*Notification, 0, 8)
+					} else {
+						out.Notifications = []*Notification{}
+					}
+				} else {
+					out.Notifications = (out.Notifications)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v28 *Notification
+					if in.IsNull() {
+						in.Skip()
+						v28 = nil
+					} else {
+						if v28 == nil {
+							v28 = new(Notification)
+						}
+						(*v28).UnmarshalEasyJSON(in)
+					}
+					out.Notifications = append(out.Notifications, v28)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "unread":
+			out.Unread = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCd7c0adaEncodeYulaInternalModels18(out *jwriter.Writer, in HttpBodyNotifications) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"notifications\":"
+		out.RawString(prefix[1:])
+		if in.Notifications == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v29, v30 := range in.Notifications {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				if v30 == nil {
+					out.RawString("null")
+				} else {
+					(*v30).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"unread\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Unread))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodyNotifications) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodyNotifications) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodyNotifications) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodyNotifications) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels18(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels19(in *jlexer.Lexer, out *HttpBodyInterface) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = int(in.Int())
+		case "message":
+			out.Message = string(in.String())
+		case "body":
+			if m, ok := out.Body.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Body.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Body = in.Interface()
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCd7c0adaEncodeYulaInternalModels19(out *jwriter.Writer, in HttpBodyInterface) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
 		const prefix string = ",\"code\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.Code))
+		out.Int(int(in.Code))
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"body\":"
+		out.RawString(prefix)
+		if m, ok := in.Body.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Body.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Body))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodyInterface) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodyInterface) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodyInterface) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodyInterface) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels19(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels20(in *jlexer.Lexer, out *HttpBodyImportJob) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "import":
+			(out.ImportJob).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCd7c0adaEncodeYulaInternalModels20(out *jwriter.Writer, in HttpBodyImportJob) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"import\":"
+		out.RawString(prefix[1:])
+		(in.ImportJob).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodyImportJob) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodyImportJob) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodyImportJob) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodyImportJob) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels20(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels21(in *jlexer.Lexer, out *HttpBodyDispute) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "dispute":
+			(out.Dispute).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCd7c0adaEncodeYulaInternalModels21(out *jwriter.Writer, in HttpBodyDispute) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"dispute\":"
+		out.RawString(prefix[1:])
+		(in.Dispute).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodyDispute) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodyDispute) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodyDispute) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodyDispute) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels21(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels22(in *jlexer.Lexer, out *HttpBodyDialogs) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "dialogs":
+			if in.IsNull() {
+				in.Skip()
+				out.Dialogs = nil
+			} else {
+				in.Delim('[')
+				if out.Dialogs == nil {
+					if !in.IsDelim(']') {
+						out.Dialogs = make([]*HttpDialog, 0, 8)
+					} else {
+						out.Dialogs = []*HttpDialog{}
+					}
+				} else {
+					out.Dialogs = (out.Dialogs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v31 *HttpDialog
+					if in.IsNull() {
+						in.Skip()
+						v31 = nil
+					} else {
+						if v31 == nil {
+							v31 = new(HttpDialog)
+						}
+						(*v31).UnmarshalEasyJSON(in)
+					}
+					out.Dialogs = append(out.Dialogs, v31)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCd7c0adaEncodeYulaInternalModels22(out *jwriter.Writer, in HttpBodyDialogs) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"dialogs\":"
+		out.RawString(prefix[1:])
+		if in.Dialogs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v32, v33 := range in.Dialogs {
+				if v32 > 0 {
+					out.RawByte(',')
+				}
+				if v33 == nil {
+					out.RawString("null")
+				} else {
+					(*v33).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodyDialogs) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodyDialogs) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodyDialogs) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodyDialogs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels22(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels23(in *jlexer.Lexer, out *HttpBodyCoupons) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "coupons":
+			if in.IsNull() {
+				in.Skip()
+				out.Coupons = nil
+			} else {
+				in.Delim('[')
+				if out.Coupons == nil {
+					if !in.IsDelim(']') {
+						out.Coupons = make([]*Coupon, 0, 8)
+					} else {
+						out.Coupons = []*Coupon{}
+					}
+				} else {
+					out.Coupons = (out.Coupons)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v34 *Coupon
+					if in.IsNull() {
+						in.Skip()
+						v34 = nil
+					} else {
+						if v34 == nil {
+							v34 = new(Coupon)
+						}
+						(*v34).UnmarshalEasyJSON(in)
+					}
+					out.Coupons = append(out.Coupons, v34)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCd7c0adaEncodeYulaInternalModels23(out *jwriter.Writer, in HttpBodyCoupons) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"coupons\":"
+		out.RawString(prefix[1:])
+		if in.Coupons == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v35, v36 := range in.Coupons {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				if v36 == nil {
+					out.RawString("null")
+				} else {
+					(*v36).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodyCoupons) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodyCoupons) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodyCoupons) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodyCoupons) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels23(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels24(in *jlexer.Lexer, out *HttpBodyCoupon) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
 	}
-	{
-		const prefix string = ",\"message\":"
-		out.RawString(prefix)
-		out.String(string(in.Message))
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "coupon":
+			(out.Coupon).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
 	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCd7c0adaEncodeYulaInternalModels24(out *jwriter.Writer, in HttpBodyCoupon) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"body\":"
-		out.RawString(prefix)
-		if m, ok := in.Body.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.Body.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(in.Body))
-		}
+		const prefix string = ",\"coupon\":"
+		out.RawString(prefix[1:])
+		(in.Coupon).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v HttpBodyInterface) MarshalJSON() ([]byte, error) {
+func (v HttpBodyCoupon) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCd7c0adaEncodeYulaInternalModels8(&w, v)
+	easyjsonCd7c0adaEncodeYulaInternalModels24(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v HttpBodyInterface) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCd7c0adaEncodeYulaInternalModels8(w, v)
+func (v HttpBodyCoupon) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels24(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *HttpBodyInterface) UnmarshalJSON(data []byte) error {
+func (v *HttpBodyCoupon) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCd7c0adaDecodeYulaInternalModels8(&r, v)
+	easyjsonCd7c0adaDecodeYulaInternalModels24(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *HttpBodyInterface) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCd7c0adaDecodeYulaInternalModels8(l, v)
+func (v *HttpBodyCoupon) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels24(l, v)
 }
-func easyjsonCd7c0adaDecodeYulaInternalModels9(in *jlexer.Lexer, out *HttpBodyDialogs) {
+func easyjsonCd7c0adaDecodeYulaInternalModels25(in *jlexer.Lexer, out *HttpBodyCheckout) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -833,33 +2387,87 @@ func easyjsonCd7c0adaDecodeYulaInternalModels9(in *jlexer.Lexer, out *HttpBodyDi
 			continue
 		}
 		switch key {
-		case "dialogs":
+		case "orders":
 			if in.IsNull() {
 				in.Skip()
-				out.Dialogs = nil
+				out.Orders = nil
 			} else {
 				in.Delim('[')
-				if out.Dialogs == nil {
+				if out.Orders == nil {
 					if !in.IsDelim(']') {
-						out.Dialogs = make([]*HttpDialog, 0, 8)
+						out.Orders = make([]*HttpBodyOrder, 0, 8)
 					} else {
-						out.Dialogs = []*HttpDialog{}
+						out.Orders = []*HttpBodyOrder{}
 					}
 				} else {
-					out.Dialogs = (out.Dialogs)[:0]
+					out.Orders = (out.Orders)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v7 *HttpDialog
+					var v37 *HttpBodyOrder
 					if in.IsNull() {
 						in.Skip()
-						v7 = nil
+						v37 = nil
 					} else {
-						if v7 == nil {
-							v7 = new(HttpDialog)
+						if v37 == nil {
+							v37 = new(HttpBodyOrder)
 						}
-						(*v7).UnmarshalEasyJSON(in)
+						(*v37).UnmarshalEasyJSON(in)
+					}
+					out.Orders = append(out.Orders, v37)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "cart":
+			if in.IsNull() {
+				in.Skip()
+				out.Cart = nil
+			} else {
+				in.Delim('[')
+				if out.Cart == nil {
+					if !in.IsDelim(']') {
+						out.Cart = make([]*Cart, 0, 8)
+					} else {
+						out.Cart = []*Cart{}
+					}
+				} else {
+					out.Cart = (out.Cart)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v38 *Cart
+					if in.IsNull() {
+						in.Skip()
+						v38 = nil
+					} else {
+						if v38 == nil {
+							v38 = new(Cart)
+						}
+						(*v38).UnmarshalEasyJSON(in)
+					}
+					out.Cart = append(out.Cart, v38)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "hints":
+			if in.IsNull() {
+				in.Skip()
+				out.Hints = nil
+			} else {
+				in.Delim('[')
+				if out.Hints == nil {
+					if !in.IsDelim(']') {
+						out.Hints = make([]string, 0, 4)
+					} else {
+						out.Hints = []string{}
 					}
-					out.Dialogs = append(out.Dialogs, v7)
+				} else {
+					out.Hints = (out.Hints)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v39 string
+					v39 = string(in.String())
+					out.Hints = append(out.Hints, v39)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -874,26 +2482,62 @@ func easyjsonCd7c0adaDecodeYulaInternalModels9(in *jlexer.Lexer, out *HttpBodyDi
 		in.Consumed()
 	}
 }
-func easyjsonCd7c0adaEncodeYulaInternalModels9(out *jwriter.Writer, in HttpBodyDialogs) {
+func easyjsonCd7c0adaEncodeYulaInternalModels25(out *jwriter.Writer, in HttpBodyCheckout) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"dialogs\":"
+		const prefix string = ",\"orders\":"
 		out.RawString(prefix[1:])
-		if in.Dialogs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Orders == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v8, v9 := range in.Dialogs {
-				if v8 > 0 {
+			for v40, v41 := range in.Orders {
+				if v40 > 0 {
 					out.RawByte(',')
 				}
-				if v9 == nil {
+				if v41 == nil {
 					out.RawString("null")
 				} else {
-					(*v9).MarshalEasyJSON(out)
+					(*v41).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"cart\":"
+		out.RawString(prefix)
+		if in.Cart == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v42, v43 := range in.Cart {
+				if v42 > 0 {
+					out.RawByte(',')
+				}
+				if v43 == nil {
+					out.RawString("null")
+				} else {
+					(*v43).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"hints\":"
+		out.RawString(prefix)
+		if in.Hints == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v44, v45 := range in.Hints {
+				if v44 > 0 {
+					out.RawByte(',')
 				}
+				out.String(string(v45))
 			}
 			out.RawByte(']')
 		}
@@ -902,29 +2546,29 @@ func easyjsonCd7c0adaEncodeYulaInternalModels9(out *jwriter.Writer, in HttpBodyD
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v HttpBodyDialogs) MarshalJSON() ([]byte, error) {
+func (v HttpBodyCheckout) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCd7c0adaEncodeYulaInternalModels9(&w, v)
+	easyjsonCd7c0adaEncodeYulaInternalModels25(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v HttpBodyDialogs) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCd7c0adaEncodeYulaInternalModels9(w, v)
+func (v HttpBodyCheckout) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels25(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *HttpBodyDialogs) UnmarshalJSON(data []byte) error {
+func (v *HttpBodyCheckout) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCd7c0adaDecodeYulaInternalModels9(&r, v)
+	easyjsonCd7c0adaDecodeYulaInternalModels25(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *HttpBodyDialogs) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCd7c0adaDecodeYulaInternalModels9(l, v)
+func (v *HttpBodyCheckout) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels25(l, v)
 }
-func easyjsonCd7c0adaDecodeYulaInternalModels10(in *jlexer.Lexer, out *HttpBodyChatHistory) {
+func easyjsonCd7c0adaDecodeYulaInternalModels26(in *jlexer.Lexer, out *HttpBodyChatHistory) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -959,17 +2603,17 @@ func easyjsonCd7c0adaDecodeYulaInternalModels10(in *jlexer.Lexer, out *HttpBodyC
 					out.Messages = (out.Messages)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v10 *Message
+					var v46 *Message
 					if in.IsNull() {
 						in.Skip()
-						v10 = nil
+						v46 = nil
 					} else {
-						if v10 == nil {
-							v10 = new(Message)
+						if v46 == nil {
+							v46 = new(Message)
 						}
-						(*v10).UnmarshalEasyJSON(in)
+						(*v46).UnmarshalEasyJSON(in)
 					}
-					out.Messages = append(out.Messages, v10)
+					out.Messages = append(out.Messages, v46)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -984,7 +2628,7 @@ func easyjsonCd7c0adaDecodeYulaInternalModels10(in *jlexer.Lexer, out *HttpBodyC
 		in.Consumed()
 	}
 }
-func easyjsonCd7c0adaEncodeYulaInternalModels10(out *jwriter.Writer, in HttpBodyChatHistory) {
+func easyjsonCd7c0adaEncodeYulaInternalModels26(out *jwriter.Writer, in HttpBodyChatHistory) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -995,14 +2639,14 @@ func easyjsonCd7c0adaEncodeYulaInternalModels10(out *jwriter.Writer, in HttpBody
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v11, v12 := range in.Messages {
-				if v11 > 0 {
+			for v47, v48 := range in.Messages {
+				if v47 > 0 {
 					out.RawByte(',')
 				}
-				if v12 == nil {
+				if v48 == nil {
 					out.RawString("null")
 				} else {
-					(*v12).MarshalEasyJSON(out)
+					(*v48).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -1012,29 +2656,161 @@ func easyjsonCd7c0adaEncodeYulaInternalModels10(out *jwriter.Writer, in HttpBody
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v HttpBodyChatHistory) MarshalJSON() ([]byte, error) {
+func (v HttpBodyChatHistory) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels26(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodyChatHistory) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels26(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodyChatHistory) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels26(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodyChatHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels26(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels27(in *jlexer.Lexer, out *HttpBodyCategoryAttributes) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attributes":
+			(out.Attributes).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCd7c0adaEncodeYulaInternalModels27(out *jwriter.Writer, in HttpBodyCategoryAttributes) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attributes\":"
+		out.RawString(prefix[1:])
+		(in.Attributes).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodyCategoryAttributes) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels27(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodyCategoryAttributes) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels27(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodyCategoryAttributes) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels27(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodyCategoryAttributes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels27(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels28(in *jlexer.Lexer, out *HttpBodyCategory) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "category":
+			(out.Category).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCd7c0adaEncodeYulaInternalModels28(out *jwriter.Writer, in HttpBodyCategory) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"category\":"
+		out.RawString(prefix[1:])
+		(in.Category).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodyCategory) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCd7c0adaEncodeYulaInternalModels10(&w, v)
+	easyjsonCd7c0adaEncodeYulaInternalModels28(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v HttpBodyChatHistory) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCd7c0adaEncodeYulaInternalModels10(w, v)
+func (v HttpBodyCategory) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels28(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *HttpBodyChatHistory) UnmarshalJSON(data []byte) error {
+func (v *HttpBodyCategory) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCd7c0adaDecodeYulaInternalModels10(&r, v)
+	easyjsonCd7c0adaDecodeYulaInternalModels28(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *HttpBodyChatHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCd7c0adaDecodeYulaInternalModels10(l, v)
+func (v *HttpBodyCategory) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels28(l, v)
 }
-func easyjsonCd7c0adaDecodeYulaInternalModels11(in *jlexer.Lexer, out *HttpBodyCategories) {
+func easyjsonCd7c0adaDecodeYulaInternalModels29(in *jlexer.Lexer, out *HttpBodyCategories) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1069,17 +2845,17 @@ func easyjsonCd7c0adaDecodeYulaInternalModels11(in *jlexer.Lexer, out *HttpBodyC
 					out.Categories = (out.Categories)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v13 *Category
+					var v49 *Category
 					if in.IsNull() {
 						in.Skip()
-						v13 = nil
+						v49 = nil
 					} else {
-						if v13 == nil {
-							v13 = new(Category)
+						if v49 == nil {
+							v49 = new(Category)
 						}
-						(*v13).UnmarshalEasyJSON(in)
+						(*v49).UnmarshalEasyJSON(in)
 					}
-					out.Categories = append(out.Categories, v13)
+					out.Categories = append(out.Categories, v49)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1094,7 +2870,7 @@ func easyjsonCd7c0adaDecodeYulaInternalModels11(in *jlexer.Lexer, out *HttpBodyC
 		in.Consumed()
 	}
 }
-func easyjsonCd7c0adaEncodeYulaInternalModels11(out *jwriter.Writer, in HttpBodyCategories) {
+func easyjsonCd7c0adaEncodeYulaInternalModels29(out *jwriter.Writer, in HttpBodyCategories) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1105,14 +2881,14 @@ func easyjsonCd7c0adaEncodeYulaInternalModels11(out *jwriter.Writer, in HttpBody
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v14, v15 := range in.Categories {
-				if v14 > 0 {
+			for v50, v51 := range in.Categories {
+				if v50 > 0 {
 					out.RawByte(',')
 				}
-				if v15 == nil {
+				if v51 == nil {
 					out.RawString("null")
 				} else {
-					(*v15).MarshalEasyJSON(out)
+					(*v51).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -1124,27 +2900,93 @@ func easyjsonCd7c0adaEncodeYulaInternalModels11(out *jwriter.Writer, in HttpBody
 // MarshalJSON supports json.Marshaler interface
 func (v HttpBodyCategories) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCd7c0adaEncodeYulaInternalModels11(&w, v)
+	easyjsonCd7c0adaEncodeYulaInternalModels29(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v HttpBodyCategories) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCd7c0adaEncodeYulaInternalModels11(w, v)
+	easyjsonCd7c0adaEncodeYulaInternalModels29(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *HttpBodyCategories) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCd7c0adaDecodeYulaInternalModels11(&r, v)
+	easyjsonCd7c0adaDecodeYulaInternalModels29(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *HttpBodyCategories) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCd7c0adaDecodeYulaInternalModels11(l, v)
+	easyjsonCd7c0adaDecodeYulaInternalModels29(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels30(in *jlexer.Lexer, out *HttpBodyCartOne) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "cart":
+			(out.Cart).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCd7c0adaEncodeYulaInternalModels30(out *jwriter.Writer, in HttpBodyCartOne) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"cart\":"
+		out.RawString(prefix[1:])
+		(in.Cart).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodyCartOne) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels30(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodyCartOne) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels30(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodyCartOne) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels30(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodyCartOne) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels30(l, v)
 }
-func easyjsonCd7c0adaDecodeYulaInternalModels12(in *jlexer.Lexer, out *HttpBodyCartAll) {
+func easyjsonCd7c0adaDecodeYulaInternalModels31(in *jlexer.Lexer, out *HttpBodyCartAll) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1179,17 +3021,17 @@ func easyjsonCd7c0adaDecodeYulaInternalModels12(in *jlexer.Lexer, out *HttpBodyC
 					out.Cart = (out.Cart)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v16 *Cart
+					var v52 *Cart
 					if in.IsNull() {
 						in.Skip()
-						v16 = nil
+						v52 = nil
 					} else {
-						if v16 == nil {
-							v16 = new(Cart)
+						if v52 == nil {
+							v52 = new(Cart)
 						}
-						(*v16).UnmarshalEasyJSON(in)
+						(*v52).UnmarshalEasyJSON(in)
 					}
-					out.Cart = append(out.Cart, v16)
+					out.Cart = append(out.Cart, v52)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1210,17 +3052,17 @@ func easyjsonCd7c0adaDecodeYulaInternalModels12(in *jlexer.Lexer, out *HttpBodyC
 					out.Adverts = (out.Adverts)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v17 *Advert
+					var v53 *Advert
 					if in.IsNull() {
 						in.Skip()
-						v17 = nil
+						v53 = nil
 					} else {
-						if v17 == nil {
-							v17 = new(Advert)
+						if v53 == nil {
+							v53 = new(Advert)
 						}
-						(*v17).UnmarshalEasyJSON(in)
+						(*v53).UnmarshalEasyJSON(in)
 					}
-					out.Adverts = append(out.Adverts, v17)
+					out.Adverts = append(out.Adverts, v53)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1241,9 +3083,9 @@ func easyjsonCd7c0adaDecodeYulaInternalModels12(in *jlexer.Lexer, out *HttpBodyC
 					out.Hints = (out.Hints)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v18 string
-					v18 = string(in.String())
-					out.Hints = append(out.Hints, v18)
+					var v54 string
+					v54 = string(in.String())
+					out.Hints = append(out.Hints, v54)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1258,7 +3100,7 @@ func easyjsonCd7c0adaDecodeYulaInternalModels12(in *jlexer.Lexer, out *HttpBodyC
 		in.Consumed()
 	}
 }
-func easyjsonCd7c0adaEncodeYulaInternalModels12(out *jwriter.Writer, in HttpBodyCartAll) {
+func easyjsonCd7c0adaEncodeYulaInternalModels31(out *jwriter.Writer, in HttpBodyCartAll) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1269,14 +3111,14 @@ func easyjsonCd7c0adaEncodeYulaInternalModels12(out *jwriter.Writer, in HttpBody
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v19, v20 := range in.Cart {
-				if v19 > 0 {
+			for v55, v56 := range in.Cart {
+				if v55 > 0 {
 					out.RawByte(',')
 				}
-				if v20 == nil {
+				if v56 == nil {
 					out.RawString("null")
 				} else {
-					(*v20).MarshalEasyJSON(out)
+					(*v56).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -1289,14 +3131,14 @@ func easyjsonCd7c0adaEncodeYulaInternalModels12(out *jwriter.Writer, in HttpBody
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v21, v22 := range in.Adverts {
-				if v21 > 0 {
+			for v57, v58 := range in.Adverts {
+				if v57 > 0 {
 					out.RawByte(',')
 				}
-				if v22 == nil {
+				if v58 == nil {
 					out.RawString("null")
 				} else {
-					(*v22).MarshalEasyJSON(out)
+					(*v58).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -1309,11 +3151,11 @@ func easyjsonCd7c0adaEncodeYulaInternalModels12(out *jwriter.Writer, in HttpBody
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v23, v24 := range in.Hints {
-				if v23 > 0 {
+			for v59, v60 := range in.Hints {
+				if v59 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v24))
+				out.String(string(v60))
 			}
 			out.RawByte(']')
 		}
@@ -1324,27 +3166,27 @@ func easyjsonCd7c0adaEncodeYulaInternalModels12(out *jwriter.Writer, in HttpBody
 // MarshalJSON supports json.Marshaler interface
 func (v HttpBodyCartAll) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCd7c0adaEncodeYulaInternalModels12(&w, v)
+	easyjsonCd7c0adaEncodeYulaInternalModels31(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v HttpBodyCartAll) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCd7c0adaEncodeYulaInternalModels12(w, v)
+	easyjsonCd7c0adaEncodeYulaInternalModels31(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *HttpBodyCartAll) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCd7c0adaDecodeYulaInternalModels12(&r, v)
+	easyjsonCd7c0adaDecodeYulaInternalModels31(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *HttpBodyCartAll) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCd7c0adaDecodeYulaInternalModels12(l, v)
+	easyjsonCd7c0adaDecodeYulaInternalModels31(l, v)
 }
-func easyjsonCd7c0adaDecodeYulaInternalModels13(in *jlexer.Lexer, out *HttpBodyCart) {
+func easyjsonCd7c0adaDecodeYulaInternalModels32(in *jlexer.Lexer, out *HttpBodyCart) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1379,17 +3221,17 @@ func easyjsonCd7c0adaDecodeYulaInternalModels13(in *jlexer.Lexer, out *HttpBodyC
 					out.Cart = (out.Cart)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v25 *Cart
+					var v61 *Cart
 					if in.IsNull() {
 						in.Skip()
-						v25 = nil
+						v61 = nil
 					} else {
-						if v25 == nil {
-							v25 = new(Cart)
+						if v61 == nil {
+							v61 = new(Cart)
 						}
-						(*v25).UnmarshalEasyJSON(in)
+						(*v61).UnmarshalEasyJSON(in)
 					}
-					out.Cart = append(out.Cart, v25)
+					out.Cart = append(out.Cart, v61)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1410,17 +3252,17 @@ func easyjsonCd7c0adaDecodeYulaInternalModels13(in *jlexer.Lexer, out *HttpBodyC
 					out.Adverts = (out.Adverts)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v26 *Advert
+					var v62 *Advert
 					if in.IsNull() {
 						in.Skip()
-						v26 = nil
+						v62 = nil
 					} else {
-						if v26 == nil {
-							v26 = new(Advert)
+						if v62 == nil {
+							v62 = new(Advert)
 						}
-						(*v26).UnmarshalEasyJSON(in)
+						(*v62).UnmarshalEasyJSON(in)
 					}
-					out.Adverts = append(out.Adverts, v26)
+					out.Adverts = append(out.Adverts, v62)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1435,7 +3277,7 @@ func easyjsonCd7c0adaDecodeYulaInternalModels13(in *jlexer.Lexer, out *HttpBodyC
 		in.Consumed()
 	}
 }
-func easyjsonCd7c0adaEncodeYulaInternalModels13(out *jwriter.Writer, in HttpBodyCart) {
+func easyjsonCd7c0adaEncodeYulaInternalModels32(out *jwriter.Writer, in HttpBodyCart) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1446,14 +3288,14 @@ func easyjsonCd7c0adaEncodeYulaInternalModels13(out *jwriter.Writer, in HttpBody
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v27, v28 := range in.Cart {
-				if v27 > 0 {
+			for v63, v64 := range in.Cart {
+				if v63 > 0 {
 					out.RawByte(',')
 				}
-				if v28 == nil {
+				if v64 == nil {
 					out.RawString("null")
 				} else {
-					(*v28).MarshalEasyJSON(out)
+					(*v64).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -1466,14 +3308,14 @@ func easyjsonCd7c0adaEncodeYulaInternalModels13(out *jwriter.Writer, in HttpBody
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v29, v30 := range in.Adverts {
-				if v29 > 0 {
+			for v65, v66 := range in.Adverts {
+				if v65 > 0 {
 					out.RawByte(',')
 				}
-				if v30 == nil {
+				if v66 == nil {
 					out.RawString("null")
 				} else {
-					(*v30).MarshalEasyJSON(out)
+					(*v66).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -1485,27 +3327,27 @@ func easyjsonCd7c0adaEncodeYulaInternalModels13(out *jwriter.Writer, in HttpBody
 // MarshalJSON supports json.Marshaler interface
 func (v HttpBodyCart) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCd7c0adaEncodeYulaInternalModels13(&w, v)
+	easyjsonCd7c0adaEncodeYulaInternalModels32(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v HttpBodyCart) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCd7c0adaEncodeYulaInternalModels13(w, v)
+	easyjsonCd7c0adaEncodeYulaInternalModels32(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *HttpBodyCart) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCd7c0adaDecodeYulaInternalModels13(&r, v)
+	easyjsonCd7c0adaDecodeYulaInternalModels32(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *HttpBodyCart) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCd7c0adaDecodeYulaInternalModels13(l, v)
+	easyjsonCd7c0adaDecodeYulaInternalModels32(l, v)
 }
-func easyjsonCd7c0adaDecodeYulaInternalModels14(in *jlexer.Lexer, out *HttpBodyAdverts) {
+func easyjsonCd7c0adaDecodeYulaInternalModels33(in *jlexer.Lexer, out *HttpBodyAdverts) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1540,17 +3382,17 @@ func easyjsonCd7c0adaDecodeYulaInternalModels14(in *jlexer.Lexer, out *HttpBodyA
 					out.Advert = (out.Advert)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v31 *Advert
+					var v67 *Advert
 					if in.IsNull() {
 						in.Skip()
-						v31 = nil
+						v67 = nil
 					} else {
-						if v31 == nil {
-							v31 = new(Advert)
+						if v67 == nil {
+							v67 = new(Advert)
 						}
-						(*v31).UnmarshalEasyJSON(in)
+						(*v67).UnmarshalEasyJSON(in)
 					}
-					out.Advert = append(out.Advert, v31)
+					out.Advert = append(out.Advert, v67)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1565,7 +3407,7 @@ func easyjsonCd7c0adaDecodeYulaInternalModels14(in *jlexer.Lexer, out *HttpBodyA
 		in.Consumed()
 	}
 }
-func easyjsonCd7c0adaEncodeYulaInternalModels14(out *jwriter.Writer, in HttpBodyAdverts) {
+func easyjsonCd7c0adaEncodeYulaInternalModels33(out *jwriter.Writer, in HttpBodyAdverts) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1576,14 +3418,14 @@ func easyjsonCd7c0adaEncodeYulaInternalModels14(out *jwriter.Writer, in HttpBody
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v32, v33 := range in.Advert {
-				if v32 > 0 {
+			for v68, v69 := range in.Advert {
+				if v68 > 0 {
 					out.RawByte(',')
 				}
-				if v33 == nil {
+				if v69 == nil {
 					out.RawString("null")
 				} else {
-					(*v33).MarshalEasyJSON(out)
+					(*v69).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -1595,27 +3437,27 @@ func easyjsonCd7c0adaEncodeYulaInternalModels14(out *jwriter.Writer, in HttpBody
 // MarshalJSON supports json.Marshaler interface
 func (v HttpBodyAdverts) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCd7c0adaEncodeYulaInternalModels14(&w, v)
+	easyjsonCd7c0adaEncodeYulaInternalModels33(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v HttpBodyAdverts) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCd7c0adaEncodeYulaInternalModels14(w, v)
+	easyjsonCd7c0adaEncodeYulaInternalModels33(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *HttpBodyAdverts) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCd7c0adaDecodeYulaInternalModels14(&r, v)
+	easyjsonCd7c0adaDecodeYulaInternalModels33(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *HttpBodyAdverts) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCd7c0adaDecodeYulaInternalModels14(l, v)
+	easyjsonCd7c0adaDecodeYulaInternalModels33(l, v)
 }
-func easyjsonCd7c0adaDecodeYulaInternalModels15(in *jlexer.Lexer, out *HttpBodyAdvertShort) {
+func easyjsonCd7c0adaDecodeYulaInternalModels34(in *jlexer.Lexer, out *HttpBodyAdvertShort) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1646,7 +3488,7 @@ func easyjsonCd7c0adaDecodeYulaInternalModels15(in *jlexer.Lexer, out *HttpBodyA
 		in.Consumed()
 	}
 }
-func easyjsonCd7c0adaEncodeYulaInternalModels15(out *jwriter.Writer, in HttpBodyAdvertShort) {
+func easyjsonCd7c0adaEncodeYulaInternalModels34(out *jwriter.Writer, in HttpBodyAdvertShort) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1661,27 +3503,27 @@ func easyjsonCd7c0adaEncodeYulaInternalModels15(out *jwriter.Writer, in HttpBody
 // MarshalJSON supports json.Marshaler interface
 func (v HttpBodyAdvertShort) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCd7c0adaEncodeYulaInternalModels15(&w, v)
+	easyjsonCd7c0adaEncodeYulaInternalModels34(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v HttpBodyAdvertShort) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCd7c0adaEncodeYulaInternalModels15(w, v)
+	easyjsonCd7c0adaEncodeYulaInternalModels34(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *HttpBodyAdvertShort) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCd7c0adaDecodeYulaInternalModels15(&r, v)
+	easyjsonCd7c0adaDecodeYulaInternalModels34(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *HttpBodyAdvertShort) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCd7c0adaDecodeYulaInternalModels15(l, v)
+	easyjsonCd7c0adaDecodeYulaInternalModels34(l, v)
 }
-func easyjsonCd7c0adaDecodeYulaInternalModels16(in *jlexer.Lexer, out *HttpBodyAdvertDetail) {
+func easyjsonCd7c0adaDecodeYulaInternalModels35(in *jlexer.Lexer, out *HttpBodyAdvertDetail) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1703,9 +3545,9 @@ func easyjsonCd7c0adaDecodeYulaInternalModels16(in *jlexer.Lexer, out *HttpBodyA
 		case "advert":
 			(out.Advert).UnmarshalEasyJSON(in)
 		case "salesman":
-			easyjsonCd7c0adaDecodeYulaInternalModels3(in, &out.Salesman)
+			(out.Salesman).UnmarshalEasyJSON(in)
 		case "rating":
-			easyjsonCd7c0adaDecodeYulaInternalModels4(in, &out.Rating)
+			(out.Rating).UnmarshalEasyJSON(in)
 		case "price_history":
 			if in.IsNull() {
 				in.Skip()
@@ -1722,17 +3564,17 @@ func easyjsonCd7c0adaDecodeYulaInternalModels16(in *jlexer.Lexer, out *HttpBodyA
 					out.PriceHistory = (out.PriceHistory)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v34 *AdvertPrice
+					var v70 *AdvertPrice
 					if in.IsNull() {
 						in.Skip()
-						v34 = nil
+						v70 = nil
 					} else {
-						if v34 == nil {
-							v34 = new(AdvertPrice)
+						if v70 == nil {
+							v70 = new(AdvertPrice)
 						}
-						(*v34).UnmarshalEasyJSON(in)
+						(*v70).UnmarshalEasyJSON(in)
 					}
-					out.PriceHistory = append(out.PriceHistory, v34)
+					out.PriceHistory = append(out.PriceHistory, v70)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1749,7 +3591,7 @@ func easyjsonCd7c0adaDecodeYulaInternalModels16(in *jlexer.Lexer, out *HttpBodyA
 		in.Consumed()
 	}
 }
-func easyjsonCd7c0adaEncodeYulaInternalModels16(out *jwriter.Writer, in HttpBodyAdvertDetail) {
+func easyjsonCd7c0adaEncodeYulaInternalModels35(out *jwriter.Writer, in HttpBodyAdvertDetail) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1761,12 +3603,12 @@ func easyjsonCd7c0adaEncodeYulaInternalModels16(out *jwriter.Writer, in HttpBody
 	{
 		const prefix string = ",\"salesman\":"
 		out.RawString(prefix)
-		easyjsonCd7c0adaEncodeYulaInternalModels3(out, in.Salesman)
+		(in.Salesman).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"rating\":"
 		out.RawString(prefix)
-		easyjsonCd7c0adaEncodeYulaInternalModels4(out, in.Rating)
+		(in.Rating).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"price_history\":"
@@ -1775,14 +3617,14 @@ func easyjsonCd7c0adaEncodeYulaInternalModels16(out *jwriter.Writer, in HttpBody
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v35, v36 := range in.PriceHistory {
-				if v35 > 0 {
+			for v71, v72 := range in.PriceHistory {
+				if v71 > 0 {
 					out.RawByte(',')
 				}
-				if v36 == nil {
+				if v72 == nil {
 					out.RawString("null")
 				} else {
-					(*v36).MarshalEasyJSON(out)
+					(*v72).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -1799,27 +3641,27 @@ func easyjsonCd7c0adaEncodeYulaInternalModels16(out *jwriter.Writer, in HttpBody
 // MarshalJSON supports json.Marshaler interface
 func (v HttpBodyAdvertDetail) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCd7c0adaEncodeYulaInternalModels16(&w, v)
+	easyjsonCd7c0adaEncodeYulaInternalModels35(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v HttpBodyAdvertDetail) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCd7c0adaEncodeYulaInternalModels16(w, v)
+	easyjsonCd7c0adaEncodeYulaInternalModels35(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *HttpBodyAdvertDetail) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCd7c0adaDecodeYulaInternalModels16(&r, v)
+	easyjsonCd7c0adaDecodeYulaInternalModels35(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *HttpBodyAdvertDetail) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCd7c0adaDecodeYulaInternalModels16(l, v)
+	easyjsonCd7c0adaDecodeYulaInternalModels35(l, v)
 }
-func easyjsonCd7c0adaDecodeYulaInternalModels17(in *jlexer.Lexer, out *HttpBodyAdvert) {
+func easyjsonCd7c0adaDecodeYulaInternalModels36(in *jlexer.Lexer, out *HttpBodyAdvert) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1850,7 +3692,7 @@ func easyjsonCd7c0adaDecodeYulaInternalModels17(in *jlexer.Lexer, out *HttpBodyA
 		in.Consumed()
 	}
 }
-func easyjsonCd7c0adaEncodeYulaInternalModels17(out *jwriter.Writer, in HttpBodyAdvert) {
+func easyjsonCd7c0adaEncodeYulaInternalModels36(out *jwriter.Writer, in HttpBodyAdvert) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1865,23 +3707,199 @@ func easyjsonCd7c0adaEncodeYulaInternalModels17(out *jwriter.Writer, in HttpBody
 // MarshalJSON supports json.Marshaler interface
 func (v HttpBodyAdvert) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCd7c0adaEncodeYulaInternalModels17(&w, v)
+	easyjsonCd7c0adaEncodeYulaInternalModels36(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v HttpBodyAdvert) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCd7c0adaEncodeYulaInternalModels17(w, v)
+	easyjsonCd7c0adaEncodeYulaInternalModels36(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *HttpBodyAdvert) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCd7c0adaDecodeYulaInternalModels17(&r, v)
+	easyjsonCd7c0adaDecodeYulaInternalModels36(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *HttpBodyAdvert) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCd7c0adaDecodeYulaInternalModels17(l, v)
+	easyjsonCd7c0adaDecodeYulaInternalModels36(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels37(in *jlexer.Lexer, out *HttpBodyAddresses) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "addresses":
+			if in.IsNull() {
+				in.Skip()
+				out.Addresses = nil
+			} else {
+				in.Delim('[')
+				if out.Addresses == nil {
+					if !in.IsDelim(']') {
+						out.Addresses = make([]*Address, 0, 8)
+					} else {
+						out.Addresses = []*Address{}
+					}
+				} else {
+					out.Addresses = (out.Addresses)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v73 *Address
+					if in.IsNull() {
+						in.Skip()
+						v73 = nil
+					} else {
+						if v73 == nil {
+							v73 = new(Address)
+						}
+						(*v73).UnmarshalEasyJSON(in)
+					}
+					out.Addresses = append(out.Addresses, v73)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCd7c0adaEncodeYulaInternalModels37(out *jwriter.Writer, in HttpBodyAddresses) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"addresses\":"
+		out.RawString(prefix[1:])
+		if in.Addresses == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v74, v75 := range in.Addresses {
+				if v74 > 0 {
+					out.RawByte(',')
+				}
+				if v75 == nil {
+					out.RawString("null")
+				} else {
+					(*v75).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodyAddresses) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels37(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodyAddresses) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels37(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodyAddresses) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels37(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodyAddresses) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels37(l, v)
+}
+func easyjsonCd7c0adaDecodeYulaInternalModels38(in *jlexer.Lexer, out *HttpBodyAddress) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "address":
+			(out.Address).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCd7c0adaEncodeYulaInternalModels38(out *jwriter.Writer, in HttpBodyAddress) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"address\":"
+		out.RawString(prefix[1:])
+		(in.Address).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HttpBodyAddress) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCd7c0adaEncodeYulaInternalModels38(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HttpBodyAddress) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCd7c0adaEncodeYulaInternalModels38(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HttpBodyAddress) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCd7c0adaDecodeYulaInternalModels38(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HttpBodyAddress) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCd7c0adaDecodeYulaInternalModels38(l, v)
 }
@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// виды уведомлений
+const (
+	NotificationChatMessage = "chat_message"
+	NotificationOrder       = "order"
+	NotificationFavorite    = "favorite"
+	NotificationModeration  = "moderation"
+	NotificationExpiry      = "expiry"
+	NotificationSavedSearch = "saved_search"
+)
+
+const (
+	// сколько уведомлений копится в очереди открытого потока, пока клиент их не забрал
+	NotificationStreamBuffer int = 16
+	// как часто в открытый поток пишется пустое событие, чтобы прокси не закрывали соединение
+	NotificationStreamPing time.Duration = 30 * time.Second
+)
+
+type Notification struct {
+	Id     int64  `json:"id" example:"1"`
+	UserId int64  `json:"-"`
+	Kind   string `json:"kind" example:"order"`
+	Text   string `json:"text" example:"Order #12 has been shipped"`
+	// объявление, заказ и собеседник, к которым относится уведомление, 0 - не относится
+	AdvertId  int64     `json:"advert_id,omitempty" example:"3"`
+	OrderId   int64     `json:"order_id,omitempty" example:"12"`
+	SenderId  int64     `json:"sender_id,omitempty" example:"2"`
+	IsRead    bool      `json:"is_read" example:"false"`
+	CreatedAt time.Time `json:"created_at" swaggerignore:"true"`
+}
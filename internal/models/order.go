@@ -0,0 +1,91 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	OrderStatusCreated   string = "created"
+	OrderStatusPaid      string = "paid"
+	OrderStatusConfirmed string = "confirmed"
+	OrderStatusShipped   string = "shipped"
+	OrderStatusDelivered string = "delivered"
+	OrderStatusCancelled string = "cancelled"
+	OrderStatusReleased  string = "released"
+	OrderStatusDisputed  string = "disputed"
+	OrderStatusRefunded  string = "refunded"
+)
+
+const (
+	OrderEventStatus          string = "status"
+	OrderEventDisputeOpened   string = "dispute_opened"
+	OrderEventDisputeAnswered string = "dispute_answered"
+	OrderEventDisputeResolved string = "dispute_resolved"
+)
+
+// Price - цена, по которой товар продан, ListPrice - цена объявления на момент заказа
+type OrderLine struct {
+	OrderId   int64 `json:"order_id" example:"1"`
+	AdvertId  int64 `json:"advert_id" example:"1"`
+	Amount    int64 `json:"amount" example:"1"`
+	Price     int64 `json:"price" example:"90"`
+	ListPrice int64 `json:"list_price" example:"100"`
+	CouponId  int64 `json:"coupon_id,omitempty" example:"1"`
+}
+
+type Order struct {
+	Id         int64  `json:"id" example:"1"`
+	BuyerId    int64  `json:"buyer_id" example:"1"`
+	SalesmanId int64  `json:"salesman_id" example:"2"`
+	Status     string `json:"status" example:"created"`
+
+	DeliveryMethod  string `json:"delivery_method" example:"courier"`
+	DeliveryAddress string `json:"delivery_address" example:"Ivan Ivanov, +79990000000, Moscow, Tverskaya st, 1"`
+
+	CreatedAt time.Time    `json:"created_at" swaggerignore:"true"`
+	UpdatedAt time.Time    `json:"updated_at" swaggerignore:"true"`
+	Lines     []*OrderLine `json:"lines"`
+}
+
+// запись журнала заказа, нулевой actor_id означает действие системы
+type OrderEvent struct {
+	Id        int64     `json:"id" example:"1"`
+	OrderId   int64     `json:"order_id" example:"1"`
+	ActorId   int64     `json:"actor_id" example:"1"`
+	Event     string    `json:"event" example:"status"`
+	Details   string    `json:"details" example:"shipped"`
+	CreatedAt time.Time `json:"created_at" swaggerignore:"true"`
+}
+
+type OrderStatusChange struct {
+	Status string `json:"status" valid:"in(confirmed|shipped|delivered|cancelled)" example:"confirmed"`
+}
+
+// полная стоимость заказа
+func (o *Order) Total() int64 {
+	var total int64
+	for _, line := range o.Lines {
+		total += line.Price * line.Amount
+	}
+	return total
+}
+
+// собираем заказ из одной позиции корзины
+func NewOrder(cart *Cart, advert *Advert) *Order {
+	return &Order{
+		BuyerId:    cart.UserId,
+		SalesmanId: advert.PublisherId,
+		Status:     OrderStatusCreated,
+
+		DeliveryMethod: DeliveryPickup,
+
+		Lines: []*OrderLine{
+			{
+				AdvertId:  cart.AdvertId,
+				Amount:    cart.Amount,
+				Price:     int64(advert.Price),
+				ListPrice: int64(advert.Price),
+			},
+		},
+	}
+}
@@ -0,0 +1,78 @@
+package models
+
+import "time"
+
+const (
+	PaymentStatusPending   string = "pending"
+	PaymentStatusHeld      string = "held"
+	PaymentStatusReleased  string = "released"
+	PaymentStatusRefunded  string = "refunded"
+	PaymentStatusCancelled string = "cancelled"
+)
+
+const (
+	PaymentEventSucceeded string = "payment.succeeded"
+	PaymentEventCanceled  string = "payment.canceled"
+)
+
+// платеж по безопасной сделке: деньги держатся до подтверждения получения
+type Payment struct {
+	Id              int64     `json:"id" example:"1"`
+	OrderId         int64     `json:"order_id" example:"1"`
+	ExternalId      string    `json:"external_id" example:"fake-1"`
+	Amount          int64     `json:"amount" example:"100"`
+	Refunded        int64     `json:"refunded" example:"0"`
+	Status          string    `json:"status" example:"pending"`
+	ConfirmationUrl string    `json:"confirmation_url,omitempty" example:"fake://pay/fake-1"`
+	CreatedAt       time.Time `json:"created_at" swaggerignore:"true"`
+	UpdatedAt       time.Time `json:"updated_at" swaggerignore:"true"`
+}
+
+// уведомление платежного провайдера о смене статуса платежа
+type PaymentNotification struct {
+	ExternalId string `json:"payment_id" valid:"type(string),stringlength(1|64)" example:"fake-1"`
+	Event      string `json:"event" valid:"in(payment.succeeded|payment.canceled)" example:"payment.succeeded"`
+}
+
+// сумма в формате ЮKassa: рубли строкой с копейками
+type YooKassaAmount struct {
+	Value    string `json:"value"`
+	Currency string `json:"currency"`
+}
+
+type YooKassaConfirmation struct {
+	Type            string `json:"type"`
+	ReturnUrl       string `json:"return_url,omitempty"`
+	ConfirmationUrl string `json:"confirmation_url,omitempty"`
+}
+
+// запрос на создание платежа, capture=false оставляет деньги удержанными до подтверждения
+type YooKassaPaymentRequest struct {
+	Amount       YooKassaAmount       `json:"amount"`
+	Capture      bool                 `json:"capture"`
+	Confirmation YooKassaConfirmation `json:"confirmation"`
+	Description  string               `json:"description"`
+}
+
+type YooKassaCaptureRequest struct {
+	Amount YooKassaAmount `json:"amount"`
+}
+
+type YooKassaRefundRequest struct {
+	PaymentId string         `json:"payment_id"`
+	Amount    YooKassaAmount `json:"amount"`
+}
+
+// платеж в ответах API ЮKassa
+type YooKassaPayment struct {
+	Id           string               `json:"id"`
+	Status       string               `json:"status"`
+	Amount       YooKassaAmount       `json:"amount"`
+	Confirmation YooKassaConfirmation `json:"confirmation"`
+}
+
+// уведомление ЮKassa, из него берется только id платежа, статус перепроверяется через API
+type YooKassaNotification struct {
+	Event  string          `json:"event"`
+	Object YooKassaPayment `json:"object"`
+}
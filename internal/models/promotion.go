@@ -0,0 +1,150 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// выделенное объявление только подсвечивается в выдаче
+	PromotionHighlightLevel int64 = 1
+	// объявления в топе занимают зарезервированные места в начале каждой страницы выдачи
+	PromotionTopLevel int64 = 2
+
+	// сколько мест на странице отдается объявлениям в топе, на маленьких страницах - не больше половины
+	PromotedSlots int64 = 4
+)
+
+type PromotionPackage struct {
+	Id    string `json:"id" example:"top_3"`
+	Title string `json:"title" example:"Топ на 3 дня"`
+	Level int64  `json:"level" example:"2"`
+	Days  int64  `json:"days" example:"3"`
+	Price int64  `json:"price" example:"199"`
+}
+
+var PromotionPackages = []*PromotionPackage{
+	{Id: "highlight_7", Title: "Выделение на 7 дней", Level: PromotionHighlightLevel, Days: 7, Price: 99},
+	{Id: "top_3", Title: "Топ на 3 дня", Level: PromotionTopLevel, Days: 3, Price: 199},
+	{Id: "top_7", Title: "Топ на 7 дней", Level: PromotionTopLevel, Days: 7, Price: 399},
+}
+
+func GetPromotionPackage(id string) *PromotionPackage {
+	for _, pkg := range PromotionPackages {
+		if pkg.Id == id {
+			return pkg
+		}
+	}
+	return nil
+}
+
+// PromotedSlotsOnPage возвращает число мест под объявления в топе на странице из count объявлений
+func PromotedSlotsOnPage(count int64) int64 {
+	if PromotedSlots > count/2 {
+		return count / 2
+	}
+	return PromotedSlots
+}
+
+// ReservePromotedSlots расставляет уже отсортированную выдачу по страницам из count объявлений:
+// первые места каждой страницы занимают объявления в топе, остальные - обычная выдача в исходном порядке
+func ReservePromotedSlots(adverts []*Advert, count int64) []*Advert {
+	slots := PromotedSlotsOnPage(count)
+	if slots == 0 {
+		return adverts
+	}
+
+	promoted := make([]*Advert, 0)
+	organic := make([]*Advert, 0, len(adverts))
+	for _, advert := range adverts {
+		if advert.PromoLevel >= PromotionTopLevel {
+			promoted = append(promoted, advert)
+		} else {
+			organic = append(organic, advert)
+		}
+	}
+
+	result := make([]*Advert, 0, len(adverts))
+	for len(promoted) != 0 || len(organic) != 0 {
+		n := minInt64(slots, int64(len(promoted)))
+		result, promoted = append(result, promoted[:n]...), promoted[n:]
+		n = minInt64(count-slots, int64(len(organic)))
+		result, organic = append(result, organic[:n]...), organic[n:]
+	}
+	return result
+}
+
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+const (
+	PromotionPaymentPending string = "pending"
+	// уведомление по платежу сейчас применяется, повторные уведомления его не трогают
+	PromotionPaymentProcessing string = "processing"
+	PromotionPaymentApplied    string = "applied"
+	PromotionPaymentRejected   string = "rejected"
+	PromotionPaymentFailed     string = "failed"
+)
+
+// PromotionLabel - метка платежа за продвижение: id покупателя, id объявления и id пакета,
+// провайдер возвращает ее в уведомлении без изменений
+func PromotionLabel(userId int64, advertId int64, packageId string) string {
+	return fmt.Sprintf("%d__%d__%s", userId, advertId, packageId)
+}
+
+func ParsePromotionLabel(label string) (userId int64, advertId int64, packageId string, err error) {
+	vals := strings.Split(label, "__")
+	if len(vals) != 3 {
+		return 0, 0, "", fmt.Errorf("invalid promotion label %q", label)
+	}
+	userId, err = strconv.ParseInt(vals[0], 10, 64)
+	if err != nil {
+		return 0, 0, "", err
+	}
+	advertId, err = strconv.ParseInt(vals[1], 10, 64)
+	if err != nil {
+		return 0, 0, "", err
+	}
+	return userId, advertId, vals[2], nil
+}
+
+type PromotionCheckoutRequest struct {
+	Package string `json:"package" valid:"required,type(string),stringlength(1|64)" example:"top_3"`
+}
+
+// ссылка на оплату пакета продвижения
+type PromotionCheckout struct {
+	AdvertId    int64  `json:"advert_id" example:"1"`
+	Package     string `json:"package" example:"top_3"`
+	Price       int64  `json:"price" example:"199"`
+	Label       string `json:"label" example:"1__1__top_3"`
+	CheckoutUrl string `json:"checkout_url" example:"fake://promotion?label=1__1__top_3&sum=199"`
+}
+
+// уведомление провайдера об оплате, Amount - сколько фактически зачислено, в копейках
+type PromotionNotification struct {
+	OperationId string
+	Label       string
+	Amount      int64
+	// деньги еще не зачислены (защищенный перевод или перевод требует подтверждения)
+	Unaccepted bool
+}
+
+// платеж за продвижение, operation_id уникален и защищает от повторной обработки уведомления
+type PromotionPayment struct {
+	Id          int64     `json:"id" example:"1"`
+	OperationId string    `json:"operation_id" example:"1234567"`
+	UserId      int64     `json:"user_id" example:"1"`
+	AdvertId    int64     `json:"advert_id" example:"1"`
+	Package     string    `json:"package" example:"top_3"`
+	Amount      int64     `json:"amount" example:"19900"`
+	Status      string    `json:"status" example:"applied"`
+	CreatedAt   time.Time `json:"created_at" swaggerignore:"true"`
+	UpdatedAt   time.Time `json:"updated_at" swaggerignore:"true"`
+}
@@ -0,0 +1,486 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonDad19f25DecodeYulaInternalModels(in *jlexer.Lexer, out *PromotionPayment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = int64(in.Int64())
+		case "operation_id":
+			out.OperationId = string(in.String())
+		case "user_id":
+			out.UserId = int64(in.Int64())
+		case "advert_id":
+			out.AdvertId = int64(in.Int64())
+		case "package":
+			out.Package = string(in.String())
+		case "amount":
+			out.Amount = int64(in.Int64())
+		case "status":
+			out.Status = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDad19f25EncodeYulaInternalModels(out *jwriter.Writer, in PromotionPayment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Id))
+	}
+	{
+		const prefix string = ",\"operation_id\":"
+		out.RawString(prefix)
+		out.String(string(in.OperationId))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UserId))
+	}
+	{
+		const prefix string = ",\"advert_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AdvertId))
+	}
+	{
+		const prefix string = ",\"package\":"
+		out.RawString(prefix)
+		out.String(string(in.Package))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Amount))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PromotionPayment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDad19f25EncodeYulaInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PromotionPayment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDad19f25EncodeYulaInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PromotionPayment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDad19f25DecodeYulaInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PromotionPayment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDad19f25DecodeYulaInternalModels(l, v)
+}
+func easyjsonDad19f25DecodeYulaInternalModels1(in *jlexer.Lexer, out *PromotionPackage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "level":
+			out.Level = int64(in.Int64())
+		case "days":
+			out.Days = int64(in.Int64())
+		case "price":
+			out.Price = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDad19f25EncodeYulaInternalModels1(out *jwriter.Writer, in PromotionPackage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Id))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"level\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Level))
+	}
+	{
+		const prefix string = ",\"days\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Days))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Price))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PromotionPackage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDad19f25EncodeYulaInternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PromotionPackage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDad19f25EncodeYulaInternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PromotionPackage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDad19f25DecodeYulaInternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PromotionPackage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDad19f25DecodeYulaInternalModels1(l, v)
+}
+func easyjsonDad19f25DecodeYulaInternalModels2(in *jlexer.Lexer, out *PromotionNotification) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "OperationId":
+			out.OperationId = string(in.String())
+		case "Label":
+			out.Label = string(in.String())
+		case "Amount":
+			out.Amount = int64(in.Int64())
+		case "Unaccepted":
+			out.Unaccepted = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDad19f25EncodeYulaInternalModels2(out *jwriter.Writer, in PromotionNotification) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"OperationId\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.OperationId))
+	}
+	{
+		const prefix string = ",\"Label\":"
+		out.RawString(prefix)
+		out.String(string(in.Label))
+	}
+	{
+		const prefix string = ",\"Amount\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Amount))
+	}
+	{
+		const prefix string = ",\"Unaccepted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Unaccepted))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PromotionNotification) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDad19f25EncodeYulaInternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PromotionNotification) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDad19f25EncodeYulaInternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PromotionNotification) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDad19f25DecodeYulaInternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PromotionNotification) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDad19f25DecodeYulaInternalModels2(l, v)
+}
+func easyjsonDad19f25DecodeYulaInternalModels3(in *jlexer.Lexer, out *PromotionCheckoutRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "package":
+			out.Package = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDad19f25EncodeYulaInternalModels3(out *jwriter.Writer, in PromotionCheckoutRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"package\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Package))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PromotionCheckoutRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDad19f25EncodeYulaInternalModels3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PromotionCheckoutRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDad19f25EncodeYulaInternalModels3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PromotionCheckoutRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDad19f25DecodeYulaInternalModels3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PromotionCheckoutRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDad19f25DecodeYulaInternalModels3(l, v)
+}
+func easyjsonDad19f25DecodeYulaInternalModels4(in *jlexer.Lexer, out *PromotionCheckout) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "advert_id":
+			out.AdvertId = int64(in.Int64())
+		case "package":
+			out.Package = string(in.String())
+		case "price":
+			out.Price = int64(in.Int64())
+		case "label":
+			out.Label = string(in.String())
+		case "checkout_url":
+			out.CheckoutUrl = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDad19f25EncodeYulaInternalModels4(out *jwriter.Writer, in PromotionCheckout) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"advert_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.AdvertId))
+	}
+	{
+		const prefix string = ",\"package\":"
+		out.RawString(prefix)
+		out.String(string(in.Package))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Price))
+	}
+	{
+		const prefix string = ",\"label\":"
+		out.RawString(prefix)
+		out.String(string(in.Label))
+	}
+	{
+		const prefix string = ",\"checkout_url\":"
+		out.RawString(prefix)
+		out.String(string(in.CheckoutUrl))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PromotionCheckout) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDad19f25EncodeYulaInternalModels4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PromotionCheckout) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDad19f25EncodeYulaInternalModels4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PromotionCheckout) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDad19f25DecodeYulaInternalModels4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PromotionCheckout) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDad19f25DecodeYulaInternalModels4(l, v)
+}
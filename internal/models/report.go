@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+const (
+	ReportTargetAdvert  string = "advert"
+	ReportTargetUser    string = "user"
+	ReportTargetMessage string = "message"
+
+	ReportStatusOpen      string = "open"
+	ReportStatusDismissed string = "dismissed"
+	ReportStatusConfirmed string = "confirmed"
+
+	ReportActionDismiss string = "dismiss"
+	ReportActionConfirm string = "confirm"
+)
+
+type Report struct {
+	Id         int64     `json:"id" example:"1"`
+	ReporterId int64     `json:"reporter_id" example:"2"`
+	TargetType string    `json:"target_type" example:"advert"`
+	TargetId   int64     `json:"target_id" example:"10"`
+	Reason     string    `json:"reason" example:"fraud"`
+	Comment    string    `json:"comment" example:"asks for prepayment to a card"`
+	Status     string    `json:"status" example:"open"`
+	CreatedAt  time.Time `json:"created_at" swaggerignore:"true"`
+}
+
+type ReportInput struct {
+	TargetType string `json:"target_type" valid:"in(advert|user|message),required" example:"advert"`
+	TargetId   int64  `json:"target_id" valid:"required" example:"10"`
+	Reason     string `json:"reason" valid:"in(fraud|spam|prohibited|offensive|other),required" example:"fraud"`
+	Comment    string `json:"comment" valid:"type(string),stringlength(0|1000),optional" example:"asks for prepayment to a card"`
+}
+
+// ReportTarget - строка очереди модерации: все открытые жалобы на одну цель
+type ReportTarget struct {
+	TargetType   string    `json:"target_type" example:"advert"`
+	TargetId     int64     `json:"target_id" example:"10"`
+	ReportsCount int64     `json:"reports_count" example:"3"`
+	Reasons      []string  `json:"reasons"`
+	LastReportAt time.Time `json:"last_report_at" swaggerignore:"true"`
+}
+
+type ReportResolution struct {
+	Action string `json:"action" valid:"in(dismiss|confirm),required" example:"confirm"`
+}
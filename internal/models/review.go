@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+)
+
+type Review struct {
+	Id        int64      `json:"id" example:"1"`
+	OrderId   int64      `json:"order_id" example:"1"`
+	AuthorId  int64      `json:"author_id" example:"1"`
+	TargetId  int64      `json:"target_id" example:"2"`
+	Score     int        `json:"score" example:"5"`
+	Text      string     `json:"text" example:"all good"`
+	Reply     string     `json:"reply,omitempty" example:"thanks"`
+	CreatedAt time.Time  `json:"created_at" swaggerignore:"true"`
+	RepliedAt *time.Time `json:"replied_at,omitempty" swaggerignore:"true"`
+}
+
+type ReviewInput struct {
+	Score int    `json:"score" valid:"range(1|5),required" example:"5"`
+	Text  string `json:"text" valid:"type(string),stringlength(0|2000)" example:"all good"`
+}
+
+type ReviewReply struct {
+	Text string `json:"text" valid:"type(string),stringlength(1|2000),required" example:"thanks"`
+}
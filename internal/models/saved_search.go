@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+const (
+	// сколько поисков может сохранить один пользователь
+	SavedSearchLimit int = 20
+	// сколько новых объявлений показывается в одном оповещении
+	SavedSearchAlertAdverts int = 10
+)
+
+type SavedSearchInput struct {
+	Name string `json:"name" valid:"required,type(string),stringlength(1|100)" example:"Велосипеды рядом"`
+	// параметры запроса /search в том же виде, что и в адресной строке
+	Params string `json:"params" valid:"required,type(string),stringlength(1|2000)" example:"query=велосипед&category=transport"`
+}
+
+type SavedSearch struct {
+	Id        int64     `json:"id" example:"1"`
+	UserId    int64     `json:"-"`
+	Name      string    `json:"name" example:"Велосипеды рядом"`
+	Params    string    `json:"params" example:"category=transport&query=велосипед"`
+	LastRunAt time.Time `json:"last_run_at" swaggerignore:"true"`
+	CreatedAt time.Time `json:"created_at" swaggerignore:"true"`
+}
+
+// SavedSearchAlert - новые объявления по сохраненному поиску с прошлой проверки
+type SavedSearchAlert struct {
+	SavedSearch *SavedSearch   `json:"saved_search"`
+	Total       int            `json:"total" example:"12"`
+	Adverts     []*AdvertShort `json:"adverts"`
+}
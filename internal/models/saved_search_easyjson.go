@@ -0,0 +1,326 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonD15b35c8DecodeYulaInternalModels(in *jlexer.Lexer, out *SavedSearchInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "params":
+			out.Params = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD15b35c8EncodeYulaInternalModels(out *jwriter.Writer, in SavedSearchInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"params\":"
+		out.RawString(prefix)
+		out.String(string(in.Params))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SavedSearchInput) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD15b35c8EncodeYulaInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SavedSearchInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD15b35c8EncodeYulaInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SavedSearchInput) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD15b35c8DecodeYulaInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SavedSearchInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD15b35c8DecodeYulaInternalModels(l, v)
+}
+func easyjsonD15b35c8DecodeYulaInternalModels1(in *jlexer.Lexer, out *SavedSearchAlert) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "saved_search":
+			if in.IsNull() {
+				in.Skip()
+				out.SavedSearch = nil
+			} else {
+				if out.SavedSearch == nil {
+					out.SavedSearch = new(SavedSearch)
+				}
+				(*out.SavedSearch).UnmarshalEasyJSON(in)
+			}
+		case "total":
+			out.Total = int(in.Int())
+		case "adverts":
+			if in.IsNull() {
+				in.Skip()
+				out.Adverts = nil
+			} else {
+				in.Delim('[')
+				if out.Adverts == nil {
+					if !in.IsDelim(']') {
+						out.Adverts = make([]*AdvertShort, 0, 8)
+					} else {
+						out.Adverts = []*AdvertShort{}
+					}
+				} else {
+					out.Adverts = (out.Adverts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 *AdvertShort
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						if v1 == nil {
+							v1 = new(AdvertShort)
+						}
+						(*v1).UnmarshalEasyJSON(in)
+					}
+					out.Adverts = append(out.Adverts, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD15b35c8EncodeYulaInternalModels1(out *jwriter.Writer, in SavedSearchAlert) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"saved_search\":"
+		out.RawString(prefix[1:])
+		if in.SavedSearch == nil {
+			out.RawString("null")
+		} else {
+			(*in.SavedSearch).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	{
+		const prefix string = ",\"adverts\":"
+		out.RawString(prefix)
+		if in.Adverts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Adverts {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				if v3 == nil {
+					out.RawString("null")
+				} else {
+					(*v3).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SavedSearchAlert) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD15b35c8EncodeYulaInternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SavedSearchAlert) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD15b35c8EncodeYulaInternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SavedSearchAlert) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD15b35c8DecodeYulaInternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SavedSearchAlert) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD15b35c8DecodeYulaInternalModels1(l, v)
+}
+func easyjsonD15b35c8DecodeYulaInternalModels2(in *jlexer.Lexer, out *SavedSearch) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = int64(in.Int64())
+		case "name":
+			out.Name = string(in.String())
+		case "params":
+			out.Params = string(in.String())
+		case "last_run_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.LastRunAt).UnmarshalJSON(data))
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD15b35c8EncodeYulaInternalModels2(out *jwriter.Writer, in SavedSearch) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Id))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"params\":"
+		out.RawString(prefix)
+		out.String(string(in.Params))
+	}
+	{
+		const prefix string = ",\"last_run_at\":"
+		out.RawString(prefix)
+		out.Raw((in.LastRunAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SavedSearch) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD15b35c8EncodeYulaInternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SavedSearch) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD15b35c8EncodeYulaInternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SavedSearch) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD15b35c8DecodeYulaInternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SavedSearch) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD15b35c8DecodeYulaInternalModels2(l, v)
+}
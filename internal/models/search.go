@@ -1,8 +1,10 @@
 package models
 
 import (
+	"math"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 	internalError "yula/internal/error"
 )
@@ -12,6 +14,8 @@ const (
 	LatitudeNone     float64 = -80
 	LongitudeNone    float64 = 80
 	RadiusNone       int64   = -1
+
+	AttributeFilterPrefix string = "attr."
 )
 
 type SearchFilter struct {
@@ -24,6 +28,15 @@ type SearchFilter struct {
 	Radius       int64     `valid:"int,optional"`
 	SortingDate  bool      `valid:"optional"`
 	SortingName  bool      `valid:"optional"`
+
+	// только объявления, опубликованные позже этого момента, нужно сохраненным поискам
+	PublishedFrom time.Time `valid:"-"`
+
+	// фильтры по атрибутам категории: attr.size=M сравнивается на равенство,
+	// attr.year_from и attr.year_to задают границы числового атрибута year
+	Attributes     map[string]string  `valid:"-"`
+	AttributesFrom map[string]float64 `valid:"-"`
+	AttributesTo   map[string]float64 `valid:"-"`
 }
 
 func NewSearchFilter(values *url.Values) (*SearchFilter, error) {
@@ -108,5 +121,46 @@ func NewSearchFilter(values *url.Values) (*SearchFilter, error) {
 		sf.SortingDate = tmp
 	}
 
+	for key := range *values {
+		if !strings.HasPrefix(key, AttributeFilterPrefix) {
+			continue
+		}
+		if err := sf.addAttributeFilter(strings.TrimPrefix(key, AttributeFilterPrefix), values.Get(key)); err != nil {
+			return nil, err
+		}
+	}
+
 	return sf, nil
 }
+
+func (sf *SearchFilter) addAttributeFilter(name string, value string) error {
+	if name == "" || value == "" {
+		return internalError.BadRequest
+	}
+
+	if !strings.HasSuffix(name, AttributeFromSuffix) && !strings.HasSuffix(name, AttributeToSuffix) {
+		if sf.Attributes == nil {
+			sf.Attributes = make(map[string]string)
+		}
+		sf.Attributes[name] = value
+		return nil
+	}
+
+	bound, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(bound) || math.IsInf(bound, 0) {
+		return internalError.BadRequest
+	}
+
+	if strings.HasSuffix(name, AttributeFromSuffix) {
+		if sf.AttributesFrom == nil {
+			sf.AttributesFrom = make(map[string]float64)
+		}
+		sf.AttributesFrom[strings.TrimSuffix(name, AttributeFromSuffix)] = bound
+	} else {
+		if sf.AttributesTo == nil {
+			sf.AttributesTo = make(map[string]float64)
+		}
+		sf.AttributesTo[strings.TrimSuffix(name, AttributeToSuffix)] = bound
+	}
+	return nil
+}
@@ -56,6 +56,58 @@ func easyjsonD4176298DecodeYulaInternalModels(in *jlexer.Lexer, out *SearchFilte
 			out.SortingDate = bool(in.Bool())
 		case "SortingName":
 			out.SortingName = bool(in.Bool())
+		case "PublishedFrom":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PublishedFrom).UnmarshalJSON(data))
+			}
+		case "Attributes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Attributes = make(map[string]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v1 string
+					v1 = string(in.String())
+					(out.Attributes)[key] = v1
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "AttributesFrom":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.AttributesFrom = make(map[string]float64)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v2 float64
+					v2 = float64(in.Float64())
+					(out.AttributesFrom)[key] = v2
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "AttributesTo":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.AttributesTo = make(map[string]float64)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v3 float64
+					v3 = float64(in.Float64())
+					(out.AttributesTo)[key] = v3
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -115,6 +167,74 @@ func easyjsonD4176298EncodeYulaInternalModels(out *jwriter.Writer, in SearchFilt
 		out.RawString(prefix)
 		out.Bool(bool(in.SortingName))
 	}
+	{
+		const prefix string = ",\"PublishedFrom\":"
+		out.RawString(prefix)
+		out.Raw((in.PublishedFrom).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"Attributes\":"
+		out.RawString(prefix)
+		if in.Attributes == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v4First := true
+			for v4Name, v4Value := range in.Attributes {
+				if v4First {
+					v4First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v4Name))
+				out.RawByte(':')
+				out.String(string(v4Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"AttributesFrom\":"
+		out.RawString(prefix)
+		if in.AttributesFrom == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v5First := true
+			for v5Name, v5Value := range in.AttributesFrom {
+				if v5First {
+					v5First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v5Name))
+				out.RawByte(':')
+				out.Float64(float64(v5Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"AttributesTo\":"
+		out.RawString(prefix)
+		if in.AttributesTo == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v6First := true
+			for v6Name, v6Value := range in.AttributesTo {
+				if v6First {
+					v6First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v6Name))
+				out.RawByte(':')
+				out.Float64(float64(v6Value))
+			}
+			out.RawByte('}')
+		}
+	}
 	out.RawByte('}')
 }
 
@@ -4,6 +4,12 @@ import (
 	"time"
 )
 
+const (
+	RoleUser      string = "user"
+	RoleModerator string = "moderator"
+	RoleAdmin     string = "admin"
+)
+
 type UserData struct {
 	Id        int64     `json:"id" valid:"-"`
 	Email     string    `json:"email" valid:"email"`
@@ -13,6 +19,13 @@ type UserData struct {
 	Name      string    `json:"name" valid:"type(string),minstringlength(2)"`
 	Surname   string    `json:"surname" valid:"type(string),minstringlength(2)"`
 	Image     string    `json:"image" valid:"-"`
+	Role      string    `json:"role" valid:"-"`
+	IsBanned  bool      `json:"is_banned" valid:"-"`
+	IsHidden  bool      `json:"is_hidden" valid:"-"`
+}
+
+type UserRole struct {
+	Role string `json:"role" valid:"in(user|moderator|admin),required" example:"moderator"`
 }
 
 type UserSignIn struct {
@@ -35,6 +48,9 @@ type Profile struct {
 	Name      string    `json:"name" valid:"type(string),minstringlength(2)"`
 	Surname   string    `json:"surname" valid:"type(string),minstringlength(2)"`
 	Image     string    `json:"image" valid:"-"`
+
+	// страница продавца скрыта по жалобам до проверки модератором
+	IsHidden bool `json:"-" valid:"-"`
 }
 
 func (user *UserData) ToProfile() *Profile {
@@ -42,6 +58,7 @@ func (user *UserData) ToProfile() *Profile {
 		Id: user.Id, Email: user.Email, Phone: user.Phone,
 		CreatedAt: user.CreatedAt, Name: user.Name,
 		Surname: user.Surname, Image: user.Image,
+		IsHidden: user.IsHidden,
 	}
 }
 
@@ -177,7 +177,73 @@ func (v *UserSignIn) UnmarshalJSON(data []byte) error {
 func (v *UserSignIn) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson9e1087fdDecodeYulaInternalModels1(l, v)
 }
-func easyjson9e1087fdDecodeYulaInternalModels2(in *jlexer.Lexer, out *UserData) {
+func easyjson9e1087fdDecodeYulaInternalModels2(in *jlexer.Lexer, out *UserRole) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "role":
+			out.Role = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9e1087fdEncodeYulaInternalModels2(out *jwriter.Writer, in UserRole) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Role))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UserRole) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9e1087fdEncodeYulaInternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UserRole) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9e1087fdEncodeYulaInternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UserRole) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9e1087fdDecodeYulaInternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UserRole) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9e1087fdDecodeYulaInternalModels2(l, v)
+}
+func easyjson9e1087fdDecodeYulaInternalModels3(in *jlexer.Lexer, out *UserData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -214,6 +280,12 @@ func easyjson9e1087fdDecodeYulaInternalModels2(in *jlexer.Lexer, out *UserData)
 			out.Surname = string(in.String())
 		case "image":
 			out.Image = string(in.String())
+		case "role":
+			out.Role = string(in.String())
+		case "is_banned":
+			out.IsBanned = bool(in.Bool())
+		case "is_hidden":
+			out.IsHidden = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -224,7 +296,7 @@ func easyjson9e1087fdDecodeYulaInternalModels2(in *jlexer.Lexer, out *UserData)
 		in.Consumed()
 	}
 }
-func easyjson9e1087fdEncodeYulaInternalModels2(out *jwriter.Writer, in UserData) {
+func easyjson9e1087fdEncodeYulaInternalModels3(out *jwriter.Writer, in UserData) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -268,33 +340,48 @@ func easyjson9e1087fdEncodeYulaInternalModels2(out *jwriter.Writer, in UserData)
 		out.RawString(prefix)
 		out.String(string(in.Image))
 	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	{
+		const prefix string = ",\"is_banned\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsBanned))
+	}
+	{
+		const prefix string = ",\"is_hidden\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsHidden))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v UserData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson9e1087fdEncodeYulaInternalModels2(&w, v)
+	easyjson9e1087fdEncodeYulaInternalModels3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UserData) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson9e1087fdEncodeYulaInternalModels2(w, v)
+	easyjson9e1087fdEncodeYulaInternalModels3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UserData) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson9e1087fdDecodeYulaInternalModels2(&r, v)
+	easyjson9e1087fdDecodeYulaInternalModels3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UserData) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson9e1087fdDecodeYulaInternalModels2(l, v)
+	easyjson9e1087fdDecodeYulaInternalModels3(l, v)
 }
-func easyjson9e1087fdDecodeYulaInternalModels3(in *jlexer.Lexer, out *RatingStat) {
+func easyjson9e1087fdDecodeYulaInternalModels4(in *jlexer.Lexer, out *RatingStat) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -333,7 +420,7 @@ func easyjson9e1087fdDecodeYulaInternalModels3(in *jlexer.Lexer, out *RatingStat
 		in.Consumed()
 	}
 }
-func easyjson9e1087fdEncodeYulaInternalModels3(out *jwriter.Writer, in RatingStat) {
+func easyjson9e1087fdEncodeYulaInternalModels4(out *jwriter.Writer, in RatingStat) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -368,27 +455,27 @@ func easyjson9e1087fdEncodeYulaInternalModels3(out *jwriter.Writer, in RatingSta
 // MarshalJSON supports json.Marshaler interface
 func (v RatingStat) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson9e1087fdEncodeYulaInternalModels3(&w, v)
+	easyjson9e1087fdEncodeYulaInternalModels4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v RatingStat) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson9e1087fdEncodeYulaInternalModels3(w, v)
+	easyjson9e1087fdEncodeYulaInternalModels4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *RatingStat) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson9e1087fdDecodeYulaInternalModels3(&r, v)
+	easyjson9e1087fdDecodeYulaInternalModels4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *RatingStat) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson9e1087fdDecodeYulaInternalModels3(l, v)
+	easyjson9e1087fdDecodeYulaInternalModels4(l, v)
 }
-func easyjson9e1087fdDecodeYulaInternalModels4(in *jlexer.Lexer, out *Rating) {
+func easyjson9e1087fdDecodeYulaInternalModels5(in *jlexer.Lexer, out *Rating) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -423,7 +510,7 @@ func easyjson9e1087fdDecodeYulaInternalModels4(in *jlexer.Lexer, out *Rating) {
 		in.Consumed()
 	}
 }
-func easyjson9e1087fdEncodeYulaInternalModels4(out *jwriter.Writer, in Rating) {
+func easyjson9e1087fdEncodeYulaInternalModels5(out *jwriter.Writer, in Rating) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -448,27 +535,27 @@ func easyjson9e1087fdEncodeYulaInternalModels4(out *jwriter.Writer, in Rating) {
 // MarshalJSON supports json.Marshaler interface
 func (v Rating) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson9e1087fdEncodeYulaInternalModels4(&w, v)
+	easyjson9e1087fdEncodeYulaInternalModels5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Rating) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson9e1087fdEncodeYulaInternalModels4(w, v)
+	easyjson9e1087fdEncodeYulaInternalModels5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Rating) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson9e1087fdDecodeYulaInternalModels4(&r, v)
+	easyjson9e1087fdDecodeYulaInternalModels5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Rating) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson9e1087fdDecodeYulaInternalModels4(l, v)
+	easyjson9e1087fdDecodeYulaInternalModels5(l, v)
 }
-func easyjson9e1087fdDecodeYulaInternalModels5(in *jlexer.Lexer, out *Profile) {
+func easyjson9e1087fdDecodeYulaInternalModels6(in *jlexer.Lexer, out *Profile) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -513,7 +600,7 @@ func easyjson9e1087fdDecodeYulaInternalModels5(in *jlexer.Lexer, out *Profile) {
 		in.Consumed()
 	}
 }
-func easyjson9e1087fdEncodeYulaInternalModels5(out *jwriter.Writer, in Profile) {
+func easyjson9e1087fdEncodeYulaInternalModels6(out *jwriter.Writer, in Profile) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -558,27 +645,27 @@ func easyjson9e1087fdEncodeYulaInternalModels5(out *jwriter.Writer, in Profile)
 // MarshalJSON supports json.Marshaler interface
 func (v Profile) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson9e1087fdEncodeYulaInternalModels5(&w, v)
+	easyjson9e1087fdEncodeYulaInternalModels6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Profile) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson9e1087fdEncodeYulaInternalModels5(w, v)
+	easyjson9e1087fdEncodeYulaInternalModels6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Profile) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson9e1087fdDecodeYulaInternalModels5(&r, v)
+	easyjson9e1087fdDecodeYulaInternalModels6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Profile) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson9e1087fdDecodeYulaInternalModels5(l, v)
+	easyjson9e1087fdDecodeYulaInternalModels6(l, v)
 }
-func easyjson9e1087fdDecodeYulaInternalModels6(in *jlexer.Lexer, out *ChangePassword) {
+func easyjson9e1087fdDecodeYulaInternalModels7(in *jlexer.Lexer, out *ChangePassword) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -613,7 +700,7 @@ func easyjson9e1087fdDecodeYulaInternalModels6(in *jlexer.Lexer, out *ChangePass
 		in.Consumed()
 	}
 }
-func easyjson9e1087fdEncodeYulaInternalModels6(out *jwriter.Writer, in ChangePassword) {
+func easyjson9e1087fdEncodeYulaInternalModels7(out *jwriter.Writer, in ChangePassword) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -638,23 +725,23 @@ func easyjson9e1087fdEncodeYulaInternalModels6(out *jwriter.Writer, in ChangePas
 // MarshalJSON supports json.Marshaler interface
 func (v ChangePassword) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson9e1087fdEncodeYulaInternalModels6(&w, v)
+	easyjson9e1087fdEncodeYulaInternalModels7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ChangePassword) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson9e1087fdEncodeYulaInternalModels6(w, v)
+	easyjson9e1087fdEncodeYulaInternalModels7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ChangePassword) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson9e1087fdDecodeYulaInternalModels6(&r, v)
+	easyjson9e1087fdDecodeYulaInternalModels7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ChangePassword) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson9e1087fdDecodeYulaInternalModels6(l, v)
+	easyjson9e1087fdDecodeYulaInternalModels7(l, v)
 }
@@ -0,0 +1,292 @@
+package delivery
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/admin"
+	"yula/internal/pkg/logging"
+	"yula/internal/pkg/middleware"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	logger logging.Logger = logging.GetLogger()
+)
+
+type AdminHandler struct {
+	adminUsecase admin.AdminUsecase
+}
+
+func NewAdminHandler(adminUsecase admin.AdminUsecase) *AdminHandler {
+	return &AdminHandler{
+		adminUsecase: adminUsecase,
+	}
+}
+
+// Routing - пути регистрируются целиком, а не подроутером /admin,
+// чтобы не перекрывать /admin/adverts модерации и /admin/disputes
+func (ah *AdminHandler) Routing(r *mux.Router, sm *middleware.SessionMiddleware) {
+	r.Handle("/admin/users/{id:[0-9]+}/ban", sm.CheckAuthorized(middleware.RequireAdmin(http.HandlerFunc(ah.BanUserHandler)))).Methods(http.MethodPost, http.MethodOptions)
+	r.Handle("/admin/users/{id:[0-9]+}/unban", sm.CheckAuthorized(middleware.RequireAdmin(http.HandlerFunc(ah.UnbanUserHandler)))).Methods(http.MethodPost, http.MethodOptions)
+	r.Handle("/admin/users/{id:[0-9]+}/role", sm.CheckAuthorized(middleware.RequireAdmin(http.HandlerFunc(ah.SetRoleHandler)))).Methods(http.MethodPost, http.MethodOptions)
+
+	r.Handle("/admin/adverts/{id:[0-9]+}/close", sm.CheckAuthorized(middleware.RequireModerator(http.HandlerFunc(ah.CloseAdvertHandler)))).Methods(http.MethodPost, http.MethodOptions)
+	r.Handle("/admin/adverts/{id:[0-9]+}", sm.CheckAuthorized(middleware.RequireModerator(http.HandlerFunc(ah.DeleteAdvertHandler)))).Methods(http.MethodDelete, http.MethodOptions)
+}
+
+// BanUserHandler godoc
+// @Summary Ban user
+// @Description Banned user can not sign in, active sessions are rejected
+// @Tags admin
+// @Produce application/json
+// @Param id path integer true "User id"
+// @Success 200 {object} models.HttpBodyInterface
+// @failure default {object} models.HttpError
+// @Router /admin/users/{id}/ban [post]
+func (ah *AdminHandler) BanUserHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var adminId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		adminId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	userId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse user id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	err = ah.adminUsecase.BanUser(adminId, userId)
+	if err != nil {
+		logger.Warnf("can not ban user: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(models.ToBytes(http.StatusOK, "user banned", nil))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// UnbanUserHandler godoc
+// @Summary Unban user
+// @Description Restore access for banned user
+// @Tags admin
+// @Produce application/json
+// @Param id path integer true "User id"
+// @Success 200 {object} models.HttpBodyInterface
+// @failure default {object} models.HttpError
+// @Router /admin/users/{id}/unban [post]
+func (ah *AdminHandler) UnbanUserHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+
+	vars := mux.Vars(r)
+	userId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse user id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	err = ah.adminUsecase.UnbanUser(userId)
+	if err != nil {
+		logger.Warnf("can not unban user: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(models.ToBytes(http.StatusOK, "user unbanned", nil))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// SetRoleHandler godoc
+// @Summary Set user role
+// @Description Grant or revoke moderator and admin roles
+// @Tags admin
+// @Accept application/json
+// @Produce application/json
+// @Param id path integer true "User id"
+// @Param body body models.UserRole true "Role"
+// @Success 200 {object} models.HttpBodyInterface
+// @failure default {object} models.HttpError
+// @Router /admin/users/{id}/role [post]
+func (ah *AdminHandler) SetRoleHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var adminId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		adminId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	userId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse user id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	defer r.Body.Close()
+	userRole := &models.UserRole{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = easyjson.Unmarshal(buf, userRole)
+	}
+	if err == nil {
+		_, err = govalidator.ValidateStruct(userRole)
+	}
+	if err != nil {
+		logger.Warnf("invalid data: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	err = ah.adminUsecase.SetRole(adminId, userId, userRole.Role)
+	if err != nil {
+		logger.Warnf("can not set role: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(models.ToBytes(http.StatusOK, "role updated", nil))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// CloseAdvertHandler godoc
+// @Summary Force close advert
+// @Description Close any advert regardless of its publisher
+// @Tags admin
+// @Produce application/json
+// @Param id path integer true "Advert id"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyAdvert}
+// @failure default {object} models.HttpError
+// @Router /admin/adverts/{id}/close [post]
+func (ah *AdminHandler) CloseAdvertHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+
+	vars := mux.Vars(r)
+	advertId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse adv id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	advert, err := ah.adminUsecase.CloseAdvert(advertId)
+	if err != nil {
+		logger.Warnf("can not close adv: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyAdvert{Advert: *advert}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "advert closed", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// DeleteAdvertHandler godoc
+// @Summary Delete advert
+// @Description Delete any advert regardless of its publisher
+// @Tags admin
+// @Produce application/json
+// @Param id path integer true "Advert id"
+// @Success 200 {object} models.HttpBodyInterface
+// @failure default {object} models.HttpError
+// @Router /admin/adverts/{id} [delete]
+func (ah *AdminHandler) DeleteAdvertHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+
+	vars := mux.Vars(r)
+	advertId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse adv id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	err = ah.adminUsecase.DeleteAdvert(advertId)
+	if err != nil {
+		logger.Warnf("can not delete adv: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(models.ToBytes(http.StatusOK, "advert deleted", nil))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
@@ -0,0 +1,76 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"yula/internal/models"
+	"yula/internal/pkg/middleware"
+
+	adminMock "yula/internal/pkg/admin/mocks"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func withUser(userId int64) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), middleware.ContextUserId, userId)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func newTestRouter(ah *AdminHandler, userId int64) *mux.Router {
+	router := mux.NewRouter().PathPrefix("/admin").Subrouter()
+	router.HandleFunc("/users/{id:[0-9]+}/ban", ah.BanUserHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.HandleFunc("/users/{id:[0-9]+}/role", ah.SetRoleHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+	router.Use(withUser(userId))
+	return router
+}
+
+func TestBanUserSuccess(t *testing.T) {
+	au := adminMock.AdminUsecase{}
+	ah := NewAdminHandler(&au)
+
+	srv := httptest.NewServer(newTestRouter(ah, 1))
+	defer srv.Close()
+
+	au.On("BanUser", int64(1), int64(3)).Return(nil)
+
+	res, err := http.Post(fmt.Sprintf("%s/admin/users/3/ban", srv.URL), "application/json", nil)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "user banned", Answer.Message)
+}
+
+func TestSetRoleInvalid(t *testing.T) {
+	au := adminMock.AdminUsecase{}
+	ah := NewAdminHandler(&au)
+
+	srv := httptest.NewServer(newTestRouter(ah, 1))
+	defer srv.Close()
+
+	reqBody := `{"role": "owner"}`
+	res, err := http.Post(fmt.Sprintf("%s/admin/users/3/role", srv.URL), "application/json", bytes.NewBufferString(reqBody))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, Answer.Code)
+	au.AssertNotCalled(t, "SetRole", mock.Anything, mock.Anything, mock.Anything)
+}
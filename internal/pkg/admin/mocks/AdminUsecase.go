@@ -0,0 +1,93 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AdminUsecase is an autogenerated mock type for the AdminUsecase type
+type AdminUsecase struct {
+	mock.Mock
+}
+
+// BanUser provides a mock function with given fields: adminId, userId
+func (_m *AdminUsecase) BanUser(adminId int64, userId int64) error {
+	ret := _m.Called(adminId, userId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(adminId, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CloseAdvert provides a mock function with given fields: advertId
+func (_m *AdminUsecase) CloseAdvert(advertId int64) (*models.Advert, error) {
+	ret := _m.Called(advertId)
+
+	var r0 *models.Advert
+	if rf, ok := ret.Get(0).(func(int64) *models.Advert); ok {
+		r0 = rf(advertId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Advert)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(advertId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAdvert provides a mock function with given fields: advertId
+func (_m *AdminUsecase) DeleteAdvert(advertId int64) error {
+	ret := _m.Called(advertId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(advertId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetRole provides a mock function with given fields: adminId, userId, role
+func (_m *AdminUsecase) SetRole(adminId int64, userId int64, role string) error {
+	ret := _m.Called(adminId, userId, role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64, string) error); ok {
+		r0 = rf(adminId, userId, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnbanUser provides a mock function with given fields: userId
+func (_m *AdminUsecase) UnbanUser(userId int64) error {
+	ret := _m.Called(userId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,14 @@
+package admin
+
+import "yula/internal/models"
+
+//go:generate mockery -name=AdminUsecase
+
+type AdminUsecase interface {
+	BanUser(adminId int64, userId int64) error
+	UnbanUser(userId int64) error
+	SetRole(adminId int64, userId int64, role string) error
+
+	CloseAdvert(advertId int64) (*models.Advert, error)
+	DeleteAdvert(advertId int64) error
+}
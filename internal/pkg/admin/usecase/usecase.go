@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"time"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/admin"
+	"yula/internal/pkg/advt"
+	"yula/internal/pkg/user"
+)
+
+type AdminUsecase struct {
+	userRepository user.UserRepository
+	advtRepository advt.AdvtRepository
+}
+
+func NewAdminUsecase(userRepository user.UserRepository, advtRepository advt.AdvtRepository) admin.AdminUsecase {
+	return &AdminUsecase{
+		userRepository: userRepository,
+		advtRepository: advtRepository,
+	}
+}
+
+// BanUser блокирует пользователя, его сессии перестают проходить проверку в CheckAuthorized,
+// администраторов заблокировать нельзя, сначала нужно снять роль
+func (au *AdminUsecase) BanUser(adminId int64, userId int64) error {
+	if adminId == userId {
+		return internalError.Conflict
+	}
+
+	user, err := au.userRepository.SelectById(userId)
+	switch {
+	case err == internalError.EmptyQuery:
+		return internalError.NotExist
+	case err != nil:
+		return err
+	}
+
+	if user.Role == models.RoleAdmin {
+		return internalError.Forbidden
+	}
+
+	return au.setBanned(userId, true)
+}
+
+func (au *AdminUsecase) UnbanUser(userId int64) error {
+	return au.setBanned(userId, false)
+}
+
+func (au *AdminUsecase) setBanned(userId int64, isBanned bool) error {
+	err := au.userRepository.UpdateBanned(userId, isBanned)
+	if err == internalError.EmptyQuery {
+		return internalError.NotExist
+	}
+	return err
+}
+
+func (au *AdminUsecase) SetRole(adminId int64, userId int64, role string) error {
+	// свою роль администратор не меняет, чтобы площадка не осталась без администраторов
+	if adminId == userId {
+		return internalError.Conflict
+	}
+
+	err := au.userRepository.UpdateRole(userId, role)
+	if err == internalError.EmptyQuery {
+		return internalError.NotExist
+	}
+	return err
+}
+
+func (au *AdminUsecase) CloseAdvert(advertId int64) (*models.Advert, error) {
+	advert, err := au.advtRepository.SelectById(advertId)
+	if err != nil {
+		return nil, err
+	}
+
+	advert.IsActive = false
+	advert.Status = models.AdvertStatusClosed
+	advert.CloseReason = models.CloseReasonModerator
+	advert.DateClose = time.Now()
+
+	err = au.advtRepository.Update(advert)
+	if err != nil {
+		return nil, err
+	}
+	return advert, nil
+}
+
+func (au *AdminUsecase) DeleteAdvert(advertId int64) error {
+	_, err := au.advtRepository.SelectById(advertId)
+	if err != nil {
+		return err
+	}
+
+	return au.advtRepository.Delete(advertId)
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"testing"
+	"yula/internal/models"
+
+	myerr "yula/internal/error"
+	advtMocks "yula/internal/pkg/advt/mocks"
+	userMocks "yula/internal/pkg/user/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBanUserSuccess(t *testing.T) {
+	ur := userMocks.UserRepository{}
+	ur.On("SelectById", int64(3)).Return(&models.UserData{Id: 3, Role: models.RoleUser}, nil)
+	ur.On("UpdateBanned", int64(3), true).Return(nil)
+
+	au := NewAdminUsecase(&ur, &advtMocks.AdvtRepository{})
+	err := au.BanUser(1, 3)
+	assert.Nil(t, err)
+	ur.AssertExpectations(t)
+}
+
+func TestBanUserAdmin(t *testing.T) {
+	ur := userMocks.UserRepository{}
+	ur.On("SelectById", int64(3)).Return(&models.UserData{Id: 3, Role: models.RoleAdmin}, nil)
+
+	au := NewAdminUsecase(&ur, &advtMocks.AdvtRepository{})
+	err := au.BanUser(1, 3)
+	assert.Equal(t, myerr.Forbidden, err)
+	ur.AssertNotCalled(t, "UpdateBanned", mock.Anything, mock.Anything)
+}
+
+func TestBanUserSelf(t *testing.T) {
+	ur := userMocks.UserRepository{}
+
+	au := NewAdminUsecase(&ur, &advtMocks.AdvtRepository{})
+	err := au.BanUser(1, 1)
+	assert.Equal(t, myerr.Conflict, err)
+}
+
+func TestUnbanUserNotExist(t *testing.T) {
+	ur := userMocks.UserRepository{}
+	ur.On("UpdateBanned", int64(3), false).Return(myerr.EmptyQuery)
+
+	au := NewAdminUsecase(&ur, &advtMocks.AdvtRepository{})
+	err := au.UnbanUser(3)
+	assert.Equal(t, myerr.NotExist, err)
+}
+
+func TestSetRoleSuccess(t *testing.T) {
+	ur := userMocks.UserRepository{}
+	ur.On("UpdateRole", int64(3), models.RoleModerator).Return(nil)
+
+	au := NewAdminUsecase(&ur, &advtMocks.AdvtRepository{})
+	err := au.SetRole(1, 3, models.RoleModerator)
+	assert.Nil(t, err)
+}
+
+func TestCloseAdvert(t *testing.T) {
+	ar := advtMocks.AdvtRepository{}
+	ar.On("SelectById", int64(10)).Return(&models.Advert{Id: 10, PublisherId: 2, IsActive: true,
+		Status: models.AdvertStatusPublished}, nil)
+	ar.On("Update", mock.MatchedBy(func(a *models.Advert) bool {
+		return !a.IsActive && a.Status == models.AdvertStatusClosed && a.CloseReason == models.CloseReasonModerator
+	})).Return(nil)
+
+	au := NewAdminUsecase(&userMocks.UserRepository{}, &ar)
+	advert, err := au.CloseAdvert(10)
+	assert.Nil(t, err)
+	assert.Equal(t, models.AdvertStatusClosed, advert.Status)
+}
+
+func TestDeleteAdvertNotFound(t *testing.T) {
+	ar := advtMocks.AdvtRepository{}
+	ar.On("SelectById", int64(10)).Return(nil, myerr.EmptyQuery)
+
+	au := NewAdminUsecase(&userMocks.UserRepository{}, &ar)
+	err := au.DeleteAdvert(10)
+	assert.Equal(t, myerr.EmptyQuery, err)
+	ar.AssertNotCalled(t, "Delete", mock.Anything)
+}
@@ -0,0 +1,54 @@
+package delivery
+
+import (
+	"fmt"
+	"yula/internal/models"
+	"yula/internal/pkg/advt"
+	"yula/internal/pkg/notifications"
+)
+
+// ExpiryJob - фоновая задача: закрывает истекшие объявления и заранее предупреждает продавцов
+type ExpiryJob struct {
+	advtUsecase advt.AdvtUsecase
+	notifier    notifications.Notifier
+	warnDays    int64
+}
+
+func NewExpiryJob(advtUsecase advt.AdvtUsecase, notifier notifications.Notifier, warnDays int64) *ExpiryJob {
+	return &ExpiryJob{
+		advtUsecase: advtUsecase,
+		notifier:    notifier,
+		warnDays:    warnDays,
+	}
+}
+
+func (ej *ExpiryJob) Run() {
+	expiring, err := ej.advtUsecase.WarnExpiringAdverts(ej.warnDays)
+	if err != nil {
+		logger.Warnf("can not get expiring adverts: %s", err.Error())
+	}
+	for _, advert := range expiring {
+		ej.notifyPublisher(advert, fmt.Sprintf("Your advert \"%s\" will be closed on %s, renew it to keep it published",
+			advert.Name, advert.DateClose.Format("02.01.2006")))
+	}
+
+	expired, err := ej.advtUsecase.CloseExpiredAdverts()
+	if err != nil {
+		logger.Warnf("can not close expired adverts: %s", err.Error())
+	}
+	for _, advert := range expired {
+		ej.notifyPublisher(advert, fmt.Sprintf("Your advert \"%s\" has expired and moved to archive", advert.Name))
+	}
+}
+
+func (ej *ExpiryJob) notifyPublisher(advert *models.Advert, text string) {
+	err := ej.notifier.Notify(&models.Notification{
+		UserId:   advert.PublisherId,
+		Kind:     models.NotificationExpiry,
+		Text:     text,
+		AdvertId: advert.Id,
+	})
+	if err != nil {
+		logger.Warnf("can not notify publisher %d about advert %d: %s", advert.PublisherId, advert.Id, err.Error())
+	}
+}
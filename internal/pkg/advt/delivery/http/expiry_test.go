@@ -0,0 +1,43 @@
+package delivery
+
+import (
+	"testing"
+	"yula/internal/models"
+
+	myerr "yula/internal/error"
+
+	advtMock "yula/internal/pkg/advt/mocks"
+	notifMock "yula/internal/pkg/notifications/mocks"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExpiryJobRun(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	nt := notifMock.Notifier{}
+	ej := NewExpiryJob(&au, &nt, 3)
+
+	au.On("WarnExpiringAdverts", int64(3)).Return([]*models.Advert{{Id: 2, Name: "aboba", PublisherId: 1}}, nil)
+	au.On("CloseExpiredAdverts").Return([]*models.Advert{{Id: 3, Name: "abeba", PublisherId: 1}}, nil)
+	nt.On("Notify", mock.MatchedBy(func(n *models.Notification) bool {
+		return n.UserId == 1 && n.Kind == models.NotificationExpiry
+	})).Return(nil)
+
+	ej.Run()
+
+	nt.AssertNumberOfCalls(t, "Notify", 2)
+}
+
+func TestExpiryJobRunWarnError(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	nt := notifMock.Notifier{}
+	ej := NewExpiryJob(&au, &nt, 3)
+
+	au.On("WarnExpiringAdverts", int64(3)).Return(nil, myerr.InternalError)
+	au.On("CloseExpiredAdverts").Return([]*models.Advert{}, nil)
+
+	ej.Run()
+
+	au.AssertExpectations(t)
+	nt.AssertNotCalled(t, "Notify", mock.Anything)
+}
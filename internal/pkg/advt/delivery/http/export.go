@@ -0,0 +1,418 @@
+package delivery
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"yula/internal/config"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/middleware"
+
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	"github.com/sirupsen/logrus"
+)
+
+// advertExporter пишет выгрузку в ответ по мере чтения объявлений
+type advertExporter interface {
+	begin() error
+	write(advert *models.AdvertExport) error
+	end() error
+}
+
+// ExportAdvertsHandler godoc
+// @Summary Export salesman's adverts
+// @Description Stream all adverts of the salesman with images, price history and status.
+// @Description csv has the same columns as import, images and price history are separated by ";".
+// @Description xml follows YML marketplace feed layout, price history is reduced to oldprice.
+// @Tags advert
+// @Produce text/csv
+// @Produce application/json
+// @Produce application/xml
+// @Param id path integer true "Salesman id"
+// @Param format query string false "csv, json or xml, csv by default"
+// @Success 200 {array} models.AdvertExport
+// @failure default {object} models.HttpError
+// @Router /adverts/salesman/{id}/export [get]
+func (ah *AdvertHandler) ExportAdvertsHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64 = 0
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	salesmanId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse string: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	// выгружать можно только свой каталог, в нем есть черновики и архив
+	if userId != salesmanId {
+		logger.Warnf("user %d can not export adverts of %d", userId, salesmanId)
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.Forbidden)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	format := strings.ToLower(r.URL.Query().Get("format"))
+	if format == "" {
+		format = models.ExportFormatCSV
+	}
+
+	ah.writeExport(w, salesmanId, format, false)
+}
+
+// AdvertsFeedHandler godoc
+// @Summary Public YML feed of salesman's adverts
+// @Description Feed for aggregators and marketplaces, does not require authorization.
+// @Description Only active published adverts get into the feed, hidden salesmen have no feed.
+// @Tags advert
+// @Produce application/xml
+// @Param id path integer true "Salesman id"
+// @Success 200 {string} string "yml_catalog"
+// @failure default {object} models.HttpError
+// @Router /adverts/salesman/{id}/feed [get]
+func (ah *AdvertHandler) AdvertsFeedHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+
+	vars := mux.Vars(r)
+	salesmanId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse string: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	ah.writeExport(w, salesmanId, models.ExportFormatXML, true)
+}
+
+// writeExport отдает выгрузку объявлений продавца в нужном формате, publishedOnly - для публичного фида
+func (ah *AdvertHandler) writeExport(w http.ResponseWriter, salesmanId int64, format string, publishedOnly bool) {
+	var err error
+	siteUrl := config.Cfg.GetSiteUrl()
+	var exporter advertExporter
+	var contentType string
+	switch format {
+	case models.ExportFormatCSV:
+		exporter, contentType = newCSVExporter(w), "text/csv; charset=utf-8"
+	case models.ExportFormatJSON:
+		exporter, contentType = newJSONExporter(w), "application/json"
+	case models.ExportFormatXML:
+		// заголовок фида читает продавца и категории, ошибку еще можно отдать обычным ответом
+		yml := ah.newYMLExporter(w, salesmanId, siteUrl, publishedOnly)
+		err = yml.load()
+		exporter, contentType = yml, "application/xml; charset=utf-8"
+	}
+	if exporter == nil {
+		logger.Warnf("unknown export format %s", format)
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	if err != nil {
+		logger.Warnf("can not prepare export: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"adverts_%d.%s\"", salesmanId, format))
+	w.WriteHeader(http.StatusOK)
+
+	// после начала выгрузки статус уже отправлен, ошибки остается только записать в лог
+	err = exporter.begin()
+	if err == nil {
+		err = ah.advtUsecase.ExportAdverts(salesmanId, publishedOnly, func(advert *models.AdvertExport) error {
+			for i, image := range advert.Images {
+				advert.Images[i] = siteUrl + "/" + image
+			}
+			return exporter.write(advert)
+		})
+	}
+	if err == nil {
+		err = exporter.end()
+	}
+	if err != nil {
+		logger.Warnf("export of adverts of %d interrupted: %s", salesmanId, err.Error())
+	}
+}
+
+// csvExporter пишет колонки в том же виде, что принимает импорт, поэтому выгрузку можно загрузить обратно
+type csvExporter struct {
+	writer *csv.Writer
+}
+
+var csvExportHeader = []string{"id", "name", "description", "price", "category", "amount", "location", "latitude",
+	"longitude", "is_new", "status", "close_reason", "published_at", "date_close", "views", "images", "price_history"}
+
+func newCSVExporter(w io.Writer) *csvExporter {
+	return &csvExporter{writer: csv.NewWriter(w)}
+}
+
+func (ce *csvExporter) begin() error {
+	return ce.writer.Write(csvExportHeader)
+}
+
+func (ce *csvExporter) write(advert *models.AdvertExport) error {
+	history := make([]string, 0, len(advert.PriceHistory))
+	for _, price := range advert.PriceHistory {
+		history = append(history, fmt.Sprintf("%d@%s", price.Price, price.ChangeTime.Format(time.RFC3339)))
+	}
+
+	return ce.writer.Write([]string{
+		strconv.FormatInt(advert.Id, 10),
+		advert.Name,
+		advert.Description,
+		strconv.Itoa(advert.Price),
+		advert.Category,
+		strconv.FormatInt(advert.Amount, 10),
+		advert.Location,
+		strconv.FormatFloat(advert.Latitude, 'f', -1, 64),
+		strconv.FormatFloat(advert.Longitude, 'f', -1, 64),
+		strconv.FormatBool(advert.IsNew),
+		advert.Status,
+		advert.CloseReason,
+		advert.PublishedAt.Format(time.RFC3339),
+		advert.DateClose.Format(time.RFC3339),
+		strconv.FormatInt(advert.Views, 10),
+		strings.Join(advert.Images, ";"),
+		strings.Join(history, ";"),
+	})
+}
+
+func (ce *csvExporter) end() error {
+	ce.writer.Flush()
+	return ce.writer.Error()
+}
+
+// jsonExporter пишет массив объявлений по одному элементу
+type jsonExporter struct {
+	w     io.Writer
+	count int
+}
+
+func newJSONExporter(w io.Writer) *jsonExporter {
+	return &jsonExporter{w: w}
+}
+
+func (je *jsonExporter) begin() error {
+	_, err := io.WriteString(je.w, "[")
+	return err
+}
+
+func (je *jsonExporter) write(advert *models.AdvertExport) error {
+	if je.count != 0 {
+		_, err := io.WriteString(je.w, ",")
+		if err != nil {
+			return err
+		}
+	}
+	je.count++
+	_, err := easyjson.MarshalToWriter(advert, je.w)
+	return err
+}
+
+func (je *jsonExporter) end() error {
+	_, err := io.WriteString(je.w, "]")
+	return err
+}
+
+// ymlExporter пишет фид в формате YML (yml_catalog), его понимают агрегаторы и маркетплейсы
+type ymlExporter struct {
+	encoder *xml.Encoder
+	w       io.Writer
+
+	ah            *AdvertHandler
+	salesmanId    int64
+	publishedOnly bool
+	siteUrl       string
+	shopName      string
+	categories    []*models.Category
+	categoryIds   map[string]int64
+}
+
+type ymlCurrency struct {
+	Id   string `xml:"id,attr"`
+	Rate string `xml:"rate,attr"`
+}
+
+type ymlCategory struct {
+	Id   int64  `xml:"id,attr"`
+	Name string `xml:",chardata"`
+}
+
+type ymlParam struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:",chardata"`
+}
+
+type ymlOffer struct {
+	XMLName     xml.Name   `xml:"offer"`
+	Id          int64      `xml:"id,attr"`
+	Available   bool       `xml:"available,attr"`
+	Url         string     `xml:"url"`
+	Price       int        `xml:"price"`
+	OldPrice    int64      `xml:"oldprice,omitempty"`
+	CurrencyId  string     `xml:"currencyId"`
+	CategoryId  int64      `xml:"categoryId"`
+	Pictures    []string   `xml:"picture"`
+	Name        string     `xml:"name"`
+	Description string     `xml:"description"`
+	Count       int64      `xml:"count"`
+	Params      []ymlParam `xml:"param"`
+}
+
+const ymlCurrencyRUR = "RUR"
+
+func (ah *AdvertHandler) newYMLExporter(w io.Writer, salesmanId int64, siteUrl string, publishedOnly bool) *ymlExporter {
+	return &ymlExporter{
+		encoder:       xml.NewEncoder(w),
+		w:             w,
+		ah:            ah,
+		salesmanId:    salesmanId,
+		publishedOnly: publishedOnly,
+		siteUrl:       siteUrl,
+	}
+}
+
+func (ye *ymlExporter) load() error {
+	salesman, err := ye.ah.userUsecase.GetById(ye.salesmanId)
+	if err != nil {
+		return err
+	}
+	// скрытый продавец не виден в выдаче, публичного фида у него тоже нет
+	if ye.publishedOnly && salesman.IsHidden {
+		return internalError.NotExist
+	}
+	ye.shopName = strings.TrimSpace(salesman.Name + " " + salesman.Surname)
+
+	ye.categories, err = ye.ah.advtUsecase.GetPublisherCategories(ye.salesmanId, ye.publishedOnly)
+	if err != nil {
+		return err
+	}
+	ye.categoryIds = make(map[string]int64, len(ye.categories))
+	for _, category := range ye.categories {
+		ye.categoryIds[category.Name] = category.Id
+	}
+	return nil
+}
+
+func (ye *ymlExporter) begin() error {
+	_, err := io.WriteString(ye.w, xml.Header)
+	if err != nil {
+		return err
+	}
+
+	err = ye.encoder.EncodeToken(xml.StartElement{
+		Name: xml.Name{Local: "yml_catalog"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "date"}, Value: time.Now().Format(time.RFC3339)}},
+	})
+	if err != nil {
+		return err
+	}
+	err = ye.encoder.EncodeToken(xml.StartElement{Name: xml.Name{Local: "shop"}})
+	if err != nil {
+		return err
+	}
+
+	categories := make([]ymlCategory, 0, len(ye.categories))
+	for _, category := range ye.categories {
+		categories = append(categories, ymlCategory{Id: category.Id, Name: category.Name})
+	}
+	shop := []struct {
+		name  string
+		value interface{}
+	}{
+		{"name", ye.shopName},
+		{"company", ye.shopName},
+		{"url", fmt.Sprintf("%s/salesman/%d", ye.siteUrl, ye.salesmanId)},
+		{"currencies", struct {
+			Currency []ymlCurrency `xml:"currency"`
+		}{[]ymlCurrency{{Id: ymlCurrencyRUR, Rate: "1"}}}},
+		{"categories", struct {
+			Category []ymlCategory `xml:"category"`
+		}{categories}},
+	}
+	for _, element := range shop {
+		err = ye.encoder.EncodeElement(element.value, xml.StartElement{Name: xml.Name{Local: element.name}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return ye.encoder.EncodeToken(xml.StartElement{Name: xml.Name{Local: "offers"}})
+}
+
+func (ye *ymlExporter) write(advert *models.AdvertExport) error {
+	condition := "used"
+	if advert.IsNew {
+		condition = "new"
+	}
+
+	offer := ymlOffer{
+		Id:          advert.Id,
+		Available:   advert.IsActive && advert.Status == models.AdvertStatusPublished,
+		Url:         fmt.Sprintf("%s/ad/%d", ye.siteUrl, advert.Id),
+		Price:       advert.Price,
+		CurrencyId:  ymlCurrencyRUR,
+		CategoryId:  ye.categoryIds[advert.Category],
+		Pictures:    advert.Images,
+		Name:        advert.Name,
+		Description: advert.Description,
+		Count:       advert.Amount,
+		Params: []ymlParam{
+			{Name: "status", Value: advert.Status},
+			{Name: "condition", Value: condition},
+			{Name: "location", Value: advert.Location},
+		},
+	}
+
+	// площадки показывают oldprice как скидку, поэтому берем только предыдущую цену выше текущей
+	if n := len(advert.PriceHistory); n > 1 && advert.PriceHistory[n-2].Price > int64(advert.Price) {
+		offer.OldPrice = advert.PriceHistory[n-2].Price
+	}
+
+	return ye.encoder.Encode(offer)
+}
+
+func (ye *ymlExporter) end() error {
+	for _, name := range []string{"offers", "shop", "yml_catalog"} {
+		err := ye.encoder.EncodeToken(xml.EndElement{Name: xml.Name{Local: name}})
+		if err != nil {
+			return err
+		}
+	}
+	return ye.encoder.Flush()
+}
@@ -0,0 +1,235 @@
+package delivery
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"yula/internal/models"
+	"yula/internal/pkg/middleware"
+
+	myerr "yula/internal/error"
+
+	advtMock "yula/internal/pkg/advt/mocks"
+	userMock "yula/internal/pkg/user/mocks"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newExportRouter(ah *AdvertHandler, userId int64) *mux.Router {
+	router := mux.NewRouter()
+	router.HandleFunc("/adverts/salesman/{id:[0-9]+}/export", ah.ExportAdvertsHandler).Methods(http.MethodGet, http.MethodOptions)
+	router.HandleFunc("/adverts/salesman/{id:[0-9]+}/feed", ah.AdvertsFeedHandler).Methods(http.MethodGet, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+	router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), middleware.ContextUserId, userId)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	})
+	return router
+}
+
+func mockExport(au *advtMock.AdvtUsecase, adverts ...*models.AdvertExport) {
+	au.On("ExportAdverts", int64(1), mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		export := args.Get(2).(func(*models.AdvertExport) error)
+		for _, advert := range adverts {
+			_ = export(advert)
+		}
+	}).Return(nil)
+}
+
+var exportAdvert = &models.AdvertExport{
+	Advert: models.Advert{Id: 3, Name: "Худи", Price: 900, Category: "одежда", Amount: 1, IsActive: true,
+		Status: models.AdvertStatusPublished, Images: []string{"static/advertimages/1.webp"}},
+	PriceHistory: []*models.AdvertPrice{
+		{AdvertId: 3, Price: 1000, ChangeTime: time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)},
+		{AdvertId: 3, Price: 900, ChangeTime: time.Date(2021, 12, 5, 0, 0, 0, 0, time.UTC)},
+	},
+}
+
+func TestExportAdvertsCSV(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	ah := NewAdvertHandler(&au, &uu)
+	srv := httptest.NewServer(newExportRouter(ah, 1))
+	defer srv.Close()
+
+	advert := *exportAdvert
+	advert.Images = []string{"static/advertimages/1.webp"}
+	mockExport(&au, &advert)
+
+	res, err := http.Get(fmt.Sprintf("%s/adverts/salesman/1/export?format=csv", srv.URL))
+	assert.NoError(t, err)
+	assert.Contains(t, res.Header.Get("Content-Type"), "text/csv")
+	assert.Contains(t, res.Header.Get("Content-Disposition"), "adverts_1.csv")
+
+	records, err := csv.NewReader(res.Body).ReadAll()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(records))
+	assert.Equal(t, csvExportHeader, records[0])
+	assert.Equal(t, "Худи", records[1][1])
+	assert.Equal(t, "https://volchock.ru/static/advertimages/1.webp", records[1][15])
+	assert.Equal(t, "1000@2021-12-01T00:00:00Z;900@2021-12-05T00:00:00Z", records[1][16])
+}
+
+func TestExportAdvertsJSON(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	ah := NewAdvertHandler(&au, &uu)
+	srv := httptest.NewServer(newExportRouter(ah, 1))
+	defer srv.Close()
+
+	first, second := *exportAdvert, *exportAdvert
+	first.Images, second.Images = []string{}, []string{}
+	second.Id = 4
+	mockExport(&au, &first, &second)
+
+	res, err := http.Get(fmt.Sprintf("%s/adverts/salesman/1/export?format=json", srv.URL))
+	assert.NoError(t, err)
+
+	var adverts []*models.AdvertExport
+	err = json.NewDecoder(res.Body).Decode(&adverts)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(adverts))
+	assert.Equal(t, int64(4), adverts[1].Id)
+	assert.Equal(t, 2, len(adverts[0].PriceHistory))
+}
+
+func TestExportAdvertsXML(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	ah := NewAdvertHandler(&au, &uu)
+	srv := httptest.NewServer(newExportRouter(ah, 1))
+	defer srv.Close()
+
+	advert := *exportAdvert
+	advert.Images = []string{"static/advertimages/1.webp"}
+	mockExport(&au, &advert)
+	uu.On("GetById", int64(1)).Return(&models.Profile{Id: 1, Name: "Иван", Surname: "Петров"}, nil)
+	au.On("GetPublisherCategories", int64(1), false).Return([]*models.Category{{Id: 7, Name: "одежда"}}, nil)
+
+	res, err := http.Get(fmt.Sprintf("%s/adverts/salesman/1/export?format=xml", srv.URL))
+	assert.NoError(t, err)
+	assert.Contains(t, res.Header.Get("Content-Type"), "application/xml")
+
+	var feed struct {
+		Shop struct {
+			Name       string        `xml:"name"`
+			Categories []ymlCategory `xml:"categories>category"`
+			Offers     []ymlOffer    `xml:"offers>offer"`
+		} `xml:"shop"`
+	}
+	data, err := ioutil.ReadAll(res.Body)
+	assert.NoError(t, err)
+	err = xml.Unmarshal(data, &feed)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Иван Петров", feed.Shop.Name)
+	assert.Equal(t, 1, len(feed.Shop.Categories))
+	assert.Equal(t, 1, len(feed.Shop.Offers))
+
+	offer := feed.Shop.Offers[0]
+	assert.Equal(t, int64(7), offer.CategoryId)
+	assert.Equal(t, int64(1000), offer.OldPrice)
+	assert.True(t, offer.Available)
+	assert.Equal(t, []string{"https://volchock.ru/static/advertimages/1.webp"}, offer.Pictures)
+}
+
+func TestExportAdvertsForbidden(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	ah := NewAdvertHandler(&au, &uu)
+	srv := httptest.NewServer(newExportRouter(ah, 2))
+	defer srv.Close()
+
+	res, err := http.Get(fmt.Sprintf("%s/adverts/salesman/1/export?format=csv", srv.URL))
+	assert.NoError(t, err)
+
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+
+	metaCode, _ := myerr.ToMetaStatus(myerr.Forbidden)
+	assert.Equal(t, metaCode, answer.Code)
+	au.AssertNotCalled(t, "ExportAdverts", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestExportAdvertsInvalidFormat(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	ah := NewAdvertHandler(&au, &uu)
+	srv := httptest.NewServer(newExportRouter(ah, 1))
+	defer srv.Close()
+
+	res, err := http.Get(fmt.Sprintf("%s/adverts/salesman/1/export?format=xls", srv.URL))
+	assert.NoError(t, err)
+
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, answer.Code)
+}
+
+func TestAdvertsFeedPublishedOnly(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	ah := NewAdvertHandler(&au, &uu)
+	srv := httptest.NewServer(newExportRouter(ah, 0))
+	defer srv.Close()
+
+	advert := *exportAdvert
+	advert.Images = []string{}
+	au.On("ExportAdverts", int64(1), true, mock.Anything).Run(func(args mock.Arguments) {
+		export := args.Get(2).(func(*models.AdvertExport) error)
+		_ = export(&advert)
+	}).Return(nil)
+	uu.On("GetById", int64(1)).Return(&models.Profile{Id: 1, Name: "Иван", Surname: "Петров"}, nil)
+	au.On("GetPublisherCategories", int64(1), true).Return([]*models.Category{{Id: 7, Name: "одежда"}}, nil)
+
+	res, err := http.Get(fmt.Sprintf("%s/adverts/salesman/1/feed", srv.URL))
+	assert.NoError(t, err)
+	assert.Contains(t, res.Header.Get("Content-Type"), "application/xml")
+
+	var feed struct {
+		Shop struct {
+			Offers []ymlOffer `xml:"offers>offer"`
+		} `xml:"shop"`
+	}
+	data, err := ioutil.ReadAll(res.Body)
+	assert.NoError(t, err)
+	err = xml.Unmarshal(data, &feed)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(feed.Shop.Offers))
+	au.AssertExpectations(t)
+}
+
+func TestAdvertsFeedHiddenSalesman(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	ah := NewAdvertHandler(&au, &uu)
+	srv := httptest.NewServer(newExportRouter(ah, 0))
+	defer srv.Close()
+
+	uu.On("GetById", int64(1)).Return(&models.Profile{Id: 1, IsHidden: true}, nil)
+
+	res, err := http.Get(fmt.Sprintf("%s/adverts/salesman/1/feed", srv.URL))
+	assert.NoError(t, err)
+
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+
+	metaCode, _ := myerr.ToMetaStatus(myerr.NotExist)
+	assert.Equal(t, metaCode, answer.Code)
+	au.AssertNotCalled(t, "ExportAdverts", mock.Anything, mock.Anything, mock.Anything)
+}
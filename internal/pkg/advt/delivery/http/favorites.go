@@ -0,0 +1,58 @@
+package delivery
+
+import (
+	"fmt"
+	"yula/internal/models"
+	"yula/internal/pkg/advt"
+	"yula/internal/pkg/notifications"
+)
+
+// FavoritesJob - фоновая задача: сообщает пользователям о снижении цены, достижении целевой цены,
+// закрытии и распродаже объявлений из их избранного
+type FavoritesJob struct {
+	advtUsecase advt.AdvtUsecase
+	notifier    notifications.Notifier
+}
+
+func NewFavoritesJob(advtUsecase advt.AdvtUsecase, notifier notifications.Notifier) *FavoritesJob {
+	return &FavoritesJob{
+		advtUsecase: advtUsecase,
+		notifier:    notifier,
+	}
+}
+
+func (fj *FavoritesJob) Run() {
+	changes, err := fj.advtUsecase.CollectFavoriteNotifications()
+	if err != nil {
+		logger.Warnf("can not collect favorite notifications: %s", err.Error())
+	}
+	for _, notification := range changes {
+		fj.notify(notification)
+	}
+}
+
+func favoriteNotificationText(n *models.FavoriteNotification) string {
+	switch n.Kind {
+	case models.FavoriteNotifyPriceDrop:
+		return fmt.Sprintf("Price of \"%s\" from your favorites dropped from %d to %d", n.AdvertName, n.OldPrice, n.Price)
+	case models.FavoriteNotifyTargetPrice:
+		return fmt.Sprintf("Price of \"%s\" from your favorites is now %d, below your target price %d",
+			n.AdvertName, n.Price, n.Target)
+	case models.FavoriteNotifySoldOut:
+		return fmt.Sprintf("\"%s\" from your favorites is sold out", n.AdvertName)
+	default:
+		return fmt.Sprintf("\"%s\" from your favorites has been closed", n.AdvertName)
+	}
+}
+
+func (fj *FavoritesJob) notify(n *models.FavoriteNotification) {
+	err := fj.notifier.Notify(&models.Notification{
+		UserId:   n.UserId,
+		Kind:     models.NotificationFavorite,
+		Text:     favoriteNotificationText(n),
+		AdvertId: n.AdvertId,
+	})
+	if err != nil {
+		logger.Warnf("can not notify user %d about favorite advert %d: %s", n.UserId, n.AdvertId, err.Error())
+	}
+}
@@ -0,0 +1,47 @@
+package delivery
+
+import (
+	"strings"
+	"testing"
+	"yula/internal/models"
+
+	myerr "yula/internal/error"
+
+	advtMock "yula/internal/pkg/advt/mocks"
+	notifMock "yula/internal/pkg/notifications/mocks"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFavoritesJobRun(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	nt := notifMock.Notifier{}
+	fj := NewFavoritesJob(&au, &nt)
+
+	au.On("CollectFavoriteNotifications").Return([]*models.FavoriteNotification{
+		{UserId: 5, AdvertId: 2, AdvertName: "aboba", Kind: models.FavoriteNotifyPriceDrop, OldPrice: 1000, Price: 900},
+		{UserId: 6, AdvertId: 3, AdvertName: "abeba", Kind: models.FavoriteNotifySoldOut},
+	}, nil)
+	nt.On("Notify", mock.MatchedBy(func(n *models.Notification) bool {
+		return n.UserId == 5 && n.Kind == models.NotificationFavorite && n.AdvertId == 2 && strings.Contains(n.Text, "1000 to 900")
+	})).Return(nil).Once()
+	nt.On("Notify", mock.MatchedBy(func(n *models.Notification) bool {
+		return n.UserId == 6 && strings.Contains(n.Text, "sold out")
+	})).Return(nil).Once()
+
+	fj.Run()
+
+	nt.AssertExpectations(t)
+}
+
+func TestFavoritesJobRunError(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	nt := notifMock.Notifier{}
+	fj := NewFavoritesJob(&au, &nt)
+
+	au.On("CollectFavoriteNotifications").Return(nil, myerr.InternalError)
+
+	fj.Run()
+
+	nt.AssertNotCalled(t, "Notify", mock.Anything)
+}
@@ -8,7 +8,7 @@ import (
 	"net/url"
 	"path"
 	"strconv"
-	"strings"
+	"time"
 	internalError "yula/internal/error"
 	"yula/internal/models"
 	"yula/internal/pkg/advt"
@@ -51,72 +51,44 @@ func (ah *AdvertHandler) Routing(r *mux.Router, sm *middleware.SessionMiddleware
 	s.Handle("/{id:[0-9]+}", sm.CheckAuthorized(http.HandlerFunc(ah.AdvertUpdateHandler))).Methods(http.MethodPost, http.MethodOptions)
 	s.Handle("/{id:[0-9]+}", sm.CheckAuthorized(http.HandlerFunc(ah.DeleteAdvertHandler))).Methods(http.MethodDelete, http.MethodOptions)
 	s.Handle("/{id:[0-9]+}/close", sm.CheckAuthorized(http.HandlerFunc(ah.CloseAdvertHandler))).Methods(http.MethodPost, http.MethodOptions)
+	s.Handle("/{id:[0-9]+}/submit", sm.CheckAuthorized(http.HandlerFunc(ah.SubmitAdvertHandler))).Methods(http.MethodPost, http.MethodOptions)
+	s.Handle("/{id:[0-9]+}/renew", sm.CheckAuthorized(http.HandlerFunc(ah.RenewAdvertHandler))).Methods(http.MethodPost, http.MethodOptions)
 
 	s.Handle("/{id:[0-9]+}/images", sm.CheckAuthorized(http.HandlerFunc(ah.UploadImageHandler))).Methods(http.MethodPost, http.MethodOptions)
 	s.Handle("/{id:[0-9]+}/images", sm.CheckAuthorized(http.HandlerFunc(ah.RemoveImageHandler))).Methods(http.MethodDelete, http.MethodOptions)
 
 	s.HandleFunc("/salesman/{id:[0-9]+}", middleware.SetSCRFToken(sm.SoftCheckAuthorized(ah.SalesmanPageHandler))).Methods(http.MethodGet, http.MethodOptions)
+	s.Handle("/salesman/{id:[0-9]+}/export", middleware.SetSCRFToken(sm.CheckAuthorized(http.HandlerFunc(ah.ExportAdvertsHandler)))).Methods(http.MethodGet, http.MethodOptions)
+	s.HandleFunc("/salesman/{id:[0-9]+}/feed", ah.AdvertsFeedHandler).Methods(http.MethodGet, http.MethodOptions)
 
 	s.Handle("/favorite", middleware.SetSCRFToken(sm.CheckAuthorized(http.HandlerFunc(ah.FavoriteListHandler)))).Methods(http.MethodGet, http.MethodOptions)
 	s.Handle("/favorite/{id:[0-9]+}", sm.CheckAuthorized(http.HandlerFunc(ah.AddFavoriteHandler))).Methods(http.MethodPost, http.MethodOptions)
 	s.Handle("/favorite/{id:[0-9]+}", sm.CheckAuthorized(http.HandlerFunc(ah.RemoveFavoriteHandler))).Methods(http.MethodDelete, http.MethodOptions)
+	s.Handle("/favorite/{id:[0-9]+}/target", sm.CheckAuthorized(http.HandlerFunc(ah.FavoriteTargetHandler))).Methods(http.MethodPost, http.MethodOptions)
 
 	s.Handle("/price_history", sm.CheckAuthorized(http.HandlerFunc(ah.UpdatePriceHistory))).Methods(http.MethodPost, http.MethodOptions)
 	s.Handle("/price_history/{id:[0-9]+}", middleware.SetSCRFToken(sm.CheckAuthorized(http.HandlerFunc(ah.GetPriceHistory)))).Methods(http.MethodGet, http.MethodOptions)
 
-	r.HandleFunc("/promotion", ah.HandlePromotion).Methods(http.MethodPost, http.MethodOptions)
+	s.HandleFunc("/promotion/packages", middleware.SetSCRFToken(http.HandlerFunc(ah.PromotionPackagesHandler))).Methods(http.MethodGet, http.MethodOptions)
 
 	s.Handle("/recomendations/{id:[0-9]+}", middleware.SetSCRFToken(sm.SoftCheckAuthorized(ah.RecomendationsHandler))).Methods(http.MethodGet, http.MethodOptions)
 }
 
-func (ah *AdvertHandler) HandlePromotion(w http.ResponseWriter, r *http.Request) {
+// PromotionPackagesHandler godoc
+// @Summary Promotion packages
+// @Description Packages that can be bought for an advert. Top level adverts get reserved slots
+// @Description at the beginning of every page of listings and search, highlight only marks the advert.
+// @Tags advert
+// @Produce application/json
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyPromotionPackages}
+// @failure default {object} models.HttpError
+// @Router /adverts/promotion/packages [get]
+func (ah *AdvertHandler) PromotionPackagesHandler(w http.ResponseWriter, r *http.Request) {
 	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
 
-	err := r.ParseForm()
-	fmt.Println("request.Form::")
-	for key, value := range r.Form {
-		fmt.Printf("Key:%s, Value:%s\n", key, value)
-	}
-	fmt.Println("\nrequest.PostForm::")
-	for key, value := range r.PostForm {
-		fmt.Printf("Key:%s, Value:%s\n", key, value)
-	}
-	if err != nil {
-		logger.Warnf("invalid form: %s", err.Error())
-		w.WriteHeader(http.StatusOK)
-		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
-		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
-		if err != nil {
-			logger.Warnf("invalid write: %s", err.Error())
-		}
-		return
-	}
-
-	label := r.PostFormValue("label")
-	fmt.Println(label)
-	vals := strings.Split(label, "__")
-	userId, _ := strconv.ParseInt(vals[0], 10, 64)
-	adId, _ := strconv.ParseInt(vals[1], 10, 64)
-	lvl, _ := strconv.ParseInt(vals[2], 10, 64)
-	promo := &models.Promotion{
-		AdvertId:   adId,
-		PromoLevel: lvl,
-	}
-
-	err = ah.advtUsecase.UpdatePromotion(userId, promo)
-	if err != nil {
-		logger.Warnf("invalid data: %s", err.Error())
-		w.WriteHeader(http.StatusOK)
-		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
-		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
-		if err != nil {
-			logger.Warnf("cannot write answer to body %s", err.Error())
-		}
-		return
-	}
-
 	w.WriteHeader(http.StatusOK)
-	_, err = w.Write(models.ToBytes(http.StatusOK, "promotion updated successfully", nil))
+	body := models.HttpBodyPromotionPackages{Packages: models.PromotionPackages}
+	_, err := w.Write(models.ToBytes(http.StatusOK, "promotion packages got successfully", body))
 	if err != nil {
 		logger.Warnf("cannot write answer to body %s", err.Error())
 	}
@@ -592,6 +564,110 @@ func (ah *AdvertHandler) CloseAdvertHandler(w http.ResponseWriter, r *http.Reque
 	logger.Debug("advert closed successfully")
 }
 
+// SubmitAdvertHandler godoc
+// @Summary Submit advert for review
+// @Description Send draft or rejected advert to moderation
+// @Tags advert
+// @Produce application/json
+// @Param id path integer true "Advert id"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyAdvert}
+// @failure default {object} models.HttpError
+// @Router /adverts/{id}/submit [post]
+func (ah *AdvertHandler) SubmitAdvertHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	advertId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse adv id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	advert, err := ah.advtUsecase.SubmitAdvert(advertId, userId)
+	if err != nil {
+		logger.Warnf("can not submit adv with id %d: %s", advertId, err.Error())
+		w.WriteHeader(http.StatusOK)
+
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyAdvert{Advert: *advert}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "advert sent to moderation", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+	logger.Debug("advert sent to moderation")
+}
+
+// RenewAdvertHandler godoc
+// @Summary Renew advert
+// @Description Publish closed advert again, the lifetime starts over
+// @Tags advert
+// @Produce application/json
+// @Param id path integer true "Advert id"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyAdvert}
+// @failure default {object} models.HttpError
+// @Router /adverts/{id}/renew [post]
+func (ah *AdvertHandler) RenewAdvertHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	advertId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse adv id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	advert, err := ah.advtUsecase.RenewAdvert(advertId, userId)
+	if err != nil {
+		logger.Warnf("can not renew adv with id %d: %s", advertId, err.Error())
+		w.WriteHeader(http.StatusOK)
+
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyAdvert{Advert: *advert}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "advert renewed", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+	logger.Debug("advert renewed")
+}
+
 // UploadImageHandler godoc
 // @Summary Upload images for advert
 // @Description Upload images for advert
@@ -818,6 +894,9 @@ func (ah *AdvertHandler) SalesmanPageHandler(w http.ResponseWriter, r *http.Requ
 	}
 
 	salesman, err := ah.userUsecase.GetById(salesmanId)
+	if err == nil && salesman.IsHidden && salesman.Id != userId {
+		err = internalError.NotExist
+	}
 	if err != nil {
 		logger.Warnf("can not parse path: %s", err.Error())
 		w.WriteHeader(http.StatusOK)
@@ -829,7 +908,7 @@ func (ah *AdvertHandler) SalesmanPageHandler(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	adverts, err := ah.advtUsecase.GetAdvertListByPublicherId(salesmanId, true, page)
+	adverts, err := ah.advtUsecase.GetAdvertListByPublicherId(salesmanId, userId, true, page)
 	if err != nil {
 		logger.Warnf("can not get adverts: %s", err.Error())
 		w.WriteHeader(http.StatusOK)
@@ -841,6 +920,13 @@ func (ah *AdvertHandler) SalesmanPageHandler(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	// продавец на своей странице видит, сколько осталось продвижения
+	if salesmanId == userId {
+		now := time.Now()
+		for _, advert := range adverts {
+			advert.SetPromoLeft(now)
+		}
+	}
 	shortAdverts := ah.advtUsecase.AdvertsToShort(adverts)
 
 	rateStat, err := ah.userUsecase.GetRating(userId, salesman.Id)
@@ -904,7 +990,7 @@ func (ah *AdvertHandler) ArchiveHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	adverts, err := ah.advtUsecase.GetAdvertListByPublicherId(userId, false, page)
+	adverts, err := ah.advtUsecase.GetAdvertListByPublicherId(userId, userId, false, page)
 	if err != nil {
 		logger.Warnf("unable to got adverts: %s", err.Error())
 		w.WriteHeader(http.StatusOK)
@@ -1124,6 +1210,76 @@ func (ah *AdvertHandler) RemoveFavoriteHandler(w http.ResponseWriter, r *http.Re
 	}
 }
 
+// FavoriteTargetHandler godoc
+// @Summary Set favorite target price
+// @Description Notify when price of favorite advert goes below target price, 0 removes target.
+// @Description Without target price user is notified about every price drop
+// @Tags favorite
+// @Accept application/json
+// @Produce application/json
+// @Param id path int true "Advert id"
+// @Param body body models.FavoriteTarget true "Target price"
+// @Success 200 {object} models.HttpBodyInterface
+// @failure default {object} models.HttpError
+// @Router /adverts/favorite/{id}/target [post]
+func (ah *AdvertHandler) FavoriteTargetHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	advertId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse string: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	defer r.Body.Close()
+	target := &models.FavoriteTarget{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = easyjson.Unmarshal(buf, target)
+	}
+	if err == nil {
+		_, err = govalidator.ValidateStruct(target)
+	}
+	if err != nil {
+		logger.Warnf("invalid data: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	err = ah.advtUsecase.SetFavoriteTarget(userId, advertId, target.TargetPrice)
+	if err != nil {
+		logger.Warnf("can not set favorite target price: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(models.ToBytes(http.StatusOK, "target price updated", nil))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
 func (ah *AdvertHandler) UpdatePriceHistory(w http.ResponseWriter, r *http.Request) {
 	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
 	var userId int64
@@ -1013,7 +1013,7 @@ func TestSalesmanPageSuccess(t *testing.T) {
 	}
 
 	uu.On("GetById", profile.Id).Return(&profile, nil)
-	au.On("GetAdvertListByPublicherId", profile.Id, true, &models.Page{PageNum: 0, Count: 50}).Return([]*models.Advert{&ad}, nil)
+	au.On("GetAdvertListByPublicherId", profile.Id, int64(0), true, &models.Page{PageNum: 0, Count: 50}).Return([]*models.Advert{&ad}, nil)
 	au.On("AdvertsToShort", []*models.Advert{&ad}).Return([]*models.AdvertShort{ad.ToShort()}, nil)
 	uu.On("GetRating", int64(0), profile.Id).Return(&models.RatingStat{}, nil)
 
@@ -1052,7 +1052,7 @@ func TestArchiveSuccess(t *testing.T) {
 		PublisherId: 0,
 	}
 
-	au.On("GetAdvertListByPublicherId", int64(0), false, &models.Page{PageNum: 0, Count: 50}).Return([]*models.Advert{&ad}, nil)
+	au.On("GetAdvertListByPublicherId", int64(0), int64(0), false, &models.Page{PageNum: 0, Count: 50}).Return([]*models.Advert{&ad}, nil)
 
 	client := &http.Client{}
 	req, err := http.NewRequest("GET", fmt.Sprintf("%s/adverts/archive?page=1&count=50", srv.URL), nil)
@@ -1174,6 +1174,57 @@ func TestRemoveFavoriteSuccess(t *testing.T) {
 	assert.Equal(t, Answer.Message, "removed from favorite")
 }
 
+func TestFavoriteTargetSuccess(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	ah := NewAdvertHandler(&au, &uu)
+
+	router := mux.NewRouter().PathPrefix("/adverts").Subrouter()
+	router.Use(middleware.LoggerMiddleware)
+	router.Handle("/favorite/{id:[0-9]+}/target", http.HandlerFunc(ah.FavoriteTargetHandler)).Methods(http.MethodPost, http.MethodOptions)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	au.On("SetFavoriteTarget", int64(0), int64(142), int64(900)).Return(nil)
+
+	res, err := http.Post(fmt.Sprintf("%s/adverts/favorite/142/target", srv.URL), "application/json",
+		bytes.NewBufferString(`{"target_price": 900}`))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, Answer.Code)
+	au.AssertExpectations(t)
+}
+
+func TestFavoriteTargetNotFavorite(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	ah := NewAdvertHandler(&au, &uu)
+
+	router := mux.NewRouter().PathPrefix("/adverts").Subrouter()
+	router.Use(middleware.LoggerMiddleware)
+	router.Handle("/favorite/{id:[0-9]+}/target", http.HandlerFunc(ah.FavoriteTargetHandler)).Methods(http.MethodPost, http.MethodOptions)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	au.On("SetFavoriteTarget", int64(0), int64(142), int64(900)).Return(myerr.NotExist)
+
+	res, err := http.Post(fmt.Sprintf("%s/adverts/favorite/142/target", srv.URL), "application/json",
+		bytes.NewBufferString(`{"target_price": 900}`))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, Answer.Code)
+}
+
 func TestUpdatePriceHistory(t *testing.T) {
 	au := advtMock.AdvtUsecase{}
 	uu := userMock.UserUsecase{}
@@ -1256,3 +1307,122 @@ func TestGetPriceHistory(t *testing.T) {
 	assert.Equal(t, Answer.Code, 200)
 	assert.Equal(t, Answer.Message, "favorite adverts got successfully")
 }
+
+func TestSubmitAdSuccess(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	ah := NewAdvertHandler(&au, &uu)
+
+	router := mux.NewRouter().PathPrefix("/adverts").Subrouter()
+	router.Use(middleware.LoggerMiddleware)
+	router.Handle("/{id:[0-9]+}/submit", http.HandlerFunc(ah.SubmitAdvertHandler)).Methods(http.MethodPost, http.MethodOptions)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	ad := models.Advert{
+		Id:     2,
+		Name:   "aboba",
+		Status: models.AdvertStatusPendingReview,
+	}
+
+	au.On("SubmitAdvert", ad.Id, int64(0)).Return(&ad, nil)
+
+	client := &http.Client{}
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/adverts/2/submit", srv.URL), nil)
+	assert.Nil(t, err)
+
+	res, err := client.Do(req)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, Answer.Code, 200)
+	assert.Equal(t, Answer.Message, "advert sent to moderation")
+}
+
+func TestSubmitAdFailPublished(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	ah := NewAdvertHandler(&au, &uu)
+
+	router := mux.NewRouter().PathPrefix("/adverts").Subrouter()
+	router.Use(middleware.LoggerMiddleware)
+	router.Handle("/{id:[0-9]+}/submit", http.HandlerFunc(ah.SubmitAdvertHandler)).Methods(http.MethodPost, http.MethodOptions)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	au.On("SubmitAdvert", int64(2), int64(0)).Return(nil, myerr.ModerationNotAllowed)
+
+	client := &http.Client{}
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/adverts/2/submit", srv.URL), nil)
+	assert.Nil(t, err)
+
+	res, err := client.Do(req)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, Answer.Code, http.StatusConflict)
+	assert.Equal(t, Answer.Message, "advert is not awaiting moderation")
+}
+
+func TestRenewAdNotAllowed(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	ah := NewAdvertHandler(&au, &uu)
+
+	router := mux.NewRouter().PathPrefix("/adverts").Subrouter()
+	router.Use(middleware.LoggerMiddleware)
+	router.Handle("/{id:[0-9]+}/renew", http.HandlerFunc(ah.RenewAdvertHandler)).Methods(http.MethodPost, http.MethodOptions)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	au.On("RenewAdvert", int64(2), int64(0)).Return(nil, myerr.RenewNotAllowed)
+
+	client := &http.Client{}
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/adverts/2/renew", srv.URL), nil)
+	assert.Nil(t, err)
+
+	res, err := client.Do(req)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, Answer.Code, http.StatusConflict)
+	assert.Equal(t, Answer.Message, "advert can not be renewed")
+}
+
+func TestPromotionPackagesHandler(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	ah := NewAdvertHandler(&au, &uu)
+
+	router := mux.NewRouter().PathPrefix("/adverts").Subrouter()
+	router.Use(middleware.LoggerMiddleware)
+	router.HandleFunc("/promotion/packages", ah.PromotionPackagesHandler).Methods(http.MethodGet, http.MethodOptions)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	res, err := http.Get(fmt.Sprintf("%s/adverts/promotion/packages", srv.URL))
+	assert.Nil(t, err)
+
+	var Answer struct {
+		Code int                              `json:"code"`
+		Body models.HttpBodyPromotionPackages `json:"body"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, len(models.PromotionPackages), len(Answer.Body.Packages))
+}
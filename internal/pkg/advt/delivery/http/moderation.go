@@ -0,0 +1,242 @@
+package delivery
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/advt"
+	"yula/internal/pkg/middleware"
+	"yula/internal/pkg/notifications"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/sirupsen/logrus"
+)
+
+// ModerationHandler - очередь проверки объявлений для администраторов,
+// о решении владелец объявления узнает из уведомления
+type ModerationHandler struct {
+	advtUsecase advt.AdvtUsecase
+	notifier    notifications.Notifier
+}
+
+func NewModerationHandler(advtUsecase advt.AdvtUsecase, notifier notifications.Notifier) *ModerationHandler {
+	return &ModerationHandler{
+		advtUsecase: advtUsecase,
+		notifier:    notifier,
+	}
+}
+
+func (mh *ModerationHandler) Routing(r *mux.Router, sm *middleware.SessionMiddleware) {
+	s := r.PathPrefix("/admin/adverts").Subrouter()
+
+	s.Handle("/moderation", middleware.SetSCRFToken(sm.CheckAuthorized(middleware.RequireModerator(http.HandlerFunc(mh.ModerationQueueHandler))))).Methods(http.MethodGet, http.MethodOptions)
+	s.Handle("/{id:[0-9]+}/approve", sm.CheckAuthorized(middleware.RequireModerator(http.HandlerFunc(mh.ApproveAdvertHandler)))).Methods(http.MethodPost, http.MethodOptions)
+	s.Handle("/{id:[0-9]+}/reject", sm.CheckAuthorized(middleware.RequireModerator(http.HandlerFunc(mh.RejectAdvertHandler)))).Methods(http.MethodPost, http.MethodOptions)
+}
+
+// ModerationQueueHandler godoc
+// @Summary Moderation queue
+// @Description Adverts awaiting review, oldest first
+// @Tags moderation
+// @Produce application/json
+// @Param page query string false "Page"
+// @Param count query string false "Count"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyAdverts}
+// @failure default {object} models.HttpError
+// @Router /admin/adverts/moderation [get]
+func (mh *ModerationHandler) ModerationQueueHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+
+	u, err := url.Parse(r.URL.RequestURI())
+	if err != nil {
+		logger.Warnf("can not parse path: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	query := u.Query()
+	page, err := models.NewPage(query.Get("page"), query.Get("count"))
+	if err != nil {
+		logger.Warnf("can not create page: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	adverts, err := mh.advtUsecase.GetModerationQueue(page)
+	if err != nil {
+		logger.Warnf("unable to get moderation queue: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyAdverts{Advert: adverts}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "moderation queue got successfully", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// ApproveAdvertHandler godoc
+// @Summary Approve advert
+// @Description Publish advert awaiting review
+// @Tags moderation
+// @Produce application/json
+// @Param id path integer true "Advert id"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyAdvert}
+// @failure default {object} models.HttpError
+// @Router /admin/adverts/{id}/approve [post]
+func (mh *ModerationHandler) ApproveAdvertHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	advertId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse adv id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	advert, err := mh.advtUsecase.ApproveAdvert(advertId)
+	if err != nil {
+		logger.Warnf("can not approve adv with id %d: %s", advertId, err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	mh.notifyPublisher(userId, advert, fmt.Sprintf("Your advert \"%s\" has been published", advert.Name))
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyAdvert{Advert: *advert}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "advert approved", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// RejectAdvertHandler godoc
+// @Summary Reject advert
+// @Description Reject advert awaiting review, the reason is shown to the publisher
+// @Tags moderation
+// @Accept application/json
+// @Produce application/json
+// @Param id path integer true "Advert id"
+// @Param body body models.ModerationDecision true "Reason"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyAdvert}
+// @failure default {object} models.HttpError
+// @Router /admin/adverts/{id}/reject [post]
+func (mh *ModerationHandler) RejectAdvertHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	advertId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse adv id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	defer r.Body.Close()
+	decision := &models.ModerationDecision{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = easyjson.Unmarshal(buf, decision)
+	}
+	if err == nil {
+		_, err = govalidator.ValidateStruct(decision)
+	}
+	if err != nil {
+		logger.Warnf("invalid data: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	sanitizer := bluemonday.UGCPolicy()
+	decision.Reason = sanitizer.Sanitize(decision.Reason)
+
+	advert, err := mh.advtUsecase.RejectAdvert(advertId, decision.Reason)
+	if err != nil {
+		logger.Warnf("can not reject adv with id %d: %s", advertId, err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	mh.notifyPublisher(userId, advert,
+		fmt.Sprintf("Your advert \"%s\" has been rejected: %s", advert.Name, advert.ModerationReason))
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyAdvert{Advert: *advert}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "advert rejected", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// notifyPublisher сообщает владельцу о решении модератора,
+// решение уже сохранено, поэтому ошибка доставки только логируется
+func (mh *ModerationHandler) notifyPublisher(moderatorId int64, advert *models.Advert, text string) {
+	err := mh.notifier.Notify(&models.Notification{
+		UserId:   advert.PublisherId,
+		Kind:     models.NotificationModeration,
+		Text:     text,
+		AdvertId: advert.Id,
+		SenderId: moderatorId,
+	})
+	if err != nil {
+		logger.Warnf("can not notify publisher %d about advert %d: %s", advert.PublisherId, advert.Id, err.Error())
+	}
+}
@@ -0,0 +1,144 @@
+package delivery
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"yula/internal/models"
+	"yula/internal/pkg/middleware"
+
+	myerr "yula/internal/error"
+
+	advtMock "yula/internal/pkg/advt/mocks"
+	notifMock "yula/internal/pkg/notifications/mocks"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newModerationRouter(mh *ModerationHandler) *mux.Router {
+	router := mux.NewRouter().PathPrefix("/admin/adverts").Subrouter()
+	router.Use(middleware.LoggerMiddleware)
+	router.Handle("/moderation", http.HandlerFunc(mh.ModerationQueueHandler)).Methods(http.MethodGet, http.MethodOptions)
+	router.Handle("/{id:[0-9]+}/approve", http.HandlerFunc(mh.ApproveAdvertHandler)).Methods(http.MethodPost, http.MethodOptions)
+	router.Handle("/{id:[0-9]+}/reject", http.HandlerFunc(mh.RejectAdvertHandler)).Methods(http.MethodPost, http.MethodOptions)
+	return router
+}
+
+func TestModerationQueueSuccess(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	nt := notifMock.Notifier{}
+	mh := NewModerationHandler(&au, &nt)
+
+	srv := httptest.NewServer(newModerationRouter(mh))
+	defer srv.Close()
+
+	ads := []*models.Advert{{Id: 2, Name: "aboba", Status: models.AdvertStatusPendingReview}}
+	au.On("GetModerationQueue", &models.Page{PageNum: 0, Count: 50}).Return(ads, nil)
+
+	res, err := http.Get(fmt.Sprintf("%s/admin/adverts/moderation", srv.URL))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "moderation queue got successfully", Answer.Message)
+}
+
+func TestApproveAdvertSuccess(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	nt := notifMock.Notifier{}
+	mh := NewModerationHandler(&au, &nt)
+
+	srv := httptest.NewServer(newModerationRouter(mh))
+	defer srv.Close()
+
+	ad := models.Advert{Id: 2, Name: "aboba", PublisherId: 5, Status: models.AdvertStatusPublished}
+	au.On("ApproveAdvert", int64(2)).Return(&ad, nil)
+	nt.On("Notify", mock.MatchedBy(func(n *models.Notification) bool {
+		return n.UserId == 5 && n.AdvertId == 2
+	})).Return(nil)
+
+	res, err := http.Post(fmt.Sprintf("%s/admin/adverts/2/approve", srv.URL), "application/json", nil)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "advert approved", Answer.Message)
+	nt.AssertExpectations(t)
+}
+
+func TestApproveAdvertNotPending(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	nt := notifMock.Notifier{}
+	mh := NewModerationHandler(&au, &nt)
+
+	srv := httptest.NewServer(newModerationRouter(mh))
+	defer srv.Close()
+
+	au.On("ApproveAdvert", int64(2)).Return(nil, myerr.ModerationNotAllowed)
+
+	res, err := http.Post(fmt.Sprintf("%s/admin/adverts/2/approve", srv.URL), "application/json", nil)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusConflict, Answer.Code)
+	nt.AssertNotCalled(t, "Notify", mock.Anything)
+}
+
+func TestRejectAdvertSuccessNotifyFailed(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	nt := notifMock.Notifier{}
+	mh := NewModerationHandler(&au, &nt)
+
+	srv := httptest.NewServer(newModerationRouter(mh))
+	defer srv.Close()
+
+	ad := models.Advert{Id: 2, Name: "aboba", PublisherId: 5, Status: models.AdvertStatusRejected,
+		ModerationReason: "prohibited goods"}
+	au.On("RejectAdvert", int64(2), "prohibited goods").Return(&ad, nil)
+	nt.On("Notify", mock.Anything).Return(myerr.InternalError)
+
+	reqBody := `{"reason": "prohibited goods"}`
+	res, err := http.Post(fmt.Sprintf("%s/admin/adverts/2/reject", srv.URL), "application/json", bytes.NewBufferString(reqBody))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "advert rejected", Answer.Message)
+}
+
+func TestRejectAdvertEmptyReason(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	nt := notifMock.Notifier{}
+	mh := NewModerationHandler(&au, &nt)
+
+	srv := httptest.NewServer(newModerationRouter(mh))
+	defer srv.Close()
+
+	reqBody := `{"reason": ""}`
+	res, err := http.Post(fmt.Sprintf("%s/admin/adverts/2/reject", srv.URL), "application/json", bytes.NewBufferString(reqBody))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, Answer.Code)
+	assert.Equal(t, "invalid data", Answer.Message)
+}
@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	time "time"
 	models "yula/internal/models"
 
 	mock "github.com/stretchr/testify/mock"
@@ -13,6 +14,29 @@ type AdvtRepository struct {
 	mock.Mock
 }
 
+// CloseExpired provides a mock function with given fields:
+func (_m *AdvtRepository) CloseExpired() ([]*models.Advert, error) {
+	ret := _m.Called()
+
+	var r0 []*models.Advert
+	if rf, ok := ret.Get(0).(func() []*models.Advert); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Advert)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Delete provides a mock function with given fields: advertId
 func (_m *AdvtRepository) Delete(advertId int64) error {
 	ret := _m.Called(advertId)
@@ -55,6 +79,27 @@ func (_m *AdvtRepository) DeleteImages(images []string, advertId int64) error {
 	return r0
 }
 
+// ExpirePromotions provides a mock function with given fields: now
+func (_m *AdvtRepository) ExpirePromotions(now time.Time) (int64, error) {
+	ret := _m.Called(now)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(time.Time) int64); ok {
+		r0 = rf(now)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Insert provides a mock function with given fields: advert
 func (_m *AdvtRepository) Insert(advert *models.Advert) error {
 	ret := _m.Called(advert)
@@ -97,6 +142,29 @@ func (_m *AdvtRepository) InsertImages(advertId int64, newImages []string) error
 	return r0
 }
 
+// MarkExpiring provides a mock function with given fields: warnDays
+func (_m *AdvtRepository) MarkExpiring(warnDays int64) ([]*models.Advert, error) {
+	ret := _m.Called(warnDays)
+
+	var r0 []*models.Advert
+	if rf, ok := ret.Get(0).(func(int64) []*models.Advert); ok {
+		r0 = rf(warnDays)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Advert)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(warnDays)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RegenerateRecomendations provides a mock function with given fields:
 func (_m *AdvtRepository) RegenerateRecomendations() error {
 	ret := _m.Called()
@@ -111,6 +179,20 @@ func (_m *AdvtRepository) RegenerateRecomendations() error {
 	return r0
 }
 
+// Renew provides a mock function with given fields: advert
+func (_m *AdvtRepository) Renew(advert *models.Advert) error {
+	ret := _m.Called(advert)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Advert) error); ok {
+		r0 = rf(advert)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SelectAdvertsByCategory provides a mock function with given fields: categoryName, from, count
 func (_m *AdvtRepository) SelectAdvertsByCategory(categoryName string, from int64, count int64) ([]*models.Advert, error) {
 	ret := _m.Called(categoryName, from, count)
@@ -134,13 +216,13 @@ func (_m *AdvtRepository) SelectAdvertsByCategory(categoryName string, from int6
 	return r0, r1
 }
 
-// SelectAdvertsByPublisherId provides a mock function with given fields: publisherId, is_active, offset, limit
-func (_m *AdvtRepository) SelectAdvertsByPublisherId(publisherId int64, is_active bool, offset int64, limit int64) ([]*models.Advert, error) {
-	ret := _m.Called(publisherId, is_active, offset, limit)
+// SelectAdvertsByPublisherId provides a mock function with given fields: publisherId, is_active, publishedOnly, offset, limit
+func (_m *AdvtRepository) SelectAdvertsByPublisherId(publisherId int64, is_active bool, publishedOnly bool, offset int64, limit int64) ([]*models.Advert, error) {
+	ret := _m.Called(publisherId, is_active, publishedOnly, offset, limit)
 
 	var r0 []*models.Advert
-	if rf, ok := ret.Get(0).(func(int64, bool, int64, int64) []*models.Advert); ok {
-		r0 = rf(publisherId, is_active, offset, limit)
+	if rf, ok := ret.Get(0).(func(int64, bool, bool, int64, int64) []*models.Advert); ok {
+		r0 = rf(publisherId, is_active, publishedOnly, offset, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*models.Advert)
@@ -148,8 +230,8 @@ func (_m *AdvtRepository) SelectAdvertsByPublisherId(publisherId int64, is_activ
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64, bool, int64, int64) error); ok {
-		r1 = rf(publisherId, is_active, offset, limit)
+	if rf, ok := ret.Get(1).(func(int64, bool, bool, int64, int64) error); ok {
+		r1 = rf(publisherId, is_active, publishedOnly, offset, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -180,6 +262,29 @@ func (_m *AdvtRepository) SelectById(advertId int64) (*models.Advert, error) {
 	return r0, r1
 }
 
+// SelectCategoryAttributes provides a mock function with given fields: categoryName
+func (_m *AdvtRepository) SelectCategoryAttributes(categoryName string) (models.CategoryAttributeList, error) {
+	ret := _m.Called(categoryName)
+
+	var r0 models.CategoryAttributeList
+	if rf, ok := ret.Get(0).(func(string) models.CategoryAttributeList); ok {
+		r0 = rf(categoryName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(models.CategoryAttributeList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(categoryName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SelectDummyRecomendations provides a mock function with given fields: advertId, count
 func (_m *AdvtRepository) SelectDummyRecomendations(advertId int64, count int64) ([]*models.Advert, error) {
 	ret := _m.Called(advertId, count)
@@ -249,6 +354,29 @@ func (_m *AdvtRepository) SelectFavoriteAdverts(userId int64, from int64, count
 	return r0, r1
 }
 
+// SelectFavoriteChanges provides a mock function with given fields: limit
+func (_m *AdvtRepository) SelectFavoriteChanges(limit int64) ([]*models.FavoriteChange, error) {
+	ret := _m.Called(limit)
+
+	var r0 []*models.FavoriteChange
+	if rf, ok := ret.Get(0).(func(int64) []*models.FavoriteChange); ok {
+		r0 = rf(limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.FavoriteChange)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SelectFavoriteCount provides a mock function with given fields: advertId
 func (_m *AdvtRepository) SelectFavoriteCount(advertId int64) (int64, error) {
 	ret := _m.Called(advertId)
@@ -293,6 +421,52 @@ func (_m *AdvtRepository) SelectListAdvt(isSortedByPublichedDate bool, from int6
 	return r0, r1
 }
 
+// SelectModerationQueue provides a mock function with given fields: from, count
+func (_m *AdvtRepository) SelectModerationQueue(from int64, count int64) ([]*models.Advert, error) {
+	ret := _m.Called(from, count)
+
+	var r0 []*models.Advert
+	if rf, ok := ret.Get(0).(func(int64, int64) []*models.Advert); ok {
+		r0 = rf(from, count)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Advert)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(from, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SelectPriceHistories provides a mock function with given fields: advertIds
+func (_m *AdvtRepository) SelectPriceHistories(advertIds []int64) (map[int64][]*models.AdvertPrice, error) {
+	ret := _m.Called(advertIds)
+
+	var r0 map[int64][]*models.AdvertPrice
+	if rf, ok := ret.Get(0).(func([]int64) map[int64][]*models.AdvertPrice); ok {
+		r0 = rf(advertIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64][]*models.AdvertPrice)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]int64) error); ok {
+		r1 = rf(advertIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SelectPriceHistory provides a mock function with given fields: advertId
 func (_m *AdvtRepository) SelectPriceHistory(advertId int64) ([]*models.AdvertPrice, error) {
 	ret := _m.Called(advertId)
@@ -316,6 +490,29 @@ func (_m *AdvtRepository) SelectPriceHistory(advertId int64) ([]*models.AdvertPr
 	return r0, r1
 }
 
+// SelectPublisherCategories provides a mock function with given fields: publisherId, publishedOnly
+func (_m *AdvtRepository) SelectPublisherCategories(publisherId int64, publishedOnly bool) ([]*models.Category, error) {
+	ret := _m.Called(publisherId, publishedOnly)
+
+	var r0 []*models.Category
+	if rf, ok := ret.Get(0).(func(int64, bool) []*models.Category); ok {
+		r0 = rf(publisherId, publishedOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Category)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, bool) error); ok {
+		r1 = rf(publisherId, publishedOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SelectRecomendations provides a mock function with given fields: advertId, count, userId
 func (_m *AdvtRepository) SelectRecomendations(advertId int64, count int64, userId int64) ([]*models.Advert, error) {
 	ret := _m.Called(advertId, count, userId)
@@ -339,6 +536,27 @@ func (_m *AdvtRepository) SelectRecomendations(advertId int64, count int64, user
 	return r0, r1
 }
 
+// SelectReservedAmount provides a mock function with given fields: advertId, userId
+func (_m *AdvtRepository) SelectReservedAmount(advertId int64, userId int64) (int64, error) {
+	ret := _m.Called(advertId, userId)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(int64, int64) int64); ok {
+		r0 = rf(advertId, userId)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(advertId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SelectViews provides a mock function with given fields: advertId
 func (_m *AdvtRepository) SelectViews(advertId int64) (int64, error) {
 	ret := _m.Called(advertId)
@@ -374,6 +592,34 @@ func (_m *AdvtRepository) Update(newAdvert *models.Advert) error {
 	return r0
 }
 
+// UpdateFavoriteSeen provides a mock function with given fields: change
+func (_m *AdvtRepository) UpdateFavoriteSeen(change *models.FavoriteChange) error {
+	ret := _m.Called(change)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.FavoriteChange) error); ok {
+		r0 = rf(change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateFavoriteTarget provides a mock function with given fields: userId, advertId, targetPrice
+func (_m *AdvtRepository) UpdateFavoriteTarget(userId int64, advertId int64, targetPrice int64) error {
+	ret := _m.Called(userId, advertId, targetPrice)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64, int64) error); ok {
+		r0 = rf(userId, advertId, targetPrice)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdatePrice provides a mock function with given fields: advertPrice
 func (_m *AdvtRepository) UpdatePrice(advertPrice *models.AdvertPrice) error {
 	ret := _m.Called(advertPrice)
@@ -3,11 +3,10 @@
 package mocks
 
 import (
+	multipart "mime/multipart"
 	models "yula/internal/models"
 
 	mock "github.com/stretchr/testify/mock"
-
-	multipart "mime/multipart"
 )
 
 // AdvtUsecase is an autogenerated mock type for the AdvtUsecase type
@@ -45,6 +44,29 @@ func (_m *AdvtUsecase) AdvertsToShort(adverts []*models.Advert) []*models.Advert
 	return r0
 }
 
+// ApproveAdvert provides a mock function with given fields: advertId
+func (_m *AdvtUsecase) ApproveAdvert(advertId int64) (*models.Advert, error) {
+	ret := _m.Called(advertId)
+
+	var r0 *models.Advert
+	if rf, ok := ret.Get(0).(func(int64) *models.Advert); ok {
+		r0 = rf(advertId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Advert)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(advertId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CloseAdvert provides a mock function with given fields: advertId, userId
 func (_m *AdvtUsecase) CloseAdvert(advertId int64, userId int64) error {
 	ret := _m.Called(advertId, userId)
@@ -59,6 +81,52 @@ func (_m *AdvtUsecase) CloseAdvert(advertId int64, userId int64) error {
 	return r0
 }
 
+// CloseExpiredAdverts provides a mock function with given fields:
+func (_m *AdvtUsecase) CloseExpiredAdverts() ([]*models.Advert, error) {
+	ret := _m.Called()
+
+	var r0 []*models.Advert
+	if rf, ok := ret.Get(0).(func() []*models.Advert); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Advert)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CollectFavoriteNotifications provides a mock function with given fields:
+func (_m *AdvtUsecase) CollectFavoriteNotifications() ([]*models.FavoriteNotification, error) {
+	ret := _m.Called()
+
+	var r0 []*models.FavoriteNotification
+	if rf, ok := ret.Get(0).(func() []*models.FavoriteNotification); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.FavoriteNotification)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateAdvert provides a mock function with given fields: userId, advert
 func (_m *AdvtUsecase) CreateAdvert(userId int64, advert *models.Advert) error {
 	ret := _m.Called(userId, advert)
@@ -87,6 +155,34 @@ func (_m *AdvtUsecase) DeleteAdvert(advertId int64, userId int64) error {
 	return r0
 }
 
+// ExpirePromotions provides a mock function with given fields:
+func (_m *AdvtUsecase) ExpirePromotions() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExportAdverts provides a mock function with given fields: publisherId, publishedOnly, export
+func (_m *AdvtUsecase) ExportAdverts(publisherId int64, publishedOnly bool, export func(*models.AdvertExport) error) error {
+	ret := _m.Called(publisherId, publishedOnly, export)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, bool, func(*models.AdvertExport) error) error); ok {
+		r0 = rf(publisherId, publishedOnly, export)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetAdvert provides a mock function with given fields: advertId, userId, updateViews
 func (_m *AdvtUsecase) GetAdvert(advertId int64, userId int64, updateViews bool) (*models.Advert, error) {
 	ret := _m.Called(advertId, userId, updateViews)
@@ -133,13 +229,13 @@ func (_m *AdvtUsecase) GetAdvertListByCategory(categoryName string, page *models
 	return r0, r1
 }
 
-// GetAdvertListByPublicherId provides a mock function with given fields: publisherId, is_active, page
-func (_m *AdvtUsecase) GetAdvertListByPublicherId(publisherId int64, is_active bool, page *models.Page) ([]*models.Advert, error) {
-	ret := _m.Called(publisherId, is_active, page)
+// GetAdvertListByPublicherId provides a mock function with given fields: publisherId, viewerId, is_active, page
+func (_m *AdvtUsecase) GetAdvertListByPublicherId(publisherId int64, viewerId int64, is_active bool, page *models.Page) ([]*models.Advert, error) {
+	ret := _m.Called(publisherId, viewerId, is_active, page)
 
 	var r0 []*models.Advert
-	if rf, ok := ret.Get(0).(func(int64, bool, *models.Page) []*models.Advert); ok {
-		r0 = rf(publisherId, is_active, page)
+	if rf, ok := ret.Get(0).(func(int64, int64, bool, *models.Page) []*models.Advert); ok {
+		r0 = rf(publisherId, viewerId, is_active, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*models.Advert)
@@ -147,8 +243,8 @@ func (_m *AdvtUsecase) GetAdvertListByPublicherId(publisherId int64, is_active b
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64, bool, *models.Page) error); ok {
-		r1 = rf(publisherId, is_active, page)
+	if rf, ok := ret.Get(1).(func(int64, int64, bool, *models.Page) error); ok {
+		r1 = rf(publisherId, viewerId, is_active, page)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -244,6 +340,29 @@ func (_m *AdvtUsecase) GetListAdvt(from int64, count int64, newest bool) ([]*mod
 	return r0, r1
 }
 
+// GetModerationQueue provides a mock function with given fields: page
+func (_m *AdvtUsecase) GetModerationQueue(page *models.Page) ([]*models.Advert, error) {
+	ret := _m.Called(page)
+
+	var r0 []*models.Advert
+	if rf, ok := ret.Get(0).(func(*models.Page) []*models.Advert); ok {
+		r0 = rf(page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Advert)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.Page) error); ok {
+		r1 = rf(page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPriceHistory provides a mock function with given fields: advertId
 func (_m *AdvtUsecase) GetPriceHistory(advertId int64) ([]*models.AdvertPrice, error) {
 	ret := _m.Called(advertId)
@@ -267,6 +386,29 @@ func (_m *AdvtUsecase) GetPriceHistory(advertId int64) ([]*models.AdvertPrice, e
 	return r0, r1
 }
 
+// GetPublisherCategories provides a mock function with given fields: publisherId, publishedOnly
+func (_m *AdvtUsecase) GetPublisherCategories(publisherId int64, publishedOnly bool) ([]*models.Category, error) {
+	ret := _m.Called(publisherId, publishedOnly)
+
+	var r0 []*models.Category
+	if rf, ok := ret.Get(0).(func(int64, bool) []*models.Category); ok {
+		r0 = rf(publisherId, publishedOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Category)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, bool) error); ok {
+		r1 = rf(publisherId, publishedOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRecomendations provides a mock function with given fields: advertId, count, userId
 func (_m *AdvtUsecase) GetRecomendations(advertId int64, count int64, userId int64) ([]*models.Advert, error) {
 	ret := _m.Called(advertId, count, userId)
@@ -290,6 +432,43 @@ func (_m *AdvtUsecase) GetRecomendations(advertId int64, count int64, userId int
 	return r0, r1
 }
 
+// PreparePromotion provides a mock function with given fields: userId, promo
+func (_m *AdvtUsecase) PreparePromotion(userId int64, promo *models.Promotion) error {
+	ret := _m.Called(userId, promo)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, *models.Promotion) error); ok {
+		r0 = rf(userId, promo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RejectAdvert provides a mock function with given fields: advertId, reason
+func (_m *AdvtUsecase) RejectAdvert(advertId int64, reason string) (*models.Advert, error) {
+	ret := _m.Called(advertId, reason)
+
+	var r0 *models.Advert
+	if rf, ok := ret.Get(0).(func(int64, string) *models.Advert); ok {
+		r0 = rf(advertId, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Advert)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, string) error); ok {
+		r1 = rf(advertId, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveFavorite provides a mock function with given fields: userId, advertId
 func (_m *AdvtUsecase) RemoveFavorite(userId int64, advertId int64) error {
 	ret := _m.Called(userId, advertId)
@@ -318,6 +497,66 @@ func (_m *AdvtUsecase) RemoveImages(images []string, advertId int64, userId int6
 	return r0
 }
 
+// RenewAdvert provides a mock function with given fields: advertId, userId
+func (_m *AdvtUsecase) RenewAdvert(advertId int64, userId int64) (*models.Advert, error) {
+	ret := _m.Called(advertId, userId)
+
+	var r0 *models.Advert
+	if rf, ok := ret.Get(0).(func(int64, int64) *models.Advert); ok {
+		r0 = rf(advertId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Advert)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(advertId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetFavoriteTarget provides a mock function with given fields: userId, advertId, targetPrice
+func (_m *AdvtUsecase) SetFavoriteTarget(userId int64, advertId int64, targetPrice int64) error {
+	ret := _m.Called(userId, advertId, targetPrice)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64, int64) error); ok {
+		r0 = rf(userId, advertId, targetPrice)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubmitAdvert provides a mock function with given fields: advertId, userId
+func (_m *AdvtUsecase) SubmitAdvert(advertId int64, userId int64) (*models.Advert, error) {
+	ret := _m.Called(advertId, userId)
+
+	var r0 *models.Advert
+	if rf, ok := ret.Get(0).(func(int64, int64) *models.Advert); ok {
+		r0 = rf(advertId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Advert)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(advertId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateAdvert provides a mock function with given fields: advertId, newAdvert
 func (_m *AdvtUsecase) UpdateAdvert(advertId int64, newAdvert *models.Advert) error {
 	ret := _m.Called(advertId, newAdvert)
@@ -382,3 +621,26 @@ func (_m *AdvtUsecase) UploadImages(files []*multipart.FileHeader, advertId int6
 
 	return r0, r1
 }
+
+// WarnExpiringAdverts provides a mock function with given fields: warnDays
+func (_m *AdvtUsecase) WarnExpiringAdverts(warnDays int64) ([]*models.Advert, error) {
+	ret := _m.Called(warnDays)
+
+	var r0 []*models.Advert
+	if rf, ok := ret.Get(0).(func(int64) []*models.Advert); ok {
+		r0 = rf(warnDays)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Advert)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(warnDays)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -1,19 +1,30 @@
 package advt
 
-import "yula/internal/models"
+import (
+	"time"
+	"yula/internal/models"
+)
 
 //go:generate mockery --name=AdvtRepository
 
 type AdvtRepository interface {
 	SelectListAdvt(isSortedByPublichedDate bool, from, count int64) ([]*models.Advert, error)
-	SelectAdvertsByPublisherId(publisherId int64, is_active bool, offset int64, limit int64) ([]*models.Advert, error)
+	SelectAdvertsByPublisherId(publisherId int64, is_active bool, publishedOnly bool, offset int64, limit int64) ([]*models.Advert, error)
 	SelectAdvertsByCategory(categoryName string, from, count int64) ([]*models.Advert, error)
 	SelectFavoriteAdverts(userId int64, from, count int64) ([]*models.Advert, error)
+	SelectModerationQueue(from, count int64) ([]*models.Advert, error)
+	SelectPublisherCategories(publisherId int64, publishedOnly bool) ([]*models.Category, error)
 
 	Insert(advert *models.Advert) error
 	SelectById(advertId int64) (*models.Advert, error)
 	Update(newAdvert *models.Advert) error
 	Delete(advertId int64) error
+	Renew(advert *models.Advert) error
+
+	SelectCategoryAttributes(categoryName string) (models.CategoryAttributeList, error)
+
+	CloseExpired() ([]*models.Advert, error)
+	MarkExpiring(warnDays int64) ([]*models.Advert, error)
 
 	InsertImages(advertId int64, newImages []string) error
 	DeleteImages(images []string, advertId int64) error
@@ -22,14 +33,21 @@ type AdvtRepository interface {
 	SelectFavorite(userId, advertId int64) (*models.Advert, error)
 	InsertFavorite(userId, advertId int64) error
 	DeleteFavorite(userId, advertId int64) error
+	UpdateFavoriteTarget(userId, advertId int64, targetPrice int64) error
+	SelectFavoriteChanges(limit int64) ([]*models.FavoriteChange, error)
+	UpdateFavoriteSeen(change *models.FavoriteChange) error
+
+	SelectReservedAmount(advertId int64, userId int64) (int64, error)
 
 	SelectViews(advertId int64) (int64, error)
 	UpdateViews(advertId int64) error
 
 	SelectPriceHistory(advertId int64) ([]*models.AdvertPrice, error)
+	SelectPriceHistories(advertIds []int64) (map[int64][]*models.AdvertPrice, error)
 	UpdatePrice(advertPrice *models.AdvertPrice) error
 
 	UpdatePromo(promo *models.Promotion) error
+	ExpirePromotions(now time.Time) (int64, error)
 
 	RegenerateRecomendations() error
 	SelectRecomendations(advertId int64, count int64, userId int64) ([]*models.Advert, error)
@@ -7,10 +7,13 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 	internalError "yula/internal/error"
 	"yula/internal/models"
 	"yula/internal/pkg/advt"
 	imageloader "yula/internal/pkg/image_loader"
+
+	"github.com/mailru/easyjson"
 )
 
 type AdvtRepository struct {
@@ -23,24 +26,44 @@ func NewAdvtRepository(DB *sql.DB) advt.AdvtRepository {
 	}
 }
 
+// withPromotedSlots расставляет выдачу listing по страницам: первые места каждой страницы занимают объявления
+// в топе, остальные - обычная выдача, и те и другие идут в исходном порядке ord. Пока объявления в топе
+// не закончились, на каждой странице их ровно slots, поэтому LIMIT/OFFSET по этому порядку дают те же страницы.
+// count, level и slots - номера параметров с размером страницы, уровнем топа и числом мест под топ
+func withPromotedSlots(listing string, count, level, slots int) string {
+	return fmt.Sprintf(`
+		SELECT id, name, description, price, location, latitude, longitude, published_at, date_close, is_active,
+			views, publisher_id, category, images, amount, is_new, promo_level
+		FROM (
+			SELECT l.*, row_number() OVER (PARTITION BY $%[3]d > 0 AND l.promo_level >= $%[2]d ORDER BY l.ord) - 1 AS idx
+			FROM (%[4]s) AS l
+		) AS ranked
+		ORDER BY CASE WHEN $%[3]d > 0 AND promo_level >= $%[2]d
+			THEN (idx / GREATEST($%[3]d, 1)) * $%[1]d + idx %% GREATEST($%[3]d, 1)
+			ELSE (idx / GREATEST($%[1]d - $%[3]d, 1)) * $%[1]d + $%[3]d + idx %% GREATEST($%[1]d - $%[3]d, 1)
+		END, ord`, count, level, slots, listing)
+}
+
 func (ar *AdvtRepository) SelectListAdvt(isSortedByPublichedDate bool, from, count int64) ([]*models.Advert, error) {
 	queryStr := `SELECT a.id, a.Name, a.Description, a.price, a.location, a.latitude, a.longitude, a.published_at, 
-				 	a.date_close, a.is_active, a.views, a.publisher_id, c.name, array_agg(ai.img_path), 
-					a.amount, a.is_new, p.promo_level 
+				 	a.date_close, a.is_active, a.views, a.publisher_id, c.name AS category, array_agg(ai.img_path) AS images, 
+					a.amount, a.is_new, p.promo_level, row_number() OVER (%s) AS ord 
 				 FROM advert a
 				 JOIN category c ON a.category_id = c.Id
 				 JOIN promotion as p ON a.id = p.advert_id
 				 LEFT JOIN advert_image ai ON a.id = ai.advert_id
-				 WHERE a.is_active 
+				 WHERE a.status = 'published' AND a.publisher_id NOT IN (SELECT id FROM users WHERE is_hidden)
 				 GROUP BY a.id, a.name, a.Description,  a.price, a.location, a.latitude, a.longitude, a.published_at, 
-				 a.date_close, a.is_active, a.views, a.publisher_id, c.name, p.promo_level %s LIMIT $1 OFFSET $2;`
+				 a.date_close, a.is_active, a.views, a.publisher_id, c.name, p.promo_level`
 	if isSortedByPublichedDate {
-		queryStr = fmt.Sprintf(queryStr, " ORDER BY a.published_at DESC")
+		queryStr = fmt.Sprintf(queryStr, "ORDER BY a.published_at DESC")
 	} else {
 		queryStr = fmt.Sprintf(queryStr, "")
 	}
+	queryStr = withPromotedSlots(queryStr, 1, 3, 4) + " LIMIT $1 OFFSET $2;"
 
-	rows, err := ar.DB.QueryContext(context.Background(), queryStr, count, from*count)
+	rows, err := ar.DB.QueryContext(context.Background(), queryStr, count, from*count,
+		models.PromotionTopLevel, models.PromotedSlotsOnPage(count))
 	if err != nil {
 		return nil, internalError.GenInternalError(err)
 	}
@@ -80,11 +103,22 @@ func (ar *AdvtRepository) Insert(advert *models.Advert) error {
 		return internalError.GenInternalError(err)
 	}
 
-	queryStr := `INSERT INTO advert (name, description, category_id, publisher_id, latitude, longitude, location, price, amount, is_new) 
-				VALUES ($1, $2, (SELECT id FROM category WHERE lower(name) = lower($3)), $4, $5, $6, $7, $8, $9, $10) RETURNING id;`
+	attributes, err := easyjson.Marshal(advert.Attributes)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return internalError.RollbackError
+		}
+		return internalError.GenInternalError(err)
+	}
+
+	queryStr := `INSERT INTO advert (name, description, category_id, publisher_id, latitude, longitude, location, price, amount, is_new, 
+					delivery_pickup, delivery_courier, delivery_post, status, attributes) 
+				VALUES ($1, $2, (SELECT id FROM category WHERE lower(name) = lower($3)), $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15) RETURNING id;`
 	query := ar.DB.QueryRowContext(context.Background(), queryStr,
 		advert.Name, advert.Description, advert.Category, advert.PublisherId,
-		advert.Latitude, advert.Longitude, advert.Location, advert.Price, advert.Amount, advert.IsNew)
+		advert.Latitude, advert.Longitude, advert.Location, advert.Price, advert.Amount, advert.IsNew,
+		advert.DeliveryPickup, advert.DeliveryCourier, advert.DeliveryPost, advert.Status, string(attributes))
 
 	if err := query.Scan(&advert.Id); err != nil {
 		rollbackErr := tx.Rollback()
@@ -139,27 +173,36 @@ func (ar *AdvtRepository) SelectById(advertId int64) (*models.Advert, error) {
 	queryStr := `
 				SELECT a.id, a.Name, a.Description, a.price, a.location, a.latitude, a.longitude, a.published_at, 
 					a.date_close, a.is_active, a.views, a.publisher_id, c.name, array_agg(ai.img_path), a.amount, 
-					a.is_new, p.promo_level 
+					a.is_new, p.promo_level, p.promo_until, a.delivery_pickup, a.delivery_courier, a.delivery_post, 
+					a.status, a.moderation_reason, a.close_reason, a.attributes, a.needs_review 
 				FROM advert a
 				JOIN category c ON a.category_id = c.Id
 				JOIN promotion as p ON a.id = p.advert_id
 				LEFT JOIN advert_image ai ON a.id = ai.advert_id 
 				GROUP BY a.id, a.name, a.Description,  a.price, a.location, a.latitude, a.longitude, a.published_at, 
-				a.date_close, a.is_active, a.views, a.publisher_id, c.name, p.promo_level 
+				a.date_close, a.is_active, a.views, a.publisher_id, c.name, p.promo_level, p.promo_until 
 				HAVING a.id = $1;`
 	queryRow := ar.DB.QueryRowContext(context.Background(), queryStr, advertId)
 
 	var advert models.Advert
 	var images string
+	var attributes []byte
 
 	err := queryRow.Scan(&advert.Id, &advert.Name, &advert.Description, &advert.Price, &advert.Location, &advert.Latitude,
 		&advert.Longitude, &advert.PublishedAt, &advert.DateClose, &advert.IsActive, &advert.Views,
-		&advert.PublisherId, &advert.Category, &images, &advert.Amount, &advert.IsNew, &advert.PromoLevel)
+		&advert.PublisherId, &advert.Category, &images, &advert.Amount, &advert.IsNew, &advert.PromoLevel, &advert.PromoUntil,
+		&advert.DeliveryPickup, &advert.DeliveryCourier, &advert.DeliveryPost, &advert.Status, &advert.ModerationReason,
+		&advert.CloseReason, &attributes, &advert.NeedsReview)
 
 	if err != nil {
 		return nil, internalError.EmptyQuery
 	}
 
+	err = easyjson.Unmarshal(attributes, &advert.Attributes)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+
 	advert.Images = make([]string, 0)
 	if images[1:len(images)-1] != "NULL" {
 		advert.Images = strings.Split(images[1:len(images)-1], ",")
@@ -173,18 +216,32 @@ func (ar *AdvtRepository) SelectById(advertId int64) (*models.Advert, error) {
 	return &advert, nil
 }
 
+// Update сохраняет объявление, при переходе в published после проверки published_at становится моментом публикации
 func (ar *AdvtRepository) Update(newAdvert *models.Advert) error {
 	tx, err := ar.DB.BeginTx(context.Background(), nil)
 	if err != nil {
 		return internalError.GenInternalError(err)
 	}
 
+	attributes, err := easyjson.Marshal(newAdvert.Attributes)
+	if err != nil {
+		if rlbckEr := tx.Rollback(); rlbckEr != nil {
+			return internalError.RollbackError
+		}
+		return internalError.GenInternalError(err)
+	}
+
 	queryStr := `UPDATE advert set name = $2, description = $3, category_id = (SELECT c.id FROM category c WHERE lower(c.name) = lower($4)), 
 				location = $5, latitude = $6, longitude = $7, price = $8, is_active = $9, date_close = $10, 
-				amount = $11, is_new = $12 WHERE id = $1 RETURNING id;`
+				amount = $11, is_new = $12, delivery_pickup = $13, delivery_courier = $14, delivery_post = $15, 
+				status = $16, moderation_reason = $17, close_reason = $18, attributes = $19, needs_review = $20, 
+				published_at = CASE WHEN status <> 'published' AND $16 = 'published' THEN CURRENT_TIMESTAMP ELSE published_at END 
+				WHERE id = $1 RETURNING id;`
 	query := tx.QueryRowContext(context.Background(), queryStr, newAdvert.Id, newAdvert.Name, newAdvert.Description,
 		newAdvert.Category, newAdvert.Location, newAdvert.Latitude, newAdvert.Longitude,
-		newAdvert.Price, newAdvert.IsActive, newAdvert.DateClose, newAdvert.Amount, newAdvert.IsNew)
+		newAdvert.Price, newAdvert.IsActive, newAdvert.DateClose, newAdvert.Amount, newAdvert.IsNew,
+		newAdvert.DeliveryPickup, newAdvert.DeliveryCourier, newAdvert.DeliveryPost,
+		newAdvert.Status, newAdvert.ModerationReason, newAdvert.CloseReason, string(attributes), newAdvert.NeedsReview)
 
 	err = query.Scan(&newAdvert.Id)
 	if err != nil {
@@ -223,6 +280,99 @@ func (ar *AdvtRepository) Delete(advertId int64) error {
 	return nil
 }
 
+// Renew снова публикует закрытое объявление, срок жизни отсчитывается заново.
+// Объявление, измененное после проверки, вместо публикации уходит на модерацию
+func (ar *AdvtRepository) Renew(advert *models.Advert) error {
+	queryStr := `UPDATE advert SET is_active = true, close_reason = '', expiry_warned = false,
+				status = CASE WHEN needs_review THEN 'pending_review' ELSE 'published' END, 
+				moderation_reason = '', needs_review = false,
+				published_at = CURRENT_TIMESTAMP, date_close = to_timestamp(0)
+				WHERE id = $1 AND status = 'closed' RETURNING status, published_at, date_close;`
+	query := ar.DB.QueryRowContext(context.Background(), queryStr, advert.Id)
+
+	err := query.Scan(&advert.Status, &advert.PublishedAt, &advert.DateClose)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return internalError.EmptyQuery
+		}
+		return internalError.GenInternalError(err)
+	}
+
+	advert.IsActive = true
+	advert.CloseReason = ""
+	advert.ModerationReason = ""
+	advert.NeedsReview = false
+	return nil
+}
+
+// SelectCategoryAttributes возвращает схему атрибутов категории, по ней проверяются объявления
+func (ar *AdvtRepository) SelectCategoryAttributes(categoryName string) (models.CategoryAttributeList, error) {
+	var raw []byte
+	err := ar.DB.QueryRowContext(context.Background(),
+		"SELECT attributes FROM category WHERE lower(name) = lower($1);", categoryName).Scan(&raw)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return nil, internalError.EmptyQuery
+		}
+		return nil, internalError.GenInternalError(err)
+	}
+
+	attributes := models.CategoryAttributeList{}
+	err = easyjson.Unmarshal(raw, &attributes)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+	return attributes, nil
+}
+
+// CloseExpired закрывает опубликованные объявления, срок жизни категории которых истек
+func (ar *AdvtRepository) CloseExpired() ([]*models.Advert, error) {
+	queryStr := `UPDATE advert a SET is_active = false, status = 'closed', close_reason = 'expired',
+				date_close = CURRENT_TIMESTAMP
+				FROM category c
+				WHERE a.category_id = c.id AND a.status = 'published'
+					AND a.published_at + c.lifetime_days * interval '1 day' <= CURRENT_TIMESTAMP
+				RETURNING a.id, a.name, a.publisher_id, a.date_close;`
+
+	return ar.selectExpiry(queryStr)
+}
+
+// MarkExpiring отмечает объявления, которые истекут в ближайшие warnDays дней,
+// каждое объявление возвращается один раз, чтобы продавца предупредили однократно,
+// в DateClose возвращается дата, когда объявление закроется
+func (ar *AdvtRepository) MarkExpiring(warnDays int64) ([]*models.Advert, error) {
+	queryStr := `UPDATE advert a SET expiry_warned = true
+				FROM category c
+				WHERE a.category_id = c.id AND a.status = 'published' AND NOT a.expiry_warned
+					AND a.published_at + c.lifetime_days * interval '1 day' <= CURRENT_TIMESTAMP + $1 * interval '1 day'
+				RETURNING a.id, a.name, a.publisher_id, a.published_at + c.lifetime_days * interval '1 day';`
+
+	return ar.selectExpiry(queryStr, warnDays)
+}
+
+func (ar *AdvtRepository) selectExpiry(queryStr string, args ...interface{}) ([]*models.Advert, error) {
+	query, err := ar.DB.QueryContext(context.Background(), queryStr, args...)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+	defer query.Close()
+
+	adverts := make([]*models.Advert, 0)
+	for query.Next() {
+		advert := &models.Advert{}
+		err = query.Scan(&advert.Id, &advert.Name, &advert.PublisherId, &advert.DateClose)
+		if err != nil {
+			return nil, internalError.GenInternalError(err)
+		}
+
+		adverts = append(adverts, advert)
+	}
+
+	return adverts, nil
+}
+
 func (ar *AdvtRepository) DeleteImages(images []string, advertId int64) error {
 	tx, err := ar.DB.BeginTx(context.Background(), nil)
 	if err != nil {
@@ -294,29 +444,29 @@ const (
 	defaultAdvertsQueryByPublisherId string = `
 		SELECT a.id, a.Name, a.Description, a.price, a.location, a.latitude, a.longitude, a.published_at, 
 			a.date_close, a.is_active, a.views, a.publisher_id, c.name, array_agg(ai.img_path),
-			a.amount, a.is_new, p.promo_level
+			a.amount, a.is_new, p.promo_level, p.promo_until, a.status, a.moderation_reason, a.close_reason
 		FROM advert a
 		JOIN category c ON a.category_id = c.Id 
 		JOIN promotion as p ON a.id = p.advert_id
 		LEFT JOIN advert_image ai ON a.id = ai.advert_id
 		GROUP BY a.id, a.name, a.Description,  a.price, a.location, a.latitude, a.longitude, a.published_at, 
-			a.date_close, a.is_active, a.views, a.publisher_id, c.name, a.amount, a.is_new, p.promo_level 
+			a.date_close, a.is_active, a.views, a.publisher_id, c.name, a.amount, a.is_new, p.promo_level, p.promo_until 
 		HAVING a.publisher_id = $1 %s %s 
 		LIMIT $2 OFFSET $3;
 	`
 )
 
-func (ar *AdvtRepository) SelectAdvertsByPublisherId(publisherId int64, is_active bool, offset int64, limit int64) ([]*models.Advert, error) {
-	var queryStr string
+// SelectAdvertsByPublisherId - объявления продавца, publishedOnly оставляет только прошедшие модерацию,
+// черновики и объявления на проверке видит только сам продавец
+func (ar *AdvtRepository) SelectAdvertsByPublisherId(publisherId int64, is_active bool, publishedOnly bool, offset int64, limit int64) ([]*models.Advert, error) {
+	filter := "AND a.is_active = false"
 	if is_active {
-		queryStr = fmt.Sprintf(defaultAdvertsQueryByPublisherId, "AND a.is_active = true",
-			"ORDER BY a.is_active DESC, a.published_at DESC",
-		)
-	} else {
-		queryStr = fmt.Sprintf(defaultAdvertsQueryByPublisherId, "AND a.is_active = false",
-			"ORDER BY a.is_active DESC, a.published_at DESC",
-		)
+		filter = "AND a.is_active = true"
+	}
+	if publishedOnly {
+		filter += " AND a.status = 'published'"
 	}
+	queryStr := fmt.Sprintf(defaultAdvertsQueryByPublisherId, filter, "ORDER BY a.is_active DESC, a.published_at DESC")
 
 	rows, err := ar.DB.QueryContext(context.Background(), queryStr, publisherId, limit, offset*limit)
 	if err != nil {
@@ -331,7 +481,8 @@ func (ar *AdvtRepository) SelectAdvertsByPublisherId(publisherId int64, is_activ
 
 		err := rows.Scan(&advert.Id, &advert.Name, &advert.Description, &advert.Price, &advert.Location, &advert.Latitude,
 			&advert.Longitude, &advert.PublishedAt, &advert.DateClose, &advert.IsActive, &advert.Views,
-			&advert.PublisherId, &advert.Category, &images, &advert.Amount, &advert.IsNew, &advert.PromoLevel)
+			&advert.PublisherId, &advert.Category, &images, &advert.Amount, &advert.IsNew, &advert.PromoLevel,
+			&advert.PromoUntil, &advert.Status, &advert.ModerationReason, &advert.CloseReason)
 
 		if err != nil {
 			return nil, internalError.GenInternalError(err)
@@ -353,24 +504,70 @@ func (ar *AdvtRepository) SelectAdvertsByPublisherId(publisherId int64, is_activ
 	return adverts, nil
 }
 
+// SelectPublisherCategories возвращает категории, в которых есть объявления продавца, для заголовка выгрузки;
+// для публичного фида берутся только категории активных опубликованных объявлений
+func (ar *AdvtRepository) SelectPublisherCategories(publisherId int64, publishedOnly bool) ([]*models.Category, error) {
+	queryStr := `
+				SELECT DISTINCT c.id, c.name
+				FROM advert a
+				JOIN category c ON a.category_id = c.id
+				WHERE a.publisher_id = $1 %s
+				ORDER BY c.id;
+			`
+	if publishedOnly {
+		queryStr = fmt.Sprintf(queryStr, "AND a.is_active AND a.status = 'published'")
+	} else {
+		queryStr = fmt.Sprintf(queryStr, "")
+	}
+	rows, err := ar.DB.QueryContext(context.Background(), queryStr, publisherId)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+	defer rows.Close()
+
+	categories := make([]*models.Category, 0)
+	for rows.Next() {
+		var category models.Category
+
+		err = rows.Scan(&category.Id, &category.Name)
+		if err != nil {
+			return nil, internalError.GenInternalError(err)
+		}
+
+		categories = append(categories, &category)
+	}
+	return categories, nil
+}
+
 func (ar *AdvtRepository) SelectAdvertsByCategory(categoryName string, from, count int64) ([]*models.Advert, error) {
+	// объявления подкатегорий тоже попадают в выдачу категории
 	queryStr := `
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM category WHERE lower(name) = lower($1) OR slug = $1
+			UNION
+			SELECT c.id FROM category c JOIN subtree s ON c.parent_id = s.id
+		)
+		%s
+		LIMIT $2 OFFSET $3;
+	`
+	listing := `
 		SELECT a.id, a.Name, a.Description, a.price, a.location, a.latitude, a.longitude, a.published_at, 
-			a.date_close, a.is_active, a.views, a.publisher_id, c.name, array_agg(ai.img_path), 
-			a.amount, a.is_new, p.promo_level  
+			a.date_close, a.is_active, a.views, a.publisher_id, c.name AS category, array_agg(ai.img_path) AS images, 
+			a.amount, a.is_new, p.promo_level, row_number() OVER (ORDER BY a.published_at DESC) AS ord  
 		FROM (
-			SELECT * FROM advert WHERE category_id = (SELECT id FROM category WHERE lower(name) = lower($1))
+			SELECT * FROM advert 
+			WHERE category_id IN (SELECT id FROM subtree) AND status = 'published'
+				AND publisher_id NOT IN (SELECT id FROM users WHERE is_hidden)
 		) as a 
 		JOIN category c ON a.category_id = c.Id
 		JOIN promotion as p ON a.id = p.advert_id
 		LEFT JOIN advert_image ai ON a.id = ai.advert_id
 		GROUP BY a.id, a.name, a.Description,  a.price, a.location, a.latitude, a.longitude, a.published_at, 
-				a.date_close, a.is_active, a.views, a.publisher_id, c.name, a.amount, a.is_new, p.promo_level 
-		HAVING a.is_active = true
-		ORDER BY a.published_at DESC
-		LIMIT $2 OFFSET $3;
-	`
-	query, err := ar.DB.QueryContext(context.Background(), queryStr, categoryName, count, from*count)
+				a.date_close, a.is_active, a.views, a.publisher_id, c.name, a.amount, a.is_new, p.promo_level`
+	queryStr = fmt.Sprintf(queryStr, withPromotedSlots(listing, 2, 4, 5))
+
+	query, err := ar.DB.QueryContext(context.Background(), queryStr, categoryName, count, from*count,
+		models.PromotionTopLevel, models.PromotedSlotsOnPage(count))
 	if err != nil {
 		fmt.Println(err.Error())
 		return nil, internalError.InternalError
@@ -405,11 +602,62 @@ func (ar *AdvtRepository) SelectAdvertsByCategory(categoryName string, from, cou
 	return adverts, nil
 }
 
+// SelectModerationQueue возвращает объявления, ожидающие проверки, начиная с самых старых
+func (ar *AdvtRepository) SelectModerationQueue(from, count int64) ([]*models.Advert, error) {
+	queryStr := `
+		SELECT a.id, a.Name, a.Description, a.price, a.location, a.latitude, a.longitude, a.published_at, 
+			a.date_close, a.is_active, a.views, a.publisher_id, c.name, array_agg(ai.img_path), 
+			a.amount, a.is_new, p.promo_level, a.status, a.moderation_reason 
+		FROM advert a
+		JOIN category c ON a.category_id = c.Id
+		JOIN promotion as p ON a.id = p.advert_id
+		LEFT JOIN advert_image ai ON a.id = ai.advert_id
+		WHERE a.status = 'pending_review'
+		GROUP BY a.id, a.name, a.Description,  a.price, a.location, a.latitude, a.longitude, a.published_at, 
+			a.date_close, a.is_active, a.views, a.publisher_id, c.name, a.amount, a.is_new, p.promo_level
+		ORDER BY a.published_at ASC
+		LIMIT $1 OFFSET $2;
+	`
+	query, err := ar.DB.QueryContext(context.Background(), queryStr, count, from*count)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+
+	defer query.Close()
+	adverts := make([]*models.Advert, 0)
+	for query.Next() {
+		var advert models.Advert
+		var images string
+
+		err = query.Scan(&advert.Id, &advert.Name, &advert.Description, &advert.Price, &advert.Location, &advert.Latitude,
+			&advert.Longitude, &advert.PublishedAt, &advert.DateClose, &advert.IsActive, &advert.Views,
+			&advert.PublisherId, &advert.Category, &images, &advert.Amount, &advert.IsNew, &advert.PromoLevel,
+			&advert.Status, &advert.ModerationReason)
+
+		if err != nil {
+			return nil, internalError.GenInternalError(err)
+		}
+
+		advert.Images = make([]string, 0)
+		if images[1:len(images)-1] != "NULL" {
+			advert.Images = strings.Split(images[1:len(images)-1], ",")
+			sort.Strings(advert.Images)
+		}
+
+		if len(advert.Images) == 0 {
+			advert.Images = append(advert.Images, imageloader.DefaultAdvertImage)
+		}
+
+		adverts = append(adverts, &advert)
+	}
+	return adverts, nil
+}
+
 func (ar *AdvtRepository) SelectFavoriteAdverts(userId int64, from, count int64) ([]*models.Advert, error) {
 	queryStr := `
 		SELECT a.id, a.Name, a.Description, a.price, a.location, a.latitude, a.longitude, a.published_at, 
 			a.date_close, a.is_active, a.views, a.publisher_id, c.name, array_agg(ai.img_path), 
-			a.amount, a.is_new, p.promo_level 
+			a.amount, a.is_new, p.promo_level, f.target_price 
 		FROM advert a
 		JOIN favorite f ON a.id = f.advert_id
 		JOIN category c ON a.category_id = c.Id 
@@ -417,7 +665,7 @@ func (ar *AdvtRepository) SelectFavoriteAdverts(userId int64, from, count int64)
 		LEFT JOIN advert_image ai ON a.id = ai.advert_id
 		WHERE f.user_id = $1
 		GROUP BY a.id, a.name, a.Description,  a.price, a.location, a.latitude, a.longitude, a.published_at, 
-			a.date_close, a.is_active, a.views, a.publisher_id, c.name, a.amount, a.is_new, p.promo_level
+			a.date_close, a.is_active, a.views, a.publisher_id, c.name, a.amount, a.is_new, p.promo_level, f.target_price
 		LIMIT $2 OFFSET $3;
 	`
 	query, err := ar.DB.QueryContext(context.Background(), queryStr, userId, count, from*count)
@@ -433,7 +681,7 @@ func (ar *AdvtRepository) SelectFavoriteAdverts(userId int64, from, count int64)
 
 		err = query.Scan(&advert.Id, &advert.Name, &advert.Description, &advert.Price, &advert.Location, &advert.Latitude,
 			&advert.Longitude, &advert.PublishedAt, &advert.DateClose, &advert.IsActive, &advert.Views,
-			&advert.PublisherId, &advert.Category, &images, &advert.Amount, &advert.IsNew, &advert.PromoLevel)
+			&advert.PublisherId, &advert.Category, &images, &advert.Amount, &advert.IsNew, &advert.PromoLevel, &advert.TargetPrice)
 
 		if err != nil {
 			return nil, internalError.GenInternalError(err)
@@ -526,7 +774,7 @@ func (ar *AdvtRepository) InsertFavorite(userId, advertId int64) error {
 	}
 
 	_, err = tx.ExecContext(context.Background(),
-		"INSERT INTO favorite(user_id, advert_id) VALUES ($1, $2);",
+		"INSERT INTO favorite(user_id, advert_id, price_seen) SELECT $1, $2, price FROM advert WHERE id = $2;",
 		userId, advertId)
 	if err != nil {
 		rollbackErr := tx.Rollback()
@@ -569,6 +817,80 @@ func (ar *AdvtRepository) DeleteFavorite(userId, advertId int64) error {
 	return nil
 }
 
+func (ar *AdvtRepository) UpdateFavoriteTarget(userId, advertId int64, targetPrice int64) error {
+	result, err := ar.DB.ExecContext(context.Background(),
+		"UPDATE favorite SET target_price = $3 WHERE user_id = $1 AND advert_id = $2;",
+		userId, advertId, targetPrice)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+	if updated == 0 {
+		return internalError.EmptyQuery
+	}
+	return nil
+}
+
+// SelectFavoriteChanges ищет избранное, у объявлений которого изменилась цена или закрытие
+// с момента последнего уведомления
+func (ar *AdvtRepository) SelectFavoriteChanges(limit int64) ([]*models.FavoriteChange, error) {
+	queryStr := `
+		SELECT f.user_id, f.advert_id, a.name, a.price, a.status, a.close_reason,
+			f.price_seen, f.target_price, f.closed_notified
+		FROM favorite f
+		JOIN advert a ON a.id = f.advert_id
+		WHERE a.price <> f.price_seen OR (a.status = 'closed') <> f.closed_notified
+		ORDER BY f.advert_id, f.user_id
+		LIMIT $1;
+	`
+	rows, err := ar.DB.QueryContext(context.Background(), queryStr, limit)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+
+	defer rows.Close()
+	changes := make([]*models.FavoriteChange, 0)
+	for rows.Next() {
+		var change models.FavoriteChange
+		err = rows.Scan(&change.UserId, &change.AdvertId, &change.AdvertName, &change.Price, &change.Status,
+			&change.CloseReason, &change.PriceSeen, &change.TargetPrice, &change.ClosedNotified)
+		if err != nil {
+			return nil, internalError.GenInternalError(err)
+		}
+
+		changes = append(changes, &change)
+	}
+	return changes, nil
+}
+
+// UpdateFavoriteSeen запоминает, что пользователь знает о текущей цене и закрытии объявления
+func (ar *AdvtRepository) UpdateFavoriteSeen(change *models.FavoriteChange) error {
+	_, err := ar.DB.ExecContext(context.Background(),
+		"UPDATE favorite SET price_seen = $3, closed_notified = $4 WHERE user_id = $1 AND advert_id = $2;",
+		change.UserId, change.AdvertId, change.Price, change.Status == models.AdvertStatusClosed)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+	return nil
+}
+
+func (ar *AdvtRepository) SelectReservedAmount(advertId int64, userId int64) (int64, error) {
+	queryStr := `SELECT COALESCE(SUM(amount), 0) FROM cart
+				WHERE advert_id = $1 AND user_id <> $2 AND reserved_until > CURRENT_TIMESTAMP;`
+	queryRow := ar.DB.QueryRowContext(context.Background(), queryStr, advertId, userId)
+
+	var reserved int64
+	err := queryRow.Scan(&reserved)
+	if err != nil {
+		return 0, internalError.GenInternalError(err)
+	}
+	return reserved, nil
+}
+
 func (ar *AdvtRepository) SelectViews(advertId int64) (int64, error) {
 	queryStr := "SELECT count FROM views_ WHERE advert_id = $1;"
 	queryRow := ar.DB.QueryRowContext(context.Background(), queryStr, advertId)
@@ -653,6 +975,44 @@ func (ar *AdvtRepository) SelectPriceHistory(advertId int64) ([]*models.AdvertPr
 	return history, nil
 }
 
+// SelectPriceHistories читает историю цен сразу для страницы объявлений, ключ - id объявления
+func (ar *AdvtRepository) SelectPriceHistories(advertIds []int64) (map[int64][]*models.AdvertPrice, error) {
+	histories := make(map[int64][]*models.AdvertPrice, len(advertIds))
+	if len(advertIds) == 0 {
+		return histories, nil
+	}
+
+	placeholders := make([]string, 0, len(advertIds))
+	vars := make([]interface{}, 0, len(advertIds))
+	for i, advertId := range advertIds {
+		placeholders = append(placeholders, fmt.Sprintf("$%d", i+1))
+		vars = append(vars, advertId)
+	}
+	queryStr := fmt.Sprintf(`
+					SELECT advert_id, price, change_date 
+					FROM price_history 
+					WHERE advert_id IN (%s)
+					ORDER BY advert_id, change_date ASC;
+				`, strings.Join(placeholders, ", "))
+	query, err := ar.DB.QueryContext(context.Background(), queryStr, vars...)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+
+	defer query.Close()
+	for query.Next() {
+		var price models.AdvertPrice
+
+		err = query.Scan(&price.AdvertId, &price.Price, &price.ChangeTime)
+		if err != nil {
+			return nil, internalError.GenInternalError(err)
+		}
+
+		histories[price.AdvertId] = append(histories[price.AdvertId], &price)
+	}
+	return histories, nil
+}
+
 func (ar *AdvtRepository) UpdatePromo(promo *models.Promotion) error {
 	tx, err := ar.DB.BeginTx(context.Background(), nil)
 	if err != nil {
@@ -660,8 +1020,8 @@ func (ar *AdvtRepository) UpdatePromo(promo *models.Promotion) error {
 	}
 
 	_, err = tx.ExecContext(context.Background(),
-		"UPDATE promotion SET promo_level = $2, promo_start = $3 WHERE advert_id = $1;",
-		promo.AdvertId, promo.PromoLevel, promo.UpdateTime,
+		"UPDATE promotion SET promo_level = $2, promo_start = $3, promo_until = $4 WHERE advert_id = $1;",
+		promo.AdvertId, promo.PromoLevel, promo.UpdateTime, promo.PromoUntil,
 	)
 	if err != nil {
 		rollbackErr := tx.Rollback()
@@ -679,6 +1039,21 @@ func (ar *AdvtRepository) UpdatePromo(promo *models.Promotion) error {
 	return nil
 }
 
+// ExpirePromotions возвращает объявления с истекшим продвижением на обычный уровень
+func (ar *AdvtRepository) ExpirePromotions(now time.Time) (int64, error) {
+	result, err := ar.DB.ExecContext(context.Background(),
+		"UPDATE promotion SET promo_level = 0 WHERE promo_level > 0 AND promo_until <= $1;", now)
+	if err != nil {
+		return 0, internalError.GenInternalError(err)
+	}
+
+	expired, err := result.RowsAffected()
+	if err != nil {
+		return 0, internalError.GenInternalError(err)
+	}
+	return expired, nil
+}
+
 func (ar *AdvtRepository) RegenerateRecomendations() error {
 	tx, err := ar.DB.BeginTx(context.Background(), nil)
 	if err != nil {
@@ -758,7 +1133,8 @@ func (ar *AdvtRepository) SelectRecomendations(advertId int64, count int64, user
 							a.location as location, a.latitude as latitude, a.longitude as longitude, 
 							a.published_at as published_at, a.date_close as date_close, a.is_active as is_active, 
 							a.views as views, a.publisher_id as publisher_id, c.name as cat_name, 
-							array_agg(ai.img_path) as images, a.amount as amount, a.is_new as is_new, p.promo_level as promo_level
+							array_agg(ai.img_path) as images, a.amount as amount, a.is_new as is_new, p.promo_level as promo_level,
+							a.status as status
 						FROM advert a
 						JOIN category c ON a.category_id = c.Id
 						JOIN promotion as p ON a.id = p.advert_id
@@ -766,7 +1142,7 @@ func (ar *AdvtRepository) SelectRecomendations(advertId int64, count int64, user
 						GROUP BY a.id, a.name, a.Description,  a.price, a.location, a.latitude, a.longitude, a.published_at, 
 							a.date_close, a.is_active, a.views, a.publisher_id, c.name, p.promo_level
 					) as t1 ON r1.rec_id = t1.advert_id
-					WHERE t1.is_active
+					WHERE t1.status = 'published' AND t1.publisher_id NOT IN (SELECT id FROM users WHERE is_hidden)
 					ORDER BY r1.shows DESC
 					LIMIT $2;
 	`
@@ -831,7 +1207,8 @@ func (ar *AdvtRepository) SelectDummyRecomendations(advertId int64, count int64)
 							a.location as location, a.latitude as latitude, a.longitude as longitude, 
 							a.published_at as published_at, a.date_close as date_close, a.is_active as is_active, 
 							a.views as views, a.publisher_id as publisher_id, c.name as cat_name, 
-							array_agg(ai.img_path) as images, a.amount as amount, a.is_new as is_new, p.promo_level as promo_level
+							array_agg(ai.img_path) as images, a.amount as amount, a.is_new as is_new, p.promo_level as promo_level,
+							a.status as status
 						FROM advert a
 						JOIN category c ON a.category_id = c.Id
 						JOIN promotion as p ON a.id = p.advert_id
@@ -839,7 +1216,8 @@ func (ar *AdvtRepository) SelectDummyRecomendations(advertId int64, count int64)
 						GROUP BY a.id, a.name, a.Description,  a.price, a.location, a.latitude, a.longitude, a.published_at, 
 							a.date_close, a.is_active, a.views, a.publisher_id, c.name, p.promo_level
 					) as t1 ON f1.advert_id = t1.advert_id
-					WHERE f1.advert_id != $1
+					WHERE f1.advert_id != $1 AND t1.status = 'published'
+						AND t1.publisher_id NOT IN (SELECT id FROM users WHERE is_hidden)
 					ORDER BY f1.cnt DESC
 					LIMIT $2;
 	`
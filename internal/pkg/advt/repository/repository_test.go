@@ -1,11 +1,13 @@
 package repository
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"fmt"
 	"strings"
 	"testing"
 	"time"
+	internalError "yula/internal/error"
 	"yula/internal/models"
 
 	"github.com/stretchr/testify/assert"
@@ -37,8 +39,12 @@ var testadvert = &models.Advert{
 	Amount:      1,
 	IsNew:       true,
 	PromoLevel:  0,
+	Status:      models.AdvertStatusPublished,
+	Attributes:  models.AdvertAttributes{"size": "M"},
 }
 
+var testattributes = `{"size":"M"}`
+
 var testimages = fmt.Sprintf("{%s}", strings.Join(testadvert.Images, ", "))
 
 var testpage = &models.Page{
@@ -62,7 +68,8 @@ func TestSelectListAdvtOk(t *testing.T) {
 		testadvert.Longitude, testadvert.PublishedAt, testadvert.DateClose, testadvert.IsActive, testadvert.Views, testadvert.PublisherId,
 		testadvert.Category, testimages, testadvert.Amount, testadvert.IsNew, testadvert.PromoLevel,
 	)
-	mock.ExpectQuery("SELECT").WithArgs(testpage.Count, testpage.PageNum*testpage.Count).WillReturnRows(rows)
+	mock.ExpectQuery(`a.publisher_id NOT IN \(SELECT id FROM users WHERE is_hidden\)`).WithArgs(testpage.Count,
+		testpage.PageNum*testpage.Count, models.PromotionTopLevel, models.PromotedSlotsOnPage(testpage.Count)).WillReturnRows(rows)
 
 	_, err = repo.SelectListAdvt(true, testpage.PageNum, testpage.Count)
 
@@ -87,7 +94,8 @@ func TestSelectListAdvtError(t *testing.T) {
 		testadvert.Longitude, testadvert.PublishedAt, testadvert.DateClose, testadvert.IsActive, testadvert.Views, testadvert.PublisherId,
 		testadvert.Category, testimages, testadvert.Amount, testadvert.IsNew,
 	)
-	mock.ExpectQuery("SELECT").WithArgs(testpage.Count, testpage.PageNum*testpage.Count)
+	mock.ExpectQuery("SELECT").WithArgs(testpage.Count, testpage.PageNum*testpage.Count,
+		models.PromotionTopLevel, models.PromotedSlotsOnPage(testpage.Count))
 
 	_, err = repo.SelectListAdvt(true, testpage.PageNum, testpage.Count)
 
@@ -109,7 +117,8 @@ func TestInsertOk(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectQuery("INSERT").WithArgs(testadvert.Name, testadvert.Description, testadvert.Category, testadvert.PublisherId,
-		testadvert.Latitude, testadvert.Longitude, testadvert.Location, testadvert.Price, testadvert.Amount, testadvert.IsNew).
+		testadvert.Latitude, testadvert.Longitude, testadvert.Location, testadvert.Price, testadvert.Amount, testadvert.IsNew,
+		testadvert.DeliveryPickup, testadvert.DeliveryCourier, testadvert.DeliveryPost, testadvert.Status, testattributes).
 		WillReturnRows(rows)
 	mock.ExpectExec("INSERT").WithArgs(testadvert.Id).WillReturnResult(driver.ResultNoRows)
 	mock.ExpectExec("INSERT").WithArgs(testadvert.Id, testadvert.Price).WillReturnResult(driver.ResultNoRows)
@@ -136,7 +145,8 @@ func TestInsertError(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectQuery("INSERT").WithArgs(testadvert.Name, testadvert.Description, testadvert.Category, testadvert.PublisherId,
-		testadvert.Latitude, testadvert.Longitude, testadvert.Location, testadvert.Price, testadvert.Amount, testadvert.IsNew).
+		testadvert.Latitude, testadvert.Longitude, testadvert.Location, testadvert.Price, testadvert.Amount, testadvert.IsNew,
+		testadvert.DeliveryPickup, testadvert.DeliveryCourier, testadvert.DeliveryPost, testadvert.Status, testattributes).
 		WillReturnRows(rows)
 	mock.ExpectExec("INSERT").WithArgs(testadvert.Id)
 	mock.ExpectRollback()
@@ -161,7 +171,8 @@ func TestInsertError2(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectQuery("INSERT").WithArgs(testadvert.Name, testadvert.Description, testadvert.Category, testadvert.PublisherId,
-		testadvert.Latitude, testadvert.Longitude, testadvert.Location, testadvert.Price, testadvert.Amount, testadvert.IsNew)
+		testadvert.Latitude, testadvert.Longitude, testadvert.Location, testadvert.Price, testadvert.Amount, testadvert.IsNew,
+		testadvert.DeliveryPickup, testadvert.DeliveryCourier, testadvert.DeliveryPost, testadvert.Status, testattributes)
 	mock.ExpectRollback()
 
 	err = repo.Insert(testadvert)
@@ -184,7 +195,8 @@ func TestInsertError3(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectQuery("INSERT").WithArgs(testadvert.Name, testadvert.Description, testadvert.Category, testadvert.PublisherId,
-		testadvert.Latitude, testadvert.Longitude, testadvert.Location, testadvert.Price, testadvert.Amount, testadvert.IsNew).
+		testadvert.Latitude, testadvert.Longitude, testadvert.Location, testadvert.Price, testadvert.Amount, testadvert.IsNew,
+		testadvert.DeliveryPickup, testadvert.DeliveryCourier, testadvert.DeliveryPost, testadvert.Status, testattributes).
 		WillReturnRows(rows)
 	mock.ExpectExec("INSERT").WithArgs(testadvert.Id).WillReturnResult(driver.ResultNoRows)
 	mock.ExpectExec("INSERT").WithArgs(testadvert.Id, testadvert.Price)
@@ -210,7 +222,8 @@ func TestInsertError4(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectQuery("INSERT").WithArgs(testadvert.Name, testadvert.Description, testadvert.Category, testadvert.PublisherId,
-		testadvert.Latitude, testadvert.Longitude, testadvert.Location, testadvert.Price, testadvert.Amount, testadvert.IsNew).
+		testadvert.Latitude, testadvert.Longitude, testadvert.Location, testadvert.Price, testadvert.Amount, testadvert.IsNew,
+		testadvert.DeliveryPickup, testadvert.DeliveryCourier, testadvert.DeliveryPost, testadvert.Status, testattributes).
 		WillReturnRows(rows)
 	mock.ExpectExec("INSERT").WithArgs(testadvert.Id).WillReturnResult(driver.ResultNoRows)
 	mock.ExpectExec("INSERT").WithArgs(testadvert.Id, testadvert.Price).WillReturnResult(driver.ResultNoRows)
@@ -234,15 +247,23 @@ func TestSelectByIdOk(t *testing.T) {
 	repo := NewAdvtRepository(db)
 
 	rows := sqlmock.NewRows([]string{"id", "name", "description", "price", "location", "latitude", "longitude", "published_at",
-		"date_close", "is_active", "views", "publisher_id", "c.name", "array_agg(ai.img_path)", "amount", "a.is_new", "p.promo_level"},
+		"date_close", "is_active", "views", "publisher_id", "c.name", "array_agg(ai.img_path)", "amount", "a.is_new", "p.promo_level",
+		"p.promo_until", "a.delivery_pickup", "a.delivery_courier", "a.delivery_post", "a.status", "a.moderation_reason", "a.close_reason",
+		"a.attributes", "a.needs_review"},
 	)
 	rows.AddRow(testadvert.Id, testadvert.Name, testadvert.Description, testadvert.Price, testadvert.Location, testadvert.Latitude,
 		testadvert.Longitude, testadvert.PublishedAt, testadvert.DateClose, testadvert.IsActive, testadvert.Views, testadvert.PublisherId,
 		testadvert.Category, testimages, testadvert.Amount, testadvert.IsNew, testadvert.PromoLevel,
+		testadvert.PublishedAt, true, true, false, models.AdvertStatusRejected, "prohibited goods", "", []byte(testattributes), false,
 	)
 	mock.ExpectQuery("SELECT").WithArgs(testadvert.Id).WillReturnRows(rows)
 
-	_, err = repo.SelectById(testadvert.Id)
+	advert, err := repo.SelectById(testadvert.Id)
+	assert.True(t, advert.SupportsDelivery(models.DeliveryCourier))
+	assert.Equal(t, models.AdvertStatusRejected, advert.Status)
+	assert.Equal(t, "prohibited goods", advert.ModerationReason)
+	assert.False(t, advert.SupportsDelivery(models.DeliveryPost))
+	assert.Equal(t, "M", advert.Attributes["size"])
 
 	assert.NoError(t, err)
 	err = mock.ExpectationsWereMet()
@@ -287,7 +308,9 @@ func TestUpdateOk(t *testing.T) {
 	mock.ExpectBegin()
 	mock.ExpectQuery("UPDATE").WithArgs(testadvert.Id, testadvert.Name, testadvert.Description, testadvert.Category, testadvert.Location,
 		testadvert.Latitude, testadvert.Longitude, testadvert.Price, testadvert.IsActive, testadvert.DateClose,
-		testadvert.Amount, testadvert.IsNew).WillReturnRows(rows)
+		testadvert.Amount, testadvert.IsNew, testadvert.DeliveryPickup, testadvert.DeliveryCourier,
+		testadvert.DeliveryPost, testadvert.Status, testadvert.ModerationReason, testadvert.CloseReason, testattributes,
+		testadvert.NeedsReview).WillReturnRows(rows)
 	mock.ExpectCommit()
 
 	err = repo.Update(testadvert)
@@ -309,7 +332,9 @@ func TestUpdateError(t *testing.T) {
 	mock.ExpectBegin()
 	mock.ExpectQuery("UPDATE").WithArgs(testadvert.Id, testadvert.Name, testadvert.Description, testadvert.Category, testadvert.Location,
 		testadvert.Latitude, testadvert.Longitude, testadvert.Price, testadvert.IsActive, testadvert.DateClose,
-		testadvert.Amount, testadvert.IsNew)
+		testadvert.Amount, testadvert.IsNew, testadvert.DeliveryPickup, testadvert.DeliveryCourier,
+		testadvert.DeliveryPost, testadvert.Status, testadvert.ModerationReason, testadvert.CloseReason, testattributes,
+		testadvert.NeedsReview)
 	mock.ExpectRollback()
 
 	err = repo.Update(testadvert)
@@ -453,15 +478,37 @@ func TestSelecByPublisherIdOk(t *testing.T) {
 	repo := NewAdvtRepository(db)
 
 	rows := sqlmock.NewRows([]string{"id", "name", "description", "price", "location", "latitude", "longitude", "published_at",
-		"date_close", "is_active", "views", "publisher_id", "c.name", "array_agg(ai.img_path)", "amount", "a.is_new", "p.promo_level"},
+		"date_close", "is_active", "views", "publisher_id", "c.name", "array_agg(ai.img_path)", "amount", "a.is_new", "p.promo_level",
+		"p.promo_until", "a.status", "a.moderation_reason", "a.close_reason"},
 	)
 	rows.AddRow(testadvert.Id, testadvert.Name, testadvert.Description, testadvert.Price, testadvert.Location, testadvert.Latitude,
 		testadvert.Longitude, testadvert.PublishedAt, testadvert.DateClose, testadvert.IsActive, testadvert.Views, testadvert.PublisherId,
 		testadvert.Category, testimages, testadvert.Amount, testadvert.IsNew, testadvert.PromoLevel,
+		testadvert.PublishedAt, testadvert.Status, testadvert.ModerationReason, models.CloseReasonExpired,
 	)
 	mock.ExpectQuery("SELECT").WithArgs(testadvert.PublisherId, testpage.Count, testpage.PageNum*testpage.Count).WillReturnRows(rows)
 
-	_, err = repo.SelectAdvertsByPublisherId(testadvert.PublisherId, true, testpage.PageNum, testpage.Count)
+	_, err = repo.SelectAdvertsByPublisherId(testadvert.PublisherId, true, false, testpage.PageNum, testpage.Count)
+
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelecByPublisherIdPublishedOnly(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+
+	mock.ExpectQuery(`a.is_active = true AND a.status = 'published'`).
+		WithArgs(testadvert.PublisherId, testpage.Count, testpage.PageNum*testpage.Count).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err = repo.SelectAdvertsByPublisherId(testadvert.PublisherId, true, true, testpage.PageNum, testpage.Count)
 
 	assert.NoError(t, err)
 	err = mock.ExpectationsWereMet()
@@ -486,7 +533,7 @@ func TestSelecByPublisherIdError(t *testing.T) {
 	)
 	mock.ExpectQuery("SELECT").WithArgs(testadvert.PublisherId, testpage.Count, testpage.PageNum*testpage.Count)
 
-	_, err = repo.SelectAdvertsByPublisherId(testadvert.PublisherId, true, testpage.PageNum, testpage.Count)
+	_, err = repo.SelectAdvertsByPublisherId(testadvert.PublisherId, true, false, testpage.PageNum, testpage.Count)
 
 	assert.Error(t, err)
 	err = mock.ExpectationsWereMet()
@@ -509,7 +556,8 @@ func TestSelectAdvertsByCategoryOk(t *testing.T) {
 		testadvert.Longitude, testadvert.PublishedAt, testadvert.DateClose, testadvert.IsActive, testadvert.Views, testadvert.PublisherId,
 		testadvert.Category, testimages, testadvert.Amount, testadvert.IsNew, testadvert.PromoLevel,
 	)
-	mock.ExpectQuery("SELECT").WithArgs(testadvert.Category, testpage.Count, testpage.PageNum*testpage.Count).WillReturnRows(rows)
+	mock.ExpectQuery("WITH RECURSIVE subtree").WithArgs(testadvert.Category, testpage.Count, testpage.PageNum*testpage.Count,
+		models.PromotionTopLevel, models.PromotedSlotsOnPage(testpage.Count)).WillReturnRows(rows)
 
 	_, err = repo.SelectAdvertsByCategory(testadvert.Category, testpage.PageNum, testpage.Count)
 
@@ -534,7 +582,8 @@ func TestSelectAdvertsByCategoryError(t *testing.T) {
 		testadvert.Longitude, testadvert.PublishedAt, testadvert.DateClose, testadvert.IsActive, testadvert.Views, testadvert.PublisherId,
 		testadvert.Category, testimages, testadvert.Amount, testadvert.IsNew,
 	)
-	mock.ExpectQuery("SELECT").WithArgs(testadvert.Category, testpage.Count, testpage.PageNum*testpage.Count)
+	mock.ExpectQuery("WITH RECURSIVE subtree").WithArgs(testadvert.Category, testpage.Count, testpage.PageNum*testpage.Count,
+		models.PromotionTopLevel, models.PromotedSlotsOnPage(testpage.Count))
 
 	_, err = repo.SelectAdvertsByCategory(testadvert.Category, testpage.PageNum, testpage.Count)
 
@@ -543,6 +592,54 @@ func TestSelectAdvertsByCategoryError(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestSelectModerationQueueOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+
+	rows := sqlmock.NewRows([]string{"id", "name", "description", "price", "location", "latitude", "longitude", "published_at",
+		"date_close", "is_active", "views", "publisher_id", "c.name", "array_agg(ai.img_path)", "amount", "a.is_new", "p.promo_level",
+		"a.status", "a.moderation_reason"},
+	)
+	rows.AddRow(testadvert.Id, testadvert.Name, testadvert.Description, testadvert.Price, testadvert.Location, testadvert.Latitude,
+		testadvert.Longitude, testadvert.PublishedAt, testadvert.DateClose, testadvert.IsActive, testadvert.Views, testadvert.PublisherId,
+		testadvert.Category, testimages, testadvert.Amount, testadvert.IsNew, testadvert.PromoLevel,
+		models.AdvertStatusPendingReview, "",
+	)
+	mock.ExpectQuery("pending_review").WithArgs(testpage.Count, testpage.PageNum*testpage.Count).WillReturnRows(rows)
+
+	adverts, err := repo.SelectModerationQueue(testpage.PageNum, testpage.Count)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(adverts))
+	assert.Equal(t, models.AdvertStatusPendingReview, adverts[0].Status)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectModerationQueueError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+
+	mock.ExpectQuery("pending_review").WithArgs(testpage.Count, testpage.PageNum*testpage.Count).
+		WillReturnError(sql.ErrConnDone)
+
+	_, err = repo.SelectModerationQueue(testpage.PageNum, testpage.Count)
+
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
 func TestSelectFavoriteCountOk(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -593,17 +690,18 @@ func TestSelectFavoriteAdvertsOk(t *testing.T) {
 	repo := NewAdvtRepository(db)
 
 	rows := sqlmock.NewRows([]string{"id", "name", "description", "price", "location", "latitude", "longitude", "published_at",
-		"date_close", "is_active", "views", "publisher_id", "c.name", "array_agg(ai.img_path)", "amount", "a.is_new", "p.promo_level"},
+		"date_close", "is_active", "views", "publisher_id", "c.name", "array_agg(ai.img_path)", "amount", "a.is_new", "p.promo_level", "f.target_price"},
 	)
 	rows.AddRow(testadvert.Id, testadvert.Name, testadvert.Description, testadvert.Price, testadvert.Location, testadvert.Latitude,
 		testadvert.Longitude, testadvert.PublishedAt, testadvert.DateClose, testadvert.IsActive, testadvert.Views, testadvert.PublisherId,
-		testadvert.Category, testimages, testadvert.Amount, testadvert.IsNew, testadvert.PromoLevel,
+		testadvert.Category, testimages, testadvert.Amount, testadvert.IsNew, testadvert.PromoLevel, int64(900),
 	)
 	mock.ExpectQuery("SELECT").WithArgs(testadvert.PublisherId, testpage.Count, testpage.PageNum*testpage.Count).WillReturnRows(rows)
 
-	_, err = repo.SelectFavoriteAdverts(testadvert.PublisherId, testpage.PageNum, testpage.Count)
+	adverts, err := repo.SelectFavoriteAdverts(testadvert.PublisherId, testpage.PageNum, testpage.Count)
 
 	assert.NoError(t, err)
+	assert.Equal(t, int64(900), adverts[0].TargetPrice)
 	err = mock.ExpectationsWereMet()
 	assert.Nil(t, err)
 }
@@ -763,6 +861,143 @@ func TestDeleteFavoriteError(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestUpdateFavoriteTargetOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+
+	mock.ExpectExec("UPDATE favorite SET target_price").WithArgs(int64(5), testadvert.Id, int64(900)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.UpdateFavoriteTarget(5, testadvert.Id, 900)
+
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateFavoriteTargetNotFavorite(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+
+	mock.ExpectExec("UPDATE favorite SET target_price").WithArgs(int64(5), testadvert.Id, int64(900)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.UpdateFavoriteTarget(5, testadvert.Id, 900)
+
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectFavoriteChangesOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+
+	rows := sqlmock.NewRows([]string{"user_id", "advert_id", "name", "price", "status", "close_reason",
+		"price_seen", "target_price", "closed_notified"})
+	rows.AddRow(5, testadvert.Id, testadvert.Name, 900, models.AdvertStatusPublished, "", 1000, 0, false)
+	mock.ExpectQuery("SELECT .* FROM favorite f").WithArgs(models.FavoriteChangesBatch).WillReturnRows(rows)
+
+	changes, err := repo.SelectFavoriteChanges(models.FavoriteChangesBatch)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(changes))
+	assert.Equal(t, int64(1000), changes[0].PriceSeen)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectFavoriteChangesError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+
+	mock.ExpectQuery("SELECT .* FROM favorite f").WithArgs(models.FavoriteChangesBatch).WillReturnError(sql.ErrConnDone)
+
+	_, err = repo.SelectFavoriteChanges(models.FavoriteChangesBatch)
+
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateFavoriteSeenOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+	change := &models.FavoriteChange{UserId: 5, AdvertId: testadvert.Id, Price: 900, Status: models.AdvertStatusClosed}
+
+	mock.ExpectExec("UPDATE favorite SET price_seen").WithArgs(int64(5), testadvert.Id, int64(900), true).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.UpdateFavoriteSeen(change)
+
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectReservedAmountOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+
+	rows := sqlmock.NewRows([]string{"sum"}).AddRow(3)
+	mock.ExpectQuery("SELECT COALESCE").WithArgs(testadvert.Id, int64(1)).WillReturnRows(rows)
+
+	reserved, err := repo.SelectReservedAmount(testadvert.Id, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), reserved)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectReservedAmountError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+
+	mock.ExpectQuery("SELECT COALESCE").WithArgs(testadvert.Id, int64(1))
+
+	_, err = repo.SelectReservedAmount(testadvert.Id, 1)
+
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
 func TestSelectViewsOk(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -923,6 +1158,104 @@ func TestSelectPriceHistoryError(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestSelectPublisherCategoriesOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+	rows := sqlmock.NewRows([]string{"id", "name"})
+	rows.AddRow(1, "одежда")
+	rows.AddRow(4, "обувь")
+	mock.ExpectQuery("SELECT DISTINCT c.id, c.name").WithArgs(int64(2)).WillReturnRows(rows)
+
+	categories, err := repo.SelectPublisherCategories(2, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.Category{{Id: 1, Name: "одежда"}, {Id: 4, Name: "обувь"}}, categories)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectPublisherCategoriesPublishedOnly(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+	rows := sqlmock.NewRows([]string{"id", "name"})
+	rows.AddRow(1, "одежда")
+	mock.ExpectQuery("a.publisher_id = \\$1 AND a.is_active AND a.status = 'published'").WithArgs(int64(2)).WillReturnRows(rows)
+
+	_, err = repo.SelectPublisherCategories(2, true)
+
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectPriceHistoriesOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+	rows := sqlmock.NewRows([]string{"advert_id", "price", "change_date"})
+	rows.AddRow(int64(3), int64(1000), testadvert.PublishedAt)
+	rows.AddRow(int64(3), int64(900), testadvert.PublishedAt)
+	rows.AddRow(int64(4), int64(500), testadvert.PublishedAt)
+	mock.ExpectQuery("WHERE advert_id IN \\(\\$1, \\$2, \\$3\\)").WithArgs(int64(3), int64(4), int64(5)).WillReturnRows(rows)
+
+	histories, err := repo.SelectPriceHistories([]int64{3, 4, 5})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(histories[3]))
+	assert.Equal(t, int64(500), histories[4][0].Price)
+	assert.Empty(t, histories[5])
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectPriceHistoriesEmpty(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+
+	histories, err := repo.SelectPriceHistories([]int64{})
+
+	assert.NoError(t, err)
+	assert.Empty(t, histories)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectPublisherCategoriesError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+	mock.ExpectQuery("SELECT DISTINCT c.id, c.name").WithArgs(int64(2)).WillReturnError(fmt.Errorf("db error"))
+
+	_, err = repo.SelectPublisherCategories(2, false)
+
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
 func TestUpdatePromoOk(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -934,7 +1267,7 @@ func TestUpdatePromoOk(t *testing.T) {
 	promo := &models.Promotion{AdvertId: testadvert.Id, PromoLevel: 1, UpdateTime: testadvert.PublishedAt}
 
 	mock.ExpectBegin()
-	mock.ExpectExec("UPDATE").WithArgs(promo.AdvertId, promo.PromoLevel, promo.UpdateTime).WillReturnResult(driver.ResultNoRows)
+	mock.ExpectExec("UPDATE").WithArgs(promo.AdvertId, promo.PromoLevel, promo.UpdateTime, promo.PromoUntil).WillReturnResult(driver.ResultNoRows)
 	mock.ExpectCommit()
 
 	err = repo.UpdatePromo(promo)
@@ -955,7 +1288,7 @@ func TestUpdatePromoError(t *testing.T) {
 	promo := &models.Promotion{AdvertId: testadvert.Id, PromoLevel: 1, UpdateTime: testadvert.PublishedAt}
 
 	mock.ExpectBegin()
-	mock.ExpectExec("UPDATE").WithArgs(promo.AdvertId, promo.PromoLevel, promo.UpdateTime)
+	mock.ExpectExec("UPDATE").WithArgs(promo.AdvertId, promo.PromoLevel, promo.UpdateTime, promo.PromoUntil)
 	mock.ExpectRollback()
 
 	err = repo.UpdatePromo(promo)
@@ -965,6 +1298,45 @@ func TestUpdatePromoError(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestExpirePromotionsOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+	now := time.Now()
+
+	mock.ExpectExec("UPDATE promotion SET promo_level = 0").WithArgs(now).WillReturnResult(sqlmock.NewResult(0, 3))
+
+	expired, err := repo.ExpirePromotions(now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), expired)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestExpirePromotionsError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+	now := time.Now()
+
+	mock.ExpectExec("UPDATE promotion SET promo_level = 0").WithArgs(now).WillReturnError(fmt.Errorf("db error"))
+
+	_, err = repo.ExpirePromotions(now)
+
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
 func TestSelectRecomendationsOk(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -1108,3 +1480,141 @@ func TestRegenerateRecomendationsError(t *testing.T) {
 	err = mock.ExpectationsWereMet()
 	assert.Nil(t, err)
 }
+
+func TestRenewOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+	advert := &models.Advert{Id: 4, Status: models.AdvertStatusClosed, CloseReason: models.CloseReasonExpired}
+
+	mock.ExpectQuery("UPDATE advert").WithArgs(advert.Id).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "published_at", "date_close"}).
+			AddRow(models.AdvertStatusPublished, ParseTime(), ParseTime()))
+
+	err = repo.Renew(advert)
+	assert.NoError(t, err)
+	assert.True(t, advert.IsActive)
+	assert.Equal(t, models.AdvertStatusPublished, advert.Status)
+	assert.Equal(t, "", advert.CloseReason)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestRenewNeedsReview(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+	advert := &models.Advert{Id: 4, Status: models.AdvertStatusClosed, CloseReason: models.CloseReasonManual, NeedsReview: true}
+
+	mock.ExpectQuery("status = CASE WHEN needs_review THEN 'pending_review' ELSE 'published' END").WithArgs(advert.Id).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "published_at", "date_close"}).
+			AddRow(models.AdvertStatusPendingReview, ParseTime(), ParseTime()))
+
+	err = repo.Renew(advert)
+	assert.NoError(t, err)
+	assert.Equal(t, models.AdvertStatusPendingReview, advert.Status)
+	assert.False(t, advert.NeedsReview)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestRenewNotClosed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+
+	mock.ExpectQuery("UPDATE advert").WithArgs(int64(4)).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "published_at", "date_close"}))
+
+	err = repo.Renew(&models.Advert{Id: 4})
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestCloseExpiredOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+
+	mock.ExpectQuery("UPDATE advert").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "publisher_id", "date_close"}).
+			AddRow(4, "объявление", 1, ParseTime()))
+
+	adverts, err := repo.CloseExpired()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(adverts))
+	assert.Equal(t, int64(1), adverts[0].PublisherId)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestMarkExpiringError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+
+	mock.ExpectQuery("UPDATE advert").WithArgs(int64(3)).WillReturnError(fmt.Errorf("error"))
+
+	_, err = repo.MarkExpiring(3)
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectCategoryAttributesOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+
+	mock.ExpectQuery("SELECT attributes FROM category").WithArgs(testadvert.Category).
+		WillReturnRows(sqlmock.NewRows([]string{"attributes"}).AddRow([]byte(`[{"name":"size","type":"enum","values":["M"]}]`)))
+
+	attributes, err := repo.SelectCategoryAttributes(testadvert.Category)
+	assert.NoError(t, err)
+	assert.Equal(t, models.AttributeEnum, attributes[0].Type)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectCategoryAttributesUnknown(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAdvtRepository(db)
+
+	mock.ExpectQuery("SELECT attributes FROM category").WithArgs("нет такой").
+		WillReturnRows(sqlmock.NewRows([]string{"attributes"}))
+
+	_, err = repo.SelectCategoryAttributes("нет такой")
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
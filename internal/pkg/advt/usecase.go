@@ -14,16 +14,28 @@ const (
 
 type AdvtUsecase interface {
 	GetListAdvt(from int64, count int64, newest bool) ([]*models.Advert, error)
-	GetAdvertListByPublicherId(publisherId int64, is_active bool, page *models.Page) ([]*models.Advert, error)
+	GetAdvertListByPublicherId(publisherId int64, viewerId int64, is_active bool, page *models.Page) ([]*models.Advert, error)
 	GetAdvertListByCategory(categoryName string, page *models.Page) ([]*models.Advert, error)
 
 	AdvertsToShort(adverts []*models.Advert) []*models.AdvertShort
 
+	ExportAdverts(publisherId int64, publishedOnly bool, export func(advert *models.AdvertExport) error) error
+	GetPublisherCategories(publisherId int64, publishedOnly bool) ([]*models.Category, error)
+
 	CreateAdvert(userId int64, advert *models.Advert) error
 	GetAdvert(advertId, userId int64, updateViews bool) (*models.Advert, error)
 	UpdateAdvert(advertId int64, newAdvert *models.Advert) error
 	DeleteAdvert(advertId int64, userId int64) error
 	CloseAdvert(advertId int64, userId int64) error
+	RenewAdvert(advertId int64, userId int64) (*models.Advert, error)
+
+	CloseExpiredAdverts() ([]*models.Advert, error)
+	WarnExpiringAdverts(warnDays int64) ([]*models.Advert, error)
+
+	SubmitAdvert(advertId int64, userId int64) (*models.Advert, error)
+	GetModerationQueue(page *models.Page) ([]*models.Advert, error)
+	ApproveAdvert(advertId int64) (*models.Advert, error)
+	RejectAdvert(advertId int64, reason string) (*models.Advert, error)
 
 	UploadImages(files []*multipart.FileHeader, advertId int64, userId int64) (*models.Advert, error)
 	RemoveImages(images []string, advertId, userId int64) error
@@ -32,6 +44,8 @@ type AdvtUsecase interface {
 	GetFavoriteList(userId int64, page *models.Page) ([]*models.Advert, error)
 	AddFavorite(userId int64, advertId int64) error
 	RemoveFavorite(userId int64, advertId int64) error
+	SetFavoriteTarget(userId int64, advertId int64, targetPrice int64) error
+	CollectFavoriteNotifications() ([]*models.FavoriteNotification, error)
 
 	GetAdvertViews(advertId int64) (int64, error)
 
@@ -39,6 +53,8 @@ type AdvtUsecase interface {
 	UpdateAdvertPrice(userId int64, adPrice *models.AdvertPrice) error
 
 	UpdatePromotion(userId int64, promo *models.Promotion) error
+	PreparePromotion(userId int64, promo *models.Promotion) error
+	ExpirePromotions() error
 
 	GetRecomendations(advertId int64, count int64, userId int64) ([]*models.Advert, error)
 }
@@ -8,8 +8,11 @@ import (
 	"yula/internal/models"
 	"yula/internal/pkg/advt"
 	imageloader "yula/internal/pkg/image_loader"
+	"yula/internal/pkg/logging"
 )
 
+var logger logging.Logger = logging.GetLogger()
+
 type AdvtUsecase struct {
 	advtRepository     advt.AdvtRepository
 	imageLoaderUsecase imageloader.ImageLoaderUsecase
@@ -32,17 +35,76 @@ func (au *AdvtUsecase) CreateAdvert(userId int64, advert *models.Advert) error {
 	if advert.Amount == 0 {
 		advert.Amount = 1
 	}
-	err := au.advtRepository.Insert(advert)
+	if !advert.DeliveryPickup && !advert.DeliveryCourier && !advert.DeliveryPost {
+		advert.DeliveryPickup = true
+	}
+
+	// новое объявление попадает в ленту только после проверки модератором
+	if advert.Status != models.AdvertStatusDraft {
+		advert.Status = models.AdvertStatusPendingReview
+	}
+	advert.ModerationReason = ""
+
+	err := au.checkAttributes(advert)
+	if err != nil {
+		return err
+	}
+
+	err = au.advtRepository.Insert(advert)
 	return err
 }
 
+// checkAttributes сверяет атрибуты объявления со схемой его категории
+func (au *AdvtUsecase) checkAttributes(advert *models.Advert) error {
+	schema, err := au.advtRepository.SelectCategoryAttributes(advert.Category)
+	if err == internalError.EmptyQuery {
+		return internalError.BadRequest
+	}
+	if err != nil {
+		return err
+	}
+
+	if advert.Attributes == nil {
+		advert.Attributes = models.AdvertAttributes{}
+	}
+	return models.ValidateAttributes(schema, advert.Attributes)
+}
+
 func (au *AdvtUsecase) GetAdvert(advertId, userId int64, updateViews bool) (*models.Advert, error) {
+	advert, err := au.getAdvert(advertId, userId, updateViews)
+	if err != nil {
+		return advert, err
+	}
+
+	// доступное количество не включает то, что придержано в чужих корзинах
+	reserved, err := au.advtRepository.SelectReservedAmount(advertId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	advert.Amount -= reserved
+	if advert.Amount < 0 {
+		advert.Amount = 0
+	}
+
+	if userId == advert.PublisherId {
+		advert.SetPromoLeft(time.Now())
+	}
+	return advert, nil
+}
+
+func (au *AdvtUsecase) getAdvert(advertId, userId int64, updateViews bool) (*models.Advert, error) {
 	advert, err := au.advtRepository.SelectById(advertId)
 
 	if err != nil {
 		return nil, err
 	}
 
+	// непроверенные и отклоненные объявления видит только их владелец
+	if !advert.IsVisible() && userId != advert.PublisherId {
+		return nil, internalError.EmptyQuery
+	}
+
 	if len(advert.Images) == 0 {
 		advert.Images = append(advert.Images, imageloader.DefaultAdvertImage)
 	}
@@ -65,6 +127,22 @@ func (au *AdvtUsecase) UpdateAdvert(advertId int64, newAdvert *models.Advert) er
 	newAdvert.PublishedAt = oldAdvert.PublishedAt
 	newAdvert.DateClose = oldAdvert.DateClose
 	newAdvert.IsActive = oldAdvert.IsActive
+	newAdvert.Status = oldAdvert.Status
+	newAdvert.ModerationReason = oldAdvert.ModerationReason
+	newAdvert.CloseReason = oldAdvert.CloseReason
+	newAdvert.NeedsReview = oldAdvert.NeedsReview
+
+	err = au.checkAttributes(newAdvert)
+	if err != nil {
+		return err
+	}
+
+	// измененный текст объявления проверяется заново
+	contentChanged := newAdvert.Name != oldAdvert.Name || newAdvert.Description != oldAdvert.Description ||
+		newAdvert.Category != oldAdvert.Category
+	if contentChanged {
+		sendToReview(newAdvert)
+	}
 
 	if newAdvert.Price != oldAdvert.Price {
 		if err = au.advtRepository.UpdatePrice(&models.AdvertPrice{
@@ -87,8 +165,24 @@ func (au *AdvtUsecase) UpdateAdvert(advertId int64, newAdvert *models.Advert) er
 	return nil
 }
 
+// sendToReview возвращает измененное объявление на проверку: опубликованное и отклоненное
+// сразу уходят в очередь модерации, закрытое - при продлении, черновик проверят после отправки
+func sendToReview(advert *models.Advert) bool {
+	switch advert.Status {
+	case models.AdvertStatusPublished, models.AdvertStatusRejected:
+		advert.Status = models.AdvertStatusPendingReview
+		advert.ModerationReason = ""
+		return true
+	case models.AdvertStatusClosed:
+		changed := !advert.NeedsReview
+		advert.NeedsReview = true
+		return changed
+	}
+	return false
+}
+
 func (au *AdvtUsecase) DeleteAdvert(advertId int64, userId int64) error {
-	advert, err := au.GetAdvert(advertId, userId, false)
+	advert, err := au.getAdvert(advertId, userId, false)
 	if err != nil {
 		return err
 	}
@@ -101,8 +195,10 @@ func (au *AdvtUsecase) DeleteAdvert(advertId int64, userId int64) error {
 	return err
 }
 
+// CloseAdvert снимает объявление с продажи. Закрыть можно только опубликованное объявление,
+// иначе продление вернуло бы в ленту черновик или отклоненное объявление в обход модерации
 func (au *AdvtUsecase) CloseAdvert(advertId int64, userId int64) error {
-	advert, err := au.GetAdvert(advertId, userId, false)
+	advert, err := au.getAdvert(advertId, userId, false)
 	if err != nil {
 		return err
 	}
@@ -111,13 +207,112 @@ func (au *AdvtUsecase) CloseAdvert(advertId int64, userId int64) error {
 		return internalError.Conflict
 	}
 
+	if advert.Status != models.AdvertStatusPublished {
+		return internalError.CloseNotAllowed
+	}
+
 	advert.IsActive = false
+	advert.Status = models.AdvertStatusClosed
+	advert.CloseReason = models.CloseReasonManual
 	advert.DateClose = time.Now()
 
 	err = au.advtRepository.Update(advert)
 	return err
 }
 
+// RenewAdvert возвращает в ленту закрытое объявление, распроданные и закрытые модератором
+// объявления продлить нельзя
+func (au *AdvtUsecase) RenewAdvert(advertId int64, userId int64) (*models.Advert, error) {
+	advert, err := au.advtRepository.SelectById(advertId)
+	if err != nil {
+		return nil, err
+	}
+
+	if advert.PublisherId != userId {
+		return nil, internalError.Conflict
+	}
+
+	if advert.Status != models.AdvertStatusClosed || advert.Amount <= 0 ||
+		advert.CloseReason == models.CloseReasonModerator {
+		return nil, internalError.RenewNotAllowed
+	}
+
+	err = au.advtRepository.Renew(advert)
+	if err == internalError.EmptyQuery {
+		return nil, internalError.RenewNotAllowed
+	}
+	if err != nil {
+		return nil, err
+	}
+	return advert, nil
+}
+
+func (au *AdvtUsecase) CloseExpiredAdverts() ([]*models.Advert, error) {
+	return au.advtRepository.CloseExpired()
+}
+
+func (au *AdvtUsecase) WarnExpiringAdverts(warnDays int64) ([]*models.Advert, error) {
+	return au.advtRepository.MarkExpiring(warnDays)
+}
+
+func (au *AdvtUsecase) SubmitAdvert(advertId int64, userId int64) (*models.Advert, error) {
+	advert, err := au.advtRepository.SelectById(advertId)
+	if err != nil {
+		return nil, err
+	}
+
+	if advert.PublisherId != userId {
+		return nil, internalError.Conflict
+	}
+
+	if advert.Status != models.AdvertStatusDraft && advert.Status != models.AdvertStatusRejected {
+		return nil, internalError.ModerationNotAllowed
+	}
+
+	advert.Status = models.AdvertStatusPendingReview
+	advert.ModerationReason = ""
+	err = au.advtRepository.Update(advert)
+	if err != nil {
+		return nil, err
+	}
+
+	return advert, nil
+}
+
+func (au *AdvtUsecase) GetModerationQueue(page *models.Page) ([]*models.Advert, error) {
+	return au.advtRepository.SelectModerationQueue(page.PageNum, page.Count)
+}
+
+func (au *AdvtUsecase) ApproveAdvert(advertId int64) (*models.Advert, error) {
+	return au.moderate(advertId, models.AdvertStatusPublished, "")
+}
+
+func (au *AdvtUsecase) RejectAdvert(advertId int64, reason string) (*models.Advert, error) {
+	return au.moderate(advertId, models.AdvertStatusRejected, reason)
+}
+
+// moderate выносит решение по объявлению, ожидающему проверки
+func (au *AdvtUsecase) moderate(advertId int64, status string, reason string) (*models.Advert, error) {
+	advert, err := au.advtRepository.SelectById(advertId)
+	if err != nil {
+		return nil, err
+	}
+
+	if advert.Status != models.AdvertStatusPendingReview {
+		return nil, internalError.ModerationNotAllowed
+	}
+
+	advert.Status = status
+	advert.ModerationReason = reason
+	advert.NeedsReview = false
+	err = au.advtRepository.Update(advert)
+	if err != nil {
+		return nil, err
+	}
+
+	return advert, nil
+}
+
 func (au *AdvtUsecase) UploadImages(files []*multipart.FileHeader, advertId int64, userId int64) (*models.Advert, error) {
 	advert, err := au.advtRepository.SelectById(advertId)
 	if err != nil {
@@ -140,6 +335,14 @@ func (au *AdvtUsecase) UploadImages(files []*multipart.FileHeader, advertId int6
 	}
 	advert.Images = append(oldImages, imageUrls...)
 
+	// новые фотографии проверяются так же, как измененный текст
+	if sendToReview(advert) {
+		err = au.advtRepository.Update(advert)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// err = au.imageLoaderUsecase.RemoveAdvertImages(oldImages)
 	// if err != nil {
 	// 	return nil, err
@@ -163,12 +366,21 @@ func (au *AdvtUsecase) RemoveImages(images []string, advertId, userId int64) err
 		return err
 	}
 
+	if sendToReview(advert) {
+		err = au.advtRepository.Update(advert)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = au.imageLoaderUsecase.RemoveAdvertImages(images)
 	return err
 }
 
-func (au *AdvtUsecase) GetAdvertListByPublicherId(publisherId int64, is_active bool, page *models.Page) ([]*models.Advert, error) {
-	adverts, err := au.advtRepository.SelectAdvertsByPublisherId(publisherId, is_active, page.PageNum, page.Count)
+// GetAdvertListByPublicherId - объявления продавца глазами viewerId: чужим показываются только опубликованные
+func (au *AdvtUsecase) GetAdvertListByPublicherId(publisherId int64, viewerId int64, is_active bool, page *models.Page) ([]*models.Advert, error) {
+	adverts, err := au.advtRepository.SelectAdvertsByPublisherId(publisherId, is_active, viewerId != publisherId,
+		page.PageNum, page.Count)
 	return adverts, err
 }
 
@@ -180,6 +392,61 @@ func (au *AdvtUsecase) AdvertsToShort(adverts []*models.Advert) []*models.Advert
 	return advertsShort
 }
 
+// ExportAdverts передает в export все объявления продавца с историей цен: сначала активные, потом архив;
+// объявления читаются страницами, поэтому выгрузка не держит весь каталог в памяти.
+// В публичный фид попадают только активные опубликованные объявления
+func (au *AdvtUsecase) ExportAdverts(publisherId int64, publishedOnly bool, export func(advert *models.AdvertExport) error) error {
+	activity := []bool{true, false}
+	if publishedOnly {
+		activity = []bool{true}
+	}
+
+	for _, isActive := range activity {
+		for page := int64(0); ; page++ {
+			adverts, err := au.advtRepository.SelectAdvertsByPublisherId(publisherId, isActive, publishedOnly, page, models.ExportPageSize)
+			if err != nil {
+				return err
+			}
+
+			advertIds := make([]int64, 0, len(adverts))
+			for _, advert := range adverts {
+				advertIds = append(advertIds, advert.Id)
+			}
+			histories, err := au.advtRepository.SelectPriceHistories(advertIds)
+			if err != nil {
+				return err
+			}
+
+			for _, advert := range adverts {
+				history := histories[advert.Id]
+				if history == nil {
+					history = []*models.AdvertPrice{}
+				}
+
+				// заглушку вместо картинки во внешние площадки не отдаем
+				if len(advert.Images) == 1 && advert.Images[0] == imageloader.DefaultAdvertImage {
+					advert.Images = []string{}
+				}
+
+				err = export(&models.AdvertExport{Advert: *advert, PriceHistory: history})
+				if err != nil {
+					return err
+				}
+			}
+
+			if int64(len(adverts)) < models.ExportPageSize {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func (au *AdvtUsecase) GetPublisherCategories(publisherId int64, publishedOnly bool) ([]*models.Category, error) {
+	categories, err := au.advtRepository.SelectPublisherCategories(publisherId, publishedOnly)
+	return categories, err
+}
+
 func (au *AdvtUsecase) GetAdvertListByCategory(categoryName string, page *models.Page) ([]*models.Advert, error) {
 	adverts, err := au.advtRepository.SelectAdvertsByCategory(categoryName, page.PageNum, page.Count)
 	if err != nil {
@@ -218,6 +485,52 @@ func (au *AdvtUsecase) RemoveFavorite(userId int64, advertId int64) error {
 	return err
 }
 
+func (au *AdvtUsecase) SetFavoriteTarget(userId int64, advertId int64, targetPrice int64) error {
+	if targetPrice < 0 {
+		return internalError.BadRequest
+	}
+
+	err := au.advtRepository.UpdateFavoriteTarget(userId, advertId, targetPrice)
+	if err == internalError.EmptyQuery {
+		return internalError.NotExist
+	}
+	return err
+}
+
+// CollectFavoriteNotifications находит избранное с изменившейся ценой или закрытым объявлением,
+// отмечает изменения увиденными и возвращает то, о чем надо сообщить пользователям
+func (au *AdvtUsecase) CollectFavoriteNotifications() ([]*models.FavoriteNotification, error) {
+	changes, err := au.advtRepository.SelectFavoriteChanges(models.FavoriteChangesBatch)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := make([]*models.FavoriteNotification, 0)
+	for _, change := range changes {
+		kind := change.Notification()
+
+		// сначала отмечаем, иначе при ошибке записи пользователь получал бы уведомление каждый запуск
+		err = au.advtRepository.UpdateFavoriteSeen(change)
+		if err != nil {
+			return notifications, err
+		}
+		if kind == "" {
+			continue
+		}
+
+		notifications = append(notifications, &models.FavoriteNotification{
+			UserId:     change.UserId,
+			AdvertId:   change.AdvertId,
+			AdvertName: change.AdvertName,
+			Kind:       kind,
+			OldPrice:   change.PriceSeen,
+			Price:      change.Price,
+			Target:     change.TargetPrice,
+		})
+	}
+	return notifications, nil
+}
+
 func (au *AdvtUsecase) GetAdvertViews(advertId int64) (int64, error) {
 	views, err := au.advtRepository.SelectViews(advertId)
 	return views, err
@@ -252,23 +565,55 @@ func (au *AdvtUsecase) GetPriceHistory(advertId int64) ([]*models.AdvertPrice, e
 	return priceHistory, err
 }
 
+// UpdatePromotion включает купленный пакет продвижения: уровень берется из пакета, срок отсчитывается от
+// текущего момента, а повторная покупка пакета того же уровня продлевает еще не истекшее продвижение
 func (au *AdvtUsecase) UpdatePromotion(userId int64, promo *models.Promotion) error {
-	_, err := au.advtRepository.SelectById(promo.AdvertId)
+	err := au.PreparePromotion(userId, promo)
 	if err != nil {
 		return err
 	}
 
-	// if userId != advert.PublisherId {
-	// 	return internalError.Conflict
-	// }
+	err = au.advtRepository.UpdatePromo(promo)
+	return err
+}
+
+// PreparePromotion проверяет пакет и считает уровень и срок продвижения, не сохраняя их:
+// оплаченное продвижение сохраняется вместе с платежом
+func (au *AdvtUsecase) PreparePromotion(userId int64, promo *models.Promotion) error {
+	advert, err := au.advtRepository.SelectById(promo.AdvertId)
+	if err != nil {
+		return err
+	}
 
-	if promo.PromoLevel < advt.MinPromo || promo.PromoLevel >= advt.MaxPromo {
+	if userId != advert.PublisherId {
+		return internalError.Conflict
+	}
+
+	pkg := models.GetPromotionPackage(promo.Package)
+	if pkg == nil || pkg.Level <= advt.MinPromo || pkg.Level >= advt.MaxPromo {
 		return internalError.BadRequest
 	}
 
+	promo.PromoLevel = pkg.Level
 	promo.UpdateTime = time.Now()
-	err = au.advtRepository.UpdatePromo(promo)
-	return err
+	start := promo.UpdateTime
+	if advert.PromoLevel == pkg.Level && advert.PromoUntil.After(start) {
+		start = advert.PromoUntil
+	}
+	promo.PromoUntil = start.Add(time.Duration(pkg.Days) * 24 * time.Hour)
+	return nil
+}
+
+// ExpirePromotions снимает продвижение, срок которого истек
+func (au *AdvtUsecase) ExpirePromotions() error {
+	expired, err := au.advtRepository.ExpirePromotions(time.Now())
+	if err != nil {
+		return err
+	}
+	if expired != 0 {
+		logger.Infof("promotion expired for %d adverts", expired)
+	}
+	return nil
 }
 
 func (au *AdvtUsecase) GetFavoriteCount(advertId int64) (int64, error) {
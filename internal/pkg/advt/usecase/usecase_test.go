@@ -3,15 +3,18 @@ package usecase
 import (
 	"mime/multipart"
 	"testing"
+	"time"
 	"yula/internal/models"
 
 	mockAdvt "yula/internal/pkg/advt/mocks"
 
 	myerr "yula/internal/error"
 
+	imageloader "yula/internal/pkg/image_loader"
 	"yula/internal/pkg/image_loader/mocks"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
@@ -53,6 +56,7 @@ func TestCreateAdvert(t *testing.T) {
 		Amount: 0,
 	}
 	ua.On("Insert", &ad).Return(nil)
+	ua.On("SelectCategoryAttributes", "").Return(models.CategoryAttributeList{}, nil)
 
 	err := au.CreateAdvert(int64(22), &ad)
 	assert.Nil(t, err)
@@ -67,14 +71,83 @@ func TestGetAdvert(t *testing.T) {
 		Id:     0,
 		Name:   "aboba",
 		Amount: 0,
+		Status: models.AdvertStatusPublished,
 	}
 	ua.On("SelectById", int64(0)).Return(&ad, nil)
+	ua.On("SelectReservedAmount", int64(0), int64(-1)).Return(int64(0), nil)
 
 	advts, err := au.GetAdvert(ad.Id, -1, false)
 	assert.Nil(t, err)
 	assert.Equal(t, ad, *advts)
 }
 
+func TestGetAdvertReserved(t *testing.T) {
+	ua := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ua, &ilu)
+	ad := models.Advert{
+		Id:     0,
+		Name:   "aboba",
+		Amount: 5,
+		Status: models.AdvertStatusPublished,
+	}
+	ua.On("SelectById", int64(0)).Return(&ad, nil)
+	ua.On("SelectReservedAmount", int64(0), int64(1)).Return(int64(3), nil)
+
+	advts, err := au.GetAdvert(ad.Id, 1, false)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), advts.Amount)
+}
+
+func TestGetAdvertReservedFail(t *testing.T) {
+	ua := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ua, &ilu)
+	ad := models.Advert{
+		Id:     0,
+		Name:   "aboba",
+		Amount: 5,
+		Status: models.AdvertStatusPublished,
+	}
+	ua.On("SelectById", int64(0)).Return(&ad, nil)
+	ua.On("SelectReservedAmount", int64(0), int64(1)).Return(int64(0), myerr.DatabaseError)
+
+	advts, err := au.GetAdvert(ad.Id, 1, false)
+	assert.Equal(t, myerr.DatabaseError, err)
+	assert.Nil(t, advts)
+}
+
+func TestGetAdvertPendingHidden(t *testing.T) {
+	ua := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ua, &ilu)
+	ad := models.Advert{
+		Id:          3,
+		Name:        "aboba",
+		PublisherId: 1,
+		Status:      models.AdvertStatusPendingReview,
+	}
+	ua.On("SelectById", int64(3)).Return(&ad, nil)
+
+	advts, err := au.GetAdvert(ad.Id, 2, false)
+	assert.Equal(t, myerr.EmptyQuery, err)
+	assert.Nil(t, advts)
+}
+
+func TestGetAdvertPendingOwner(t *testing.T) {
+	ua := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ua, &ilu)
+	ad := models.Advert{
+		Id:          3,
+		Name:        "aboba",
+		PublisherId: 1,
+		Status:      models.AdvertStatusRejected,
+	}
+	ua.On("SelectById", int64(3)).Return(&ad, nil)
+	ua.On("SelectReservedAmount", int64(3), int64(1)).Return(int64(0), nil)
+
+	advts, err := au.GetAdvert(ad.Id, 1, false)
+	assert.Nil(t, err)
+	assert.Equal(t, models.AdvertStatusRejected, advts.Status)
+}
+
 func TestUpdateAdvert(t *testing.T) {
 	ua := mockAdvt.AdvtRepository{}
 	au := NewAdvtUsecase(&ua, &ilu)
@@ -90,11 +163,58 @@ func TestUpdateAdvert(t *testing.T) {
 	}
 	ua.On("SelectById", int64(0)).Return(&oldAd, nil)
 	ua.On("Update", &newAd).Return(nil)
+	ua.On("SelectCategoryAttributes", "").Return(models.CategoryAttributeList{}, nil)
 
 	err := au.UpdateAdvert(oldAd.Id, &newAd)
 	assert.Nil(t, err)
 }
 
+func TestUpdatePublishedAdvertNeedsReview(t *testing.T) {
+	ua := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ua, &ilu)
+	oldAd := models.Advert{
+		Id:     0,
+		Name:   "aboba",
+		Status: models.AdvertStatusPublished,
+	}
+	newAd := models.Advert{
+		Id:     0,
+		Name:   "baobab",
+		Status: models.AdvertStatusPublished,
+	}
+	ua.On("SelectById", int64(0)).Return(&oldAd, nil)
+	ua.On("Update", &newAd).Return(nil)
+	ua.On("SelectCategoryAttributes", "").Return(models.CategoryAttributeList{}, nil)
+
+	err := au.UpdateAdvert(oldAd.Id, &newAd)
+	assert.Nil(t, err)
+	assert.Equal(t, models.AdvertStatusPendingReview, newAd.Status)
+}
+
+func TestUpdateAdvertKeepsStatus(t *testing.T) {
+	ua := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ua, &ilu)
+	oldAd := models.Advert{
+		Id:     0,
+		Name:   "aboba",
+		Price:  100,
+		Status: models.AdvertStatusPublished,
+	}
+	newAd := models.Advert{
+		Id:     0,
+		Name:   "aboba",
+		Price:  100,
+		Status: models.AdvertStatusDraft,
+	}
+	ua.On("SelectById", int64(0)).Return(&oldAd, nil)
+	ua.On("Update", &newAd).Return(nil)
+	ua.On("SelectCategoryAttributes", "").Return(models.CategoryAttributeList{}, nil)
+
+	err := au.UpdateAdvert(oldAd.Id, &newAd)
+	assert.Nil(t, err)
+	assert.Equal(t, models.AdvertStatusPublished, newAd.Status)
+}
+
 func TestDeleteAdvertSuccess(t *testing.T) {
 	ua := mockAdvt.AdvtRepository{}
 	au := NewAdvtUsecase(&ua, &ilu)
@@ -135,12 +255,28 @@ func TestCloseAdvertSuccess(t *testing.T) {
 		Name:        "aboba",
 		Amount:      0,
 		PublisherId: 1,
+		Status:      models.AdvertStatusPublished,
 	}
 	ua.On("SelectById", ad.Id).Return(&ad, nil)
 	ua.On("Update", &ad).Return(nil)
 
 	err := au.CloseAdvert(ad.Id, 1)
 	assert.Nil(t, err)
+	assert.Equal(t, models.CloseReasonManual, ad.CloseReason)
+}
+
+func TestCloseAdvertNotPublished(t *testing.T) {
+	for _, status := range []string{models.AdvertStatusDraft, models.AdvertStatusPendingReview,
+		models.AdvertStatusRejected, models.AdvertStatusClosed} {
+		ua := mockAdvt.AdvtRepository{}
+		au := NewAdvtUsecase(&ua, &ilu)
+		ad := models.Advert{Id: 0, Amount: 1, PublisherId: 1, IsActive: true, Status: status}
+		ua.On("SelectById", ad.Id).Return(&ad, nil)
+
+		err := au.CloseAdvert(ad.Id, 1)
+		assert.Equal(t, myerr.CloseNotAllowed, err, status)
+		ua.AssertNotCalled(t, "Update", mock.Anything)
+	}
 }
 
 func TestCloseAdvertFail(t *testing.T) {
@@ -159,6 +295,141 @@ func TestCloseAdvertFail(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestCreateAdvertPendingReview(t *testing.T) {
+	ua := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ua, &ilu)
+	ad := models.Advert{
+		Name:   "aboba",
+		Status: models.AdvertStatusPublished,
+	}
+	ua.On("Insert", &ad).Return(nil)
+	ua.On("SelectCategoryAttributes", "").Return(models.CategoryAttributeList{}, nil)
+
+	err := au.CreateAdvert(int64(22), &ad)
+	assert.Nil(t, err)
+	assert.Equal(t, models.AdvertStatusPendingReview, ad.Status)
+}
+
+func TestCreateAdvertDraft(t *testing.T) {
+	ua := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ua, &ilu)
+	ad := models.Advert{
+		Name:   "aboba",
+		Status: models.AdvertStatusDraft,
+	}
+	ua.On("Insert", &ad).Return(nil)
+	ua.On("SelectCategoryAttributes", "").Return(models.CategoryAttributeList{}, nil)
+
+	err := au.CreateAdvert(int64(22), &ad)
+	assert.Nil(t, err)
+	assert.Equal(t, models.AdvertStatusDraft, ad.Status)
+}
+
+func TestSubmitAdvertSuccess(t *testing.T) {
+	ua := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ua, &ilu)
+	ad := models.Advert{
+		Id:               4,
+		PublisherId:      1,
+		Status:           models.AdvertStatusRejected,
+		ModerationReason: "prohibited goods",
+	}
+	ua.On("SelectById", ad.Id).Return(&ad, nil)
+	ua.On("Update", &ad).Return(nil)
+
+	advert, err := au.SubmitAdvert(ad.Id, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, models.AdvertStatusPendingReview, advert.Status)
+	assert.Equal(t, "", advert.ModerationReason)
+}
+
+func TestSubmitAdvertPublished(t *testing.T) {
+	ua := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ua, &ilu)
+	ad := models.Advert{
+		Id:          4,
+		PublisherId: 1,
+		Status:      models.AdvertStatusPublished,
+	}
+	ua.On("SelectById", ad.Id).Return(&ad, nil)
+
+	_, err := au.SubmitAdvert(ad.Id, 1)
+	assert.Equal(t, myerr.ModerationNotAllowed, err)
+	ua.AssertNotCalled(t, "Update", &ad)
+}
+
+func TestSubmitAdvertForeign(t *testing.T) {
+	ua := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ua, &ilu)
+	ad := models.Advert{
+		Id:          4,
+		PublisherId: 1,
+		Status:      models.AdvertStatusDraft,
+	}
+	ua.On("SelectById", ad.Id).Return(&ad, nil)
+
+	_, err := au.SubmitAdvert(ad.Id, 2)
+	assert.Equal(t, myerr.Conflict, err)
+}
+
+func TestGetModerationQueue(t *testing.T) {
+	ua := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ua, &ilu)
+	ads := []*models.Advert{{Id: 4, Status: models.AdvertStatusPendingReview}}
+	ua.On("SelectModerationQueue", int64(0), int64(50)).Return(ads, nil)
+
+	adverts, err := au.GetModerationQueue(&models.Page{PageNum: 0, Count: 50})
+	assert.Nil(t, err)
+	assert.Equal(t, ads, adverts)
+}
+
+func TestApproveAdvertSuccess(t *testing.T) {
+	ua := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ua, &ilu)
+	ad := models.Advert{
+		Id:          4,
+		PublisherId: 1,
+		Status:      models.AdvertStatusPendingReview,
+	}
+	ua.On("SelectById", ad.Id).Return(&ad, nil)
+	ua.On("Update", &ad).Return(nil)
+
+	advert, err := au.ApproveAdvert(ad.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, models.AdvertStatusPublished, advert.Status)
+}
+
+func TestRejectAdvertSuccess(t *testing.T) {
+	ua := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ua, &ilu)
+	ad := models.Advert{
+		Id:          4,
+		PublisherId: 1,
+		Status:      models.AdvertStatusPendingReview,
+	}
+	ua.On("SelectById", ad.Id).Return(&ad, nil)
+	ua.On("Update", &ad).Return(nil)
+
+	advert, err := au.RejectAdvert(ad.Id, "prohibited goods")
+	assert.Nil(t, err)
+	assert.Equal(t, models.AdvertStatusRejected, advert.Status)
+	assert.Equal(t, "prohibited goods", advert.ModerationReason)
+}
+
+func TestRejectAdvertNotPending(t *testing.T) {
+	ua := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ua, &ilu)
+	ad := models.Advert{
+		Id:          4,
+		PublisherId: 1,
+		Status:      models.AdvertStatusClosed,
+	}
+	ua.On("SelectById", ad.Id).Return(&ad, nil)
+
+	_, err := au.RejectAdvert(ad.Id, "prohibited goods")
+	assert.Equal(t, myerr.ModerationNotAllowed, err)
+}
+
 func TestUploadImagesSuccess(t *testing.T) {
 	ar := mockAdvt.AdvtRepository{}
 	au := NewAdvtUsecase(&ar, &ilu)
@@ -319,13 +590,26 @@ func TestGetAdvertListByPublicherId(t *testing.T) {
 
 	au := NewAdvtUsecase(&ar, &ilu)
 
-	ar.On("SelectAdvertsByPublisherId", int64(0), false, int64(0), int64(0)).Return(nil, nil)
+	ar.On("SelectAdvertsByPublisherId", int64(0), false, false, int64(0), int64(0)).Return(nil, nil)
 
-	advts, err := au.GetAdvertListByPublicherId(0, false, &models.Page{})
+	advts, err := au.GetAdvertListByPublicherId(0, 0, false, &models.Page{})
 	assert.Nil(t, advts)
 	assert.Nil(t, err)
 }
 
+func TestGetAdvertListByPublicherIdStranger(t *testing.T) {
+	ar := mockAdvt.AdvtRepository{}
+
+	au := NewAdvtUsecase(&ar, &ilu)
+
+	// чужой пользователь не видит черновики и объявления на проверке
+	ar.On("SelectAdvertsByPublisherId", int64(1), true, true, int64(0), int64(10)).Return([]*models.Advert{}, nil)
+
+	_, err := au.GetAdvertListByPublicherId(1, 2, true, &models.Page{Count: 10})
+	assert.Nil(t, err)
+	ar.AssertExpectations(t)
+}
+
 func TestAdvertsToShort(t *testing.T) {
 	ar := mockAdvt.AdvtRepository{}
 
@@ -460,6 +744,74 @@ func TestRemoveFavoriteError(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestSetFavoriteTarget(t *testing.T) {
+	ar := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ar, &ilu)
+
+	ar.On("UpdateFavoriteTarget", int64(1), int64(2), int64(900)).Return(nil)
+	ar.On("UpdateFavoriteTarget", int64(1), int64(3), int64(900)).Return(myerr.EmptyQuery)
+
+	err := au.SetFavoriteTarget(1, 2, 900)
+	assert.NoError(t, err)
+
+	err = au.SetFavoriteTarget(1, 3, 900)
+	assert.Equal(t, myerr.NotExist, err)
+
+	err = au.SetFavoriteTarget(1, 2, -1)
+	assert.Equal(t, myerr.BadRequest, err)
+}
+
+func TestCollectFavoriteNotifications(t *testing.T) {
+	ar := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ar, &ilu)
+
+	published, closed := models.AdvertStatusPublished, models.AdvertStatusClosed
+	changes := []*models.FavoriteChange{
+		// цена снизилась, целевой цены нет
+		{UserId: 1, AdvertId: 1, Price: 900, PriceSeen: 1000, Status: published},
+		// цена выросла - молча запоминаем
+		{UserId: 2, AdvertId: 1, Price: 1100, PriceSeen: 1000, Status: published},
+		// цена снизилась, но осталась выше целевой
+		{UserId: 3, AdvertId: 1, Price: 900, PriceSeen: 1000, TargetPrice: 800, Status: published},
+		// цена опустилась ниже целевой
+		{UserId: 4, AdvertId: 1, Price: 700, PriceSeen: 900, TargetPrice: 800, Status: published},
+		// распродано
+		{UserId: 1, AdvertId: 2, Price: 500, PriceSeen: 500, Status: closed, CloseReason: models.CloseReasonSoldOut},
+		// закрыто вручную
+		{UserId: 1, AdvertId: 3, Price: 500, PriceSeen: 500, Status: closed, CloseReason: models.CloseReasonManual},
+		// о закрытии уже сообщили, снижение цены закрытого объявления не интересно
+		{UserId: 1, AdvertId: 4, Price: 400, PriceSeen: 500, Status: closed, ClosedNotified: true},
+	}
+	ar.On("SelectFavoriteChanges", models.FavoriteChangesBatch).Return(changes, nil)
+	ar.On("UpdateFavoriteSeen", mock.Anything).Return(nil)
+
+	notifications, err := au.CollectFavoriteNotifications()
+	assert.NoError(t, err)
+	ar.AssertNumberOfCalls(t, "UpdateFavoriteSeen", len(changes))
+
+	kinds := make([]string, 0)
+	for _, n := range notifications {
+		kinds = append(kinds, n.Kind)
+	}
+	assert.Equal(t, []string{models.FavoriteNotifyPriceDrop, models.FavoriteNotifyTargetPrice,
+		models.FavoriteNotifySoldOut, models.FavoriteNotifyClosed}, kinds)
+	assert.Equal(t, int64(4), notifications[1].UserId)
+	assert.Equal(t, int64(800), notifications[1].Target)
+}
+
+func TestCollectFavoriteNotificationsError(t *testing.T) {
+	ar := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ar, &ilu)
+
+	changes := []*models.FavoriteChange{{UserId: 1, AdvertId: 1, Price: 900, PriceSeen: 1000}}
+	ar.On("SelectFavoriteChanges", models.FavoriteChangesBatch).Return(changes, nil)
+	ar.On("UpdateFavoriteSeen", changes[0]).Return(myerr.InternalError)
+
+	notifications, err := au.CollectFavoriteNotifications()
+	assert.Error(t, err)
+	assert.Equal(t, 0, len(notifications))
+}
+
 func TestGetAdvertViews(t *testing.T) {
 	ar := mockAdvt.AdvtRepository{}
 	au := NewAdvtUsecase(&ar, &ilu)
@@ -567,11 +919,73 @@ func TestGetPriceHistorySuccess(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestExportAdvertsPaging(t *testing.T) {
+	ar := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ar, &ilu)
+
+	page := make([]*models.Advert, models.ExportPageSize)
+	for i := range page {
+		page[i] = &models.Advert{Id: int64(i + 1), Images: []string{"static/advertimages/1.webp"}}
+	}
+	archived := []*models.Advert{{Id: 500, Images: []string{imageloader.DefaultAdvertImage}}}
+
+	ar.On("SelectAdvertsByPublisherId", int64(1), true, false, int64(0), models.ExportPageSize).Return(page, nil)
+	ar.On("SelectAdvertsByPublisherId", int64(1), true, false, int64(1), models.ExportPageSize).Return([]*models.Advert{}, nil)
+	ar.On("SelectAdvertsByPublisherId", int64(1), false, false, int64(0), models.ExportPageSize).Return(archived, nil)
+	ar.On("SelectPriceHistories", mock.Anything).Return(map[int64][]*models.AdvertPrice{1: {{AdvertId: 1, Price: 100}}}, nil)
+
+	var exported []*models.AdvertExport
+	err := au.ExportAdverts(1, false, func(advert *models.AdvertExport) error {
+		exported = append(exported, advert)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int(models.ExportPageSize)+1, len(exported))
+	assert.Equal(t, int64(500), exported[len(exported)-1].Id)
+	assert.Empty(t, exported[len(exported)-1].Images)
+	assert.Equal(t, 1, len(exported[0].PriceHistory))
+	assert.NotNil(t, exported[1].PriceHistory)
+	assert.Empty(t, exported[1].PriceHistory)
+	ar.AssertNumberOfCalls(t, "SelectPriceHistories", 3)
+	ar.AssertExpectations(t)
+}
+
+func TestExportAdvertsPublishedOnly(t *testing.T) {
+	ar := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ar, &ilu)
+
+	ar.On("SelectAdvertsByPublisherId", int64(1), true, true, int64(0), models.ExportPageSize).Return([]*models.Advert{{Id: 2}}, nil)
+	ar.On("SelectPriceHistories", []int64{2}).Return(map[int64][]*models.AdvertPrice{}, nil)
+
+	exported := 0
+	err := au.ExportAdverts(1, true, func(advert *models.AdvertExport) error {
+		exported++
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, exported)
+	ar.AssertNotCalled(t, "SelectAdvertsByPublisherId", int64(1), false, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestExportAdvertsStopsOnError(t *testing.T) {
+	ar := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ar, &ilu)
+
+	ar.On("SelectAdvertsByPublisherId", int64(1), true, false, int64(0), models.ExportPageSize).Return([]*models.Advert{{Id: 2}}, nil)
+	ar.On("SelectPriceHistories", []int64{2}).Return(nil, myerr.InternalError)
+
+	err := au.ExportAdverts(1, false, func(advert *models.AdvertExport) error {
+		return nil
+	})
+	assert.Equal(t, myerr.InternalError, err)
+	ar.AssertNotCalled(t, "SelectAdvertsByPublisherId", int64(1), false, false, int64(0), models.ExportPageSize)
+}
+
 func TestUpdatePromotionSuccess(t *testing.T) {
 	ar := mockAdvt.AdvtRepository{}
 	au := NewAdvtUsecase(&ar, &ilu)
 
-	promo := &models.Promotion{AdvertId: 0, PromoLevel: 1}
+	promo := &models.Promotion{AdvertId: 0, Package: "top_3"}
 	adv := &models.Advert{Id: 0, Price: 100}
 
 	ar.On("SelectById", promo.AdvertId).Return(adv, nil)
@@ -579,13 +993,31 @@ func TestUpdatePromotionSuccess(t *testing.T) {
 
 	err := au.UpdatePromotion(int64(0), promo)
 	assert.NoError(t, err)
+	assert.Equal(t, models.PromotionTopLevel, promo.PromoLevel)
+	assert.Equal(t, 72*time.Hour, promo.PromoUntil.Sub(promo.UpdateTime))
+}
+
+func TestUpdatePromotionExtend(t *testing.T) {
+	ar := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ar, &ilu)
+
+	promo := &models.Promotion{AdvertId: 0, Package: "top_7"}
+	until := time.Now().Add(24 * time.Hour)
+	adv := &models.Advert{Id: 0, Price: 100, PromoLevel: models.PromotionTopLevel, PromoUntil: until}
+
+	ar.On("SelectById", promo.AdvertId).Return(adv, nil)
+	ar.On("UpdatePromo", promo).Return(nil)
+
+	err := au.UpdatePromotion(int64(0), promo)
+	assert.NoError(t, err)
+	assert.Equal(t, until.Add(7*24*time.Hour), promo.PromoUntil)
 }
 
 func TestUpdatePromotionError1(t *testing.T) {
 	ar := mockAdvt.AdvtRepository{}
 	au := NewAdvtUsecase(&ar, &ilu)
 
-	promo := &models.Promotion{AdvertId: 0, PromoLevel: 1}
+	promo := &models.Promotion{AdvertId: 0, Package: "top_3"}
 
 	ar.On("SelectById", promo.AdvertId).Return(nil, myerr.InternalError)
 
@@ -597,7 +1029,7 @@ func TestUpdatePromotionError2(t *testing.T) {
 	ar := mockAdvt.AdvtRepository{}
 	au := NewAdvtUsecase(&ar, &ilu)
 
-	promo := &models.Promotion{AdvertId: 0, PromoLevel: -1}
+	promo := &models.Promotion{AdvertId: 0, Package: "top_100"}
 	adv := &models.Advert{Id: 0, Price: 100}
 
 	ar.On("SelectById", promo.AdvertId).Return(adv, nil)
@@ -606,6 +1038,49 @@ func TestUpdatePromotionError2(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestUpdatePromotionNotOwner(t *testing.T) {
+	ar := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ar, &ilu)
+
+	promo := &models.Promotion{AdvertId: 0, Package: "top_3"}
+	adv := &models.Advert{Id: 0, Price: 100, PublisherId: 1}
+
+	ar.On("SelectById", promo.AdvertId).Return(adv, nil)
+
+	err := au.UpdatePromotion(int64(2), promo)
+	assert.Equal(t, myerr.Conflict, err)
+	ar.AssertNotCalled(t, "UpdatePromo", mock.Anything)
+}
+
+func TestExpirePromotions(t *testing.T) {
+	ar := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ar, &ilu)
+
+	ar.On("ExpirePromotions", mock.Anything).Return(int64(2), nil)
+
+	err := au.ExpirePromotions()
+	assert.NoError(t, err)
+	ar.AssertExpectations(t)
+}
+
+func TestGetAdvertPromoLeftForOwner(t *testing.T) {
+	ua := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ua, &ilu)
+	ad := models.Advert{
+		Id:          0,
+		PublisherId: 1,
+		Status:      models.AdvertStatusPublished,
+		PromoLevel:  models.PromotionTopLevel,
+		PromoUntil:  time.Now().Add(time.Hour),
+	}
+	ua.On("SelectById", int64(0)).Return(&ad, nil)
+	ua.On("SelectReservedAmount", int64(0), int64(1)).Return(int64(0), nil)
+
+	advert, err := au.GetAdvert(ad.Id, 1, false)
+	assert.Nil(t, err)
+	assert.InDelta(t, 3600, advert.PromoLeft, 5)
+}
+
 func TestGetFavoriteCountSuccess(t *testing.T) {
 	ar := mockAdvt.AdvtRepository{}
 	au := NewAdvtUsecase(&ar, &ilu)
@@ -677,3 +1152,167 @@ func TestGetRecomendationsError2(t *testing.T) {
 	_, err := au.GetRecomendations(advert.Id, count, userId)
 	assert.NoError(t, err)
 }
+
+func TestRenewAdvertSuccess(t *testing.T) {
+	ar := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ar, &ilu)
+	ad := models.Advert{
+		Id:          4,
+		PublisherId: 1,
+		Amount:      2,
+		Status:      models.AdvertStatusClosed,
+		CloseReason: models.CloseReasonExpired,
+	}
+	ar.On("SelectById", ad.Id).Return(&ad, nil)
+	ar.On("Renew", &ad).Return(nil)
+
+	_, err := au.RenewAdvert(ad.Id, 1)
+	assert.Nil(t, err)
+	ar.AssertExpectations(t)
+}
+
+// закрытое объявление, измененное после проверки, при продлении уходит на модерацию, а не в ленту
+func TestCloseEditRenewNeedsReview(t *testing.T) {
+	ar := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ar, &ilu)
+	stored := models.Advert{
+		Id:          4,
+		Name:        "aboba",
+		PublisherId: 1,
+		Amount:      2,
+		IsActive:    true,
+		Status:      models.AdvertStatusPublished,
+		Images:      []string{"static/advertimages/1.webp"},
+	}
+	ar.On("SelectById", stored.Id).Return(&stored, nil)
+	ar.On("SelectCategoryAttributes", "").Return(models.CategoryAttributeList{}, nil)
+	ar.On("Update", mock.AnythingOfType("*models.Advert")).Run(func(args mock.Arguments) {
+		stored = *args.Get(0).(*models.Advert)
+	}).Return(nil)
+	// как в репозитории: измененное объявление продлевается в очередь модерации
+	ar.On("Renew", mock.AnythingOfType("*models.Advert")).Run(func(args mock.Arguments) {
+		advert := args.Get(0).(*models.Advert)
+		advert.Status = models.AdvertStatusPublished
+		if advert.NeedsReview {
+			advert.Status = models.AdvertStatusPendingReview
+		}
+		advert.NeedsReview = false
+		advert.IsActive = true
+	}).Return(nil)
+
+	err := au.CloseAdvert(stored.Id, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, models.AdvertStatusClosed, stored.Status)
+
+	edited := stored
+	edited.Name = "baobab"
+	err = au.UpdateAdvert(stored.Id, &edited)
+	assert.Nil(t, err)
+	assert.Equal(t, models.AdvertStatusClosed, stored.Status)
+	assert.True(t, stored.NeedsReview)
+
+	renewed, err := au.RenewAdvert(stored.Id, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, models.AdvertStatusPendingReview, renewed.Status)
+}
+
+func TestUploadImagesClosedAdvertNeedsReview(t *testing.T) {
+	ar := mockAdvt.AdvtRepository{}
+	il := mocks.ImageLoaderUsecase{}
+	au := NewAdvtUsecase(&ar, &il)
+	files := []*multipart.FileHeader{{Filename: "aboba"}}
+	ad := models.Advert{Id: 4, PublisherId: 1, Status: models.AdvertStatusClosed}
+
+	ar.On("SelectById", ad.Id).Return(&ad, nil)
+	il.On("UploadAdvertImages", files).Return([]string{"static/advertimages/2.webp"}, nil)
+	ar.On("InsertImages", ad.Id, []string{"static/advertimages/2.webp"}).Return(nil)
+	ar.On("Update", &ad).Return(nil)
+
+	_, err := au.UploadImages(files, ad.Id, 1)
+	assert.Nil(t, err)
+	assert.True(t, ad.NeedsReview)
+	assert.Equal(t, models.AdvertStatusClosed, ad.Status)
+	ar.AssertExpectations(t)
+}
+
+func TestRenewAdvertSoldOut(t *testing.T) {
+	ar := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ar, &ilu)
+	ad := models.Advert{
+		Id:          4,
+		PublisherId: 1,
+		Amount:      0,
+		Status:      models.AdvertStatusClosed,
+		CloseReason: models.CloseReasonSoldOut,
+	}
+	ar.On("SelectById", ad.Id).Return(&ad, nil)
+
+	_, err := au.RenewAdvert(ad.Id, 1)
+	assert.Equal(t, myerr.RenewNotAllowed, err)
+}
+
+func TestRenewAdvertClosedByModerator(t *testing.T) {
+	ar := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ar, &ilu)
+	ad := models.Advert{
+		Id:          4,
+		PublisherId: 1,
+		Amount:      2,
+		Status:      models.AdvertStatusClosed,
+		CloseReason: models.CloseReasonModerator,
+	}
+	ar.On("SelectById", ad.Id).Return(&ad, nil)
+
+	_, err := au.RenewAdvert(ad.Id, 1)
+	assert.Equal(t, myerr.RenewNotAllowed, err)
+}
+
+func TestRenewAdvertForeign(t *testing.T) {
+	ar := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ar, &ilu)
+	ad := models.Advert{Id: 4, PublisherId: 1, Status: models.AdvertStatusClosed}
+	ar.On("SelectById", ad.Id).Return(&ad, nil)
+
+	_, err := au.RenewAdvert(ad.Id, 10)
+	assert.Equal(t, myerr.Conflict, err)
+}
+
+func TestCreateAdvertInvalidAttributes(t *testing.T) {
+	ua := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ua, &ilu)
+	ad := models.Advert{
+		Name:       "aboba",
+		Category:   "одежда",
+		Attributes: models.AdvertAttributes{"size": "XXXL"},
+	}
+	schema := models.CategoryAttributeList{{Name: "size", Type: models.AttributeEnum, Values: []string{"S", "M"}}}
+	ua.On("SelectCategoryAttributes", ad.Category).Return(schema, nil)
+
+	err := au.CreateAdvert(1, &ad)
+	assert.Equal(t, myerr.InvalidAttributes, err)
+	ua.AssertNotCalled(t, "Insert", &ad)
+}
+
+func TestUpdateAdvertNormalizesAttributes(t *testing.T) {
+	ua := mockAdvt.AdvtRepository{}
+	au := NewAdvtUsecase(&ua, &ilu)
+	oldAd := models.Advert{Id: 3, Name: "aboba", Category: "авто"}
+	newAd := models.Advert{
+		Id:         3,
+		Name:       "aboba",
+		Category:   "авто",
+		Attributes: models.AdvertAttributes{"year": "2015.0", "used": "1"},
+	}
+	schema := models.CategoryAttributeList{
+		{Name: "year", Type: models.AttributeRange, Required: true, Min: 1950, Max: 2030},
+		{Name: "used", Type: models.AttributeBoolean},
+	}
+	ua.On("SelectById", newAd.Id).Return(&oldAd, nil)
+	ua.On("SelectCategoryAttributes", newAd.Category).Return(schema, nil)
+	ua.On("Update", &newAd).Return(nil)
+
+	err := au.UpdateAdvert(newAd.Id, &newAd)
+	assert.Nil(t, err)
+	assert.Equal(t, "2015", newAd.Attributes["year"])
+	assert.Equal(t, "true", newAd.Attributes["used"])
+}
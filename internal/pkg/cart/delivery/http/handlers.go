@@ -8,8 +8,10 @@ import (
 	"yula/internal/models"
 	"yula/internal/pkg/advt"
 	"yula/internal/pkg/cart"
+	"yula/internal/pkg/coupons"
 	"yula/internal/pkg/logging"
 	"yula/internal/pkg/middleware"
+	"yula/internal/pkg/payment"
 	"yula/internal/pkg/user"
 
 	"github.com/asaskevich/govalidator"
@@ -23,28 +25,91 @@ var (
 )
 
 type CartHandler struct {
-	cartUsecase   cart.CartUsecase
-	userUsecase   user.UserUsecase
-	advertUsecase advt.AdvtUsecase
+	cartUsecase    cart.CartUsecase
+	userUsecase    user.UserUsecase
+	advertUsecase  advt.AdvtUsecase
+	paymentUsecase payment.PaymentUsecase
+	couponUsecase  coupons.CouponUsecase
 }
 
-func NewCartHandler(cartUsecase cart.CartUsecase, userUsecase user.UserUsecase, advertUsecase advt.AdvtUsecase) *CartHandler {
+func NewCartHandler(cartUsecase cart.CartUsecase, userUsecase user.UserUsecase, advertUsecase advt.AdvtUsecase,
+	paymentUsecase payment.PaymentUsecase, couponUsecase coupons.CouponUsecase) *CartHandler {
 	return &CartHandler{
-		cartUsecase:   cartUsecase,
-		userUsecase:   userUsecase,
-		advertUsecase: advertUsecase,
+		cartUsecase:    cartUsecase,
+		userUsecase:    userUsecase,
+		advertUsecase:  advertUsecase,
+		paymentUsecase: paymentUsecase,
+		couponUsecase:  couponUsecase,
 	}
 }
 
 func (ch *CartHandler) Routing(r *mux.Router, sm *middleware.SessionMiddleware) {
 	s := r.PathPrefix("/cart").Subrouter()
-	s.Use(sm.CheckAuthorized)
 
-	s.HandleFunc("/one", ch.UpdateOneAdvertHandler).Methods(http.MethodPost, http.MethodOptions)
-	s.HandleFunc("", ch.UpdateAllCartHandler).Methods(http.MethodPost, http.MethodOptions)
-	s.HandleFunc("", middleware.SetSCRFToken(http.HandlerFunc(ch.GetCartHandler))).Methods(http.MethodGet, http.MethodOptions)
-	s.HandleFunc("/clear", ch.ClearCartHandler).Methods(http.MethodPost, http.MethodOptions)
-	s.HandleFunc("/{id:[0-9]+}/checkout", ch.CheckoutHandler).Methods(http.MethodPost, http.MethodOptions)
+	// смотреть и наполнять корзину можно без авторизации, остальное только после входа
+	s.Handle("/one", sm.SoftCheckAuthorized(middleware.GuestCart(http.HandlerFunc(ch.UpdateOneAdvertHandler)))).Methods(http.MethodPost, http.MethodOptions)
+	s.Handle("", sm.SoftCheckAuthorized(middleware.GuestCart(http.HandlerFunc(ch.UpdateAllCartHandler)))).Methods(http.MethodPost, http.MethodOptions)
+	s.Handle("", middleware.SetSCRFToken(sm.SoftCheckAuthorized(middleware.GuestCart(http.HandlerFunc(ch.GetCartHandler))))).Methods(http.MethodGet, http.MethodOptions)
+	s.Handle("/clear", sm.CheckAuthorized(http.HandlerFunc(ch.ClearCartHandler))).Methods(http.MethodPost, http.MethodOptions)
+	s.Handle("/{id:[0-9]+}/reserve", sm.CheckAuthorized(http.HandlerFunc(ch.ReserveHandler))).Methods(http.MethodPost, http.MethodOptions)
+	s.Handle("/{id:[0-9]+}/coupon", sm.CheckAuthorized(http.HandlerFunc(ch.ApplyCouponHandler))).Methods(http.MethodPost, http.MethodOptions)
+	s.Handle("/{id:[0-9]+}/coupon", sm.CheckAuthorized(http.HandlerFunc(ch.RemoveCouponHandler))).Methods(http.MethodDelete, http.MethodOptions)
+	s.Handle("/{id:[0-9]+}/checkout", sm.CheckAuthorized(http.HandlerFunc(ch.CheckoutHandler))).Methods(http.MethodPost, http.MethodOptions)
+	s.Handle("/checkout", sm.CheckAuthorized(http.HandlerFunc(ch.CheckoutAllHandler))).Methods(http.MethodPost, http.MethodOptions)
+}
+
+// токен гостевой корзины есть только у неавторизованных запросов
+func guestToken(r *http.Request) string {
+	if r.Context().Value(middleware.ContextGuestToken) != nil {
+		return r.Context().Value(middleware.ContextGuestToken).(string)
+	}
+	return ""
+}
+
+// readDelivery достает из тела выбранный способ доставки и адрес из адресной книги покупателя,
+// пустое тело означает самовывоз без безопасной сделки
+func (ch *CartHandler) readDelivery(r *http.Request, userId int64) (*models.DeliveryChoice, *models.Address, error) {
+	defer r.Body.Close()
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, nil, internalError.BadRequest
+	}
+
+	if len(buf) == 0 {
+		return &models.DeliveryChoice{Method: models.DeliveryPickup}, nil, nil
+	}
+
+	choice := &models.DeliveryChoice{}
+	err = easyjson.Unmarshal(buf, choice)
+	if err != nil {
+		return nil, nil, internalError.BadRequest
+	}
+
+	_, err = govalidator.ValidateStruct(choice)
+	if err != nil {
+		return nil, nil, internalError.BadRequest
+	}
+
+	if choice.AddressId == 0 {
+		return choice, nil, nil
+	}
+
+	address, err := ch.userUsecase.GetAddress(userId, choice.AddressId)
+	if err != nil {
+		return nil, nil, err
+	}
+	return choice, address, nil
+}
+
+// pay начинает безопасную сделку по только что оформленному заказу,
+// при ошибке заказ остается неоплаченным и его можно оплатить позже
+func (ch *CartHandler) pay(order *models.Order, userId int64) *models.Payment {
+	p, err := ch.paymentUsecase.Pay(order.Id, userId)
+	if err != nil {
+		logger.Warnf("can not start payment for order %d: %s", order.Id, err.Error())
+		return nil
+	}
+	return p
 }
 
 // UpdateOneAdvertHandler godoc
@@ -114,7 +179,11 @@ func (ch *CartHandler) UpdateOneAdvertHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	_, err = ch.cartUsecase.UpdateCart(userId, &cartInputed, advert.Amount)
+	if token := guestToken(r); token != "" {
+		_, err = ch.cartUsecase.UpdateGuestCart(token, &cartInputed, advert.Amount)
+	} else {
+		_, err = ch.cartUsecase.UpdateCart(userId, &cartInputed, advert.Amount)
+	}
 	if err != nil {
 		logger.Warnf("unable to update the cart: %s", err.Error())
 		w.WriteHeader(http.StatusOK)
@@ -207,7 +276,14 @@ func (ch *CartHandler) UpdateAllCartHandler(w http.ResponseWriter, r *http.Reque
 		adverts = append(adverts, advert)
 	}
 
-	cart, advs, messages, err := ch.cartUsecase.UpdateAllCart(userId, cartInputed, adverts)
+	var cart []*models.Cart
+	var advs []*models.Advert
+	var messages []string
+	if token := guestToken(r); token != "" {
+		cart, advs, messages, err = ch.cartUsecase.UpdateAllGuestCart(token, cartInputed, adverts)
+	} else {
+		cart, advs, messages, err = ch.cartUsecase.UpdateAllCart(userId, cartInputed, adverts)
+	}
 	if err != nil {
 		logger.Warnf("unable to update the cart: %s", err.Error())
 		w.WriteHeader(http.StatusOK)
@@ -243,7 +319,13 @@ func (ch *CartHandler) GetCartHandler(w http.ResponseWriter, r *http.Request) {
 		userId = r.Context().Value(middleware.ContextUserId).(int64)
 	}
 
-	cart, err := ch.cartUsecase.GetCart(userId)
+	var cart []*models.Cart
+	var err error
+	if token := guestToken(r); token != "" {
+		cart, err = ch.cartUsecase.GetGuestCart(token)
+	} else {
+		cart, err = ch.cartUsecase.GetCart(userId)
+	}
 	if err != nil {
 		logger.Warnf("unable to get the cart: %s", err.Error())
 		w.WriteHeader(http.StatusOK)
@@ -333,6 +415,200 @@ func (ch *CartHandler) ClearCartHandler(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// ReserveHandler godoc
+// @Summary Reserve advert in cart
+// @Description Reserve advert in cart for a limited time
+// @Tags cart
+// @Accept application/json
+// @Produce application/json
+// @Param id path integer true "Advert id"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyCartOne}
+// @failure default {object} models.HttpError
+// @Router /cart/{id}/reserve [post]
+func (ch *CartHandler) ReserveHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	advertId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse id adv: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	reserved, err := ch.cartUsecase.ReserveCart(userId, advertId)
+	if err != nil {
+		logger.Warnf("can not reserve advert: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyCartOne{Cart: *reserved}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "advert reserved", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// ApplyCouponHandler godoc
+// @Summary Apply coupon to cart line
+// @Description Apply seller's promo code to an advert in cart, the discount is charged at checkout
+// @Tags cart
+// @Accept application/json
+// @Produce application/json
+// @Param id path integer true "Advert id"
+// @Param body body models.CouponApply true "Coupon code"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyCartOne}
+// @failure default {object} models.HttpError
+// @Router /cart/{id}/coupon [post]
+func (ch *CartHandler) ApplyCouponHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	advertId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse id adv: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	defer r.Body.Close()
+	apply := &models.CouponApply{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = easyjson.Unmarshal(buf, apply)
+	}
+	if err == nil {
+		_, err = govalidator.ValidateStruct(apply)
+	}
+	if err != nil {
+		logger.Warnf("invalid data: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	advert, err := ch.advertUsecase.GetAdvert(advertId, userId, false)
+	if err != nil {
+		logger.Warnf("unable to get the advert: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	coupon, err := ch.couponUsecase.FindCoupon(apply.Code, advert)
+	if err != nil {
+		logger.Warnf("coupon can not be applied: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	oneInCart, err := ch.cartUsecase.ApplyCoupon(userId, advertId, coupon)
+	if err != nil {
+		logger.Warnf("can not apply coupon: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyCartOne{Cart: *oneInCart}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "coupon applied", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// RemoveCouponHandler godoc
+// @Summary Remove coupon from cart line
+// @Description Remove applied promo code from an advert in cart
+// @Tags cart
+// @Accept application/json
+// @Produce application/json
+// @Param id path integer true "Advert id"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyCartOne}
+// @failure default {object} models.HttpError
+// @Router /cart/{id}/coupon [delete]
+func (ch *CartHandler) RemoveCouponHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	advertId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse id adv: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	oneInCart, err := ch.cartUsecase.RemoveCoupon(userId, advertId)
+	if err != nil {
+		logger.Warnf("can not remove coupon: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyCartOne{Cart: *oneInCart}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "coupon removed", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
 // CheckoutHandler godoc
 // @Summary Checkout
 // @Description Checkout
@@ -340,6 +616,7 @@ func (ch *CartHandler) ClearCartHandler(w http.ResponseWriter, r *http.Request)
 // @Accept application/json
 // @Produce application/json
 // @Param id path integer true "Advert id"
+// @Param body body models.DeliveryChoice false "Delivery method, address and safe deal"
 // @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyOrder}
 // @failure default {object} models.HttpError
 // @Router /cart/{id}/checkout [post]
@@ -363,6 +640,18 @@ func (ch *CartHandler) CheckoutHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	choice, address, err := ch.readDelivery(r, userId)
+	if err != nil {
+		logger.Warnf("invalid delivery: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
 	order, err := ch.cartUsecase.GetOrderFromCart(userId, advertId)
 	if err != nil {
 		logger.Warnf("error with getting order: %s", err.Error())
@@ -399,7 +688,7 @@ func (ch *CartHandler) CheckoutHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = ch.cartUsecase.MakeOrder(order, advert)
+	madeOrder, err := ch.cartUsecase.MakeOrder(order, advert, choice.Method, address)
 	if err != nil {
 		logger.Warnf("can not make order: %s", err.Error())
 		w.WriteHeader(http.StatusOK)
@@ -411,10 +700,131 @@ func (ch *CartHandler) CheckoutHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	body := models.HttpBodyOrder{Salesman: *salesman, Order: *madeOrder}
+	if choice.SafeDeal {
+		body.Payment = ch.pay(madeOrder, userId)
+	}
+
 	w.WriteHeader(http.StatusOK)
-	body := models.HttpBodyOrder{Salesman: *salesman, Order: *order}
 	_, err = w.Write(models.ToBytes(http.StatusOK, "order made successfully", body))
 	if err != nil {
 		logger.Warnf("cannot write answer to body %s", err.Error())
 	}
 }
+
+// CheckoutAllHandler godoc
+// @Summary Checkout whole cart
+// @Description Checkout whole cart, one order per salesman
+// @Tags cart
+// @Accept application/json
+// @Produce application/json
+// @Param body body models.DeliveryChoice false "Delivery method, address and safe deal"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyCheckout}
+// @failure default {object} models.HttpError
+// @Router /cart/checkout [post]
+func (ch *CartHandler) CheckoutAllHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	choice, address, err := ch.readDelivery(r, userId)
+	if err != nil {
+		logger.Warnf("invalid delivery: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	cart, err := ch.cartUsecase.GetCart(userId)
+	if err != nil {
+		logger.Warnf("unable to get the cart: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	// архивные объявления не прерывают оформление, а попадают в подсказки
+	adverts := make([]*models.Advert, 0, len(cart))
+	for _, e := range cart {
+		advert, err := ch.advertUsecase.GetAdvert(e.AdvertId, userId, false)
+		if err != nil && err != internalError.EmptyQuery {
+			logger.Warnf("unable to get the advert: %s", err.Error())
+			w.WriteHeader(http.StatusOK)
+			metaCode, metaMessage := internalError.ToMetaStatus(err)
+			_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+			if err != nil {
+				logger.Warnf("cannot write answer to body %s", err.Error())
+			}
+			return
+		}
+
+		adverts = append(adverts, advert)
+	}
+
+	madeOrders, messages, err := ch.cartUsecase.MakeOrders(cart, adverts, choice.Method, address)
+	if err != nil {
+		logger.Warnf("can not make orders: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	orders := make([]*models.HttpBodyOrder, 0, len(madeOrders))
+	for _, order := range madeOrders {
+		salesman, err := ch.userUsecase.GetById(order.SalesmanId)
+		if err != nil {
+			logger.Warnf("error with getting salesman: %s", err.Error())
+			w.WriteHeader(http.StatusOK)
+			metaCode, metaMessage := internalError.ToMetaStatus(err)
+			_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+			if err != nil {
+				logger.Warnf("cannot write answer to body %s", err.Error())
+			}
+			return
+		}
+
+		orderBody := &models.HttpBodyOrder{Salesman: *salesman, Order: *order}
+		if choice.SafeDeal {
+			orderBody.Payment = ch.pay(order, userId)
+		}
+		orders = append(orders, orderBody)
+	}
+
+	// оформленные позиции из корзины уже удалены, отдаем то, что в ней осталось, с причинами отказа
+	ordered := make(map[int64]bool)
+	for _, order := range madeOrders {
+		for _, line := range order.Lines {
+			ordered[line.AdvertId] = true
+		}
+	}
+	rest := make([]*models.Cart, 0, len(cart))
+	hints := make([]string, 0, len(cart))
+	for i, e := range cart {
+		if ordered[e.AdvertId] {
+			continue
+		}
+		rest = append(rest, e)
+		hints = append(hints, messages[i])
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyCheckout{Orders: orders, Cart: rest, Hints: hints}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "orders made successfully", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
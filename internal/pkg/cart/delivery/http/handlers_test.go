@@ -15,6 +15,10 @@ import (
 
 	cartMock "yula/internal/pkg/cart/mocks"
 
+	couponMock "yula/internal/pkg/coupons/mocks"
+
+	paymentMock "yula/internal/pkg/payment/mocks"
+
 	userMock "yula/internal/pkg/user/mocks"
 
 	myerr "yula/internal/error"
@@ -28,7 +32,7 @@ func TestCartSuccess(t *testing.T) {
 	au := advtMock.AdvtUsecase{}
 	uu := userMock.UserUsecase{}
 	cu := cartMock.CartUsecase{}
-	ch := NewCartHandler(&cu, &uu, &au)
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
 
 	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
 	router.Use(middleware.LoggerMiddleware)
@@ -77,7 +81,7 @@ func TestCartFailGetAd(t *testing.T) {
 	au := advtMock.AdvtUsecase{}
 	uu := userMock.UserUsecase{}
 	cu := cartMock.CartUsecase{}
-	ch := NewCartHandler(&cu, &uu, &au)
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
 
 	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
 	router.Use(middleware.LoggerMiddleware)
@@ -117,7 +121,7 @@ func TestCartFailParse(t *testing.T) {
 	au := advtMock.AdvtUsecase{}
 	uu := userMock.UserUsecase{}
 	cu := cartMock.CartUsecase{}
-	ch := NewCartHandler(&cu, &uu, &au)
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
 
 	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
 	router.Use(middleware.LoggerMiddleware)
@@ -145,7 +149,7 @@ func TestCartFailUpdateAd(t *testing.T) {
 	au := advtMock.AdvtUsecase{}
 	uu := userMock.UserUsecase{}
 	cu := cartMock.CartUsecase{}
-	ch := NewCartHandler(&cu, &uu, &au)
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
 
 	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
 	router.Use(middleware.LoggerMiddleware)
@@ -192,7 +196,7 @@ func TestUpdateAllCartSuccess(t *testing.T) {
 	au := advtMock.AdvtUsecase{}
 	uu := userMock.UserUsecase{}
 	cu := cartMock.CartUsecase{}
-	ch := NewCartHandler(&cu, &uu, &au)
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
 
 	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
 	router.HandleFunc("", ch.UpdateAllCartHandler).Methods(http.MethodPost, http.MethodOptions)
@@ -256,7 +260,7 @@ func TestUpdateAllCartFailParse(t *testing.T) {
 	au := advtMock.AdvtUsecase{}
 	uu := userMock.UserUsecase{}
 	cu := cartMock.CartUsecase{}
-	ch := NewCartHandler(&cu, &uu, &au)
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
 
 	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
 	router.HandleFunc("", ch.UpdateAllCartHandler).Methods(http.MethodPost, http.MethodOptions)
@@ -284,7 +288,7 @@ func TestUpdateAllCartFailGetAd(t *testing.T) {
 	au := advtMock.AdvtUsecase{}
 	uu := userMock.UserUsecase{}
 	cu := cartMock.CartUsecase{}
-	ch := NewCartHandler(&cu, &uu, &au)
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
 
 	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
 	router.HandleFunc("", ch.UpdateAllCartHandler).Methods(http.MethodPost, http.MethodOptions)
@@ -326,7 +330,7 @@ func TestUpdateAllCartFailUpdateAllCart(t *testing.T) {
 	au := advtMock.AdvtUsecase{}
 	uu := userMock.UserUsecase{}
 	cu := cartMock.CartUsecase{}
-	ch := NewCartHandler(&cu, &uu, &au)
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
 
 	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
 	router.HandleFunc("", ch.UpdateAllCartHandler).Methods(http.MethodPost, http.MethodOptions)
@@ -375,7 +379,7 @@ func TestGetAllCartSuccess(t *testing.T) {
 	au := advtMock.AdvtUsecase{}
 	uu := userMock.UserUsecase{}
 	cu := cartMock.CartUsecase{}
-	ch := NewCartHandler(&cu, &uu, &au)
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
 
 	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
 	router.HandleFunc("", ch.GetCartHandler).Methods(http.MethodGet, http.MethodOptions)
@@ -418,7 +422,7 @@ func TestGetAllCartFailGetCart(t *testing.T) {
 	au := advtMock.AdvtUsecase{}
 	uu := userMock.UserUsecase{}
 	cu := cartMock.CartUsecase{}
-	ch := NewCartHandler(&cu, &uu, &au)
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
 
 	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
 	router.HandleFunc("", ch.GetCartHandler).Methods(http.MethodGet, http.MethodOptions)
@@ -452,7 +456,7 @@ func TestGetAllCartFailGetAd(t *testing.T) {
 	au := advtMock.AdvtUsecase{}
 	uu := userMock.UserUsecase{}
 	cu := cartMock.CartUsecase{}
-	ch := NewCartHandler(&cu, &uu, &au)
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
 
 	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
 	router.HandleFunc("", ch.GetCartHandler).Methods(http.MethodGet, http.MethodOptions)
@@ -487,7 +491,7 @@ func TestClearCartSuccess(t *testing.T) {
 	au := advtMock.AdvtUsecase{}
 	uu := userMock.UserUsecase{}
 	cu := cartMock.CartUsecase{}
-	ch := NewCartHandler(&cu, &uu, &au)
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
 
 	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
 	router.HandleFunc("/clear", ch.ClearCartHandler).Methods(http.MethodPost, http.MethodOptions)
@@ -525,7 +529,7 @@ func TestClearCartFailed(t *testing.T) {
 	au := advtMock.AdvtUsecase{}
 	uu := userMock.UserUsecase{}
 	cu := cartMock.CartUsecase{}
-	ch := NewCartHandler(&cu, &uu, &au)
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
 
 	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
 	router.HandleFunc("/clear", ch.ClearCartHandler).Methods(http.MethodPost, http.MethodOptions)
@@ -563,7 +567,7 @@ func TestCheckoutSuccess(t *testing.T) {
 	au := advtMock.AdvtUsecase{}
 	uu := userMock.UserUsecase{}
 	cu := cartMock.CartUsecase{}
-	ch := NewCartHandler(&cu, &uu, &au)
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
 
 	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
 	router.HandleFunc("/{id:[0-9]+}/checkout", ch.CheckoutHandler).Methods(http.MethodPost, http.MethodOptions)
@@ -593,7 +597,7 @@ func TestCheckoutSuccess(t *testing.T) {
 	cu.On("GetOrderFromCart", cart.UserId, cart.AdvertId).Return(&cart, nil)
 	au.On("GetAdvert", ad.Id, int64(0), false).Return(&ad, nil)
 	uu.On("GetById", cart.UserId).Return(&profile, nil)
-	cu.On("MakeOrder", &cart, &ad).Return(nil)
+	cu.On("MakeOrder", &cart, &ad, models.DeliveryPickup, (*models.Address)(nil)).Return(models.NewOrder(&cart, &ad), nil)
 
 	client := &http.Client{}
 	req, err := http.NewRequest("POST", fmt.Sprintf("%s/cart/2/checkout", srv.URL), nil)
@@ -610,11 +614,192 @@ func TestCheckoutSuccess(t *testing.T) {
 	// assert.Equal(t, Answer.Message, "order made successfully")
 }
 
+func TestCheckoutCourierSuccess(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	cu := cartMock.CartUsecase{}
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
+
+	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
+	router.HandleFunc("/{id:[0-9]+}/checkout", ch.CheckoutHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	cart := models.Cart{
+		UserId:   int64(0),
+		AdvertId: 2,
+		Amount:   8,
+	}
+
+	ad := models.Advert{
+		Id:              int64(2),
+		Name:            "aboba",
+		Amount:          10,
+		DeliveryCourier: true,
+	}
+
+	address := models.Address{Id: 4, Recipient: "Ivan", Phone: "+79990000000", City: "Moscow", Street: "Tverskaya, 1"}
+
+	profile := models.Profile{
+		Id:        0,
+		Email:     "aboba@baobab.com",
+		CreatedAt: time.Now(),
+	}
+
+	order := models.NewOrder(&cart, &ad)
+	order.DeliveryMethod = models.DeliveryCourier
+	order.DeliveryAddress = address.String()
+
+	uu.On("GetAddress", cart.UserId, address.Id).Return(&address, nil)
+	cu.On("GetOrderFromCart", cart.UserId, cart.AdvertId).Return(&cart, nil)
+	au.On("GetAdvert", ad.Id, int64(0), false).Return(&ad, nil)
+	uu.On("GetById", cart.UserId).Return(&profile, nil)
+	cu.On("MakeOrder", &cart, &ad, models.DeliveryCourier, &address).Return(order, nil)
+
+	reqBody, _ := json.Marshal(models.DeliveryChoice{Method: models.DeliveryCourier, AddressId: address.Id})
+	client := &http.Client{}
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/cart/2/checkout", srv.URL), bytes.NewBuffer(reqBody))
+	assert.Nil(t, err)
+
+	res, err := client.Do(req)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "order made successfully", Answer.Message)
+}
+
+func TestCheckoutSafeDealSuccess(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	cu := cartMock.CartUsecase{}
+	pu := paymentMock.PaymentUsecase{}
+	ch := NewCartHandler(&cu, &uu, &au, &pu, &couponMock.CouponUsecase{})
+
+	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
+	router.HandleFunc("/{id:[0-9]+}/checkout", ch.CheckoutHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	cart := models.Cart{
+		UserId:   int64(0),
+		AdvertId: 2,
+		Amount:   1,
+	}
+
+	ad := models.Advert{
+		Id:     int64(2),
+		Name:   "aboba",
+		Price:  100,
+		Amount: 10,
+	}
+
+	profile := models.Profile{
+		Id:        0,
+		Email:     "aboba@baobab.com",
+		CreatedAt: time.Now(),
+	}
+
+	order := models.NewOrder(&cart, &ad)
+	order.Id = 5
+
+	cu.On("GetOrderFromCart", cart.UserId, cart.AdvertId).Return(&cart, nil)
+	au.On("GetAdvert", ad.Id, int64(0), false).Return(&ad, nil)
+	uu.On("GetById", cart.UserId).Return(&profile, nil)
+	cu.On("MakeOrder", &cart, &ad, models.DeliveryPickup, (*models.Address)(nil)).Return(order, nil)
+	pu.On("Pay", order.Id, cart.UserId).Return(&models.Payment{Id: 1, OrderId: order.Id, Amount: 100,
+		Status: models.PaymentStatusPending, ConfirmationUrl: "fake://pay/fake-1"}, nil)
+
+	reqBody, _ := json.Marshal(models.DeliveryChoice{Method: models.DeliveryPickup, SafeDeal: true})
+	client := &http.Client{}
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/cart/2/checkout", srv.URL), bytes.NewBuffer(reqBody))
+	assert.Nil(t, err)
+
+	res, err := client.Do(req)
+	assert.Nil(t, err)
+
+	var Answer struct {
+		Code int                  `json:"code"`
+		Body models.HttpBodyOrder `json:"body"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.NotNil(t, Answer.Body.Payment)
+	pu.AssertExpectations(t)
+}
+
+func TestCheckoutFailForeignAddress(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	cu := cartMock.CartUsecase{}
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
+
+	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
+	router.HandleFunc("/{id:[0-9]+}/checkout", ch.CheckoutHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	uu.On("GetAddress", int64(0), int64(4)).Return(nil, myerr.EmptyQuery)
+
+	reqBody, _ := json.Marshal(models.DeliveryChoice{Method: models.DeliveryPost, AddressId: 4})
+	client := &http.Client{}
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/cart/2/checkout", srv.URL), bytes.NewBuffer(reqBody))
+	assert.Nil(t, err)
+
+	res, err := client.Do(req)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusNotFound, Answer.Code)
+}
+
+func TestCheckoutFailInvalidDelivery(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	cu := cartMock.CartUsecase{}
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
+
+	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
+	router.HandleFunc("/checkout", ch.CheckoutAllHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	reqBody, _ := json.Marshal(models.DeliveryChoice{Method: "teleport"})
+	client := &http.Client{}
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/cart/checkout", srv.URL), bytes.NewBuffer(reqBody))
+	assert.Nil(t, err)
+
+	res, err := client.Do(req)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, Answer.Code)
+}
+
 func TestCheckoutFailParseId(t *testing.T) {
 	au := advtMock.AdvtUsecase{}
 	uu := userMock.UserUsecase{}
 	cu := cartMock.CartUsecase{}
-	ch := NewCartHandler(&cu, &uu, &au)
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
 
 	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
 	router.HandleFunc("/{id:[0-9]+}/checkout", ch.CheckoutHandler).Methods(http.MethodPost, http.MethodOptions)
@@ -642,7 +827,7 @@ func TestCheckoutFailGetOrderFromCart(t *testing.T) {
 	au := advtMock.AdvtUsecase{}
 	uu := userMock.UserUsecase{}
 	cu := cartMock.CartUsecase{}
-	ch := NewCartHandler(&cu, &uu, &au)
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
 
 	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
 	router.HandleFunc("/{id:[0-9]+}/checkout", ch.CheckoutHandler).Methods(http.MethodPost, http.MethodOptions)
@@ -678,7 +863,7 @@ func TestCheckoutFailGetAd(t *testing.T) {
 	au := advtMock.AdvtUsecase{}
 	uu := userMock.UserUsecase{}
 	cu := cartMock.CartUsecase{}
-	ch := NewCartHandler(&cu, &uu, &au)
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
 
 	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
 	router.HandleFunc("/{id:[0-9]+}/checkout", ch.CheckoutHandler).Methods(http.MethodPost, http.MethodOptions)
@@ -720,7 +905,7 @@ func TestCheckoutFailGetById(t *testing.T) {
 	au := advtMock.AdvtUsecase{}
 	uu := userMock.UserUsecase{}
 	cu := cartMock.CartUsecase{}
-	ch := NewCartHandler(&cu, &uu, &au)
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
 
 	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
 	router.HandleFunc("/{id:[0-9]+}/checkout", ch.CheckoutHandler).Methods(http.MethodPost, http.MethodOptions)
@@ -764,7 +949,7 @@ func TestCheckoutFailMakeOrder(t *testing.T) {
 	au := advtMock.AdvtUsecase{}
 	uu := userMock.UserUsecase{}
 	cu := cartMock.CartUsecase{}
-	ch := NewCartHandler(&cu, &uu, &au)
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
 
 	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
 	router.HandleFunc("/{id:[0-9]+}/checkout", ch.CheckoutHandler).Methods(http.MethodPost, http.MethodOptions)
@@ -794,7 +979,7 @@ func TestCheckoutFailMakeOrder(t *testing.T) {
 	cu.On("GetOrderFromCart", cart.UserId, cart.AdvertId).Return(&cart, nil)
 	au.On("GetAdvert", ad.Id, int64(0), false).Return(&ad, nil)
 	uu.On("GetById", cart.UserId).Return(&profile, nil)
-	cu.On("MakeOrder", &cart, &ad).Return(myerr.InternalError)
+	cu.On("MakeOrder", &cart, &ad, models.DeliveryPickup, (*models.Address)(nil)).Return(nil, myerr.InternalError)
 
 	client := &http.Client{}
 	req, err := http.NewRequest("POST", fmt.Sprintf("%s/cart/2/checkout", srv.URL), nil)
@@ -810,3 +995,555 @@ func TestCheckoutFailMakeOrder(t *testing.T) {
 	// assert.Equal(t, Answer.Code, 500)
 	// assert.Equal(t, Answer.Message, "internal error")
 }
+
+func TestCheckoutFailNotEnoughCopies(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	cu := cartMock.CartUsecase{}
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
+
+	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
+	router.HandleFunc("/{id:[0-9]+}/checkout", ch.CheckoutHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	cart := models.Cart{
+		UserId:   int64(0),
+		AdvertId: 2,
+		Amount:   8,
+	}
+
+	ad := models.Advert{
+		Id:     int64(2),
+		Name:   "aboba",
+		Amount: 10,
+	}
+
+	profile := models.Profile{
+		Id:        0,
+		Email:     "aboba@baobab.com",
+		CreatedAt: time.Now(),
+	}
+
+	cu.On("GetOrderFromCart", cart.UserId, cart.AdvertId).Return(&cart, nil)
+	au.On("GetAdvert", ad.Id, int64(0), false).Return(&ad, nil)
+	uu.On("GetById", cart.UserId).Return(&profile, nil)
+	cu.On("MakeOrder", &cart, &ad, models.DeliveryPickup, (*models.Address)(nil)).Return(nil, myerr.NotEnoughCopies)
+
+	client := &http.Client{}
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/cart/2/checkout", srv.URL), nil)
+	assert.Nil(t, err)
+
+	res, err := client.Do(req)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, Answer.Code, 409)
+	assert.Equal(t, Answer.Message, "not enough copies")
+}
+
+func TestReserveSuccess(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	cu := cartMock.CartUsecase{}
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
+
+	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
+	router.HandleFunc("/{id:[0-9]+}/reserve", ch.ReserveHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	until := time.Now().Add(15 * time.Minute)
+	cart := models.Cart{
+		UserId:        int64(0),
+		AdvertId:      2,
+		Amount:        8,
+		ReservedUntil: &until,
+	}
+
+	cu.On("ReserveCart", cart.UserId, cart.AdvertId).Return(&cart, nil)
+
+	client := &http.Client{}
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/cart/2/reserve", srv.URL), nil)
+	assert.Nil(t, err)
+
+	res, err := client.Do(req)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "advert reserved", Answer.Message)
+}
+
+func TestReserveFailNotEnoughCopies(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	cu := cartMock.CartUsecase{}
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
+
+	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
+	router.HandleFunc("/{id:[0-9]+}/reserve", ch.ReserveHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	cu.On("ReserveCart", int64(0), int64(2)).Return(nil, myerr.SetMaxCopies(1))
+
+	client := &http.Client{}
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/cart/2/reserve", srv.URL), nil)
+	assert.Nil(t, err)
+
+	res, err := client.Do(req)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	metaCode, metaMessage := myerr.ToMetaStatus(myerr.SetMaxCopies(1))
+	assert.Equal(t, metaCode, Answer.Code)
+	assert.Equal(t, metaMessage, Answer.Message)
+}
+
+func TestCheckoutAllSuccess(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	cu := cartMock.CartUsecase{}
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
+
+	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
+	router.HandleFunc("/checkout", ch.CheckoutAllHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	cart := []*models.Cart{
+		{UserId: 0, AdvertId: 2, Amount: 1},
+		{UserId: 0, AdvertId: 3, Amount: 1},
+	}
+
+	ad := models.Advert{Id: 2, Name: "aboba", Amount: 10, PublisherId: 5}
+
+	profile := models.Profile{
+		Id:        5,
+		Email:     "aboba@baobab.com",
+		CreatedAt: time.Now(),
+	}
+
+	order := models.NewOrder(cart[0], &ad)
+
+	cu.On("GetCart", int64(0)).Return(cart, nil)
+	au.On("GetAdvert", int64(2), int64(0), false).Return(&ad, nil)
+	au.On("GetAdvert", int64(3), int64(0), false).Return(nil, myerr.EmptyQuery)
+	cu.On("MakeOrders", cart, []*models.Advert{&ad, nil}, models.DeliveryPickup, (*models.Address)(nil)).Return([]*models.Order{order}, []string{"ok", "not exist"}, nil)
+	uu.On("GetById", int64(5)).Return(&profile, nil)
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/cart/checkout", srv.URL), nil)
+	assert.Nil(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+
+	var Answer struct {
+		Code    int                     `json:"code"`
+		Message string                  `json:"message"`
+		Body    models.HttpBodyCheckout `json:"body"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, Answer.Code, 200)
+	assert.Equal(t, Answer.Message, "orders made successfully")
+	// в корзине осталась только неоформленная позиция
+	assert.Equal(t, 1, len(Answer.Body.Cart))
+	assert.Equal(t, int64(3), Answer.Body.Cart[0].AdvertId)
+	assert.Equal(t, []string{"not exist"}, Answer.Body.Hints)
+}
+
+func TestCheckoutAllFailGetCart(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	cu := cartMock.CartUsecase{}
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
+
+	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
+	router.HandleFunc("/checkout", ch.CheckoutAllHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	cu.On("GetCart", int64(0)).Return(nil, myerr.InternalError)
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/cart/checkout", srv.URL), nil)
+	assert.Nil(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, Answer.Code, 500)
+	assert.Equal(t, Answer.Message, "internal error")
+}
+
+func TestCheckoutAllFailGetAd(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	cu := cartMock.CartUsecase{}
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
+
+	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
+	router.HandleFunc("/checkout", ch.CheckoutAllHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	cart := []*models.Cart{
+		{UserId: 0, AdvertId: 2, Amount: 1},
+	}
+
+	cu.On("GetCart", int64(0)).Return(cart, nil)
+	au.On("GetAdvert", int64(2), int64(0), false).Return(nil, myerr.InternalError)
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/cart/checkout", srv.URL), nil)
+	assert.Nil(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, Answer.Code, 500)
+	assert.Equal(t, Answer.Message, "internal error")
+}
+
+func TestCheckoutAllFailMakeOrders(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	cu := cartMock.CartUsecase{}
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
+
+	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
+	router.HandleFunc("/checkout", ch.CheckoutAllHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	cart := []*models.Cart{}
+
+	cu.On("GetCart", int64(0)).Return(cart, nil)
+	cu.On("MakeOrders", cart, []*models.Advert{}, models.DeliveryPickup, (*models.Address)(nil)).Return(nil, nil, myerr.EmptyQuery)
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/cart/checkout", srv.URL), nil)
+	assert.Nil(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, Answer.Code, 404)
+	assert.Equal(t, Answer.Message, "empty rows")
+}
+
+func TestCheckoutAllFailGetById(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	cu := cartMock.CartUsecase{}
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
+
+	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
+	router.HandleFunc("/checkout", ch.CheckoutAllHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	cart := []*models.Cart{
+		{UserId: 0, AdvertId: 2, Amount: 1},
+	}
+
+	ad := models.Advert{Id: 2, Name: "aboba", Amount: 10, PublisherId: 5}
+	order := models.NewOrder(cart[0], &ad)
+
+	cu.On("GetCart", int64(0)).Return(cart, nil)
+	au.On("GetAdvert", int64(2), int64(0), false).Return(&ad, nil)
+	cu.On("MakeOrders", cart, []*models.Advert{&ad}, models.DeliveryPickup, (*models.Address)(nil)).Return([]*models.Order{order}, []string{"ok"}, nil)
+	uu.On("GetById", int64(5)).Return(nil, myerr.InternalError)
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/cart/checkout", srv.URL), nil)
+	assert.Nil(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, Answer.Code, 500)
+	assert.Equal(t, Answer.Message, "internal error")
+}
+
+func TestGuestCartUpdateOneSuccess(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	cu := cartMock.CartUsecase{}
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
+
+	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
+	router.Use(middleware.LoggerMiddleware)
+	router.Handle("/one", middleware.GuestCart(http.HandlerFunc(ch.UpdateOneAdvertHandler))).Methods(http.MethodPost, http.MethodOptions)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	cartHandler := models.CartHandler{
+		AdvertId: 2,
+		Amount:   3,
+	}
+
+	ad := models.Advert{
+		Id:     cartHandler.AdvertId,
+		Name:   "aboba",
+		Amount: 5,
+	}
+
+	au.On("GetAdvert", cartHandler.AdvertId, int64(0), false).Return(&ad, nil)
+	cu.On("UpdateGuestCart", mock.AnythingOfType("string"), &cartHandler, ad.Amount).Return(models.NewCart(0, &cartHandler), nil)
+
+	reqBodyBuffer := new(bytes.Buffer)
+	err := json.NewEncoder(reqBodyBuffer).Encode(cartHandler)
+	assert.Nil(t, err)
+
+	res, err := http.Post(fmt.Sprintf("%s/cart/one", srv.URL), "application/json", reqBodyBuffer)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, Answer.Code, 200)
+	assert.Equal(t, Answer.Message, "successfully updated")
+	assert.Equal(t, middleware.GuestCartCookie, res.Cookies()[0].Name)
+	cu.AssertNotCalled(t, "UpdateCart", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGuestCartGetSuccess(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	cu := cartMock.CartUsecase{}
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
+
+	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
+	router.Handle("", middleware.GuestCart(http.HandlerFunc(ch.GetCartHandler))).Methods(http.MethodGet, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	cart := []*models.Cart{
+		{
+			AdvertId: 2,
+			Amount:   1,
+		},
+	}
+
+	ad := models.Advert{
+		Id:     int64(2),
+		Name:   "aboba",
+		Amount: 10,
+	}
+
+	cu.On("GetGuestCart", mock.AnythingOfType("string")).Return(cart, nil)
+	au.On("GetAdvert", int64(2), int64(0), false).Return(&ad, nil)
+
+	res, err := http.Get(fmt.Sprintf("%s/cart", srv.URL))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, Answer.Code, 200)
+	cu.AssertNotCalled(t, "GetCart", mock.Anything)
+}
+
+func TestGuestCartUpdateAllSuccess(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	cu := cartMock.CartUsecase{}
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
+
+	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
+	router.Handle("", middleware.GuestCart(http.HandlerFunc(ch.UpdateAllCartHandler))).Methods(http.MethodPost, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	cartInputed := models.CHs{
+		{AdvertId: 2, Amount: 1},
+	}
+
+	ad := models.Advert{
+		Id:     int64(2),
+		Name:   "aboba",
+		Amount: 10,
+	}
+
+	au.On("GetAdvert", int64(2), int64(0), false).Return(&ad, nil)
+	cu.On("UpdateAllGuestCart", mock.AnythingOfType("string"), []*models.CartHandler(cartInputed), []*models.Advert{&ad}).
+		Return([]*models.Cart{{AdvertId: 2, Amount: 1}}, []*models.Advert{&ad}, []string{"ok"}, nil)
+
+	reqBodyBuffer := new(bytes.Buffer)
+	err := json.NewEncoder(reqBodyBuffer).Encode(cartInputed)
+	assert.Nil(t, err)
+
+	res, err := http.Post(fmt.Sprintf("%s/cart", srv.URL), "application/json", reqBodyBuffer)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, Answer.Code, 200)
+	assert.Equal(t, Answer.Message, "successfully updated")
+}
+
+func TestApplyCouponSuccess(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	cu := cartMock.CartUsecase{}
+	cpu := couponMock.CouponUsecase{}
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &cpu)
+
+	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
+	router.HandleFunc("/{id:[0-9]+}/coupon", ch.ApplyCouponHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	ad := models.Advert{Id: 2, PublisherId: 5, Price: 100, Amount: 3}
+	coupon := models.Coupon{Id: 4, SalesmanId: 5, Code: "SALE10"}
+	cart := models.Cart{AdvertId: 2, Amount: 1, CouponId: 4}
+
+	au.On("GetAdvert", int64(2), int64(0), false).Return(&ad, nil)
+	cpu.On("FindCoupon", "sale10", &ad).Return(&coupon, nil)
+	cu.On("ApplyCoupon", int64(0), int64(2), &coupon).Return(&cart, nil)
+
+	res, err := http.Post(fmt.Sprintf("%s/cart/2/coupon", srv.URL), "application/json",
+		bytes.NewBufferString(`{"code": "sale10"}`))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "coupon applied", Answer.Message)
+}
+
+func TestApplyCouponFailExpired(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	cu := cartMock.CartUsecase{}
+	cpu := couponMock.CouponUsecase{}
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &cpu)
+
+	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
+	router.HandleFunc("/{id:[0-9]+}/coupon", ch.ApplyCouponHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	ad := models.Advert{Id: 2, PublisherId: 5, Price: 100, Amount: 3}
+	au.On("GetAdvert", int64(2), int64(0), false).Return(&ad, nil)
+	cpu.On("FindCoupon", "SALE10", &ad).Return(nil, myerr.CouponExpired)
+
+	res, err := http.Post(fmt.Sprintf("%s/cart/2/coupon", srv.URL), "application/json",
+		bytes.NewBufferString(`{"code": "SALE10"}`))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusConflict, Answer.Code)
+	assert.Equal(t, "coupon is not valid at this time", Answer.Message)
+	cu.AssertNotCalled(t, "ApplyCoupon", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestApplyCouponFailEmptyCode(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	cu := cartMock.CartUsecase{}
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
+
+	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
+	router.HandleFunc("/{id:[0-9]+}/coupon", ch.ApplyCouponHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	res, err := http.Post(fmt.Sprintf("%s/cart/2/coupon", srv.URL), "application/json", bytes.NewBufferString(`{}`))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, Answer.Code)
+}
+
+func TestRemoveCouponSuccess(t *testing.T) {
+	au := advtMock.AdvtUsecase{}
+	uu := userMock.UserUsecase{}
+	cu := cartMock.CartUsecase{}
+	ch := NewCartHandler(&cu, &uu, &au, &paymentMock.PaymentUsecase{}, &couponMock.CouponUsecase{})
+
+	router := mux.NewRouter().PathPrefix("/cart").Subrouter()
+	router.HandleFunc("/{id:[0-9]+}/coupon", ch.RemoveCouponHandler).Methods(http.MethodDelete, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	cu.On("RemoveCoupon", int64(0), int64(2)).Return(&models.Cart{AdvertId: 2, Amount: 1}, nil)
+
+	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/cart/2/coupon", srv.URL), nil)
+	assert.Nil(t, err)
+	res, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "coupon removed", Answer.Message)
+}
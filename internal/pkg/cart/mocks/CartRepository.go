@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	time "time"
 	models "yula/internal/models"
 
 	mock "github.com/stretchr/testify/mock"
@@ -13,6 +14,27 @@ type CartRepository struct {
 	mock.Mock
 }
 
+// Checkout provides a mock function with given fields: order
+func (_m *CartRepository) Checkout(order *models.Order) (int64, error) {
+	ret := _m.Called(order)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(*models.Order) int64); ok {
+		r0 = rf(order)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.Order) error); ok {
+		r1 = rf(order)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Delete provides a mock function with given fields: _a0
 func (_m *CartRepository) Delete(_a0 *models.Cart) error {
 	ret := _m.Called(_a0)
@@ -55,6 +77,57 @@ func (_m *CartRepository) Insert(_a0 *models.Cart) error {
 	return r0
 }
 
+// MergeGuest provides a mock function with given fields: token, userId
+func (_m *CartRepository) MergeGuest(token string, userId int64) error {
+	ret := _m.Called(token, userId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(token, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReleaseExpired provides a mock function with given fields:
+func (_m *CartRepository) ReleaseExpired() ([]*models.Cart, error) {
+	ret := _m.Called()
+
+	var r0 []*models.Cart
+	if rf, ok := ret.Get(0).(func() []*models.Cart); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Cart)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reserve provides a mock function with given fields: _a0, until
+func (_m *CartRepository) Reserve(_a0 *models.Cart, until time.Time) error {
+	ret := _m.Called(_a0, until)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Cart, time.Time) error); ok {
+		r0 = rf(_a0, until)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Select provides a mock function with given fields: userId, advertId
 func (_m *CartRepository) Select(userId int64, advertId int64) (*models.Cart, error) {
 	ret := _m.Called(userId, advertId)
@@ -101,6 +174,43 @@ func (_m *CartRepository) SelectAll(userId int64) ([]*models.Cart, error) {
 	return r0, r1
 }
 
+// SelectGuest provides a mock function with given fields: token
+func (_m *CartRepository) SelectGuest(token string) ([]*models.Cart, error) {
+	ret := _m.Called(token)
+
+	var r0 []*models.Cart
+	if rf, ok := ret.Get(0).(func(string) []*models.Cart); ok {
+		r0 = rf(token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Cart)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetCoupon provides a mock function with given fields: _a0
+func (_m *CartRepository) SetCoupon(_a0 *models.Cart) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Cart) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: _a0
 func (_m *CartRepository) Update(_a0 *models.Cart) error {
 	ret := _m.Called(_a0)
@@ -114,3 +224,17 @@ func (_m *CartRepository) Update(_a0 *models.Cart) error {
 
 	return r0
 }
+
+// UpdateGuest provides a mock function with given fields: token, _a1
+func (_m *CartRepository) UpdateGuest(token string, _a1 *models.Cart) error {
+	ret := _m.Called(token, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *models.Cart) error); ok {
+		r0 = rf(token, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
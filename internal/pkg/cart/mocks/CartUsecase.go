@@ -1,9 +1,12 @@
-// Code generated by mockery v1.0.0. DO NOT EDIT.
+// Code generated by mockery v2.9.4. DO NOT EDIT.
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
-import models "yula/internal/models"
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // CartUsecase is an autogenerated mock type for the CartUsecase type
 type CartUsecase struct {
@@ -24,6 +27,29 @@ func (_m *CartUsecase) AddToCart(userId int64, singleCart *models.CartHandler) e
 	return r0
 }
 
+// ApplyCoupon provides a mock function with given fields: userId, advertId, coupon
+func (_m *CartUsecase) ApplyCoupon(userId int64, advertId int64, coupon *models.Coupon) (*models.Cart, error) {
+	ret := _m.Called(userId, advertId, coupon)
+
+	var r0 *models.Cart
+	if rf, ok := ret.Get(0).(func(int64, int64, *models.Coupon) *models.Cart); ok {
+		r0 = rf(userId, advertId, coupon)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Cart)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64, *models.Coupon) error); ok {
+		r1 = rf(userId, advertId, coupon)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ClearAllCart provides a mock function with given fields: userId
 func (_m *CartUsecase) ClearAllCart(userId int64) error {
 	ret := _m.Called(userId)
@@ -61,6 +87,29 @@ func (_m *CartUsecase) GetCart(userId int64) ([]*models.Cart, error) {
 	return r0, r1
 }
 
+// GetGuestCart provides a mock function with given fields: token
+func (_m *CartUsecase) GetGuestCart(token string) ([]*models.Cart, error) {
+	ret := _m.Called(token)
+
+	var r0 []*models.Cart
+	if rf, ok := ret.Get(0).(func(string) []*models.Cart); ok {
+		r0 = rf(token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Cart)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetOrderFromCart provides a mock function with given fields: userId, advertId
 func (_m *CartUsecase) GetOrderFromCart(userId int64, advertId int64) (*models.Cart, error) {
 	ret := _m.Called(userId, advertId)
@@ -84,13 +133,68 @@ func (_m *CartUsecase) GetOrderFromCart(userId int64, advertId int64) (*models.C
 	return r0, r1
 }
 
-// MakeOrder provides a mock function with given fields: order, advert
-func (_m *CartUsecase) MakeOrder(order *models.Cart, advert *models.Advert) error {
-	ret := _m.Called(order, advert)
+// MakeOrder provides a mock function with given fields: order, advert, method, address
+func (_m *CartUsecase) MakeOrder(order *models.Cart, advert *models.Advert, method string, address *models.Address) (*models.Order, error) {
+	ret := _m.Called(order, advert, method, address)
+
+	var r0 *models.Order
+	if rf, ok := ret.Get(0).(func(*models.Cart, *models.Advert, string, *models.Address) *models.Order); ok {
+		r0 = rf(order, advert, method, address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Order)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.Cart, *models.Advert, string, *models.Address) error); ok {
+		r1 = rf(order, advert, method, address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MakeOrders provides a mock function with given fields: _a0, adverts, method, address
+func (_m *CartUsecase) MakeOrders(_a0 []*models.Cart, adverts []*models.Advert, method string, address *models.Address) ([]*models.Order, []string, error) {
+	ret := _m.Called(_a0, adverts, method, address)
+
+	var r0 []*models.Order
+	if rf, ok := ret.Get(0).(func([]*models.Cart, []*models.Advert, string, *models.Address) []*models.Order); ok {
+		r0 = rf(_a0, adverts, method, address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Order)
+		}
+	}
+
+	var r1 []string
+	if rf, ok := ret.Get(1).(func([]*models.Cart, []*models.Advert, string, *models.Address) []string); ok {
+		r1 = rf(_a0, adverts, method, address)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func([]*models.Cart, []*models.Advert, string, *models.Address) error); ok {
+		r2 = rf(_a0, adverts, method, address)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MergeGuestCart provides a mock function with given fields: token, userId
+func (_m *CartUsecase) MergeGuestCart(token string, userId int64) error {
+	ret := _m.Called(token, userId)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*models.Cart, *models.Advert) error); ok {
-		r0 = rf(order, advert)
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(token, userId)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -98,6 +202,52 @@ func (_m *CartUsecase) MakeOrder(order *models.Cart, advert *models.Advert) erro
 	return r0
 }
 
+// ReleaseExpiredReservations provides a mock function with given fields:
+func (_m *CartUsecase) ReleaseExpiredReservations() ([]*models.Cart, error) {
+	ret := _m.Called()
+
+	var r0 []*models.Cart
+	if rf, ok := ret.Get(0).(func() []*models.Cart); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Cart)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveCoupon provides a mock function with given fields: userId, advertId
+func (_m *CartUsecase) RemoveCoupon(userId int64, advertId int64) (*models.Cart, error) {
+	ret := _m.Called(userId, advertId)
+
+	var r0 *models.Cart
+	if rf, ok := ret.Get(0).(func(int64, int64) *models.Cart); ok {
+		r0 = rf(userId, advertId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Cart)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(userId, advertId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveFromCart provides a mock function with given fields: userId, advertId
 func (_m *CartUsecase) RemoveFromCart(userId int64, advertId int64) error {
 	ret := _m.Called(userId, advertId)
@@ -112,6 +262,29 @@ func (_m *CartUsecase) RemoveFromCart(userId int64, advertId int64) error {
 	return r0
 }
 
+// ReserveCart provides a mock function with given fields: userId, advertId
+func (_m *CartUsecase) ReserveCart(userId int64, advertId int64) (*models.Cart, error) {
+	ret := _m.Called(userId, advertId)
+
+	var r0 *models.Cart
+	if rf, ok := ret.Get(0).(func(int64, int64) *models.Cart); ok {
+		r0 = rf(userId, advertId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Cart)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(userId, advertId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateAllCart provides a mock function with given fields: userId, _a1, adverts
 func (_m *CartUsecase) UpdateAllCart(userId int64, _a1 []*models.CartHandler, adverts []*models.Advert) ([]*models.Cart, []*models.Advert, []string, error) {
 	ret := _m.Called(userId, _a1, adverts)
@@ -153,6 +326,47 @@ func (_m *CartUsecase) UpdateAllCart(userId int64, _a1 []*models.CartHandler, ad
 	return r0, r1, r2, r3
 }
 
+// UpdateAllGuestCart provides a mock function with given fields: token, _a1, adverts
+func (_m *CartUsecase) UpdateAllGuestCart(token string, _a1 []*models.CartHandler, adverts []*models.Advert) ([]*models.Cart, []*models.Advert, []string, error) {
+	ret := _m.Called(token, _a1, adverts)
+
+	var r0 []*models.Cart
+	if rf, ok := ret.Get(0).(func(string, []*models.CartHandler, []*models.Advert) []*models.Cart); ok {
+		r0 = rf(token, _a1, adverts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Cart)
+		}
+	}
+
+	var r1 []*models.Advert
+	if rf, ok := ret.Get(1).(func(string, []*models.CartHandler, []*models.Advert) []*models.Advert); ok {
+		r1 = rf(token, _a1, adverts)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*models.Advert)
+		}
+	}
+
+	var r2 []string
+	if rf, ok := ret.Get(2).(func(string, []*models.CartHandler, []*models.Advert) []string); ok {
+		r2 = rf(token, _a1, adverts)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).([]string)
+		}
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(string, []*models.CartHandler, []*models.Advert) error); ok {
+		r3 = rf(token, _a1, adverts)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
 // UpdateCart provides a mock function with given fields: userId, singleCart, maxAmount
 func (_m *CartUsecase) UpdateCart(userId int64, singleCart *models.CartHandler, maxAmount int64) (*models.Cart, error) {
 	ret := _m.Called(userId, singleCart, maxAmount)
@@ -175,3 +389,26 @@ func (_m *CartUsecase) UpdateCart(userId int64, singleCart *models.CartHandler,
 
 	return r0, r1
 }
+
+// UpdateGuestCart provides a mock function with given fields: token, singleCart, maxAmount
+func (_m *CartUsecase) UpdateGuestCart(token string, singleCart *models.CartHandler, maxAmount int64) (*models.Cart, error) {
+	ret := _m.Called(token, singleCart, maxAmount)
+
+	var r0 *models.Cart
+	if rf, ok := ret.Get(0).(func(string, *models.CartHandler, int64) *models.Cart); ok {
+		r0 = rf(token, singleCart, maxAmount)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Cart)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *models.CartHandler, int64) error); ok {
+		r1 = rf(token, singleCart, maxAmount)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -1,6 +1,9 @@
 package cart
 
-import "yula/internal/models"
+import (
+	"time"
+	"yula/internal/models"
+)
 
 //go:generate mockery -name=CartRepository
 
@@ -12,4 +15,13 @@ type CartRepository interface {
 
 	SelectAll(userId int64) ([]*models.Cart, error)
 	DeleteAll(userId int64) error
+
+	Checkout(order *models.Order) (int64, error)
+	Reserve(cart *models.Cart, until time.Time) error
+	SetCoupon(cart *models.Cart) error
+	ReleaseExpired() ([]*models.Cart, error)
+
+	SelectGuest(token string) ([]*models.Cart, error)
+	UpdateGuest(token string, cart *models.Cart) error
+	MergeGuest(token string, userId int64) error
 }
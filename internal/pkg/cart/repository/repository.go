@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"log"
 	"regexp"
+	"sort"
+	"time"
 	internalError "yula/internal/error"
 	"yula/internal/models"
 	"yula/internal/pkg/cart"
@@ -21,10 +23,12 @@ func NewCartRepository(DB *sql.DB) cart.CartRepository {
 }
 
 func (cr *CartRepository) Select(userId int64, advertId int64) (*models.Cart, error) {
-	queryStr := "SELECT user_id, advert_id, amount FROM cart WHERE user_id = $1 AND advert_id = $2;"
+	queryStr := `SELECT user_id, advert_id, amount, reserved_until, COALESCE(coupon_id, 0)
+				FROM cart WHERE user_id = $1 AND advert_id = $2;`
 	query := cr.DB.QueryRowContext(context.Background(), queryStr, userId, advertId)
 	var oneInCart models.Cart
-	err := query.Scan(&oneInCart.UserId, &oneInCart.AdvertId, &oneInCart.Amount)
+	var reservedUntil sql.NullTime
+	err := query.Scan(&oneInCart.UserId, &oneInCart.AdvertId, &oneInCart.Amount, &reservedUntil, &oneInCart.CouponId)
 	if err != nil {
 		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
 		if res {
@@ -33,11 +37,15 @@ func (cr *CartRepository) Select(userId int64, advertId int64) (*models.Cart, er
 			return nil, internalError.GenInternalError(err)
 		}
 	}
+
+	if reservedUntil.Valid {
+		oneInCart.ReservedUntil = &reservedUntil.Time
+	}
 	return &oneInCart, nil
 }
 
 func (cr *CartRepository) SelectAll(userId int64) ([]*models.Cart, error) {
-	queryStr := "SELECT user_id, advert_id, amount FROM cart WHERE user_id = $1;"
+	queryStr := "SELECT user_id, advert_id, amount, reserved_until, COALESCE(coupon_id, 0) FROM cart WHERE user_id = $1;"
 	query, err := cr.DB.QueryContext(context.Background(), queryStr, userId)
 	if err != nil {
 		return nil, internalError.GenInternalError(err)
@@ -47,12 +55,16 @@ func (cr *CartRepository) SelectAll(userId int64) ([]*models.Cart, error) {
 	cart := make([]*models.Cart, 0)
 	for query.Next() {
 		var oneInCart models.Cart
+		var reservedUntil sql.NullTime
 
-		err = query.Scan(&oneInCart.UserId, &oneInCart.AdvertId, &oneInCart.Amount)
+		err = query.Scan(&oneInCart.UserId, &oneInCart.AdvertId, &oneInCart.Amount, &reservedUntil, &oneInCart.CouponId)
 		if err != nil {
 			return nil, internalError.GenInternalError(err)
 		}
 
+		if reservedUntil.Valid {
+			oneInCart.ReservedUntil = &reservedUntil.Time
+		}
 		cart = append(cart, &oneInCart)
 	}
 
@@ -171,3 +183,301 @@ func (cr *CartRepository) DeleteAll(userId int64) error {
 
 	return nil
 }
+
+func (cr *CartRepository) rollback(tx *sql.Tx, err error) error {
+	rollbackErr := tx.Rollback()
+	if rollbackErr != nil {
+		return internalError.RollbackError
+	}
+	return err
+}
+
+const (
+	// остаток объявления за вычетом чужих действующих резервов, строка объявления блокируется
+	availableAmountQuery string = `
+		SELECT a.amount - COALESCE((
+			SELECT SUM(c.amount) FROM cart c
+			WHERE c.advert_id = a.id AND c.user_id <> $2 AND c.reserved_until > CURRENT_TIMESTAMP
+		), 0)
+		FROM advert a
+		WHERE a.id = $1 AND a.status = 'published'
+		FOR UPDATE OF a;
+	`
+
+	// засчитываем использование купона, если его срок и лимит еще позволяют
+	useCouponQuery string = `
+		UPDATE coupon SET used = used + 1
+		WHERE id = $1 AND (usage_limit = 0 OR used < usage_limit)
+			AND CURRENT_TIMESTAMP BETWEEN valid_from AND valid_until
+		RETURNING used;
+	`
+)
+
+// Checkout оформляет заказ одной транзакцией. Если заказ не прошел из-за одной позиции
+// (нет остатка, объявление снято, купон исчерпан), возвращается id ее объявления
+func (cr *CartRepository) Checkout(order *models.Order) (int64, error) {
+	tx, err := cr.DB.BeginTx(context.Background(), nil)
+	if err != nil {
+		return 0, internalError.GenInternalError(err)
+	}
+
+	// блокируем объявления в одном порядке, чтобы параллельные оформления не взаимоблокировались
+	sort.Slice(order.Lines, func(i, j int) bool {
+		return order.Lines[i].AdvertId < order.Lines[j].AdvertId
+	})
+
+	for _, line := range order.Lines {
+		var amount int64
+		query := tx.QueryRowContext(context.Background(), availableAmountQuery, line.AdvertId, order.BuyerId)
+		err = query.Scan(&amount)
+		if err != nil {
+			res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+			if res {
+				return line.AdvertId, cr.rollback(tx, internalError.EmptyQuery)
+			}
+			return 0, cr.rollback(tx, internalError.GenInternalError(err))
+		}
+
+		if line.Amount > amount {
+			return line.AdvertId, cr.rollback(tx, internalError.NotEnoughCopies)
+		}
+
+		// распроданное объявление закрываем
+		_, err = tx.ExecContext(context.Background(),
+			`UPDATE advert SET amount = amount - $2, is_active = amount - $2 > 0,
+				status = CASE WHEN amount - $2 > 0 THEN status ELSE 'closed' END,
+				close_reason = CASE WHEN amount - $2 > 0 THEN close_reason ELSE 'sold_out' END,
+				date_close = CASE WHEN amount - $2 > 0 THEN date_close ELSE CURRENT_TIMESTAMP END
+			WHERE id = $1;`,
+			line.AdvertId, line.Amount)
+		if err != nil {
+			return 0, cr.rollback(tx, internalError.GenInternalError(err))
+		}
+
+		_, err = tx.ExecContext(context.Background(),
+			"DELETE FROM cart WHERE user_id = $1 AND advert_id = $2;", order.BuyerId, line.AdvertId)
+		if err != nil {
+			return 0, cr.rollback(tx, internalError.GenInternalError(err))
+		}
+	}
+
+	queryStr := `INSERT INTO orders (buyer_id, salesman_id, status, delivery_method, delivery_address) 
+				VALUES ($1, $2, $3, $4, $5) RETURNING id, created_at, updated_at;`
+	query := tx.QueryRowContext(context.Background(), queryStr, order.BuyerId, order.SalesmanId, order.Status,
+		order.DeliveryMethod, order.DeliveryAddress)
+	if err := query.Scan(&order.Id, &order.CreatedAt, &order.UpdatedAt); err != nil {
+		return 0, cr.rollback(tx, internalError.GenInternalError(err))
+	}
+
+	// купон привязан к продавцу, а заказ оформляется на одного продавца, поэтому использование
+	// засчитывается один раз на заказ, сколько бы его позиций ни было со скидкой
+	usedCoupons := make(map[int64]bool)
+	for _, line := range order.Lines {
+		line.OrderId = order.Id
+
+		_, err = tx.ExecContext(context.Background(),
+			`INSERT INTO order_line (order_id, advert_id, amount, price, list_price, coupon_id)
+			VALUES ($1, $2, $3, $4, $5, NULLIF($6, 0));`,
+			line.OrderId, line.AdvertId, line.Amount, line.Price, line.ListPrice, line.CouponId)
+		if err != nil {
+			return 0, cr.rollback(tx, internalError.GenInternalError(err))
+		}
+
+		if line.CouponId == 0 || usedCoupons[line.CouponId] {
+			continue
+		}
+		usedCoupons[line.CouponId] = true
+
+		// использование купона засчитывается только вместе с заказом
+		var used int64
+		query := tx.QueryRowContext(context.Background(), useCouponQuery, line.CouponId)
+		err = query.Scan(&used)
+		if err != nil {
+			res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+			if res {
+				return line.AdvertId, cr.rollback(tx, internalError.CouponExhausted)
+			}
+			return 0, cr.rollback(tx, internalError.GenInternalError(err))
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, internalError.NotCommited
+	}
+
+	return 0, nil
+}
+
+func (cr *CartRepository) SelectGuest(token string) ([]*models.Cart, error) {
+	queryStr := "SELECT advert_id, amount FROM guest_cart WHERE token = $1 ORDER BY created_at;"
+	query, err := cr.DB.QueryContext(context.Background(), queryStr, token)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+
+	defer query.Close()
+	cart := make([]*models.Cart, 0)
+	for query.Next() {
+		var oneInCart models.Cart
+
+		err = query.Scan(&oneInCart.AdvertId, &oneInCart.Amount)
+		if err != nil {
+			return nil, internalError.GenInternalError(err)
+		}
+
+		cart = append(cart, &oneInCart)
+	}
+
+	return cart, nil
+}
+
+func (cr *CartRepository) UpdateGuest(token string, cart *models.Cart) error {
+	tx, err := cr.DB.BeginTx(context.Background(), nil)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	_, err = tx.ExecContext(context.Background(),
+		"DELETE FROM guest_cart WHERE token = $1 AND advert_id = $2;", token, cart.AdvertId)
+	if err != nil {
+		return cr.rollback(tx, internalError.GenInternalError(err))
+	}
+
+	// нулевое количество означает удаление позиции
+	if cart.Amount > 0 {
+		_, err = tx.ExecContext(context.Background(),
+			"INSERT INTO guest_cart (token, advert_id, amount) VALUES ($1, $2, $3);",
+			token, cart.AdvertId, cart.Amount)
+		if err != nil {
+			return cr.rollback(tx, internalError.GenInternalError(err))
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return internalError.NotCommited
+	}
+
+	return nil
+}
+
+func (cr *CartRepository) MergeGuest(token string, userId int64) error {
+	tx, err := cr.DB.BeginTx(context.Background(), nil)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	// совпавшие позиции складываем, не превышая остаток объявления
+	_, err = tx.ExecContext(context.Background(),
+		`UPDATE cart c SET amount = LEAST(c.amount + g.amount, a.amount)
+		FROM guest_cart g JOIN advert a ON a.id = g.advert_id
+		WHERE g.token = $1 AND c.user_id = $2 AND c.advert_id = g.advert_id;`,
+		token, userId)
+	if err != nil {
+		return cr.rollback(tx, internalError.GenInternalError(err))
+	}
+
+	_, err = tx.ExecContext(context.Background(),
+		`INSERT INTO cart (user_id, advert_id, amount)
+		SELECT $2, g.advert_id, LEAST(g.amount, a.amount)
+		FROM guest_cart g JOIN advert a ON a.id = g.advert_id
+		WHERE g.token = $1 AND a.status = 'published' AND a.amount > 0 AND a.publisher_id <> $2
+			AND NOT EXISTS (SELECT 1 FROM cart c WHERE c.user_id = $2 AND c.advert_id = g.advert_id);`,
+		token, userId)
+	if err != nil {
+		return cr.rollback(tx, internalError.GenInternalError(err))
+	}
+
+	_, err = tx.ExecContext(context.Background(), "DELETE FROM guest_cart WHERE token = $1;", token)
+	if err != nil {
+		return cr.rollback(tx, internalError.GenInternalError(err))
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return internalError.NotCommited
+	}
+
+	return nil
+}
+
+func (cr *CartRepository) Reserve(cart *models.Cart, until time.Time) error {
+	tx, err := cr.DB.BeginTx(context.Background(), nil)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	var amount int64
+	query := tx.QueryRowContext(context.Background(), availableAmountQuery, cart.AdvertId, cart.UserId)
+	err = query.Scan(&amount)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return cr.rollback(tx, internalError.EmptyQuery)
+		}
+		return cr.rollback(tx, internalError.GenInternalError(err))
+	}
+
+	if cart.Amount > amount {
+		return cr.rollback(tx, internalError.SetMaxCopies(amount))
+	}
+
+	ct, err := tx.ExecContext(context.Background(),
+		"UPDATE cart SET reserved_until = $3 WHERE user_id = $1 AND advert_id = $2;",
+		cart.UserId, cart.AdvertId, until)
+	if err != nil {
+		return cr.rollback(tx, internalError.GenInternalError(err))
+	}
+
+	if ra, _ := ct.RowsAffected(); ra == 0 {
+		return cr.rollback(tx, internalError.EmptyQuery)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return internalError.NotCommited
+	}
+
+	cart.ReservedUntil = &until
+	return nil
+}
+
+func (cr *CartRepository) ReleaseExpired() ([]*models.Cart, error) {
+	queryStr := `UPDATE cart SET reserved_until = NULL WHERE reserved_until <= CURRENT_TIMESTAMP
+				RETURNING user_id, advert_id, amount;`
+	query, err := cr.DB.QueryContext(context.Background(), queryStr)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+
+	defer query.Close()
+	lapsed := make([]*models.Cart, 0)
+	for query.Next() {
+		var oneInCart models.Cart
+
+		err = query.Scan(&oneInCart.UserId, &oneInCart.AdvertId, &oneInCart.Amount)
+		if err != nil {
+			return nil, internalError.GenInternalError(err)
+		}
+
+		lapsed = append(lapsed, &oneInCart)
+	}
+
+	return lapsed, nil
+}
+
+func (cr *CartRepository) SetCoupon(cart *models.Cart) error {
+	ct, err := cr.DB.ExecContext(context.Background(),
+		"UPDATE cart SET coupon_id = NULLIF($3, 0) WHERE user_id = $1 AND advert_id = $2;",
+		cart.UserId, cart.AdvertId, cart.CouponId)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	if ra, _ := ct.RowsAffected(); ra == 0 {
+		return internalError.EmptyQuery
+	}
+
+	return nil
+}
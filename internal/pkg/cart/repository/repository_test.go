@@ -1,9 +1,11 @@
 package repository
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"testing"
 	"time"
+	internalError "yula/internal/error"
 	"yula/internal/models"
 
 	"github.com/stretchr/testify/assert"
@@ -47,11 +49,13 @@ func TestSelectOk(t *testing.T) {
 
 	repo := NewCartRepository(db)
 
-	rows := sqlmock.NewRows([]string{"user_id", "advert_id", "amount"}).AddRow(testuserid, testadvert.Id, testadvert.Amount)
+	rows := sqlmock.NewRows([]string{"user_id", "advert_id", "amount", "reserved_until", "coupon_id"}).
+		AddRow(testuserid, testadvert.Id, testadvert.Amount, ParseTime(), 0)
 	mock.ExpectQuery("SELECT").WithArgs(testuserid, testadvert.Id).WillReturnRows(rows)
 
-	_, err = repo.Select(testuserid, testadvert.Id)
+	oneInCart, err := repo.Select(testuserid, testadvert.Id)
 	assert.NoError(t, err)
+	assert.NotNil(t, oneInCart.ReservedUntil)
 	err = mock.ExpectationsWereMet()
 	assert.Nil(t, err)
 }
@@ -82,7 +86,8 @@ func TestSelectAllOk(t *testing.T) {
 
 	repo := NewCartRepository(db)
 
-	rows := sqlmock.NewRows([]string{"user_id", "advert_id", "amount"}).AddRow(testuserid, testadvert.Id, testadvert.Amount)
+	rows := sqlmock.NewRows([]string{"user_id", "advert_id", "amount", "reserved_until", "coupon_id"}).
+		AddRow(testuserid, testadvert.Id, testadvert.Amount, nil, 4)
 	mock.ExpectQuery("SELECT").WithArgs(testuserid).WillReturnRows(rows)
 
 	_, err = repo.SelectAll(testuserid)
@@ -284,3 +289,550 @@ func TestDeleteAllError2(t *testing.T) {
 	err = mock.ExpectationsWereMet()
 	assert.Nil(t, err)
 }
+
+func newTestCheckoutOrder() *models.Order {
+	return models.NewOrder(&models.Cart{UserId: testuserid, AdvertId: 3, Amount: 2},
+		&models.Advert{Id: 3, PublisherId: 2, Price: 100, Amount: 5})
+}
+
+func TestCheckoutOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+	order := newTestCheckoutOrder()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT a.amount").WithArgs(int64(3), testuserid).
+		WillReturnRows(sqlmock.NewRows([]string{"amount"}).AddRow(5))
+	mock.ExpectExec("UPDATE advert").WithArgs(int64(3), int64(2)).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM cart").WithArgs(testuserid, int64(3)).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("INSERT INTO orders").WithArgs(testuserid, int64(2), models.OrderStatusCreated,
+		models.DeliveryPickup, "").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(7, ParseTime(), ParseTime()))
+	mock.ExpectExec("INSERT INTO order_line").WithArgs(int64(7), int64(3), int64(2), int64(100), int64(100), int64(0)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	_, err = repo.Checkout(order)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), order.Id)
+	assert.Equal(t, int64(7), order.Lines[0].OrderId)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestCheckoutWithCoupon(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+	order := newTestCheckoutOrder()
+	order.Lines[0].Price = 90
+	order.Lines[0].CouponId = 4
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT a.amount").WithArgs(int64(3), testuserid).
+		WillReturnRows(sqlmock.NewRows([]string{"amount"}).AddRow(5))
+	mock.ExpectExec("UPDATE advert").WithArgs(int64(3), int64(2)).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM cart").WithArgs(testuserid, int64(3)).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("INSERT INTO orders").WithArgs(testuserid, int64(2), models.OrderStatusCreated,
+		models.DeliveryPickup, "").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(7, ParseTime(), ParseTime()))
+	mock.ExpectExec("INSERT INTO order_line").WithArgs(int64(7), int64(3), int64(2), int64(90), int64(100), int64(4)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("UPDATE coupon SET used").WithArgs(int64(4)).
+		WillReturnRows(sqlmock.NewRows([]string{"used"}).AddRow(1))
+	mock.ExpectCommit()
+
+	_, err = repo.Checkout(order)
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestCheckoutCouponUsedOncePerOrder(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+	order := newTestCheckoutOrder()
+	second := models.NewOrder(&models.Cart{UserId: testuserid, AdvertId: 5, Amount: 1},
+		&models.Advert{Id: 5, PublisherId: 2, Price: 200, Amount: 1})
+	order.Lines = append(order.Lines, second.Lines...)
+	order.Lines[0].Price, order.Lines[0].CouponId = 90, 4
+	order.Lines[1].Price, order.Lines[1].CouponId = 180, 4
+
+	mock.ExpectBegin()
+	for _, line := range order.Lines {
+		mock.ExpectQuery("SELECT a.amount").WithArgs(line.AdvertId, testuserid).
+			WillReturnRows(sqlmock.NewRows([]string{"amount"}).AddRow(5))
+		mock.ExpectExec("UPDATE advert").WithArgs(line.AdvertId, line.Amount).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("DELETE FROM cart").WithArgs(testuserid, line.AdvertId).WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+	mock.ExpectQuery("INSERT INTO orders").WithArgs(testuserid, int64(2), models.OrderStatusCreated,
+		models.DeliveryPickup, "").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(7, ParseTime(), ParseTime()))
+	mock.ExpectExec("INSERT INTO order_line").WithArgs(int64(7), int64(3), int64(2), int64(90), int64(100), int64(4)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("UPDATE coupon SET used").WithArgs(int64(4)).
+		WillReturnRows(sqlmock.NewRows([]string{"used"}).AddRow(1))
+	mock.ExpectExec("INSERT INTO order_line").WithArgs(int64(7), int64(5), int64(1), int64(180), int64(200), int64(4)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	_, err = repo.Checkout(order)
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestCheckoutCouponExhausted(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+	order := newTestCheckoutOrder()
+	order.Lines[0].Price = 90
+	order.Lines[0].CouponId = 4
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT a.amount").WithArgs(int64(3), testuserid).
+		WillReturnRows(sqlmock.NewRows([]string{"amount"}).AddRow(5))
+	mock.ExpectExec("UPDATE advert").WithArgs(int64(3), int64(2)).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM cart").WithArgs(testuserid, int64(3)).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("INSERT INTO orders").WithArgs(testuserid, int64(2), models.OrderStatusCreated,
+		models.DeliveryPickup, "").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(7, ParseTime(), ParseTime()))
+	mock.ExpectExec("INSERT INTO order_line").WithArgs(int64(7), int64(3), int64(2), int64(90), int64(100), int64(4)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("UPDATE coupon SET used").WithArgs(int64(4)).
+		WillReturnRows(sqlmock.NewRows([]string{"used"}))
+	mock.ExpectRollback()
+
+	failedAdvertId, err := repo.Checkout(order)
+	assert.Equal(t, internalError.CouponExhausted, err)
+	assert.Equal(t, int64(3), failedAdvertId)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestCheckoutNotEnoughCopies(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+	order := newTestCheckoutOrder()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT a.amount").WithArgs(int64(3), testuserid).
+		WillReturnRows(sqlmock.NewRows([]string{"amount"}).AddRow(1))
+	mock.ExpectRollback()
+
+	failedAdvertId, err := repo.Checkout(order)
+	assert.Equal(t, internalError.NotEnoughCopies, err)
+	assert.Equal(t, int64(3), failedAdvertId)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestCheckoutClosedAdvert(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+	order := newTestCheckoutOrder()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT a.amount").WithArgs(int64(3), testuserid).
+		WillReturnRows(sqlmock.NewRows([]string{"amount"}))
+	mock.ExpectRollback()
+
+	failedAdvertId, err := repo.Checkout(order)
+	assert.Equal(t, internalError.EmptyQuery, err)
+	assert.Equal(t, int64(3), failedAdvertId)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestCheckoutDeleteError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+	order := newTestCheckoutOrder()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT a.amount").WithArgs(int64(3), testuserid).
+		WillReturnRows(sqlmock.NewRows([]string{"amount"}).AddRow(5))
+	mock.ExpectExec("UPDATE advert").WithArgs(int64(3), int64(2)).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM cart").WithArgs(testuserid, int64(3)).WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback().WillReturnError(sql.ErrTxDone)
+
+	_, err = repo.Checkout(order)
+	assert.Equal(t, internalError.RollbackError, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestCheckoutInsertOrderError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+	order := newTestCheckoutOrder()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT a.amount").WithArgs(int64(3), testuserid).
+		WillReturnRows(sqlmock.NewRows([]string{"amount"}).AddRow(5))
+	mock.ExpectExec("UPDATE advert").WithArgs(int64(3), int64(2)).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM cart").WithArgs(testuserid, int64(3)).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("INSERT INTO orders").WithArgs(testuserid, int64(2), models.OrderStatusCreated,
+		models.DeliveryPickup, "").
+		WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	_, err = repo.Checkout(order)
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestCheckoutCommitError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+	order := newTestCheckoutOrder()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT a.amount").WithArgs(int64(3), testuserid).
+		WillReturnRows(sqlmock.NewRows([]string{"amount"}).AddRow(5))
+	mock.ExpectExec("UPDATE advert").WithArgs(int64(3), int64(2)).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM cart").WithArgs(testuserid, int64(3)).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("INSERT INTO orders").WithArgs(testuserid, int64(2), models.OrderStatusCreated,
+		models.DeliveryPickup, "").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(7, ParseTime(), ParseTime()))
+	mock.ExpectExec("INSERT INTO order_line").WithArgs(int64(7), int64(3), int64(2), int64(100), int64(100), int64(0)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit().WillReturnError(sql.ErrTxDone)
+
+	_, err = repo.Checkout(order)
+	assert.Equal(t, internalError.NotCommited, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestReserveOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+	oneInCart := &models.Cart{UserId: testuserid, AdvertId: 3, Amount: 2}
+	until := ParseTime()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT a.amount").WithArgs(int64(3), testuserid).
+		WillReturnRows(sqlmock.NewRows([]string{"amount"}).AddRow(5))
+	mock.ExpectExec("UPDATE cart SET reserved_until").WithArgs(testuserid, int64(3), until).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = repo.Reserve(oneInCart, until)
+	assert.NoError(t, err)
+	assert.Equal(t, until, *oneInCart.ReservedUntil)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestReserveNotEnoughCopies(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+	oneInCart := &models.Cart{UserId: testuserid, AdvertId: 3, Amount: 2}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT a.amount").WithArgs(int64(3), testuserid).
+		WillReturnRows(sqlmock.NewRows([]string{"amount"}).AddRow(1))
+	mock.ExpectRollback()
+
+	err = repo.Reserve(oneInCart, ParseTime())
+	assert.Equal(t, internalError.SetMaxCopies(1), err)
+	assert.Nil(t, oneInCart.ReservedUntil)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestReserveNotInCart(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+	oneInCart := &models.Cart{UserId: testuserid, AdvertId: 3, Amount: 2}
+	until := ParseTime()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT a.amount").WithArgs(int64(3), testuserid).
+		WillReturnRows(sqlmock.NewRows([]string{"amount"}).AddRow(5))
+	mock.ExpectExec("UPDATE cart SET reserved_until").WithArgs(testuserid, int64(3), until).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err = repo.Reserve(oneInCart, until)
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestReleaseExpiredOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+
+	rows := sqlmock.NewRows([]string{"user_id", "advert_id", "amount"}).
+		AddRow(testuserid, 3, 2).AddRow(int64(4), 3, 1)
+	mock.ExpectQuery("UPDATE cart SET reserved_until = NULL").WillReturnRows(rows)
+
+	lapsed, err := repo.ReleaseExpired()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(lapsed))
+	assert.Equal(t, int64(4), lapsed[1].UserId)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestReleaseExpiredError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+
+	mock.ExpectQuery("UPDATE cart SET reserved_until = NULL").WillReturnError(sql.ErrConnDone)
+
+	_, err = repo.ReleaseExpired()
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+var testguesttoken = "3b2c6f9e-guest"
+
+func TestSelectGuestOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+
+	rows := sqlmock.NewRows([]string{"advert_id", "amount"}).AddRow(testadvert.Id, testadvert.Amount)
+	mock.ExpectQuery("SELECT advert_id, amount FROM guest_cart").WithArgs(testguesttoken).WillReturnRows(rows)
+
+	cart, err := repo.SelectGuest(testguesttoken)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(cart))
+	assert.Equal(t, int64(0), cart[0].UserId)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectGuestError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+
+	mock.ExpectQuery("SELECT advert_id, amount FROM guest_cart").WithArgs(testguesttoken).WillReturnError(sql.ErrConnDone)
+
+	_, err = repo.SelectGuest(testguesttoken)
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateGuestOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+	cart := &models.Cart{AdvertId: 3, Amount: 2}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM guest_cart").WithArgs(testguesttoken, cart.AdvertId).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO guest_cart").WithArgs(testguesttoken, cart.AdvertId, cart.Amount).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = repo.UpdateGuest(testguesttoken, cart)
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateGuestRemove(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+	cart := &models.Cart{AdvertId: 3, Amount: 0}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM guest_cart").WithArgs(testguesttoken, cart.AdvertId).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = repo.UpdateGuest(testguesttoken, cart)
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateGuestError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+	cart := &models.Cart{AdvertId: 3, Amount: 2}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM guest_cart").WithArgs(testguesttoken, cart.AdvertId).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO guest_cart").WithArgs(testguesttoken, cart.AdvertId, cart.Amount).WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	err = repo.UpdateGuest(testguesttoken, cart)
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestMergeGuestOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE cart c SET amount = LEAST").WithArgs(testguesttoken, testuserid).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO cart").WithArgs(testguesttoken, testuserid).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("DELETE FROM guest_cart").WithArgs(testguesttoken).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+
+	err = repo.MergeGuest(testguesttoken, testuserid)
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestMergeGuestError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE cart c SET amount = LEAST").WithArgs(testguesttoken, testuserid).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO cart").WithArgs(testguesttoken, testuserid).WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	err = repo.MergeGuest(testguesttoken, testuserid)
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSetCouponOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+
+	mock.ExpectExec("UPDATE cart SET coupon_id").WithArgs(testuserid, int64(3), int64(4)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.SetCoupon(&models.Cart{UserId: testuserid, AdvertId: 3, CouponId: 4})
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSetCouponNotInCart(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCartRepository(db)
+
+	mock.ExpectExec("UPDATE cart SET coupon_id").WithArgs(testuserid, int64(3), int64(0)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.SetCoupon(&models.Cart{UserId: testuserid, AdvertId: 3})
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
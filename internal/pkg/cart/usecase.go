@@ -15,5 +15,19 @@ type CartUsecase interface {
 		adverts []*models.Advert) ([]*models.Cart, []*models.Advert, []string, error)
 	ClearAllCart(userId int64) error
 
-	MakeOrder(order *models.Cart, advert *models.Advert) error
+	GetGuestCart(token string) ([]*models.Cart, error)
+	UpdateGuestCart(token string, singleCart *models.CartHandler, maxAmount int64) (*models.Cart, error)
+	UpdateAllGuestCart(token string, cart []*models.CartHandler,
+		adverts []*models.Advert) ([]*models.Cart, []*models.Advert, []string, error)
+	MergeGuestCart(token string, userId int64) error
+
+	ReserveCart(userId int64, advertId int64) (*models.Cart, error)
+	ReleaseExpiredReservations() ([]*models.Cart, error)
+
+	ApplyCoupon(userId int64, advertId int64, coupon *models.Coupon) (*models.Cart, error)
+	RemoveCoupon(userId int64, advertId int64) (*models.Cart, error)
+
+	MakeOrder(order *models.Cart, advert *models.Advert, method string, address *models.Address) (*models.Order, error)
+	MakeOrders(cart []*models.Cart, adverts []*models.Advert,
+		method string, address *models.Address) ([]*models.Order, []string, error)
 }
@@ -6,15 +6,24 @@ import (
 	internalError "yula/internal/error"
 	"yula/internal/models"
 	"yula/internal/pkg/cart"
+	"yula/internal/pkg/coupons"
+	"yula/internal/pkg/logging"
 )
 
+// время, на которое товар в корзине придерживается за покупателем
+const reservationLifetime = 15 * time.Minute
+
+var logger logging.Logger = logging.GetLogger()
+
 type CartUsecase struct {
-	cartRepository cart.CartRepository
+	cartRepository   cart.CartRepository
+	couponRepository coupons.CouponRepository
 }
 
-func NewCartUsecase(cartRepository cart.CartRepository) cart.CartUsecase {
+func NewCartUsecase(cartRepository cart.CartRepository, couponRepository coupons.CouponRepository) cart.CartUsecase {
 	return &CartUsecase{
-		cartRepository: cartRepository,
+		cartRepository:   cartRepository,
+		couponRepository: couponRepository,
 	}
 }
 
@@ -79,6 +88,13 @@ func (cu *CartUsecase) RemoveFromCart(userId int64, advertId int64) error {
 
 func (cu *CartUsecase) UpdateAllCart(userId int64, cart []*models.CartHandler,
 	adverts []*models.Advert) ([]*models.Cart, []*models.Advert, []string, error) {
+	return cu.updateAll(cart, adverts, func(singleCart *models.CartHandler, maxAmount int64) (*models.Cart, error) {
+		return cu.UpdateCart(userId, singleCart, maxAmount)
+	})
+}
+
+func (cu *CartUsecase) updateAll(cart []*models.CartHandler, adverts []*models.Advert,
+	update func(*models.CartHandler, int64) (*models.Cart, error)) ([]*models.Cart, []*models.Advert, []string, error) {
 	if len(cart) != len(adverts) {
 		return nil, nil, nil, internalError.BadRequest
 	}
@@ -87,7 +103,7 @@ func (cu *CartUsecase) UpdateAllCart(userId int64, cart []*models.CartHandler,
 	newAdvert := make([]*models.Advert, 0)
 	messages := make([]string, 0)
 	for i := range cart {
-		el, err := cu.UpdateCart(userId, cart[i], adverts[i].Amount)
+		el, err := update(cart[i], adverts[i].Amount)
 		if err != nil && !strings.Contains(err.Error(), "not enough copies") {
 			return nil, nil, nil, err
 		}
@@ -107,6 +123,36 @@ func (cu *CartUsecase) UpdateAllCart(userId int64, cart []*models.CartHandler,
 	return newCart, newAdvert, messages, nil
 }
 
+func (cu *CartUsecase) GetGuestCart(token string) ([]*models.Cart, error) {
+	return cu.cartRepository.SelectGuest(token)
+}
+
+func (cu *CartUsecase) UpdateGuestCart(token string, singleCart *models.CartHandler, maxAmount int64) (*models.Cart, error) {
+	newOneInCart := models.NewCart(0, singleCart)
+	if newOneInCart.Amount > maxAmount {
+		var genErr error = internalError.SetMaxCopies(maxAmount)
+		return newOneInCart, genErr
+	}
+
+	err := cu.cartRepository.UpdateGuest(token, newOneInCart)
+	if err != nil || newOneInCart.Amount == 0 {
+		return nil, err
+	}
+
+	return newOneInCart, nil
+}
+
+func (cu *CartUsecase) UpdateAllGuestCart(token string, cart []*models.CartHandler,
+	adverts []*models.Advert) ([]*models.Cart, []*models.Advert, []string, error) {
+	return cu.updateAll(cart, adverts, func(singleCart *models.CartHandler, maxAmount int64) (*models.Cart, error) {
+		return cu.UpdateGuestCart(token, singleCart, maxAmount)
+	})
+}
+
+func (cu *CartUsecase) MergeGuestCart(token string, userId int64) error {
+	return cu.cartRepository.MergeGuest(token, userId)
+}
+
 func (cu *CartUsecase) ClearAllCart(userId int64) error {
 	err := cu.cartRepository.DeleteAll(userId)
 	if err == internalError.EmptyQuery {
@@ -115,17 +161,257 @@ func (cu *CartUsecase) ClearAllCart(userId int64) error {
 	return err
 }
 
-func (cu *CartUsecase) MakeOrder(order *models.Cart, advert *models.Advert) error {
+func (cu *CartUsecase) ReserveCart(userId int64, advertId int64) (*models.Cart, error) {
+	oneInCart, err := cu.cartRepository.Select(userId, advertId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = cu.cartRepository.Reserve(oneInCart, time.Now().Add(reservationLifetime))
+	if err != nil {
+		return nil, err
+	}
+
+	return oneInCart, nil
+}
+
+func (cu *CartUsecase) ReleaseExpiredReservations() ([]*models.Cart, error) {
+	lapsed, err := cu.cartRepository.ReleaseExpired()
+	if err != nil {
+		logger.Errorf("cannot release expired reservations: %v", err)
+		return nil, err
+	}
+
+	for _, oneInCart := range lapsed {
+		logger.Infof("reservation lapsed: user %d, advert %d, amount %d",
+			oneInCart.UserId, oneInCart.AdvertId, oneInCart.Amount)
+	}
+	return lapsed, nil
+}
+
+func (cu *CartUsecase) ApplyCoupon(userId int64, advertId int64, coupon *models.Coupon) (*models.Cart, error) {
+	oneInCart, err := cu.cartRepository.Select(userId, advertId)
+	if err != nil {
+		return nil, err
+	}
+
+	oneInCart.CouponId = coupon.Id
+	err = cu.cartRepository.SetCoupon(oneInCart)
+	if err != nil {
+		return nil, err
+	}
+
+	return oneInCart, nil
+}
+
+func (cu *CartUsecase) RemoveCoupon(userId int64, advertId int64) (*models.Cart, error) {
+	oneInCart, err := cu.cartRepository.Select(userId, advertId)
+	if err != nil {
+		return nil, err
+	}
+
+	oneInCart.CouponId = 0
+	err = cu.cartRepository.SetCoupon(oneInCart)
+	if err != nil {
+		return nil, err
+	}
+
+	return oneInCart, nil
+}
+
+func (cu *CartUsecase) MakeOrder(order *models.Cart, advert *models.Advert,
+	method string, address *models.Address) (*models.Order, error) {
+	if err := checkDeliveryAddress(method, address); err != nil {
+		return nil, err
+	}
+
 	if order.Amount == 0 || order.Amount > advert.Amount {
-		return internalError.InvalidQuery
+		return nil, internalError.InvalidQuery
+	}
+
+	if order.UserId == advert.PublisherId {
+		return nil, internalError.Conflict
+	}
+
+	if !advert.SupportsDelivery(method) {
+		return nil, internalError.DeliveryNotSupported
+	}
+
+	madeOrder := models.NewOrder(order, advert)
+	err := cu.discount(madeOrder.Lines[0], order.CouponId, advert)
+	if err != nil {
+		return nil, err
+	}
+
+	// остаток проверяется повторно под блокировкой строки объявления
+	setDelivery(madeOrder, method, address)
+	_, err = cu.cartRepository.Checkout(madeOrder)
+	if err != nil {
+		return nil, err
 	}
 
 	advert.Amount -= order.Amount
 	if advert.Amount == 0 {
 		advert.IsActive = false
+		advert.Status = models.AdvertStatusClosed
+		advert.CloseReason = models.CloseReasonSoldOut
 		advert.DateClose = time.Now()
 	}
 
-	err := cu.cartRepository.Delete(order)
-	return err
+	return madeOrder, nil
+}
+
+func (cu *CartUsecase) MakeOrders(cart []*models.Cart, adverts []*models.Advert,
+	method string, address *models.Address) ([]*models.Order, []string, error) {
+	if len(cart) != len(adverts) {
+		return nil, nil, internalError.BadRequest
+	}
+
+	if err := checkDeliveryAddress(method, address); err != nil {
+		return nil, nil, err
+	}
+
+	if len(cart) == 0 {
+		return nil, nil, internalError.EmptyQuery
+	}
+
+	messages := make([]string, len(cart))
+	lineIndex := make(map[int64]int, len(cart))
+
+	// раскладываем позиции корзины по продавцам, сохраняя порядок их появления
+	ordersBySalesman := make(map[int64]*models.Order)
+	salesmen := make([]int64, 0)
+	for i := range cart {
+		var err error
+		switch {
+		case adverts[i] == nil:
+			err = internalError.NotExist
+		case cart[i].Amount == 0 || cart[i].Amount > adverts[i].Amount:
+			err = internalError.SetMaxCopies(adverts[i].Amount)
+		case cart[i].UserId == adverts[i].PublisherId:
+			err = internalError.Conflict
+		case !adverts[i].SupportsDelivery(method):
+			err = internalError.DeliveryNotSupported
+		}
+
+		if err != nil {
+			_, messages[i] = internalError.ToMetaStatus(err)
+			continue
+		}
+
+		newOrder := models.NewOrder(cart[i], adverts[i])
+		err = cu.discount(newOrder.Lines[0], cart[i].CouponId, adverts[i])
+		switch err {
+		case nil:
+		case internalError.CouponNotFound, internalError.CouponExpired,
+			internalError.CouponExhausted, internalError.CouponMinPrice:
+			_, messages[i] = internalError.ToMetaStatus(err)
+			continue
+		default:
+			return nil, nil, err
+		}
+
+		lineIndex[cart[i].AdvertId] = i
+		order, ok := ordersBySalesman[adverts[i].PublisherId]
+		if !ok {
+			setDelivery(newOrder, method, address)
+			ordersBySalesman[adverts[i].PublisherId] = newOrder
+			salesmen = append(salesmen, adverts[i].PublisherId)
+			continue
+		}
+
+		order.Lines = append(order.Lines, newOrder.Lines...)
+	}
+
+	madeOrders := make([]*models.Order, 0, len(salesmen))
+	for _, salesmanId := range salesmen {
+		order := ordersBySalesman[salesmanId]
+
+		// позиция, из-за которой заказ не прошел, убирается, остальные позиции продавца оформляются заново
+		for len(order.Lines) != 0 {
+			failedAdvertId, err := cu.cartRepository.Checkout(order)
+			if err == nil {
+				madeOrders = append(madeOrders, order)
+				for _, line := range order.Lines {
+					messages[lineIndex[line.AdvertId]] = "ok"
+				}
+				break
+			}
+
+			switch err {
+			case internalError.NotEnoughCopies, internalError.EmptyQuery, internalError.CouponExhausted:
+			default:
+				return nil, nil, err
+			}
+
+			_, msg := internalError.ToMetaStatus(err)
+			lines := withoutAdvert(order.Lines, failedAdvertId)
+			if len(lines) == len(order.Lines) {
+				// не знаем, какая позиция виновата, отказываем всему заказу
+				lines = nil
+			}
+			for _, line := range order.Lines {
+				if len(lines) == 0 || line.AdvertId == failedAdvertId {
+					messages[lineIndex[line.AdvertId]] = msg
+				}
+			}
+			order.Lines = lines
+		}
+	}
+
+	return madeOrders, messages, nil
+}
+
+func withoutAdvert(lines []*models.OrderLine, advertId int64) []*models.OrderLine {
+	rest := make([]*models.OrderLine, 0, len(lines))
+	for _, line := range lines {
+		if line.AdvertId != advertId {
+			rest = append(rest, line)
+		}
+	}
+	return rest
+}
+
+// discount применяет к позиции заказа купон из корзины, цена объявления остается в ListPrice;
+// купон перепроверяется, так как с момента применения могли измениться цена или срок его действия
+func (cu *CartUsecase) discount(line *models.OrderLine, couponId int64, advert *models.Advert) error {
+	if couponId == 0 {
+		return nil
+	}
+
+	coupon, err := cu.couponRepository.SelectById(couponId)
+	if err == internalError.EmptyQuery {
+		return internalError.CouponNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if coupon.SalesmanId != advert.PublisherId {
+		return internalError.CouponNotFound
+	}
+
+	err = coupon.Check(line.ListPrice, time.Now())
+	if err != nil {
+		return err
+	}
+
+	line.Price = coupon.Apply(line.ListPrice)
+	line.CouponId = coupon.Id
+	return nil
+}
+
+// для самовывоза адрес не нужен, для остальных способов обязателен
+func checkDeliveryAddress(method string, address *models.Address) error {
+	if method != models.DeliveryPickup && address == nil {
+		return internalError.AddressRequired
+	}
+	return nil
+}
+
+func setDelivery(order *models.Order, method string, address *models.Address) {
+	order.DeliveryMethod = method
+	if address != nil {
+		order.DeliveryAddress = address.String()
+	}
 }
@@ -2,12 +2,16 @@ package usecase
 
 import (
 	"testing"
+	"time"
 	"yula/internal/models"
 	"yula/internal/pkg/cart/mocks"
 
+	couponMocks "yula/internal/pkg/coupons/mocks"
+
 	myerr "yula/internal/error"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestGetOrderFromCartSuccess(t *testing.T) {
@@ -19,7 +23,7 @@ func TestGetOrderFromCartSuccess(t *testing.T) {
 	cr := mocks.CartRepository{}
 	cr.On("Select", int64(1), int64(2)).Return(&cart, nil)
 
-	cu := NewCartUsecase(&cr)
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
 	cartRes, err := cu.GetOrderFromCart(1, 2)
 	assert.Equal(t, cart, *cartRes)
 	assert.Nil(t, err)
@@ -29,7 +33,7 @@ func TestGetOrderFromCartFail(t *testing.T) {
 	cr := mocks.CartRepository{}
 	cr.On("Select", int64(-2), int64(2)).Return(nil, myerr.InternalError)
 
-	cu := NewCartUsecase(&cr)
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
 	cartRes, err := cu.GetOrderFromCart(-2, 2)
 	assert.Equal(t, err, myerr.InternalError)
 	assert.Nil(t, cartRes)
@@ -51,7 +55,7 @@ func TestGetCartSuccess(t *testing.T) {
 	cr := mocks.CartRepository{}
 	cr.On("SelectAll", int64(1)).Return(cart, nil)
 
-	cu := NewCartUsecase(&cr)
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
 	cartRes, err := cu.GetCart(1)
 	assert.Equal(t, cartRes, cart)
 	assert.Nil(t, err)
@@ -61,7 +65,7 @@ func TestGetCartFail(t *testing.T) {
 	cr := mocks.CartRepository{}
 	cr.On("SelectAll", int64(-21)).Return(nil, myerr.InternalError)
 
-	cu := NewCartUsecase(&cr)
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
 	cartRes, err := cu.GetCart(-21)
 	assert.Equal(t, err, myerr.InternalError)
 	assert.Nil(t, cartRes)
@@ -81,7 +85,7 @@ func TestUpdateCartInsertSuccess(t *testing.T) {
 	cr.On("Select", int64(1), int64(2)).Return(nil, myerr.EmptyQuery)
 	cr.On("Insert", &cart).Return(nil)
 
-	cu := NewCartUsecase(&cr)
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
 	cartRes, err := cu.UpdateCart(1, &cartHandler, 6)
 	assert.Equal(t, *cartRes, cart)
 	assert.Nil(t, err)
@@ -95,7 +99,7 @@ func TestUpdateCartInsertFailAmount0(t *testing.T) {
 	cr := mocks.CartRepository{}
 	cr.On("Select", int64(1), int64(2)).Return(nil, myerr.EmptyQuery)
 
-	cu := NewCartUsecase(&cr)
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
 	cartRes, err := cu.UpdateCart(1, &cartHandler, 5)
 	assert.Nil(t, cartRes)
 	assert.Nil(t, err)
@@ -114,7 +118,7 @@ func TestUpdateCartInsertFailAmountGTAmountMax(t *testing.T) {
 	cr := mocks.CartRepository{}
 	cr.On("Select", int64(1), int64(2)).Return(nil, myerr.EmptyQuery)
 
-	cu := NewCartUsecase(&cr)
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
 	cartRes, err := cu.UpdateCart(1, &cartHandler, 6)
 	assert.Equal(t, *cartRes, cart)
 	assert.Equal(t, err, myerr.SetMaxCopies(6))
@@ -134,7 +138,7 @@ func TestUpdateCartUpdateSuccess(t *testing.T) {
 	cr.On("Select", int64(1), int64(2)).Return(nil, nil)
 	cr.On("Update", &cart).Return(nil)
 
-	cu := NewCartUsecase(&cr)
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
 	cartRes, err := cu.UpdateCart(1, &cartHandler, 6)
 	assert.Equal(t, *cartRes, cart)
 	assert.Nil(t, err)
@@ -154,7 +158,7 @@ func TestUpdateCartUpdateFailAmount0(t *testing.T) {
 	cr.On("Select", int64(1), int64(2)).Return(nil, nil)
 	cr.On("Delete", &cart).Return(nil)
 
-	cu := NewCartUsecase(&cr)
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
 	cartRes, err := cu.UpdateCart(1, &cartHandler, 5)
 	assert.Nil(t, cartRes)
 	assert.Nil(t, err)
@@ -173,7 +177,7 @@ func TestUpdateCartUpdateFailAmountGTAmountMax(t *testing.T) {
 	cr := mocks.CartRepository{}
 	cr.On("Select", int64(1), int64(2)).Return(nil, nil)
 
-	cu := NewCartUsecase(&cr)
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
 	cartRes, err := cu.UpdateCart(1, &cartHandler, 6)
 	assert.Equal(t, *cartRes, cart)
 	assert.Equal(t, err, myerr.SetMaxCopies(6))
@@ -218,7 +222,7 @@ func TestUpdateAllCartSuccess(t *testing.T) {
 	cr.On("Select", int64(1), int64(2)).Return(nil, nil)
 	cr.On("Update", &cart).Return(nil)
 
-	cu := NewCartUsecase(&cr)
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
 	newCart, newAds, _, _ := cu.UpdateAllCart(1, cartH, ads)
 	assert.Equal(t, expCart, newCart)
 	assert.Equal(t, expAds, newAds)
@@ -257,7 +261,7 @@ func TestUpdateAllCartFailSomeAmountIsGTAmountMax(t *testing.T) {
 	cr := mocks.CartRepository{}
 	cr.On("Select", int64(1), int64(2)).Return(nil, nil)
 
-	cu := NewCartUsecase(&cr)
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
 	newCart, newAds, msg, err := cu.UpdateAllCart(1, cart, ads)
 	assert.Equal(t, expCart, newCart)
 	assert.Equal(t, expAds, newAds)
@@ -290,7 +294,7 @@ func TestUpdateAllCartFailSomeAmountIs0(t *testing.T) {
 	cr.On("Select", int64(1), int64(2)).Return(nil, nil)
 	cr.On("Delete", &cart).Return(myerr.DatabaseError)
 
-	cu := NewCartUsecase(&cr)
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
 	newCart, newAds, msg, err := cu.UpdateAllCart(1, cartH, ads)
 	assert.Nil(t, newCart)
 	assert.Nil(t, newAds)
@@ -302,7 +306,7 @@ func TestClearAllCartSuccess(t *testing.T) {
 	cr := mocks.CartRepository{}
 	cr.On("DeleteAll", int64(1)).Return(nil)
 
-	cu := NewCartUsecase(&cr)
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
 	err := cu.ClearAllCart(1)
 	assert.Nil(t, err)
 }
@@ -311,7 +315,7 @@ func TestClearAllCartFail(t *testing.T) {
 	cr := mocks.CartRepository{}
 	cr.On("DeleteAll", int64(1)).Return(myerr.NotExist)
 
-	cu := NewCartUsecase(&cr)
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
 	err := cu.ClearAllCart(1)
 	assert.Equal(t, err, myerr.NotExist)
 }
@@ -330,11 +334,12 @@ func TestMakeOrderSuccess(t *testing.T) {
 	}
 
 	cr := mocks.CartRepository{}
-	cr.On("Delete", &cart).Return(nil)
+	cr.On("Checkout", mock.AnythingOfType("*models.Order")).Return(int64(0), nil)
 
-	cu := NewCartUsecase(&cr)
-	err := cu.MakeOrder(&cart, &ad)
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	order, err := cu.MakeOrder(&cart, &ad, models.DeliveryPickup, nil)
 	assert.Nil(t, err)
+	assert.Equal(t, order.Lines[0].Amount, int64(10))
 	assert.Equal(t, ad.Amount, int64(0))
 	assert.Equal(t, ad.IsActive, false)
 }
@@ -354,8 +359,8 @@ func TestMakeOrderFailAmountGTAmountMax(t *testing.T) {
 
 	cr := mocks.CartRepository{}
 
-	cu := NewCartUsecase(&cr)
-	err := cu.MakeOrder(&cart, &ad)
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	_, err := cu.MakeOrder(&cart, &ad, models.DeliveryPickup, nil)
 	assert.NotNil(t, err)
 }
 
@@ -374,7 +379,520 @@ func TestMakeOrderFail(t *testing.T) {
 
 	cr := mocks.CartRepository{}
 
-	cu := NewCartUsecase(&cr)
-	err := cu.MakeOrder(&cart, &ad)
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	_, err := cu.MakeOrder(&cart, &ad, models.DeliveryPickup, nil)
 	assert.NotNil(t, err)
 }
+
+func TestMakeOrderFailOwnAdvert(t *testing.T) {
+	ad := models.Advert{
+		Id:          32,
+		Name:        "aboba",
+		Amount:      4,
+		PublisherId: 1,
+	}
+
+	cart := models.Cart{
+		UserId:   1,
+		AdvertId: 32,
+		Amount:   1,
+	}
+
+	cr := mocks.CartRepository{}
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	_, err := cu.MakeOrder(&cart, &ad, models.DeliveryPickup, nil)
+	assert.Equal(t, err, myerr.Conflict)
+}
+
+func TestMakeOrderFailCheckout(t *testing.T) {
+	ad := models.Advert{
+		Id:          32,
+		Name:        "aboba",
+		Amount:      4,
+		PublisherId: 2,
+	}
+
+	cart := models.Cart{
+		UserId:   1,
+		AdvertId: 32,
+		Amount:   3,
+	}
+
+	cr := mocks.CartRepository{}
+	cr.On("Checkout", mock.AnythingOfType("*models.Order")).Return(int64(10), myerr.NotEnoughCopies)
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	order, err := cu.MakeOrder(&cart, &ad, models.DeliveryPickup, nil)
+	assert.Equal(t, err, myerr.NotEnoughCopies)
+	assert.Nil(t, order)
+	assert.Equal(t, ad.Amount, int64(4))
+}
+
+func TestMakeOrderCourier(t *testing.T) {
+	ad := models.Advert{
+		Id:              32,
+		Amount:          4,
+		PublisherId:     2,
+		DeliveryCourier: true,
+	}
+
+	cart := models.Cart{
+		UserId:   1,
+		AdvertId: 32,
+		Amount:   3,
+	}
+
+	address := models.Address{Recipient: "Ivan", Phone: "+79990000000", City: "Moscow", Street: "Tverskaya, 1"}
+
+	cr := mocks.CartRepository{}
+	cr.On("Checkout", mock.AnythingOfType("*models.Order")).Return(int64(0), nil)
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	order, err := cu.MakeOrder(&cart, &ad, models.DeliveryCourier, &address)
+	assert.Nil(t, err)
+	assert.Equal(t, models.DeliveryCourier, order.DeliveryMethod)
+	assert.Equal(t, "Ivan, +79990000000, Moscow, Tverskaya, 1", order.DeliveryAddress)
+}
+
+func TestMakeOrderFailNoAddress(t *testing.T) {
+	ad := models.Advert{Id: 32, Amount: 4, PublisherId: 2, DeliveryPost: true}
+	cart := models.Cart{UserId: 1, AdvertId: 32, Amount: 3}
+
+	cr := mocks.CartRepository{}
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	order, err := cu.MakeOrder(&cart, &ad, models.DeliveryPost, nil)
+	assert.Equal(t, myerr.AddressRequired, err)
+	assert.Nil(t, order)
+}
+
+func TestMakeOrderFailDeliveryNotSupported(t *testing.T) {
+	ad := models.Advert{Id: 32, Amount: 4, PublisherId: 2}
+	cart := models.Cart{UserId: 1, AdvertId: 32, Amount: 3}
+
+	cr := mocks.CartRepository{}
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	order, err := cu.MakeOrder(&cart, &ad, models.DeliveryCourier, &models.Address{})
+	assert.Equal(t, myerr.DeliveryNotSupported, err)
+	assert.Nil(t, order)
+}
+
+func TestMakeOrdersSplitBySalesman(t *testing.T) {
+	cart := []*models.Cart{
+		{UserId: 1, AdvertId: 10, Amount: 1},
+		{UserId: 1, AdvertId: 20, Amount: 2},
+		{UserId: 1, AdvertId: 11, Amount: 3},
+	}
+	adverts := []*models.Advert{
+		{Id: 10, PublisherId: 5, Amount: 1, Price: 100},
+		{Id: 20, PublisherId: 6, Amount: 5, Price: 200},
+		{Id: 11, PublisherId: 5, Amount: 4, Price: 300},
+	}
+
+	cr := mocks.CartRepository{}
+	cr.On("Checkout", mock.AnythingOfType("*models.Order")).Return(int64(0), nil)
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	orders, messages, err := cu.MakeOrders(cart, adverts, models.DeliveryPickup, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ok", "ok", "ok"}, messages)
+	assert.Equal(t, 2, len(orders))
+	assert.Equal(t, int64(5), orders[0].SalesmanId)
+	assert.Equal(t, 2, len(orders[0].Lines))
+	assert.Equal(t, int64(6), orders[1].SalesmanId)
+	assert.Equal(t, 1, len(orders[1].Lines))
+}
+
+func TestMakeOrdersLineHints(t *testing.T) {
+	cart := []*models.Cart{
+		{UserId: 1, AdvertId: 10, Amount: 3},
+		{UserId: 1, AdvertId: 20, Amount: 1},
+		{UserId: 1, AdvertId: 30, Amount: 1},
+		{UserId: 1, AdvertId: 40, Amount: 1},
+	}
+	adverts := []*models.Advert{
+		{Id: 10, PublisherId: 5, Amount: 2},
+		nil,
+		{Id: 30, PublisherId: 1, Amount: 1},
+		{Id: 40, PublisherId: 6, Amount: 1},
+	}
+
+	cr := mocks.CartRepository{}
+	cr.On("Checkout", mock.AnythingOfType("*models.Order")).Return(int64(0), nil)
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	orders, messages, err := cu.MakeOrders(cart, adverts, models.DeliveryPickup, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(orders))
+	assert.Equal(t, "not enough copies. max amount: 2", messages[0])
+	assert.Equal(t, "not exist", messages[1])
+	assert.Equal(t, "unable to access this resource", messages[2])
+	assert.Equal(t, "ok", messages[3])
+}
+
+func TestMakeOrdersCheckoutNotEnoughCopies(t *testing.T) {
+	cart := []*models.Cart{
+		{UserId: 1, AdvertId: 10, Amount: 1},
+	}
+	adverts := []*models.Advert{
+		{Id: 10, PublisherId: 5, Amount: 1},
+	}
+
+	cr := mocks.CartRepository{}
+	cr.On("Checkout", mock.AnythingOfType("*models.Order")).Return(int64(10), myerr.NotEnoughCopies)
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	orders, messages, err := cu.MakeOrders(cart, adverts, models.DeliveryPickup, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(orders))
+	assert.Equal(t, []string{"not enough copies"}, messages)
+}
+
+func TestMakeOrdersCheckoutFailsOnlyOneLine(t *testing.T) {
+	cart := []*models.Cart{
+		{UserId: 1, AdvertId: 10, Amount: 1},
+		{UserId: 1, AdvertId: 11, Amount: 1},
+	}
+	adverts := []*models.Advert{
+		{Id: 10, PublisherId: 5, Amount: 1},
+		{Id: 11, PublisherId: 5, Amount: 1},
+	}
+
+	// объявление 11 раскупили, пока покупатель оформлял заказ, позиция 10 должна оформиться
+	cr := mocks.CartRepository{}
+	cr.On("Checkout", mock.MatchedBy(func(o *models.Order) bool { return len(o.Lines) == 2 })).
+		Return(int64(11), myerr.NotEnoughCopies).Once()
+	cr.On("Checkout", mock.MatchedBy(func(o *models.Order) bool {
+		return len(o.Lines) == 1 && o.Lines[0].AdvertId == 10
+	})).Return(int64(0), nil).Once()
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	orders, messages, err := cu.MakeOrders(cart, adverts, models.DeliveryPickup, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(orders))
+	assert.Equal(t, []string{"ok", "not enough copies"}, messages)
+	cr.AssertExpectations(t)
+}
+
+func TestMakeOrdersCheckoutFail(t *testing.T) {
+	cart := []*models.Cart{
+		{UserId: 1, AdvertId: 10, Amount: 1},
+	}
+	adverts := []*models.Advert{
+		{Id: 10, PublisherId: 5, Amount: 1},
+	}
+
+	cr := mocks.CartRepository{}
+	cr.On("Checkout", mock.AnythingOfType("*models.Order")).Return(int64(0), myerr.DatabaseError)
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	_, _, err := cu.MakeOrders(cart, adverts, models.DeliveryPickup, nil)
+	assert.Equal(t, err, myerr.DatabaseError)
+}
+
+func TestMakeOrdersMismatch(t *testing.T) {
+	cr := mocks.CartRepository{}
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	_, _, err := cu.MakeOrders([]*models.Cart{{UserId: 1}}, []*models.Advert{}, models.DeliveryPickup, nil)
+	assert.Equal(t, err, myerr.BadRequest)
+}
+
+func TestMakeOrdersEmptyCart(t *testing.T) {
+	cr := mocks.CartRepository{}
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	_, _, err := cu.MakeOrders([]*models.Cart{}, []*models.Advert{}, models.DeliveryPickup, nil)
+	assert.Equal(t, err, myerr.EmptyQuery)
+}
+
+func TestGetGuestCart(t *testing.T) {
+	cart := []*models.Cart{{AdvertId: 2, Amount: 1}}
+	cr := mocks.CartRepository{}
+	cr.On("SelectGuest", "token").Return(cart, nil)
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	cartRes, err := cu.GetGuestCart("token")
+	assert.Nil(t, err)
+	assert.Equal(t, cart, cartRes)
+}
+
+func TestUpdateGuestCartSuccess(t *testing.T) {
+	cr := mocks.CartRepository{}
+	cr.On("UpdateGuest", "token", &models.Cart{AdvertId: 2, Amount: 3}).Return(nil)
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	cartRes, err := cu.UpdateGuestCart("token", &models.CartHandler{AdvertId: 2, Amount: 3}, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), cartRes.Amount)
+}
+
+func TestUpdateGuestCartRemove(t *testing.T) {
+	cr := mocks.CartRepository{}
+	cr.On("UpdateGuest", "token", &models.Cart{AdvertId: 2, Amount: 0}).Return(nil)
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	cartRes, err := cu.UpdateGuestCart("token", &models.CartHandler{AdvertId: 2, Amount: 0}, 5)
+	assert.Nil(t, err)
+	assert.Nil(t, cartRes)
+}
+
+func TestUpdateGuestCartTooMany(t *testing.T) {
+	cr := mocks.CartRepository{}
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	cartRes, err := cu.UpdateGuestCart("token", &models.CartHandler{AdvertId: 2, Amount: 7}, 5)
+	assert.Equal(t, myerr.SetMaxCopies(5), err)
+	assert.Equal(t, int64(7), cartRes.Amount)
+}
+
+func TestUpdateGuestCartFail(t *testing.T) {
+	cr := mocks.CartRepository{}
+	cr.On("UpdateGuest", "token", &models.Cart{AdvertId: 2, Amount: 3}).Return(myerr.DatabaseError)
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	cartRes, err := cu.UpdateGuestCart("token", &models.CartHandler{AdvertId: 2, Amount: 3}, 5)
+	assert.Equal(t, myerr.DatabaseError, err)
+	assert.Nil(t, cartRes)
+}
+
+func TestUpdateAllGuestCart(t *testing.T) {
+	cart := []*models.CartHandler{
+		{AdvertId: 2, Amount: 3},
+		{AdvertId: 4, Amount: 9},
+	}
+	adverts := []*models.Advert{
+		{Id: 2, Amount: 5},
+		{Id: 4, Amount: 1},
+	}
+
+	cr := mocks.CartRepository{}
+	cr.On("UpdateGuest", "token", &models.Cart{AdvertId: 2, Amount: 3}).Return(nil)
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	cartRes, advRes, messages, err := cu.UpdateAllGuestCart("token", cart, adverts)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(cartRes))
+	assert.Equal(t, adverts, advRes)
+	assert.Equal(t, []string{"ok", "not enough copies. max amount: 1"}, messages)
+}
+
+func TestMergeGuestCart(t *testing.T) {
+	cr := mocks.CartRepository{}
+	cr.On("MergeGuest", "token", int64(1)).Return(nil)
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	err := cu.MergeGuestCart("token", 1)
+	assert.Nil(t, err)
+}
+
+func TestReserveCartSuccess(t *testing.T) {
+	cart := models.Cart{UserId: 1, AdvertId: 2, Amount: 3}
+	cr := mocks.CartRepository{}
+	cr.On("Select", int64(1), int64(2)).Return(&cart, nil)
+	cr.On("Reserve", &cart, mock.AnythingOfType("time.Time")).Return(nil)
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	cartRes, err := cu.ReserveCart(1, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, &cart, cartRes)
+}
+
+func TestReserveCartNotInCart(t *testing.T) {
+	cr := mocks.CartRepository{}
+	cr.On("Select", int64(1), int64(2)).Return(nil, myerr.EmptyQuery)
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	cartRes, err := cu.ReserveCart(1, 2)
+	assert.Equal(t, myerr.EmptyQuery, err)
+	assert.Nil(t, cartRes)
+}
+
+func TestReserveCartFail(t *testing.T) {
+	cart := models.Cart{UserId: 1, AdvertId: 2, Amount: 3}
+	cr := mocks.CartRepository{}
+	cr.On("Select", int64(1), int64(2)).Return(&cart, nil)
+	cr.On("Reserve", &cart, mock.AnythingOfType("time.Time")).Return(myerr.SetMaxCopies(1))
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	cartRes, err := cu.ReserveCart(1, 2)
+	assert.Equal(t, myerr.SetMaxCopies(1), err)
+	assert.Nil(t, cartRes)
+}
+
+func TestReleaseExpiredReservations(t *testing.T) {
+	lapsed := []*models.Cart{{UserId: 1, AdvertId: 2, Amount: 3}}
+	cr := mocks.CartRepository{}
+	cr.On("ReleaseExpired").Return(lapsed, nil)
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	res, err := cu.ReleaseExpiredReservations()
+	assert.Nil(t, err)
+	assert.Equal(t, lapsed, res)
+}
+
+func TestReleaseExpiredReservationsFail(t *testing.T) {
+	cr := mocks.CartRepository{}
+	cr.On("ReleaseExpired").Return(nil, myerr.DatabaseError)
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	res, err := cu.ReleaseExpiredReservations()
+	assert.Equal(t, myerr.DatabaseError, err)
+	assert.Nil(t, res)
+}
+
+func TestMakeOrdersDeliveryNotSupported(t *testing.T) {
+	cart := []*models.Cart{
+		{UserId: 1, AdvertId: 10, Amount: 1},
+		{UserId: 1, AdvertId: 11, Amount: 1},
+	}
+	adverts := []*models.Advert{
+		{Id: 10, PublisherId: 2, Amount: 5, Price: 100, DeliveryPost: true},
+		{Id: 11, PublisherId: 3, Amount: 5, Price: 200, DeliveryPickup: true},
+	}
+	address := &models.Address{Recipient: "Ivan", Phone: "+79990000000", City: "Moscow", Street: "Tverskaya, 1"}
+
+	cr := mocks.CartRepository{}
+	cr.On("Checkout", mock.AnythingOfType("*models.Order")).Return(int64(0), nil)
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	orders, messages, err := cu.MakeOrders(cart, adverts, models.DeliveryPost, address)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(orders))
+	assert.Equal(t, address.String(), orders[0].DeliveryAddress)
+	assert.Equal(t, []string{"ok", "delivery method is not supported"}, messages)
+}
+
+func newTestCoupon() *models.Coupon {
+	return &models.Coupon{
+		Id:         4,
+		SalesmanId: 5,
+		Code:       "SALE10",
+		Kind:       models.CouponKindPercent,
+		Value:      10,
+		MinPrice:   100,
+		ValidFrom:  time.Now().Add(-time.Hour),
+		ValidUntil: time.Now().Add(time.Hour),
+	}
+}
+
+func TestApplyCouponSuccess(t *testing.T) {
+	cr := mocks.CartRepository{}
+	cr.On("Select", int64(1), int64(10)).Return(&models.Cart{UserId: 1, AdvertId: 10, Amount: 1}, nil)
+	cr.On("SetCoupon", &models.Cart{UserId: 1, AdvertId: 10, Amount: 1, CouponId: 4}).Return(nil)
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	oneInCart, err := cu.ApplyCoupon(1, 10, newTestCoupon())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), oneInCart.CouponId)
+}
+
+func TestApplyCouponNotInCart(t *testing.T) {
+	cr := mocks.CartRepository{}
+	cr.On("Select", int64(1), int64(10)).Return(nil, myerr.EmptyQuery)
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	_, err := cu.ApplyCoupon(1, 10, newTestCoupon())
+	assert.Equal(t, myerr.EmptyQuery, err)
+}
+
+func TestRemoveCouponSuccess(t *testing.T) {
+	cr := mocks.CartRepository{}
+	cr.On("Select", int64(1), int64(10)).Return(&models.Cart{UserId: 1, AdvertId: 10, Amount: 1, CouponId: 4}, nil)
+	cr.On("SetCoupon", &models.Cart{UserId: 1, AdvertId: 10, Amount: 1}).Return(nil)
+
+	cu := NewCartUsecase(&cr, &couponMocks.CouponRepository{})
+	oneInCart, err := cu.RemoveCoupon(1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), oneInCart.CouponId)
+}
+
+func TestMakeOrderWithCoupon(t *testing.T) {
+	ad := models.Advert{Id: 10, PublisherId: 5, Amount: 3, Price: 250}
+	cart := models.Cart{UserId: 1, AdvertId: 10, Amount: 2, CouponId: 4}
+
+	cr := mocks.CartRepository{}
+	cpr := couponMocks.CouponRepository{}
+	cpr.On("SelectById", int64(4)).Return(newTestCoupon(), nil)
+	cr.On("Checkout", mock.AnythingOfType("*models.Order")).Return(int64(0), nil)
+
+	cu := NewCartUsecase(&cr, &cpr)
+	order, err := cu.MakeOrder(&cart, &ad, models.DeliveryPickup, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(225), order.Lines[0].Price)
+	assert.Equal(t, int64(250), order.Lines[0].ListPrice)
+	assert.Equal(t, int64(4), order.Lines[0].CouponId)
+	assert.Equal(t, int64(450), order.Total())
+}
+
+func TestMakeOrderCouponBelowMinPrice(t *testing.T) {
+	ad := models.Advert{Id: 10, PublisherId: 5, Amount: 3, Price: 50}
+	cart := models.Cart{UserId: 1, AdvertId: 10, Amount: 1, CouponId: 4}
+
+	cr := mocks.CartRepository{}
+	cpr := couponMocks.CouponRepository{}
+	cpr.On("SelectById", int64(4)).Return(newTestCoupon(), nil)
+
+	cu := NewCartUsecase(&cr, &cpr)
+	_, err := cu.MakeOrder(&cart, &ad, models.DeliveryPickup, nil)
+	assert.Equal(t, myerr.CouponMinPrice, err)
+	cr.AssertNotCalled(t, "Checkout", mock.Anything)
+}
+
+func TestMakeOrderCouponOfAnotherSalesman(t *testing.T) {
+	ad := models.Advert{Id: 10, PublisherId: 6, Amount: 3, Price: 250}
+	cart := models.Cart{UserId: 1, AdvertId: 10, Amount: 1, CouponId: 4}
+
+	cr := mocks.CartRepository{}
+	cpr := couponMocks.CouponRepository{}
+	cpr.On("SelectById", int64(4)).Return(newTestCoupon(), nil)
+
+	cu := NewCartUsecase(&cr, &cpr)
+	_, err := cu.MakeOrder(&cart, &ad, models.DeliveryPickup, nil)
+	assert.Equal(t, myerr.CouponNotFound, err)
+}
+
+func TestMakeOrdersCouponHints(t *testing.T) {
+	cart := []*models.Cart{
+		{UserId: 1, AdvertId: 10, Amount: 1, CouponId: 4},
+		{UserId: 1, AdvertId: 20, Amount: 1, CouponId: 7},
+	}
+	adverts := []*models.Advert{
+		{Id: 10, PublisherId: 5, Amount: 1, Price: 100},
+		{Id: 20, PublisherId: 5, Amount: 1, Price: 100},
+	}
+
+	expired := newTestCoupon()
+	expired.Id = 7
+	expired.ValidUntil = time.Now().Add(-time.Minute)
+
+	cr := mocks.CartRepository{}
+	cpr := couponMocks.CouponRepository{}
+	cpr.On("SelectById", int64(4)).Return(newTestCoupon(), nil)
+	cpr.On("SelectById", int64(7)).Return(expired, nil)
+	cr.On("Checkout", mock.AnythingOfType("*models.Order")).Return(int64(0), nil)
+
+	cu := NewCartUsecase(&cr, &cpr)
+	orders, messages, err := cu.MakeOrders(cart, adverts, models.DeliveryPickup, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(orders))
+	assert.Equal(t, int64(90), orders[0].Lines[0].Price)
+	assert.Equal(t, "ok", messages[0])
+	assert.Equal(t, "coupon is not valid at this time", messages[1])
+}
+
+func TestMakeOrdersCouponExhaustedAtCheckout(t *testing.T) {
+	cart := []*models.Cart{{UserId: 1, AdvertId: 10, Amount: 1, CouponId: 4}}
+	adverts := []*models.Advert{{Id: 10, PublisherId: 5, Amount: 1, Price: 100}}
+
+	cr := mocks.CartRepository{}
+	cpr := couponMocks.CouponRepository{}
+	cpr.On("SelectById", int64(4)).Return(newTestCoupon(), nil)
+	cr.On("Checkout", mock.AnythingOfType("*models.Order")).Return(int64(10), myerr.CouponExhausted)
+
+	cu := NewCartUsecase(&cr, &cpr)
+	orders, messages, err := cu.MakeOrders(cart, adverts, models.DeliveryPickup, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(orders))
+	assert.Equal(t, "coupon usage limit reached", messages[0])
+}
@@ -0,0 +1,44 @@
+package http
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	proto "yula/proto/generated/category"
+)
+
+// сколько main отдает список категорий без похода в сервис категорий,
+// правки через админские ручки сбрасывают кеш сразу
+const categoriesCacheTTL = 30 * time.Second
+
+// categoriesCache - read-through кеш списка категорий вместе с его версией
+type categoriesCache struct {
+	mu         sync.Mutex
+	categories *proto.Categories
+	expires    time.Time
+}
+
+func (cc *categoriesCache) get(client proto.CategoryClient) (*proto.Categories, error) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	if cc.categories != nil && time.Now().Before(cc.expires) {
+		return cc.categories, nil
+	}
+
+	categories, err := client.GetCategories(context.Background(), &proto.Nothing{Dummy: true})
+	if err != nil {
+		return nil, err
+	}
+	cc.categories = categories
+	cc.expires = time.Now().Add(categoriesCacheTTL)
+	return categories, nil
+}
+
+func (cc *categoriesCache) invalidate() {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	cc.categories = nil
+}
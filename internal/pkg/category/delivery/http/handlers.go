@@ -2,7 +2,10 @@ package http
 
 import (
 	"context"
+	"fmt"
+	"io/ioutil"
 	"net/http"
+	"strconv"
 	internalError "yula/internal/error"
 	"yula/internal/models"
 	"yula/internal/pkg/logging"
@@ -10,17 +13,22 @@ import (
 
 	proto "yula/proto/generated/category"
 
+	"github.com/asaskevich/govalidator"
 	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	"github.com/microcosm-cc/bluemonday"
 	"github.com/sirupsen/logrus"
 )
 
 type CategoryHandler struct {
 	categoryUsecase proto.CategoryClient
+	cache           *categoriesCache
 }
 
 func NewCategoryHandler(categoryUsecase proto.CategoryClient) *CategoryHandler {
 	return &CategoryHandler{
 		categoryUsecase: categoryUsecase,
+		cache:           &categoriesCache{},
 	}
 }
 
@@ -28,26 +36,39 @@ var (
 	logger logging.Logger = logging.GetLogger()
 )
 
-func (ch *CategoryHandler) Routing(r *mux.Router) {
+func (ch *CategoryHandler) Routing(r *mux.Router, sm *middleware.SessionMiddleware) {
 	s := r.PathPrefix("/category").Subrouter()
 	s.HandleFunc("", middleware.SetSCRFToken(http.HandlerFunc(ch.CategoriesListHandler))).Methods(http.MethodGet, http.MethodOptions)
+	s.HandleFunc("/tree", middleware.SetSCRFToken(http.HandlerFunc(ch.CategoryTreeHandler))).Methods(http.MethodGet, http.MethodOptions)
+	s.HandleFunc("/trending", middleware.SetSCRFToken(http.HandlerFunc(ch.TrendingCategoriesHandler))).Methods(http.MethodGet, http.MethodOptions)
+	s.HandleFunc("/{slug}", middleware.SetSCRFToken(http.HandlerFunc(ch.CategoryHandler))).Methods(http.MethodGet, http.MethodOptions)
+	s.HandleFunc("/{name}/attributes", middleware.SetSCRFToken(http.HandlerFunc(ch.AttributesHandler))).Methods(http.MethodGet, http.MethodOptions)
+	s.Handle("/{name}/attributes", sm.CheckAuthorized(middleware.RequireAdmin(http.HandlerFunc(ch.SetAttributesHandler)))).Methods(http.MethodPost, http.MethodOptions)
+
+	// управление деревом категорий доступно только администраторам, пути как у остальных /admin
+	r.Handle("/admin/categories", sm.CheckAuthorized(middleware.RequireAdmin(http.HandlerFunc(ch.CreateCategoryHandler)))).Methods(http.MethodPost, http.MethodOptions)
+	r.Handle("/admin/categories/{id:[0-9]+}", sm.CheckAuthorized(middleware.RequireAdmin(http.HandlerFunc(ch.UpdateCategoryHandler)))).Methods(http.MethodPost, http.MethodOptions)
+	r.Handle("/admin/categories/{id:[0-9]+}/move", sm.CheckAuthorized(middleware.RequireAdmin(http.HandlerFunc(ch.MoveCategoryHandler)))).Methods(http.MethodPost, http.MethodOptions)
+	r.Handle("/admin/categories/{id:[0-9]+}", sm.CheckAuthorized(middleware.RequireAdmin(http.HandlerFunc(ch.DeleteCategoryHandler)))).Methods(http.MethodDelete, http.MethodOptions)
 }
 
 // CategoriesListHandler godoc
 // @Summary Get list of all categories
-// @Description Get list of all categories
+// @Description Get list of all categories, the list is cached and can be revalidated with If-None-Match
 // @Tags category
 // @Accept application/json
 // @Produce application/json
+// @Param If-None-Match header string false "ETag of the cached list"
 // @Success 200 {object} models.HttpBodyInterface{body=[]models.Category}
+// @Success 304 "List is not modified"
 // @failure default {object} models.HttpError
 // @Router /category [get]
 func (ch CategoryHandler) CategoriesListHandler(w http.ResponseWriter, r *http.Request) {
 	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
-	protocategories, err := ch.categoryUsecase.GetCategories(context.Background(), &proto.Nothing{Dummy: true})
+	protocategories, err := ch.cache.get(ch.categoryUsecase)
 	if err != nil {
 		w.WriteHeader(http.StatusOK)
-		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.FromGRPCStatus(err))
 		_, err := w.Write(models.ToBytes(metaCode, metaMessage, nil))
 		if err != nil {
 			logger.Errorf(err.Error())
@@ -55,11 +76,17 @@ func (ch CategoryHandler) CategoriesListHandler(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	etag := fmt.Sprintf("%q", protocategories.Version)
+	w.Header().Set("ETag", etag)
+	// клиент уже держит эту версию списка
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
 	var categories []*models.Category
 	for _, category := range protocategories.Categories {
-		categories = append(categories, &models.Category{
-			Name: category.Name,
-		})
+		categories = append(categories, categoryFromProto(category))
 	}
 
 	w.WriteHeader(http.StatusOK)
@@ -69,3 +96,487 @@ func (ch CategoryHandler) CategoriesListHandler(w http.ResponseWriter, r *http.R
 		logger.Errorf(err.Error())
 	}
 }
+
+// CategoryTreeHandler godoc
+// @Summary Get category tree
+// @Description Get root categories with nested subcategories
+// @Tags category
+// @Accept application/json
+// @Produce application/json
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyCategories}
+// @failure default {object} models.HttpError
+// @Router /category/tree [get]
+func (ch CategoryHandler) CategoryTreeHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	protocategories, err := ch.categoryUsecase.GetCategoryTree(context.Background(), &proto.Nothing{Dummy: true})
+	if err != nil {
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.FromGRPCStatus(err))
+		_, err := w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Errorf(err.Error())
+		}
+		return
+	}
+
+	categories := make([]*models.Category, 0, len(protocategories.Categories))
+	for _, category := range protocategories.Categories {
+		categories = append(categories, categoryFromProto(category))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyCategories{Categories: categories}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "category tree got successfully", body))
+	if err != nil {
+		logger.Errorf(err.Error())
+	}
+}
+
+// TrendingCategoriesHandler godoc
+// @Summary Get trending categories
+// @Description Get categories ranked by recent publications, views and favorites
+// @Tags category
+// @Accept application/json
+// @Produce application/json
+// @Param limit query integer false "Categories count, 10 by default, 50 at most"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyCategories}
+// @failure default {object} models.HttpError
+// @Router /category/trending [get]
+func (ch CategoryHandler) TrendingCategoriesHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+
+	var limit int64
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.ParseInt(limitStr, 10, 64)
+		if err != nil || limit < 0 {
+			logger.Warnf("invalid data")
+			w.WriteHeader(http.StatusOK)
+			_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
+			if err != nil {
+				logger.Errorf(err.Error())
+			}
+			return
+		}
+	}
+
+	protocategories, err := ch.categoryUsecase.GetTrendingCategories(context.Background(), &proto.TrendingRequest{Limit: limit})
+	if err != nil {
+		logger.Warnf("can not get trending categories: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.FromGRPCStatus(err))
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Errorf(err.Error())
+		}
+		return
+	}
+
+	categories := make([]*models.Category, 0, len(protocategories.Categories))
+	for _, category := range protocategories.Categories {
+		categories = append(categories, categoryFromProto(category))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyCategories{Categories: categories}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "trending categories got successfully", body))
+	if err != nil {
+		logger.Errorf(err.Error())
+	}
+}
+
+// CategoryHandler godoc
+// @Summary Get category
+// @Description Get category by slug with its subcategories
+// @Tags category
+// @Accept application/json
+// @Produce application/json
+// @Param slug path string true "Category slug"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyCategory}
+// @failure default {object} models.HttpError
+// @Router /category/{slug} [get]
+func (ch CategoryHandler) CategoryHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	vars := mux.Vars(r)
+
+	protocategory, err := ch.categoryUsecase.GetCategory(context.Background(), &proto.CategorySlug{Slug: vars["slug"]})
+	if err != nil {
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.FromGRPCStatus(err))
+		_, err := w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Errorf(err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyCategory{Category: *categoryFromProto(protocategory)}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "category got successfully", body))
+	if err != nil {
+		logger.Errorf(err.Error())
+	}
+}
+
+// AttributesHandler godoc
+// @Summary Get category attributes
+// @Description Get attribute schema of category, adverts of category are validated against it
+// @Tags category
+// @Accept application/json
+// @Produce application/json
+// @Param name path string true "Category name"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyCategoryAttributes}
+// @failure default {object} models.HttpError
+// @Router /category/{name}/attributes [get]
+func (ch CategoryHandler) AttributesHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	vars := mux.Vars(r)
+
+	protoattributes, err := ch.categoryUsecase.GetAttributes(context.Background(), &proto.CategoryName{Name: vars["name"]})
+	if err != nil {
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.FromGRPCStatus(err))
+		_, err := w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Errorf(err.Error())
+		}
+		return
+	}
+
+	attributes := make(models.CategoryAttributeList, 0, len(protoattributes.Attributes))
+	for _, attribute := range protoattributes.Attributes {
+		attributes = append(attributes, &models.CategoryAttribute{
+			Name:     attribute.Name,
+			Type:     attribute.Type,
+			Required: attribute.Required,
+			Values:   attribute.Values,
+			Min:      attribute.Min,
+			Max:      attribute.Max,
+		})
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyCategoryAttributes{Attributes: attributes}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "attributes got successfully", body))
+	if err != nil {
+		logger.Errorf(err.Error())
+	}
+}
+
+// SetAttributesHandler godoc
+// @Summary Set category attributes
+// @Description Replace attribute schema of category
+// @Tags category
+// @Accept application/json
+// @Produce application/json
+// @Param name path string true "Category name"
+// @Param body body models.CategoryAttributes true "Attribute schema"
+// @Success 200 {object} models.HttpBodyInterface
+// @failure default {object} models.HttpError
+// @Router /category/{name}/attributes [post]
+func (ch CategoryHandler) SetAttributesHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	vars := mux.Vars(r)
+
+	defer r.Body.Close()
+	schema := &models.CategoryAttributes{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = easyjson.Unmarshal(buf, schema)
+	}
+	for i := 0; err == nil && i < len(schema.Attributes); i++ {
+		_, err = govalidator.ValidateStruct(schema.Attributes[i])
+	}
+	if err != nil || !schema.CheckSchema() {
+		logger.Warnf("invalid attribute schema")
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
+		if err != nil {
+			logger.Errorf(err.Error())
+		}
+		return
+	}
+
+	attributes := &proto.CategoryAttributes{Category: vars["name"]}
+	for _, attribute := range schema.Attributes {
+		attributes.Attributes = append(attributes.Attributes, &proto.Attribute{
+			Name:     attribute.Name,
+			Type:     attribute.Type,
+			Required: attribute.Required,
+			Values:   attribute.Values,
+			Min:      attribute.Min,
+			Max:      attribute.Max,
+		})
+	}
+
+	_, err = ch.categoryUsecase.SetAttributes(context.Background(), attributes)
+	if err != nil {
+		logger.Warnf("can not set attributes: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.FromGRPCStatus(err))
+		_, err := w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Errorf(err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(models.ToBytes(http.StatusOK, "attributes updated", nil))
+	if err != nil {
+		logger.Errorf(err.Error())
+	}
+}
+
+// CreateCategoryHandler godoc
+// @Summary Create category
+// @Description Add new advert category, parent_id = 0 creates root category
+// @Tags admin
+// @Accept application/json
+// @Produce application/json
+// @Param body body models.Category true "Category"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyCategory}
+// @failure default {object} models.HttpError
+// @Router /admin/categories [post]
+func (ch CategoryHandler) CreateCategoryHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+
+	category, ok := readCategory(w, r)
+	if !ok {
+		return
+	}
+
+	protocategory, err := ch.categoryUsecase.CreateCategory(context.Background(), categoryToProto(category))
+	if err != nil {
+		logger.Warnf("can not create category: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.FromGRPCStatus(err))
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Errorf(err.Error())
+		}
+		return
+	}
+
+	ch.cache.invalidate()
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyCategory{Category: *categoryFromProto(protocategory)}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "category created", body))
+	if err != nil {
+		logger.Errorf(err.Error())
+	}
+}
+
+// UpdateCategoryHandler godoc
+// @Summary Update category
+// @Description Change category name, slug, icon, sort order and lifetime, parent is changed by move
+// @Tags admin
+// @Accept application/json
+// @Produce application/json
+// @Param id path integer true "Category id"
+// @Param body body models.Category true "Category"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyCategory}
+// @failure default {object} models.HttpError
+// @Router /admin/categories/{id} [post]
+func (ch CategoryHandler) UpdateCategoryHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+
+	categoryId, ok := readCategoryId(w, r)
+	if !ok {
+		return
+	}
+	category, ok := readCategory(w, r)
+	if !ok {
+		return
+	}
+	category.Id = categoryId
+
+	protocategory, err := ch.categoryUsecase.UpdateCategory(context.Background(), categoryToProto(category))
+	if err != nil {
+		logger.Warnf("can not update category: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.FromGRPCStatus(err))
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Errorf(err.Error())
+		}
+		return
+	}
+
+	ch.cache.invalidate()
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyCategory{Category: *categoryFromProto(protocategory)}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "category updated", body))
+	if err != nil {
+		logger.Errorf(err.Error())
+	}
+}
+
+// MoveCategoryHandler godoc
+// @Summary Move category
+// @Description Move category with its subtree under another parent
+// @Tags admin
+// @Accept application/json
+// @Produce application/json
+// @Param id path integer true "Category id"
+// @Param body body models.CategoryMove true "New parent"
+// @Success 200 {object} models.HttpBodyInterface
+// @failure default {object} models.HttpError
+// @Router /admin/categories/{id}/move [post]
+func (ch CategoryHandler) MoveCategoryHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+
+	categoryId, ok := readCategoryId(w, r)
+	if !ok {
+		return
+	}
+
+	defer r.Body.Close()
+	move := &models.CategoryMove{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = easyjson.Unmarshal(buf, move)
+	}
+	if err != nil || move.ParentId < 0 {
+		logger.Warnf("invalid data")
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
+		if err != nil {
+			logger.Errorf(err.Error())
+		}
+		return
+	}
+
+	_, err = ch.categoryUsecase.MoveCategory(context.Background(), &proto.CategoryMove{Id: categoryId, ParentId: move.ParentId})
+	if err != nil {
+		logger.Warnf("can not move category: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.FromGRPCStatus(err))
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Errorf(err.Error())
+		}
+		return
+	}
+
+	ch.cache.invalidate()
+
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(models.ToBytes(http.StatusOK, "category moved", nil))
+	if err != nil {
+		logger.Errorf(err.Error())
+	}
+}
+
+// DeleteCategoryHandler godoc
+// @Summary Delete category
+// @Description Delete category without adverts and subcategories
+// @Tags admin
+// @Produce application/json
+// @Param id path integer true "Category id"
+// @Success 200 {object} models.HttpBodyInterface
+// @failure default {object} models.HttpError
+// @Router /admin/categories/{id} [delete]
+func (ch CategoryHandler) DeleteCategoryHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+
+	categoryId, ok := readCategoryId(w, r)
+	if !ok {
+		return
+	}
+
+	_, err := ch.categoryUsecase.DeleteCategory(context.Background(), &proto.CategoryId{Id: categoryId})
+	if err != nil {
+		logger.Warnf("can not delete category: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.FromGRPCStatus(err))
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Errorf(err.Error())
+		}
+		return
+	}
+
+	ch.cache.invalidate()
+
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(models.ToBytes(http.StatusOK, "category deleted", nil))
+	if err != nil {
+		logger.Errorf(err.Error())
+	}
+}
+
+// readCategoryId разбирает id категории из пути, при ошибке ответ уже записан
+func readCategoryId(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	vars := mux.Vars(r)
+	categoryId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse category id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Errorf(err.Error())
+		}
+		return 0, false
+	}
+	return categoryId, true
+}
+
+// readCategory разбирает и проверяет категорию из тела запроса, при ошибке ответ уже записан
+func readCategory(w http.ResponseWriter, r *http.Request) (*models.Category, bool) {
+	defer r.Body.Close()
+	category := &models.Category{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = easyjson.Unmarshal(buf, category)
+	}
+	if err == nil {
+		_, err = govalidator.ValidateStruct(category)
+	}
+	if err != nil || category.ParentId < 0 {
+		logger.Warnf("invalid category data")
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
+		if err != nil {
+			logger.Errorf(err.Error())
+		}
+		return nil, false
+	}
+
+	sanitizer := bluemonday.UGCPolicy()
+	category.Name = sanitizer.Sanitize(category.Name)
+	category.Icon = sanitizer.Sanitize(category.Icon)
+	return category, true
+}
+
+func categoryToProto(category *models.Category) *proto.XCategory {
+	return &proto.XCategory{
+		Name:         category.Name,
+		Id:           category.Id,
+		ParentId:     category.ParentId,
+		Slug:         category.Slug,
+		Icon:         category.Icon,
+		SortOrder:    category.SortOrder,
+		LifetimeDays: category.LifetimeDays,
+	}
+}
+
+func categoryFromProto(protocategory *proto.XCategory) *models.Category {
+	category := &models.Category{
+		Name:         protocategory.Name,
+		Id:           protocategory.Id,
+		ParentId:     protocategory.ParentId,
+		Slug:         protocategory.Slug,
+		Icon:         protocategory.Icon,
+		SortOrder:    protocategory.SortOrder,
+		LifetimeDays: protocategory.LifetimeDays,
+		AdvertsCount: protocategory.AdvertsCount,
+	}
+	for _, child := range protocategory.Children {
+		category.Children = append(category.Children, categoryFromProto(child))
+	}
+	return category
+}
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -15,6 +16,8 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestCategoriesListHandlerOk(t *testing.T) {
@@ -69,3 +72,306 @@ func TestCategoriesListHandlerError(t *testing.T) {
 
 	assert.Equal(t, answer.Code, 500)
 }
+
+func TestAttributesHandlerOk(t *testing.T) {
+	cc := mocks.CategoryClient{}
+	ch := NewCategoryHandler(&cc)
+
+	router := mux.NewRouter().PathPrefix("/category").Subrouter()
+	router.Use(middleware.LoggerMiddleware)
+	router.HandleFunc("/{name}/attributes", middleware.SetSCRFToken(http.HandlerFunc(ch.AttributesHandler))).Methods(http.MethodGet, http.MethodOptions)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	protoattributes := &category.CategoryAttributes{Category: "clothes", Attributes: []*category.Attribute{
+		{Name: "size", Type: models.AttributeEnum, Required: true, Values: []string{"S", "M"}},
+	}}
+	cc.On("GetAttributes", mock.Anything, &category.CategoryName{Name: "clothes"}).Return(protoattributes, nil)
+
+	res, err := http.Get(fmt.Sprintf("%s/category/clothes/attributes", srv.URL))
+	assert.Nil(t, err)
+
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, answer.Code)
+	assert.Equal(t, "attributes got successfully", answer.Message)
+}
+
+func TestSetAttributesHandlerOk(t *testing.T) {
+	cc := mocks.CategoryClient{}
+	ch := NewCategoryHandler(&cc)
+
+	router := mux.NewRouter().PathPrefix("/category").Subrouter()
+	router.Use(middleware.LoggerMiddleware)
+	router.HandleFunc("/{name}/attributes", http.HandlerFunc(ch.SetAttributesHandler)).Methods(http.MethodPost, http.MethodOptions)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	cc.On("SetAttributes", mock.Anything, mock.MatchedBy(func(a *category.CategoryAttributes) bool {
+		return a.Category == "cars" && len(a.Attributes) == 1 && a.Attributes[0].Max == 2030
+	})).Return(&category.Nothing{Dummy: true}, nil)
+
+	body := bytes.NewReader([]byte(`{"attributes":[{"name":"year","type":"range","required":true,"min":1950,"max":2030}]}`))
+	res, err := http.Post(fmt.Sprintf("%s/category/cars/attributes", srv.URL), "application/json", body)
+	assert.Nil(t, err)
+
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, answer.Code)
+	cc.AssertExpectations(t)
+}
+
+func TestSetAttributesHandlerInvalidSchema(t *testing.T) {
+	cc := mocks.CategoryClient{}
+	ch := NewCategoryHandler(&cc)
+
+	router := mux.NewRouter().PathPrefix("/category").Subrouter()
+	router.Use(middleware.LoggerMiddleware)
+	router.HandleFunc("/{name}/attributes", http.HandlerFunc(ch.SetAttributesHandler)).Methods(http.MethodPost, http.MethodOptions)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	body := bytes.NewReader([]byte(`{"attributes":[{"name":"size","type":"enum","values":[]}]}`))
+	res, err := http.Post(fmt.Sprintf("%s/category/clothes/attributes", srv.URL), "application/json", body)
+	assert.Nil(t, err)
+
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, answer.Code)
+	cc.AssertNotCalled(t, "SetAttributes", mock.Anything, mock.Anything)
+}
+
+func TestCategoryTreeHandlerOk(t *testing.T) {
+	cc := mocks.CategoryClient{}
+	ch := NewCategoryHandler(&cc)
+
+	router := mux.NewRouter().PathPrefix("/category").Subrouter()
+	router.Use(middleware.LoggerMiddleware)
+	router.HandleFunc("/tree", middleware.SetSCRFToken(http.HandlerFunc(ch.CategoryTreeHandler))).Methods(http.MethodGet, http.MethodOptions)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	prototree := &category.Categories{Categories: []*category.XCategory{
+		{Id: 1, Name: "одежда", Slug: "clothes", Children: []*category.XCategory{
+			{Id: 2, ParentId: 1, Name: "обувь", Slug: "shoes"},
+		}},
+	}}
+	cc.On("GetCategoryTree", mock.Anything, &category.Nothing{Dummy: true}).Return(prototree, nil)
+
+	res, err := http.Get(fmt.Sprintf("%s/category/tree", srv.URL))
+	assert.Nil(t, err)
+
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, answer.Code)
+}
+
+func TestCreateCategoryHandlerOk(t *testing.T) {
+	cc := mocks.CategoryClient{}
+	ch := NewCategoryHandler(&cc)
+
+	router := mux.NewRouter()
+	router.Use(middleware.LoggerMiddleware)
+	router.HandleFunc("/admin/categories", http.HandlerFunc(ch.CreateCategoryHandler)).Methods(http.MethodPost, http.MethodOptions)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	cc.On("CreateCategory", mock.Anything, mock.MatchedBy(func(c *category.XCategory) bool {
+		return c.Slug == "sneakers" && c.ParentId == 2
+	})).Return(&category.XCategory{Id: 3, ParentId: 2, Name: "кроссовки", Slug: "sneakers", LifetimeDays: 30}, nil)
+
+	body := bytes.NewReader([]byte(`{"parent_id":2,"name":"кроссовки","slug":"sneakers"}`))
+	res, err := http.Post(fmt.Sprintf("%s/admin/categories", srv.URL), "application/json", body)
+	assert.Nil(t, err)
+
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, answer.Code)
+	cc.AssertExpectations(t)
+}
+
+func TestCreateCategoryHandlerInvalidSlug(t *testing.T) {
+	cc := mocks.CategoryClient{}
+	ch := NewCategoryHandler(&cc)
+
+	router := mux.NewRouter()
+	router.Use(middleware.LoggerMiddleware)
+	router.HandleFunc("/admin/categories", http.HandlerFunc(ch.CreateCategoryHandler)).Methods(http.MethodPost, http.MethodOptions)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	body := bytes.NewReader([]byte(`{"name":"кроссовки","slug":"Sneakers!"}`))
+	res, err := http.Post(fmt.Sprintf("%s/admin/categories", srv.URL), "application/json", body)
+	assert.Nil(t, err)
+
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, answer.Code)
+	cc.AssertNotCalled(t, "CreateCategory", mock.Anything, mock.Anything)
+}
+
+func TestMoveCategoryHandlerCycle(t *testing.T) {
+	cc := mocks.CategoryClient{}
+	ch := NewCategoryHandler(&cc)
+
+	router := mux.NewRouter()
+	router.Use(middleware.LoggerMiddleware)
+	router.HandleFunc("/admin/categories/{id:[0-9]+}/move", http.HandlerFunc(ch.MoveCategoryHandler)).Methods(http.MethodPost, http.MethodOptions)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	cc.On("MoveCategory", mock.Anything, &category.CategoryMove{Id: 1, ParentId: 3}).
+		Return(nil, status.Error(codes.Aborted, myerr.CategoryCycle.Error()))
+
+	body := bytes.NewReader([]byte(`{"parent_id":3}`))
+	res, err := http.Post(fmt.Sprintf("%s/admin/categories/1/move", srv.URL), "application/json", body)
+	assert.Nil(t, err)
+
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusConflict, answer.Code)
+}
+
+func TestDeleteCategoryHandlerNotEmpty(t *testing.T) {
+	cc := mocks.CategoryClient{}
+	ch := NewCategoryHandler(&cc)
+
+	router := mux.NewRouter()
+	router.Use(middleware.LoggerMiddleware)
+	router.HandleFunc("/admin/categories/{id:[0-9]+}", http.HandlerFunc(ch.DeleteCategoryHandler)).Methods(http.MethodDelete, http.MethodOptions)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	cc.On("DeleteCategory", mock.Anything, &category.CategoryId{Id: 4}).
+		Return(nil, status.Error(codes.Aborted, myerr.CategoryNotEmpty.Error()))
+
+	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/admin/categories/4", srv.URL), nil)
+	assert.Nil(t, err)
+	res, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusConflict, answer.Code)
+	assert.Equal(t, myerr.CategoryNotEmpty.Error(), answer.Message)
+}
+
+func TestTrendingCategoriesHandlerOk(t *testing.T) {
+	cc := mocks.CategoryClient{}
+	ch := NewCategoryHandler(&cc)
+
+	router := mux.NewRouter().PathPrefix("/category").Subrouter()
+	router.Use(middleware.LoggerMiddleware)
+	router.HandleFunc("/trending", middleware.SetSCRFToken(http.HandlerFunc(ch.TrendingCategoriesHandler))).Methods(http.MethodGet, http.MethodOptions)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	prototrending := &category.Categories{Categories: []*category.XCategory{
+		{Id: 3, Name: "животные", Slug: "animals", AdvertsCount: 1243},
+	}}
+	cc.On("GetTrendingCategories", mock.Anything, &category.TrendingRequest{Limit: 5}).Return(prototrending, nil)
+
+	res, err := http.Get(fmt.Sprintf("%s/category/trending?limit=5", srv.URL))
+	assert.Nil(t, err)
+
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, answer.Code)
+	cc.AssertExpectations(t)
+}
+
+func TestTrendingCategoriesHandlerInvalidLimit(t *testing.T) {
+	cc := mocks.CategoryClient{}
+	ch := NewCategoryHandler(&cc)
+
+	router := mux.NewRouter().PathPrefix("/category").Subrouter()
+	router.Use(middleware.LoggerMiddleware)
+	router.HandleFunc("/trending", middleware.SetSCRFToken(http.HandlerFunc(ch.TrendingCategoriesHandler))).Methods(http.MethodGet, http.MethodOptions)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	res, err := http.Get(fmt.Sprintf("%s/category/trending?limit=many", srv.URL))
+	assert.Nil(t, err)
+
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, answer.Code)
+	cc.AssertNotCalled(t, "GetTrendingCategories", mock.Anything, mock.Anything)
+}
+
+func TestCategoriesListHandlerCached(t *testing.T) {
+	cc := mocks.CategoryClient{}
+	ch := NewCategoryHandler(&cc)
+
+	router := mux.NewRouter()
+	router.Use(middleware.LoggerMiddleware)
+	router.HandleFunc("/category", middleware.SetSCRFToken(http.HandlerFunc(ch.CategoriesListHandler))).Methods(http.MethodGet, http.MethodOptions)
+	router.HandleFunc("/admin/categories/{id:[0-9]+}", http.HandlerFunc(ch.DeleteCategoryHandler)).Methods(http.MethodDelete, http.MethodOptions)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	protocategories := &category.Categories{Categories: []*category.XCategory{{Id: 1, Name: "одежда", Slug: "clothes"}}, Version: "v1"}
+	cc.On("GetCategories", mock.Anything, &category.Nothing{Dummy: true}).Return(protocategories, nil).Once()
+
+	res, err := http.Get(fmt.Sprintf("%s/category", srv.URL))
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, `"v1"`, res.Header.Get("ETag"))
+
+	// вторая загрузка берется из кеша, клиент с актуальной версией получает 304
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/category", srv.URL), nil)
+	assert.Nil(t, err)
+	req.Header.Set("If-None-Match", `"v1"`)
+	res, err = http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNotModified, res.StatusCode)
+	cc.AssertNumberOfCalls(t, "GetCategories", 1)
+
+	// удаление категории сбрасывает кеш
+	cc.On("DeleteCategory", mock.Anything, &category.CategoryId{Id: 1}).Return(&category.Nothing{Dummy: true}, nil)
+	req, err = http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/admin/categories/1", srv.URL), nil)
+	assert.Nil(t, err)
+	_, err = http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+
+	cc.On("GetCategories", mock.Anything, &category.Nothing{Dummy: true}).Return(&category.Categories{Version: "v2"}, nil).Once()
+	req, err = http.NewRequest(http.MethodGet, fmt.Sprintf("%s/category", srv.URL), nil)
+	assert.Nil(t, err)
+	req.Header.Set("If-None-Match", `"v1"`)
+	res, err = http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, `"v2"`, res.Header.Get("ETag"))
+	cc.AssertNumberOfCalls(t, "GetCategories", 2)
+}
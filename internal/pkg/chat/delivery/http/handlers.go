@@ -10,6 +10,7 @@ import (
 	"yula/internal/pkg/advt"
 	"yula/internal/pkg/logging"
 	"yula/internal/pkg/middleware"
+	"yula/internal/pkg/notifications"
 	"yula/internal/pkg/user"
 
 	"github.com/gorilla/mux"
@@ -39,16 +40,21 @@ type ChatHandler struct {
 	cu proto.ChatClient
 	au advt.AdvtUsecase
 	uu user.UserUsecase
+	nt notifications.Notifier
 }
 
-func NewChatHandler(cu proto.ChatClient, au advt.AdvtUsecase, uu user.UserUsecase) *ChatHandler {
+func NewChatHandler(cu proto.ChatClient, au advt.AdvtUsecase, uu user.UserUsecase, nt notifications.Notifier) *ChatHandler {
 	return &ChatHandler{
 		cu: cu,
 		au: au,
 		uu: uu,
+		nt: nt,
 	}
 }
 
+// длина отрывка сообщения в уведомлении, в символах
+const notificationPreview = 100
+
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024,
@@ -182,7 +188,9 @@ func (ch *ChatHandler) HandleMessages(session *ChatSession, conn *websocket.Conn
 		key := fmt.Sprintf("%d->%d:%d", session.idTo, session.idFrom, session.idAdv)
 		to := chatSessions[key]
 
-		if to == nil {
+		// у собеседника диалог не открыт, сообщение он увидит по уведомлению
+		if to == nil || len(to.conn) == 0 {
+			ch.notifyRecipient(session, string(msg))
 			continue
 		}
 
@@ -195,6 +203,24 @@ func (ch *ChatHandler) HandleMessages(session *ChatSession, conn *websocket.Conn
 	}
 }
 
+func (ch *ChatHandler) notifyRecipient(session *ChatSession, msg string) {
+	preview := []rune(msg)
+	if len(preview) > notificationPreview {
+		preview = append(preview[:notificationPreview], []rune("...")...)
+	}
+
+	err := ch.nt.Notify(&models.Notification{
+		UserId:   session.idTo,
+		Kind:     models.NotificationChatMessage,
+		Text:     fmt.Sprintf("New message: %s", string(preview)),
+		AdvertId: session.idAdv,
+		SenderId: session.idFrom,
+	})
+	if err != nil {
+		logger.Warnf("can not notify user %d about message from user %d: %s", session.idTo, session.idFrom, err.Error())
+	}
+}
+
 func (ch *ChatHandler) getHistoryHandler(w http.ResponseWriter, r *http.Request) {
 	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
 
@@ -254,6 +280,7 @@ func (ch *ChatHandler) getHistoryHandler(w http.ResponseWriter, r *http.Request)
 	var messages []*models.Message
 	for _, message := range protomessages.M {
 		messages = append(messages, &models.Message{
+			Id: message.Id,
 			MI: models.IMessage{
 				IdFrom: message.MI.IdFrom,
 				IdTo:   message.MI.IdTo,
@@ -0,0 +1,166 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	chat "yula/proto/generated/chat"
+
+	mock "github.com/stretchr/testify/mock"
+	grpc "google.golang.org/grpc"
+)
+
+// ChatClient is an autogenerated mock type for the ChatClient type
+type ChatClient struct {
+	mock.Mock
+}
+
+// Clear provides a mock function with given fields: ctx, in, opts
+func (_m *ChatClient) Clear(ctx context.Context, in *chat.DialogIdentifier, opts ...grpc.CallOption) (*chat.Nothing, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *chat.Nothing
+	if rf, ok := ret.Get(0).(func(context.Context, *chat.DialogIdentifier, ...grpc.CallOption) *chat.Nothing); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*chat.Nothing)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *chat.DialogIdentifier, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: ctx, in, opts
+func (_m *ChatClient) Create(ctx context.Context, in *chat.Message, opts ...grpc.CallOption) (*chat.Nothing, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *chat.Nothing
+	if rf, ok := ret.Get(0).(func(context.Context, *chat.Message, ...grpc.CallOption) *chat.Nothing); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*chat.Nothing)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *chat.Message, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateDialog provides a mock function with given fields: ctx, in, opts
+func (_m *ChatClient) CreateDialog(ctx context.Context, in *chat.Dialog, opts ...grpc.CallOption) (*chat.Nothing, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *chat.Nothing
+	if rf, ok := ret.Get(0).(func(context.Context, *chat.Dialog, ...grpc.CallOption) *chat.Nothing); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*chat.Nothing)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *chat.Dialog, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDialogs provides a mock function with given fields: ctx, in, opts
+func (_m *ChatClient) GetDialogs(ctx context.Context, in *chat.UserIdentifier, opts ...grpc.CallOption) (*chat.Dialogs, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *chat.Dialogs
+	if rf, ok := ret.Get(0).(func(context.Context, *chat.UserIdentifier, ...grpc.CallOption) *chat.Dialogs); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*chat.Dialogs)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *chat.UserIdentifier, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHistory provides a mock function with given fields: ctx, in, opts
+func (_m *ChatClient) GetHistory(ctx context.Context, in *chat.GetHistoryArg, opts ...grpc.CallOption) (*chat.Messages, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *chat.Messages
+	if rf, ok := ret.Get(0).(func(context.Context, *chat.GetHistoryArg, ...grpc.CallOption) *chat.Messages); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*chat.Messages)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *chat.GetHistoryArg, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
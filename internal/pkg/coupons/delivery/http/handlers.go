@@ -0,0 +1,196 @@
+package delivery
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/coupons"
+	"yula/internal/pkg/logging"
+	"yula/internal/pkg/middleware"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	logger logging.Logger = logging.GetLogger()
+)
+
+type CouponHandler struct {
+	couponUsecase coupons.CouponUsecase
+}
+
+func NewCouponHandler(couponUsecase coupons.CouponUsecase) *CouponHandler {
+	return &CouponHandler{
+		couponUsecase: couponUsecase,
+	}
+}
+
+func (ch *CouponHandler) Routing(r *mux.Router, sm *middleware.SessionMiddleware) {
+	s := r.PathPrefix("/coupons").Subrouter()
+	s.Use(sm.CheckAuthorized)
+
+	s.HandleFunc("", ch.CreateCouponHandler).Methods(http.MethodPost, http.MethodOptions)
+	s.HandleFunc("", middleware.SetSCRFToken(http.HandlerFunc(ch.CouponsListHandler))).Methods(http.MethodGet, http.MethodOptions)
+	s.HandleFunc("/{id:[0-9]+}", ch.RemoveCouponHandler).Methods(http.MethodDelete, http.MethodOptions)
+}
+
+// CreateCouponHandler godoc
+// @Summary Create coupon
+// @Description Seller creates a promo code for own adverts
+// @Tags coupons
+// @Accept application/json
+// @Produce application/json
+// @Param body body models.CouponInput true "Coupon"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyCoupon}
+// @failure default {object} models.HttpError
+// @Router /coupons [post]
+func (ch *CouponHandler) CreateCouponHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	defer r.Body.Close()
+	input := &models.CouponInput{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = easyjson.Unmarshal(buf, input)
+	}
+	if err == nil {
+		_, err = govalidator.ValidateStruct(input)
+	}
+	if err != nil {
+		logger.Warnf("invalid data: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	coupon, err := ch.couponUsecase.CreateCoupon(userId, input)
+	if err != nil {
+		logger.Warnf("can not create coupon: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyCoupon{Coupon: *coupon}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "coupon created", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// CouponsListHandler godoc
+// @Summary Seller's coupons
+// @Description Coupons created by the current user, newest first
+// @Tags coupons
+// @Accept application/json
+// @Produce application/json
+// @Param page query string false "Page num"
+// @Param count query string false "Count"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyCoupons}
+// @failure default {object} models.HttpError
+// @Router /coupons [get]
+func (ch *CouponHandler) CouponsListHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	query := r.URL.Query()
+	page, err := models.NewPage(query.Get("page"), query.Get("count"))
+	if err != nil {
+		logger.Warnf("can not create page: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	salesmanCoupons, err := ch.couponUsecase.GetCoupons(userId, page)
+	if err != nil {
+		logger.Warnf("can not get coupons: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyCoupons{Coupons: salesmanCoupons}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "coupons got successfully", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// RemoveCouponHandler godoc
+// @Summary Remove coupon
+// @Description Seller removes own coupon, carts lose it, placed orders keep their prices
+// @Tags coupons
+// @Accept application/json
+// @Produce application/json
+// @Param id path integer true "Coupon id"
+// @Success 200 {object} models.HttpBodyInterface
+// @failure default {object} models.HttpError
+// @Router /coupons/{id} [delete]
+func (ch *CouponHandler) RemoveCouponHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	couponId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse coupon id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	err = ch.couponUsecase.RemoveCoupon(couponId, userId)
+	if err != nil {
+		logger.Warnf("can not remove coupon: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(models.ToBytes(http.StatusOK, "coupon removed", nil))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
@@ -0,0 +1,152 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"yula/internal/models"
+	"yula/internal/pkg/middleware"
+
+	couponMock "yula/internal/pkg/coupons/mocks"
+
+	myerr "yula/internal/error"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func withUser(userId int64) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), middleware.ContextUserId, userId)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func newTestRouter(ch *CouponHandler, userId int64) *mux.Router {
+	router := mux.NewRouter().PathPrefix("/coupons").Subrouter()
+	router.HandleFunc("", ch.CreateCouponHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.HandleFunc("", ch.CouponsListHandler).Methods(http.MethodGet, http.MethodOptions)
+	router.HandleFunc("/{id:[0-9]+}", ch.RemoveCouponHandler).Methods(http.MethodDelete, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+	router.Use(withUser(userId))
+	return router
+}
+
+var testCoupon = models.Coupon{
+	Id:         4,
+	SalesmanId: 2,
+	Code:       "SALE10",
+	Kind:       models.CouponKindPercent,
+	Value:      10,
+}
+
+func TestCreateCouponSuccess(t *testing.T) {
+	cu := couponMock.CouponUsecase{}
+	ch := NewCouponHandler(&cu)
+
+	srv := httptest.NewServer(newTestRouter(ch, 2))
+	defer srv.Close()
+
+	cu.On("CreateCoupon", int64(2), mock.MatchedBy(func(input *models.CouponInput) bool {
+		return input.Code == "SALE10" && input.Kind == models.CouponKindPercent && !input.ValidUntil.IsZero()
+	})).Return(&testCoupon, nil)
+
+	reqBody := `{"code": "SALE10", "kind": "percent", "value": 10, "valid_until": "2030-01-01T00:00:00Z"}`
+	res, err := http.Post(fmt.Sprintf("%s/coupons", srv.URL), "application/json", bytes.NewBufferString(reqBody))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "coupon created", Answer.Message)
+}
+
+func TestCreateCouponInvalidKind(t *testing.T) {
+	cu := couponMock.CouponUsecase{}
+	ch := NewCouponHandler(&cu)
+
+	srv := httptest.NewServer(newTestRouter(ch, 2))
+	defer srv.Close()
+
+	reqBody := `{"code": "SALE10", "kind": "gift", "value": 10, "valid_until": "2030-01-01T00:00:00Z"}`
+	res, err := http.Post(fmt.Sprintf("%s/coupons", srv.URL), "application/json", bytes.NewBufferString(reqBody))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, Answer.Code)
+	assert.Equal(t, "invalid data", Answer.Message)
+}
+
+func TestCreateCouponDuplicate(t *testing.T) {
+	cu := couponMock.CouponUsecase{}
+	ch := NewCouponHandler(&cu)
+
+	srv := httptest.NewServer(newTestRouter(ch, 2))
+	defer srv.Close()
+
+	cu.On("CreateCoupon", int64(2), mock.Anything).Return(nil, myerr.AlreadyExist)
+
+	reqBody := `{"code": "SALE10", "kind": "fixed", "value": 50, "valid_until": "2030-01-01T00:00:00Z"}`
+	res, err := http.Post(fmt.Sprintf("%s/coupons", srv.URL), "application/json", bytes.NewBufferString(reqBody))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusForbidden, Answer.Code)
+}
+
+func TestCouponsListSuccess(t *testing.T) {
+	cu := couponMock.CouponUsecase{}
+	ch := NewCouponHandler(&cu)
+
+	srv := httptest.NewServer(newTestRouter(ch, 2))
+	defer srv.Close()
+
+	cu.On("GetCoupons", int64(2), &models.Page{PageNum: 0, Count: 50}).Return([]*models.Coupon{&testCoupon}, nil)
+
+	res, err := http.Get(fmt.Sprintf("%s/coupons", srv.URL))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "coupons got successfully", Answer.Message)
+}
+
+func TestRemoveCouponNotFound(t *testing.T) {
+	cu := couponMock.CouponUsecase{}
+	ch := NewCouponHandler(&cu)
+
+	srv := httptest.NewServer(newTestRouter(ch, 3))
+	defer srv.Close()
+
+	cu.On("RemoveCoupon", int64(4), int64(3)).Return(myerr.CouponNotFound)
+
+	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/coupons/4", srv.URL), nil)
+	assert.Nil(t, err)
+	res, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusNotFound, Answer.Code)
+	assert.Equal(t, "coupon not found", Answer.Message)
+}
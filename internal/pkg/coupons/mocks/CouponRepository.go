@@ -0,0 +1,111 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CouponRepository is an autogenerated mock type for the CouponRepository type
+type CouponRepository struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: couponId, salesmanId
+func (_m *CouponRepository) Delete(couponId int64, salesmanId int64) error {
+	ret := _m.Called(couponId, salesmanId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(couponId, salesmanId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Insert provides a mock function with given fields: coupon
+func (_m *CouponRepository) Insert(coupon *models.Coupon) error {
+	ret := _m.Called(coupon)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Coupon) error); ok {
+		r0 = rf(coupon)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SelectByCode provides a mock function with given fields: salesmanId, code
+func (_m *CouponRepository) SelectByCode(salesmanId int64, code string) (*models.Coupon, error) {
+	ret := _m.Called(salesmanId, code)
+
+	var r0 *models.Coupon
+	if rf, ok := ret.Get(0).(func(int64, string) *models.Coupon); ok {
+		r0 = rf(salesmanId, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Coupon)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, string) error); ok {
+		r1 = rf(salesmanId, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SelectById provides a mock function with given fields: couponId
+func (_m *CouponRepository) SelectById(couponId int64) (*models.Coupon, error) {
+	ret := _m.Called(couponId)
+
+	var r0 *models.Coupon
+	if rf, ok := ret.Get(0).(func(int64) *models.Coupon); ok {
+		r0 = rf(couponId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Coupon)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(couponId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SelectBySalesmanId provides a mock function with given fields: salesmanId, from, count
+func (_m *CouponRepository) SelectBySalesmanId(salesmanId int64, from int64, count int64) ([]*models.Coupon, error) {
+	ret := _m.Called(salesmanId, from, count)
+
+	var r0 []*models.Coupon
+	if rf, ok := ret.Get(0).(func(int64, int64, int64) []*models.Coupon); ok {
+		r0 = rf(salesmanId, from, count)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Coupon)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64, int64) error); ok {
+		r1 = rf(salesmanId, from, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
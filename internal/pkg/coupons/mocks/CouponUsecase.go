@@ -0,0 +1,97 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CouponUsecase is an autogenerated mock type for the CouponUsecase type
+type CouponUsecase struct {
+	mock.Mock
+}
+
+// CreateCoupon provides a mock function with given fields: salesmanId, input
+func (_m *CouponUsecase) CreateCoupon(salesmanId int64, input *models.CouponInput) (*models.Coupon, error) {
+	ret := _m.Called(salesmanId, input)
+
+	var r0 *models.Coupon
+	if rf, ok := ret.Get(0).(func(int64, *models.CouponInput) *models.Coupon); ok {
+		r0 = rf(salesmanId, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Coupon)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, *models.CouponInput) error); ok {
+		r1 = rf(salesmanId, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindCoupon provides a mock function with given fields: code, advert
+func (_m *CouponUsecase) FindCoupon(code string, advert *models.Advert) (*models.Coupon, error) {
+	ret := _m.Called(code, advert)
+
+	var r0 *models.Coupon
+	if rf, ok := ret.Get(0).(func(string, *models.Advert) *models.Coupon); ok {
+		r0 = rf(code, advert)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Coupon)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *models.Advert) error); ok {
+		r1 = rf(code, advert)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCoupons provides a mock function with given fields: salesmanId, page
+func (_m *CouponUsecase) GetCoupons(salesmanId int64, page *models.Page) ([]*models.Coupon, error) {
+	ret := _m.Called(salesmanId, page)
+
+	var r0 []*models.Coupon
+	if rf, ok := ret.Get(0).(func(int64, *models.Page) []*models.Coupon); ok {
+		r0 = rf(salesmanId, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Coupon)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, *models.Page) error); ok {
+		r1 = rf(salesmanId, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveCoupon provides a mock function with given fields: couponId, salesmanId
+func (_m *CouponUsecase) RemoveCoupon(couponId int64, salesmanId int64) error {
+	ret := _m.Called(couponId, salesmanId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(couponId, salesmanId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,13 @@
+package coupons
+
+import "yula/internal/models"
+
+//go:generate mockery -name=CouponRepository
+
+type CouponRepository interface {
+	Insert(coupon *models.Coupon) error
+	SelectById(couponId int64) (*models.Coupon, error)
+	SelectByCode(salesmanId int64, code string) (*models.Coupon, error)
+	SelectBySalesmanId(salesmanId int64, from, count int64) ([]*models.Coupon, error)
+	Delete(couponId int64, salesmanId int64) error
+}
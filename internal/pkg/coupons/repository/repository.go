@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/coupons"
+)
+
+type CouponRepository struct {
+	DB *sql.DB
+}
+
+func NewCouponRepository(DB *sql.DB) coupons.CouponRepository {
+	return &CouponRepository{
+		DB: DB,
+	}
+}
+
+func (cr *CouponRepository) Insert(coupon *models.Coupon) error {
+	// код купона уникален в пределах одного продавца
+	queryStr := `INSERT INTO coupon (salesman_id, code, kind, value, min_price, usage_limit, valid_from, valid_until)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+				ON CONFLICT (salesman_id, code) DO NOTHING RETURNING id, created_at;`
+	query := cr.DB.QueryRowContext(context.Background(), queryStr, coupon.SalesmanId, coupon.Code, coupon.Kind,
+		coupon.Value, coupon.MinPrice, coupon.UsageLimit, coupon.ValidFrom, coupon.ValidUntil)
+
+	err := query.Scan(&coupon.Id, &coupon.CreatedAt)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return internalError.AlreadyExist
+		}
+		return internalError.GenInternalError(err)
+	}
+
+	return nil
+}
+
+func (cr *CouponRepository) SelectById(couponId int64) (*models.Coupon, error) {
+	queryStr := `SELECT id, salesman_id, code, kind, value, min_price, usage_limit, used, valid_from, valid_until, created_at
+				FROM coupon WHERE id = $1;`
+	query := cr.DB.QueryRowContext(context.Background(), queryStr, couponId)
+
+	coupon, err := scanCoupon(query)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return nil, internalError.EmptyQuery
+		}
+		return nil, internalError.GenInternalError(err)
+	}
+
+	return coupon, nil
+}
+
+func (cr *CouponRepository) SelectByCode(salesmanId int64, code string) (*models.Coupon, error) {
+	queryStr := `SELECT id, salesman_id, code, kind, value, min_price, usage_limit, used, valid_from, valid_until, created_at
+				FROM coupon WHERE salesman_id = $1 AND code = $2;`
+	query := cr.DB.QueryRowContext(context.Background(), queryStr, salesmanId, code)
+
+	coupon, err := scanCoupon(query)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return nil, internalError.EmptyQuery
+		}
+		return nil, internalError.GenInternalError(err)
+	}
+
+	return coupon, nil
+}
+
+func (cr *CouponRepository) SelectBySalesmanId(salesmanId int64, from, count int64) ([]*models.Coupon, error) {
+	queryStr := `SELECT id, salesman_id, code, kind, value, min_price, usage_limit, used, valid_from, valid_until, created_at
+				FROM coupon WHERE salesman_id = $1
+				ORDER BY created_at DESC
+				LIMIT $2 OFFSET $3;`
+	rows, err := cr.DB.QueryContext(context.Background(), queryStr, salesmanId, count, from*count)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+
+	defer rows.Close()
+	salesmanCoupons := make([]*models.Coupon, 0)
+	for rows.Next() {
+		coupon, err := scanCoupon(rows)
+		if err != nil {
+			return nil, internalError.GenInternalError(err)
+		}
+
+		salesmanCoupons = append(salesmanCoupons, coupon)
+	}
+
+	return salesmanCoupons, nil
+}
+
+func (cr *CouponRepository) Delete(couponId int64, salesmanId int64) error {
+	queryStr := "DELETE FROM coupon WHERE id = $1 AND salesman_id = $2;"
+	res, err := cr.DB.ExecContext(context.Background(), queryStr, couponId, salesmanId)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	if affected == 0 {
+		return internalError.EmptyQuery
+	}
+
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanCoupon(row scanner) (*models.Coupon, error) {
+	var coupon models.Coupon
+	err := row.Scan(&coupon.Id, &coupon.SalesmanId, &coupon.Code, &coupon.Kind, &coupon.Value, &coupon.MinPrice,
+		&coupon.UsageLimit, &coupon.Used, &coupon.ValidFrom, &coupon.ValidUntil, &coupon.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &coupon, nil
+}
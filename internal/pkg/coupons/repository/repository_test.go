@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	sqlmock "gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+var validFrom = time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+var validUntil = time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC)
+
+func newTestCoupon() *models.Coupon {
+	return &models.Coupon{
+		SalesmanId: 2,
+		Code:       "SALE10",
+		Kind:       models.CouponKindPercent,
+		Value:      10,
+		MinPrice:   100,
+		UsageLimit: 5,
+		ValidFrom:  validFrom,
+		ValidUntil: validUntil,
+	}
+}
+
+var couponColumns = []string{"id", "salesman_id", "code", "kind", "value", "min_price", "usage_limit", "used",
+	"valid_from", "valid_until", "created_at"}
+
+func TestInsertOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCouponRepository(db)
+	coupon := newTestCoupon()
+
+	mock.ExpectQuery("INSERT INTO coupon").WithArgs(int64(2), "SALE10", models.CouponKindPercent, int64(10),
+		int64(100), int64(5), validFrom, validUntil).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(4, time.Now()))
+
+	err = repo.Insert(coupon)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), coupon.Id)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestInsertDuplicateCode(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCouponRepository(db)
+
+	mock.ExpectQuery("INSERT INTO coupon").WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}))
+
+	err = repo.Insert(newTestCoupon())
+	assert.Equal(t, internalError.AlreadyExist, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectByCodeOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCouponRepository(db)
+
+	mock.ExpectQuery("SELECT id, salesman_id").WithArgs(int64(2), "SALE10").
+		WillReturnRows(sqlmock.NewRows(couponColumns).
+			AddRow(4, 2, "SALE10", "percent", 10, 100, 5, 1, validFrom, validUntil, time.Now()))
+
+	coupon, err := repo.SelectByCode(2, "SALE10")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), coupon.Id)
+	assert.Equal(t, int64(1), coupon.Used)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectByIdEmpty(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCouponRepository(db)
+
+	mock.ExpectQuery("SELECT id, salesman_id").WithArgs(int64(4)).WillReturnRows(sqlmock.NewRows(couponColumns))
+
+	_, err = repo.SelectById(4)
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectBySalesmanIdOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCouponRepository(db)
+
+	mock.ExpectQuery("SELECT id, salesman_id").WithArgs(int64(2), int64(50), int64(0)).
+		WillReturnRows(sqlmock.NewRows(couponColumns).
+			AddRow(4, 2, "SALE10", "percent", 10, 100, 5, 1, validFrom, validUntil, time.Now()).
+			AddRow(5, 2, "MINUS50", "fixed", 50, 0, 0, 0, validFrom, validUntil, time.Now()))
+
+	salesmanCoupons, err := repo.SelectBySalesmanId(2, 0, 50)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(salesmanCoupons))
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectBySalesmanIdError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCouponRepository(db)
+
+	mock.ExpectQuery("SELECT id, salesman_id").WillReturnError(sql.ErrConnDone)
+
+	_, err = repo.SelectBySalesmanId(2, 0, 50)
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestDeleteOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCouponRepository(db)
+
+	mock.ExpectExec("DELETE FROM coupon").WithArgs(int64(4), int64(2)).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.Delete(4, 2)
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestDeleteForeign(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCouponRepository(db)
+
+	mock.ExpectExec("DELETE FROM coupon").WithArgs(int64(4), int64(3)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.Delete(4, 3)
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
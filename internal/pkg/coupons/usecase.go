@@ -0,0 +1,12 @@
+package coupons
+
+import "yula/internal/models"
+
+//go:generate mockery -name=CouponUsecase
+
+type CouponUsecase interface {
+	CreateCoupon(salesmanId int64, input *models.CouponInput) (*models.Coupon, error)
+	GetCoupons(salesmanId int64, page *models.Page) ([]*models.Coupon, error)
+	RemoveCoupon(couponId int64, salesmanId int64) error
+	FindCoupon(code string, advert *models.Advert) (*models.Coupon, error)
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"strings"
+	"time"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/coupons"
+)
+
+type CouponUsecase struct {
+	couponRepository coupons.CouponRepository
+}
+
+func NewCouponUsecase(couponRepository coupons.CouponRepository) coupons.CouponUsecase {
+	return &CouponUsecase{
+		couponRepository: couponRepository,
+	}
+}
+
+func (cu *CouponUsecase) CreateCoupon(salesmanId int64, input *models.CouponInput) (*models.Coupon, error) {
+	switch {
+	case input.Kind == models.CouponKindPercent && (input.Value < 1 || input.Value > 100):
+		return nil, internalError.BadRequest
+	case input.Kind == models.CouponKindFixed && input.Value < 1:
+		return nil, internalError.BadRequest
+	case input.MinPrice < 0 || input.UsageLimit < 0:
+		return nil, internalError.BadRequest
+	}
+
+	// без даты начала купон действует сразу
+	validFrom := input.ValidFrom
+	if validFrom.IsZero() {
+		validFrom = time.Now()
+	}
+	if !input.ValidUntil.After(validFrom) {
+		return nil, internalError.BadRequest
+	}
+
+	coupon := &models.Coupon{
+		SalesmanId: salesmanId,
+		Code:       strings.ToUpper(input.Code),
+		Kind:       input.Kind,
+		Value:      input.Value,
+		MinPrice:   input.MinPrice,
+		UsageLimit: input.UsageLimit,
+		ValidFrom:  validFrom,
+		ValidUntil: input.ValidUntil,
+	}
+	err := cu.couponRepository.Insert(coupon)
+	if err != nil {
+		return nil, err
+	}
+
+	return coupon, nil
+}
+
+func (cu *CouponUsecase) GetCoupons(salesmanId int64, page *models.Page) ([]*models.Coupon, error) {
+	return cu.couponRepository.SelectBySalesmanId(salesmanId, page.PageNum, page.Count)
+}
+
+func (cu *CouponUsecase) RemoveCoupon(couponId int64, salesmanId int64) error {
+	err := cu.couponRepository.Delete(couponId, salesmanId)
+	if err == internalError.EmptyQuery {
+		return internalError.CouponNotFound
+	}
+	return err
+}
+
+// FindCoupon ищет купон продавца объявления и проверяет, что его можно применить к цене объявления
+func (cu *CouponUsecase) FindCoupon(code string, advert *models.Advert) (*models.Coupon, error) {
+	coupon, err := cu.couponRepository.SelectByCode(advert.PublisherId, strings.ToUpper(code))
+	if err == internalError.EmptyQuery {
+		return nil, internalError.CouponNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = coupon.Check(int64(advert.Price), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return coupon, nil
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+	"yula/internal/models"
+	"yula/internal/pkg/coupons/mocks"
+
+	myerr "yula/internal/error"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestInput() *models.CouponInput {
+	return &models.CouponInput{
+		Code:       "sale10",
+		Kind:       models.CouponKindPercent,
+		Value:      10,
+		ValidUntil: time.Now().Add(24 * time.Hour),
+	}
+}
+
+func newTestCoupon() *models.Coupon {
+	return &models.Coupon{
+		Id:         4,
+		SalesmanId: 2,
+		Code:       "SALE10",
+		Kind:       models.CouponKindPercent,
+		Value:      10,
+		MinPrice:   100,
+		UsageLimit: 5,
+		ValidFrom:  time.Now().Add(-time.Hour),
+		ValidUntil: time.Now().Add(time.Hour),
+	}
+}
+
+func TestCreateCouponSuccess(t *testing.T) {
+	cr := mocks.CouponRepository{}
+	cr.On("Insert", mock.MatchedBy(func(c *models.Coupon) bool {
+		return c.Code == "SALE10" && c.SalesmanId == 2 && !c.ValidFrom.IsZero()
+	})).Return(nil)
+
+	cu := NewCouponUsecase(&cr)
+	coupon, err := cu.CreateCoupon(2, newTestInput())
+	assert.Nil(t, err)
+	assert.Equal(t, "SALE10", coupon.Code)
+}
+
+func TestCreateCouponPercentTooBig(t *testing.T) {
+	cr := mocks.CouponRepository{}
+
+	input := newTestInput()
+	input.Value = 101
+
+	cu := NewCouponUsecase(&cr)
+	_, err := cu.CreateCoupon(2, input)
+	assert.Equal(t, myerr.BadRequest, err)
+}
+
+func TestCreateCouponInvalidWindow(t *testing.T) {
+	cr := mocks.CouponRepository{}
+
+	input := newTestInput()
+	input.ValidFrom = time.Now()
+	input.ValidUntil = input.ValidFrom.Add(-time.Hour)
+
+	cu := NewCouponUsecase(&cr)
+	_, err := cu.CreateCoupon(2, input)
+	assert.Equal(t, myerr.BadRequest, err)
+	cr.AssertNotCalled(t, "Insert", mock.Anything)
+}
+
+func TestCreateCouponDuplicate(t *testing.T) {
+	cr := mocks.CouponRepository{}
+	cr.On("Insert", mock.AnythingOfType("*models.Coupon")).Return(myerr.AlreadyExist)
+
+	cu := NewCouponUsecase(&cr)
+	_, err := cu.CreateCoupon(2, newTestInput())
+	assert.Equal(t, myerr.AlreadyExist, err)
+}
+
+func TestGetCoupons(t *testing.T) {
+	cr := mocks.CouponRepository{}
+	cr.On("SelectBySalesmanId", int64(2), int64(1), int64(10)).Return([]*models.Coupon{newTestCoupon()}, nil)
+
+	cu := NewCouponUsecase(&cr)
+	salesmanCoupons, err := cu.GetCoupons(2, &models.Page{PageNum: 1, Count: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(salesmanCoupons))
+}
+
+func TestRemoveCouponForeign(t *testing.T) {
+	cr := mocks.CouponRepository{}
+	cr.On("Delete", int64(4), int64(3)).Return(myerr.EmptyQuery)
+
+	cu := NewCouponUsecase(&cr)
+	err := cu.RemoveCoupon(4, 3)
+	assert.Equal(t, myerr.CouponNotFound, err)
+}
+
+func TestFindCouponSuccess(t *testing.T) {
+	cr := mocks.CouponRepository{}
+	cr.On("SelectByCode", int64(2), "SALE10").Return(newTestCoupon(), nil)
+
+	cu := NewCouponUsecase(&cr)
+	coupon, err := cu.FindCoupon("sale10", &models.Advert{Id: 10, PublisherId: 2, Price: 200})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(180), coupon.Apply(200))
+}
+
+func TestFindCouponNotFound(t *testing.T) {
+	cr := mocks.CouponRepository{}
+	cr.On("SelectByCode", int64(3), "SALE10").Return(nil, myerr.EmptyQuery)
+
+	cu := NewCouponUsecase(&cr)
+	_, err := cu.FindCoupon("SALE10", &models.Advert{Id: 10, PublisherId: 3, Price: 200})
+	assert.Equal(t, myerr.CouponNotFound, err)
+}
+
+func TestFindCouponExhausted(t *testing.T) {
+	coupon := newTestCoupon()
+	coupon.Used = coupon.UsageLimit
+
+	cr := mocks.CouponRepository{}
+	cr.On("SelectByCode", int64(2), "SALE10").Return(coupon, nil)
+
+	cu := NewCouponUsecase(&cr)
+	_, err := cu.FindCoupon("SALE10", &models.Advert{Id: 10, PublisherId: 2, Price: 200})
+	assert.Equal(t, myerr.CouponExhausted, err)
+}
+
+func TestFindCouponNotStarted(t *testing.T) {
+	coupon := newTestCoupon()
+	coupon.ValidFrom = time.Now().Add(time.Hour)
+	coupon.ValidUntil = time.Now().Add(2 * time.Hour)
+
+	cr := mocks.CouponRepository{}
+	cr.On("SelectByCode", int64(2), "SALE10").Return(coupon, nil)
+
+	cu := NewCouponUsecase(&cr)
+	_, err := cu.FindCoupon("SALE10", &models.Advert{Id: 10, PublisherId: 2, Price: 200})
+	assert.Equal(t, myerr.CouponExpired, err)
+}
+
+func TestFixedCouponNotBelowZero(t *testing.T) {
+	coupon := &models.Coupon{Kind: models.CouponKindFixed, Value: 500}
+	assert.Equal(t, int64(0), coupon.Apply(300))
+	assert.Equal(t, int64(500), coupon.Apply(1000))
+}
@@ -0,0 +1,320 @@
+package delivery
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/disputes"
+	"yula/internal/pkg/logging"
+	"yula/internal/pkg/middleware"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	logger logging.Logger = logging.GetLogger()
+)
+
+type DisputeHandler struct {
+	disputeUsecase disputes.DisputeUsecase
+}
+
+func NewDisputeHandler(disputeUsecase disputes.DisputeUsecase) *DisputeHandler {
+	return &DisputeHandler{
+		disputeUsecase: disputeUsecase,
+	}
+}
+
+func (dh *DisputeHandler) Routing(r *mux.Router, sm *middleware.SessionMiddleware) {
+	r.Handle("/orders/{id:[0-9]+}/dispute", sm.CheckAuthorized(http.HandlerFunc(dh.OpenDisputeHandler))).Methods(http.MethodPost, http.MethodOptions)
+	r.Handle("/orders/{id:[0-9]+}/dispute", middleware.SetSCRFToken(sm.CheckAuthorized(http.HandlerFunc(dh.DisputeHandler)))).Methods(http.MethodGet, http.MethodOptions)
+	r.Handle("/disputes/{id:[0-9]+}/answer", sm.CheckAuthorized(http.HandlerFunc(dh.AnswerHandler))).Methods(http.MethodPost, http.MethodOptions)
+	r.Handle("/admin/disputes/{id:[0-9]+}/resolve", sm.CheckAuthorized(middleware.RequireAdmin(http.HandlerFunc(dh.ResolveHandler)))).Methods(http.MethodPost, http.MethodOptions)
+}
+
+// OpenDisputeHandler godoc
+// @Summary Open dispute
+// @Description Buyer disputes a paid order, photos are optional
+// @Tags disputes
+// @Accept multipart/form-data
+// @Produce application/json
+// @Param id path integer true "Order id"
+// @Param reason formData string true "not_received or not_as_described"
+// @Param message formData string true "Message"
+// @Param images formData file false "Photo evidence"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyDispute}
+// @failure default {object} models.HttpError
+// @Router /orders/{id}/dispute [post]
+func (dh *DisputeHandler) OpenDisputeHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	orderId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse order id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	defer r.Body.Close()
+	err = r.ParseMultipartForm(8 << 20) // 8Мб
+	if err != nil {
+		logger.Warnf("can not parse dispute form: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	input := &models.DisputeInput{
+		Reason:  r.FormValue("reason"),
+		Message: r.FormValue("message"),
+	}
+	_, err = govalidator.ValidateStruct(input)
+	if err != nil {
+		logger.Warnf("invalid data: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	sanitizer := bluemonday.UGCPolicy()
+	input.Message = sanitizer.Sanitize(input.Message)
+
+	files := r.MultipartForm.File["images"]
+	dispute, err := dh.disputeUsecase.Open(orderId, userId, input, files)
+	if err != nil {
+		logger.Warnf("can not open dispute: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyDispute{Dispute: *dispute}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "dispute opened", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// DisputeHandler godoc
+// @Summary Order dispute
+// @Description Dispute of the order, visible to buyer and seller
+// @Tags disputes
+// @Accept application/json
+// @Produce application/json
+// @Param id path integer true "Order id"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyDispute}
+// @failure default {object} models.HttpError
+// @Router /orders/{id}/dispute [get]
+func (dh *DisputeHandler) DisputeHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	orderId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse order id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	dispute, err := dh.disputeUsecase.GetDispute(orderId, userId)
+	if err != nil {
+		logger.Warnf("can not get dispute: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyDispute{Dispute: *dispute}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "dispute got successfully", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// AnswerHandler godoc
+// @Summary Answer dispute
+// @Description Seller's response to an open dispute
+// @Tags disputes
+// @Accept application/json
+// @Produce application/json
+// @Param id path integer true "Dispute id"
+// @Param body body models.DisputeAnswer true "Answer"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyDispute}
+// @failure default {object} models.HttpError
+// @Router /disputes/{id}/answer [post]
+func (dh *DisputeHandler) AnswerHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	disputeId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse dispute id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	defer r.Body.Close()
+	answer := &models.DisputeAnswer{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = easyjson.Unmarshal(buf, answer)
+	}
+	if err == nil {
+		_, err = govalidator.ValidateStruct(answer)
+	}
+	if err != nil {
+		logger.Warnf("invalid data: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	sanitizer := bluemonday.UGCPolicy()
+	answer.Message = sanitizer.Sanitize(answer.Message)
+
+	dispute, err := dh.disputeUsecase.Answer(disputeId, userId, answer)
+	if err != nil {
+		logger.Warnf("can not answer dispute: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyDispute{Dispute: *dispute}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "dispute answered", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// ResolveHandler godoc
+// @Summary Resolve dispute
+// @Description Admin closes the dispute with a full, partial or zero refund
+// @Tags disputes
+// @Accept application/json
+// @Produce application/json
+// @Param id path integer true "Dispute id"
+// @Param body body models.DisputeResolution true "Resolution"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyDispute}
+// @failure default {object} models.HttpError
+// @Router /admin/disputes/{id}/resolve [post]
+func (dh *DisputeHandler) ResolveHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	disputeId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse dispute id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	defer r.Body.Close()
+	resolution := &models.DisputeResolution{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = easyjson.Unmarshal(buf, resolution)
+	}
+	if err == nil {
+		_, err = govalidator.ValidateStruct(resolution)
+	}
+	if err != nil {
+		logger.Warnf("invalid data: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	sanitizer := bluemonday.UGCPolicy()
+	resolution.Comment = sanitizer.Sanitize(resolution.Comment)
+
+	dispute, err := dh.disputeUsecase.Resolve(disputeId, userId, resolution)
+	if err != nil {
+		logger.Warnf("can not resolve dispute: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyDispute{Dispute: *dispute}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "dispute resolved", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
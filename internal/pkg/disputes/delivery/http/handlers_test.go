@@ -0,0 +1,240 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"yula/internal/models"
+	"yula/internal/pkg/middleware"
+
+	disputeMock "yula/internal/pkg/disputes/mocks"
+
+	myerr "yula/internal/error"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func withUser(userId int64) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), middleware.ContextUserId, userId)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func newTestRouter(dh *DisputeHandler, userId int64) *mux.Router {
+	router := mux.NewRouter()
+	router.HandleFunc("/orders/{id:[0-9]+}/dispute", dh.OpenDisputeHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.HandleFunc("/orders/{id:[0-9]+}/dispute", dh.DisputeHandler).Methods(http.MethodGet, http.MethodOptions)
+	router.HandleFunc("/disputes/{id:[0-9]+}/answer", dh.AnswerHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.HandleFunc("/admin/disputes/{id:[0-9]+}/resolve", dh.ResolveHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+	router.Use(withUser(userId))
+	return router
+}
+
+var testDispute = models.Dispute{
+	Id:      3,
+	OrderId: 5,
+	Reason:  models.DisputeReasonNotReceived,
+	Message: "parcel never arrived",
+	Images:  []string{},
+	Status:  models.DisputeStatusOpen,
+}
+
+func newDisputeForm(t *testing.T, reason string, message string) (*bytes.Buffer, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	err := writer.WriteField("reason", reason)
+	assert.Nil(t, err)
+	err = writer.WriteField("message", message)
+	assert.Nil(t, err)
+	err = writer.Close()
+	assert.Nil(t, err)
+	return body, writer.FormDataContentType()
+}
+
+func TestOpenDisputeSuccess(t *testing.T) {
+	du := disputeMock.DisputeUsecase{}
+	dh := NewDisputeHandler(&du)
+
+	srv := httptest.NewServer(newTestRouter(dh, 1))
+	defer srv.Close()
+
+	du.On("Open", int64(5), int64(1), &models.DisputeInput{Reason: models.DisputeReasonNotReceived, Message: "parcel never arrived"},
+		mock.Anything).Return(&testDispute, nil)
+
+	body, contentType := newDisputeForm(t, models.DisputeReasonNotReceived, "parcel never arrived")
+	res, err := http.Post(fmt.Sprintf("%s/orders/5/dispute", srv.URL), contentType, body)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "dispute opened", Answer.Message)
+}
+
+func TestOpenDisputeInvalidReason(t *testing.T) {
+	du := disputeMock.DisputeUsecase{}
+	dh := NewDisputeHandler(&du)
+
+	srv := httptest.NewServer(newTestRouter(dh, 1))
+	defer srv.Close()
+
+	body, contentType := newDisputeForm(t, "changed_my_mind", "parcel never arrived")
+	res, err := http.Post(fmt.Sprintf("%s/orders/5/dispute", srv.URL), contentType, body)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, Answer.Code)
+	assert.Equal(t, "invalid data", Answer.Message)
+}
+
+func TestOpenDisputeNotForm(t *testing.T) {
+	du := disputeMock.DisputeUsecase{}
+	dh := NewDisputeHandler(&du)
+
+	srv := httptest.NewServer(newTestRouter(dh, 1))
+	defer srv.Close()
+
+	res, err := http.Post(fmt.Sprintf("%s/orders/5/dispute", srv.URL), "application/json", bytes.NewBufferString("{}"))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, Answer.Code)
+}
+
+func TestOpenDisputeNotAllowed(t *testing.T) {
+	du := disputeMock.DisputeUsecase{}
+	dh := NewDisputeHandler(&du)
+
+	srv := httptest.NewServer(newTestRouter(dh, 1))
+	defer srv.Close()
+
+	du.On("Open", int64(5), int64(1), mock.Anything, mock.Anything).Return(nil, myerr.DisputeNotAllowed)
+
+	body, contentType := newDisputeForm(t, models.DisputeReasonNotAsDescribed, "broken")
+	res, err := http.Post(fmt.Sprintf("%s/orders/5/dispute", srv.URL), contentType, body)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusConflict, Answer.Code)
+}
+
+func TestGetDisputeSuccess(t *testing.T) {
+	du := disputeMock.DisputeUsecase{}
+	dh := NewDisputeHandler(&du)
+
+	srv := httptest.NewServer(newTestRouter(dh, 2))
+	defer srv.Close()
+
+	du.On("GetDispute", int64(5), int64(2)).Return(&testDispute, nil)
+
+	res, err := http.Get(fmt.Sprintf("%s/orders/5/dispute", srv.URL))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "dispute got successfully", Answer.Message)
+}
+
+func TestAnswerSuccess(t *testing.T) {
+	du := disputeMock.DisputeUsecase{}
+	dh := NewDisputeHandler(&du)
+
+	srv := httptest.NewServer(newTestRouter(dh, 2))
+	defer srv.Close()
+
+	du.On("Answer", int64(3), int64(2), &models.DisputeAnswer{Message: "sent on monday"}).Return(&testDispute, nil)
+
+	reqBody, _ := json.Marshal(models.DisputeAnswer{Message: "sent on monday"})
+	res, err := http.Post(fmt.Sprintf("%s/disputes/3/answer", srv.URL), "application/json", bytes.NewBuffer(reqBody))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "dispute answered", Answer.Message)
+}
+
+func TestAnswerEmpty(t *testing.T) {
+	du := disputeMock.DisputeUsecase{}
+	dh := NewDisputeHandler(&du)
+
+	srv := httptest.NewServer(newTestRouter(dh, 2))
+	defer srv.Close()
+
+	res, err := http.Post(fmt.Sprintf("%s/disputes/3/answer", srv.URL), "application/json", bytes.NewBufferString("{}"))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, Answer.Code)
+}
+
+func TestResolveSuccess(t *testing.T) {
+	du := disputeMock.DisputeUsecase{}
+	dh := NewDisputeHandler(&du)
+
+	srv := httptest.NewServer(newTestRouter(dh, 9))
+	defer srv.Close()
+
+	du.On("Resolve", int64(3), int64(9), &models.DisputeResolution{Refund: 100, Comment: "partial"}).Return(&testDispute, nil)
+
+	reqBody, _ := json.Marshal(models.DisputeResolution{Refund: 100, Comment: "partial"})
+	res, err := http.Post(fmt.Sprintf("%s/admin/disputes/3/resolve", srv.URL), "application/json", bytes.NewBuffer(reqBody))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "dispute resolved", Answer.Message)
+}
+
+func TestResolvePaymentFailed(t *testing.T) {
+	du := disputeMock.DisputeUsecase{}
+	dh := NewDisputeHandler(&du)
+
+	srv := httptest.NewServer(newTestRouter(dh, 9))
+	defer srv.Close()
+
+	du.On("Resolve", int64(3), int64(9), mock.Anything).Return(nil, myerr.PaymentFailed)
+
+	reqBody, _ := json.Marshal(models.DisputeResolution{Refund: 100})
+	res, err := http.Post(fmt.Sprintf("%s/admin/disputes/3/resolve", srv.URL), "application/json", bytes.NewBuffer(reqBody))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusBadGateway, Answer.Code)
+}
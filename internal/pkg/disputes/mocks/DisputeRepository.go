@@ -0,0 +1,102 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DisputeRepository is an autogenerated mock type for the DisputeRepository type
+type DisputeRepository struct {
+	mock.Mock
+}
+
+// Insert provides a mock function with given fields: dispute
+func (_m *DisputeRepository) Insert(dispute *models.Dispute) error {
+	ret := _m.Called(dispute)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Dispute) error); ok {
+		r0 = rf(dispute)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Resolve provides a mock function with given fields: dispute, order, payment, adminId
+func (_m *DisputeRepository) Resolve(dispute *models.Dispute, order *models.Order, payment *models.Payment, adminId int64) error {
+	ret := _m.Called(dispute, order, payment, adminId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Dispute, *models.Order, *models.Payment, int64) error); ok {
+		r0 = rf(dispute, order, payment, adminId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SelectById provides a mock function with given fields: disputeId
+func (_m *DisputeRepository) SelectById(disputeId int64) (*models.Dispute, error) {
+	ret := _m.Called(disputeId)
+
+	var r0 *models.Dispute
+	if rf, ok := ret.Get(0).(func(int64) *models.Dispute); ok {
+		r0 = rf(disputeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Dispute)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(disputeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SelectByOrderId provides a mock function with given fields: orderId
+func (_m *DisputeRepository) SelectByOrderId(orderId int64) (*models.Dispute, error) {
+	ret := _m.Called(orderId)
+
+	var r0 *models.Dispute
+	if rf, ok := ret.Get(0).(func(int64) *models.Dispute); ok {
+		r0 = rf(orderId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Dispute)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(orderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: dispute
+func (_m *DisputeRepository) Update(dispute *models.Dispute) error {
+	ret := _m.Called(dispute)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Dispute) error); ok {
+		r0 = rf(dispute)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,107 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	multipart "mime/multipart"
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DisputeUsecase is an autogenerated mock type for the DisputeUsecase type
+type DisputeUsecase struct {
+	mock.Mock
+}
+
+// Answer provides a mock function with given fields: disputeId, salesmanId, answer
+func (_m *DisputeUsecase) Answer(disputeId int64, salesmanId int64, answer *models.DisputeAnswer) (*models.Dispute, error) {
+	ret := _m.Called(disputeId, salesmanId, answer)
+
+	var r0 *models.Dispute
+	if rf, ok := ret.Get(0).(func(int64, int64, *models.DisputeAnswer) *models.Dispute); ok {
+		r0 = rf(disputeId, salesmanId, answer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Dispute)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64, *models.DisputeAnswer) error); ok {
+		r1 = rf(disputeId, salesmanId, answer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDispute provides a mock function with given fields: orderId, userId
+func (_m *DisputeUsecase) GetDispute(orderId int64, userId int64) (*models.Dispute, error) {
+	ret := _m.Called(orderId, userId)
+
+	var r0 *models.Dispute
+	if rf, ok := ret.Get(0).(func(int64, int64) *models.Dispute); ok {
+		r0 = rf(orderId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Dispute)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(orderId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Open provides a mock function with given fields: orderId, buyerId, input, files
+func (_m *DisputeUsecase) Open(orderId int64, buyerId int64, input *models.DisputeInput, files []*multipart.FileHeader) (*models.Dispute, error) {
+	ret := _m.Called(orderId, buyerId, input, files)
+
+	var r0 *models.Dispute
+	if rf, ok := ret.Get(0).(func(int64, int64, *models.DisputeInput, []*multipart.FileHeader) *models.Dispute); ok {
+		r0 = rf(orderId, buyerId, input, files)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Dispute)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64, *models.DisputeInput, []*multipart.FileHeader) error); ok {
+		r1 = rf(orderId, buyerId, input, files)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Resolve provides a mock function with given fields: disputeId, adminId, resolution
+func (_m *DisputeUsecase) Resolve(disputeId int64, adminId int64, resolution *models.DisputeResolution) (*models.Dispute, error) {
+	ret := _m.Called(disputeId, adminId, resolution)
+
+	var r0 *models.Dispute
+	if rf, ok := ret.Get(0).(func(int64, int64, *models.DisputeResolution) *models.Dispute); ok {
+		r0 = rf(disputeId, adminId, resolution)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Dispute)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64, *models.DisputeResolution) error); ok {
+		r1 = rf(disputeId, adminId, resolution)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,13 @@
+package disputes
+
+import "yula/internal/models"
+
+//go:generate mockery -name=DisputeRepository
+
+type DisputeRepository interface {
+	Insert(dispute *models.Dispute) error
+	SelectById(disputeId int64) (*models.Dispute, error)
+	SelectByOrderId(orderId int64) (*models.Dispute, error)
+	Update(dispute *models.Dispute) error
+	Resolve(dispute *models.Dispute, order *models.Order, payment *models.Payment, adminId int64) error
+}
@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"strconv"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/disputes"
+)
+
+type DisputeRepository struct {
+	DB *sql.DB
+}
+
+func NewDisputeRepository(DB *sql.DB) disputes.DisputeRepository {
+	return &DisputeRepository{
+		DB: DB,
+	}
+}
+
+func (dr *DisputeRepository) rollback(tx *sql.Tx, err error) error {
+	if rollbackErr := tx.Rollback(); rollbackErr != nil {
+		return internalError.RollbackError
+	}
+	return err
+}
+
+func (dr *DisputeRepository) Insert(dispute *models.Dispute) error {
+	tx, err := dr.DB.BeginTx(context.Background(), nil)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	// по заказу открывается не больше одного спора
+	queryStr := `INSERT INTO dispute (order_id, reason, message) VALUES ($1, $2, $3)
+				ON CONFLICT (order_id) DO NOTHING RETURNING id, status, created_at, updated_at;`
+	query := tx.QueryRowContext(context.Background(), queryStr, dispute.OrderId, dispute.Reason, dispute.Message)
+	err = query.Scan(&dispute.Id, &dispute.Status, &dispute.CreatedAt, &dispute.UpdatedAt)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return dr.rollback(tx, internalError.AlreadyExist)
+		}
+		return dr.rollback(tx, internalError.GenInternalError(err))
+	}
+
+	for _, image := range dispute.Images {
+		_, err = tx.ExecContext(context.Background(),
+			"INSERT INTO dispute_image (dispute_id, img_path) VALUES ($1, $2);", dispute.Id, image)
+		if err != nil {
+			return dr.rollback(tx, internalError.GenInternalError(err))
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return internalError.NotCommited
+	}
+
+	return nil
+}
+
+func (dr *DisputeRepository) SelectById(disputeId int64) (*models.Dispute, error) {
+	queryStr := `SELECT id, order_id, reason, message, response, status, refund, resolution, created_at, updated_at
+				FROM dispute WHERE id = $1;`
+	return dr.selectDispute(queryStr, disputeId)
+}
+
+func (dr *DisputeRepository) SelectByOrderId(orderId int64) (*models.Dispute, error) {
+	queryStr := `SELECT id, order_id, reason, message, response, status, refund, resolution, created_at, updated_at
+				FROM dispute WHERE order_id = $1;`
+	return dr.selectDispute(queryStr, orderId)
+}
+
+func (dr *DisputeRepository) selectDispute(queryStr string, arg int64) (*models.Dispute, error) {
+	query := dr.DB.QueryRowContext(context.Background(), queryStr, arg)
+
+	var dispute models.Dispute
+	var response, resolution sql.NullString
+	err := query.Scan(&dispute.Id, &dispute.OrderId, &dispute.Reason, &dispute.Message, &response,
+		&dispute.Status, &dispute.Refund, &resolution, &dispute.CreatedAt, &dispute.UpdatedAt)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return nil, internalError.EmptyQuery
+		}
+		return nil, internalError.GenInternalError(err)
+	}
+	dispute.Response = response.String
+	dispute.Resolution = resolution.String
+
+	rows, err := dr.DB.QueryContext(context.Background(),
+		"SELECT img_path FROM dispute_image WHERE dispute_id = $1;", dispute.Id)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+
+	defer rows.Close()
+	dispute.Images = make([]string, 0)
+	for rows.Next() {
+		var image string
+		err = rows.Scan(&image)
+		if err != nil {
+			return nil, internalError.GenInternalError(err)
+		}
+
+		dispute.Images = append(dispute.Images, image)
+	}
+
+	return &dispute, nil
+}
+
+func (dr *DisputeRepository) Update(dispute *models.Dispute) error {
+	queryStr := `UPDATE dispute SET status = $2, response = NULLIF($3, ''), refund = $4, resolution = NULLIF($5, ''),
+				updated_at = CURRENT_TIMESTAMP WHERE id = $1 RETURNING updated_at;`
+	query := dr.DB.QueryRowContext(context.Background(), queryStr,
+		dispute.Id, dispute.Status, dispute.Response, dispute.Refund, dispute.Resolution)
+
+	err := query.Scan(&dispute.UpdatedAt)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return internalError.EmptyQuery
+		}
+		return internalError.GenInternalError(err)
+	}
+
+	return nil
+}
+
+// Resolve сохраняет решение по спору одной транзакцией: спор, итог платежа, статус заказа и журнал заказа.
+// Платежа может не быть, если заказ оплачивали не через безопасную сделку
+func (dr *DisputeRepository) Resolve(dispute *models.Dispute, order *models.Order, payment *models.Payment, adminId int64) error {
+	tx, err := dr.DB.BeginTx(context.Background(), nil)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	query := tx.QueryRowContext(context.Background(),
+		`UPDATE dispute SET status = $2, refund = $3, resolution = NULLIF($4, ''), updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 RETURNING updated_at;`,
+		dispute.Id, dispute.Status, dispute.Refund, dispute.Resolution)
+	err = query.Scan(&dispute.UpdatedAt)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return dr.rollback(tx, internalError.EmptyQuery)
+		}
+		return dr.rollback(tx, internalError.GenInternalError(err))
+	}
+
+	if payment != nil {
+		query = tx.QueryRowContext(context.Background(),
+			`UPDATE escrow_payment SET status = $2, refunded = $3, updated_at = CURRENT_TIMESTAMP
+			WHERE id = $1 RETURNING updated_at;`,
+			payment.Id, payment.Status, payment.Refunded)
+		err = query.Scan(&payment.UpdatedAt)
+		if err != nil {
+			return dr.rollback(tx, internalError.GenInternalError(err))
+		}
+	}
+
+	query = tx.QueryRowContext(context.Background(),
+		"UPDATE orders SET status = $2, updated_at = CURRENT_TIMESTAMP WHERE id = $1 RETURNING updated_at;",
+		order.Id, order.Status)
+	err = query.Scan(&order.UpdatedAt)
+	if err != nil {
+		return dr.rollback(tx, internalError.GenInternalError(err))
+	}
+
+	events := []*models.OrderEvent{
+		{OrderId: order.Id, ActorId: adminId, Event: models.OrderEventStatus, Details: order.Status},
+		{OrderId: order.Id, ActorId: adminId, Event: models.OrderEventDisputeResolved,
+			Details: strconv.FormatInt(dispute.Refund, 10)},
+	}
+	for _, event := range events {
+		_, err = tx.ExecContext(context.Background(),
+			`INSERT INTO order_event (order_id, actor_id, event, details) VALUES ($1, NULLIF($2, 0), $3, $4);`,
+			event.OrderId, event.ActorId, event.Event, event.Details)
+		if err != nil {
+			return dr.rollback(tx, internalError.GenInternalError(err))
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return internalError.NotCommited
+	}
+
+	return nil
+}
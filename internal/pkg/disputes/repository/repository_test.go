@@ -0,0 +1,236 @@
+package repository
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	sqlmock "gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func newTestDispute() *models.Dispute {
+	return &models.Dispute{
+		OrderId: 5,
+		Reason:  models.DisputeReasonNotReceived,
+		Message: "parcel never arrived",
+		Images:  []string{"static/disputeimages/1"},
+	}
+}
+
+var disputeColumns = []string{"id", "order_id", "reason", "message", "response", "status", "refund", "resolution",
+	"created_at", "updated_at"}
+
+func TestInsertOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewDisputeRepository(db)
+	dispute := newTestDispute()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO dispute").WithArgs(dispute.OrderId, dispute.Reason, dispute.Message).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "status", "created_at", "updated_at"}).
+			AddRow(3, "open", time.Now(), time.Now()))
+	mock.ExpectExec("INSERT INTO dispute_image").WithArgs(int64(3), "static/disputeimages/1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = repo.Insert(dispute)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), dispute.Id)
+	assert.Equal(t, models.DisputeStatusOpen, dispute.Status)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestInsertTwice(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewDisputeRepository(db)
+	dispute := newTestDispute()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO dispute").WithArgs(dispute.OrderId, dispute.Reason, dispute.Message).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "status", "created_at", "updated_at"}))
+	mock.ExpectRollback()
+
+	err = repo.Insert(dispute)
+	assert.Equal(t, internalError.AlreadyExist, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestInsertImageError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewDisputeRepository(db)
+	dispute := newTestDispute()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO dispute").WithArgs(dispute.OrderId, dispute.Reason, dispute.Message).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "status", "created_at", "updated_at"}).
+			AddRow(3, "open", time.Now(), time.Now()))
+	mock.ExpectExec("INSERT INTO dispute_image").WithArgs(int64(3), "static/disputeimages/1").
+		WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	err = repo.Insert(dispute)
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectByOrderIdOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewDisputeRepository(db)
+
+	mock.ExpectQuery("SELECT id, order_id").WithArgs(int64(5)).
+		WillReturnRows(sqlmock.NewRows(disputeColumns).
+			AddRow(3, 5, "not_received", "parcel never arrived", nil, "open", 0, nil, time.Now(), time.Now()))
+	mock.ExpectQuery("SELECT img_path").WithArgs(int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"img_path"}).AddRow("static/disputeimages/1"))
+
+	dispute, err := repo.SelectByOrderId(5)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), dispute.Id)
+	assert.Equal(t, "", dispute.Response)
+	assert.Equal(t, 1, len(dispute.Images))
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectByIdEmpty(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewDisputeRepository(db)
+
+	mock.ExpectQuery("SELECT id, order_id").WithArgs(int64(3)).WillReturnRows(sqlmock.NewRows(disputeColumns))
+
+	_, err = repo.SelectById(3)
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewDisputeRepository(db)
+	dispute := newTestDispute()
+	dispute.Id = 3
+	dispute.Status = models.DisputeStatusResolved
+	dispute.Refund = 100
+	dispute.Resolution = "partial refund"
+
+	mock.ExpectQuery("UPDATE dispute").WithArgs(int64(3), models.DisputeStatusResolved, "", int64(100), "partial refund").
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(time.Now()))
+
+	err = repo.Update(dispute)
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewDisputeRepository(db)
+	dispute := newTestDispute()
+	dispute.Id = 3
+
+	mock.ExpectQuery("UPDATE dispute").WillReturnError(sql.ErrConnDone)
+
+	err = repo.Update(dispute)
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestResolveOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewDisputeRepository(db)
+	dispute := &models.Dispute{Id: 3, Status: models.DisputeStatusResolved, Refund: 50}
+	order := &models.Order{Id: 5, Status: models.OrderStatusReleased}
+	payment := &models.Payment{Id: 1, Status: models.PaymentStatusReleased, Refunded: 50}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE dispute").WithArgs(int64(3), models.DisputeStatusResolved, int64(50), "").
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(time.Now()))
+	mock.ExpectQuery("UPDATE escrow_payment").WithArgs(int64(1), models.PaymentStatusReleased, int64(50)).
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(time.Now()))
+	mock.ExpectQuery("UPDATE orders").WithArgs(int64(5), models.OrderStatusReleased).
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(time.Now()))
+	mock.ExpectExec("INSERT INTO order_event").
+		WithArgs(int64(5), int64(9), models.OrderEventStatus, models.OrderStatusReleased).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO order_event").
+		WithArgs(int64(5), int64(9), models.OrderEventDisputeResolved, "50").
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+
+	err = repo.Resolve(dispute, order, payment, 9)
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestResolveRollback(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewDisputeRepository(db)
+	dispute := &models.Dispute{Id: 3, Status: models.DisputeStatusResolved}
+	order := &models.Order{Id: 5, Status: models.OrderStatusDelivered}
+
+	// без платежа escrow_payment не трогаем, ошибка заказа откатывает и спор
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE dispute").
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(time.Now()))
+	mock.ExpectQuery("UPDATE orders").WithArgs(int64(5), models.OrderStatusDelivered).
+		WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	err = repo.Resolve(dispute, order, nil, 9)
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
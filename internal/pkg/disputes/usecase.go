@@ -0,0 +1,15 @@
+package disputes
+
+import (
+	"mime/multipart"
+	"yula/internal/models"
+)
+
+//go:generate mockery -name=DisputeUsecase
+
+type DisputeUsecase interface {
+	Open(orderId int64, buyerId int64, input *models.DisputeInput, files []*multipart.FileHeader) (*models.Dispute, error)
+	Answer(disputeId int64, salesmanId int64, answer *models.DisputeAnswer) (*models.Dispute, error)
+	Resolve(disputeId int64, adminId int64, resolution *models.DisputeResolution) (*models.Dispute, error)
+	GetDispute(orderId int64, userId int64) (*models.Dispute, error)
+}
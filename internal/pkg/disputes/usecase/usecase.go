@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"mime/multipart"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/disputes"
+	imageloader "yula/internal/pkg/image_loader"
+	"yula/internal/pkg/logging"
+	"yula/internal/pkg/orders"
+	"yula/internal/pkg/payment"
+)
+
+var logger logging.Logger = logging.GetLogger()
+
+type DisputeUsecase struct {
+	disputeRepository  disputes.DisputeRepository
+	orderRepository    orders.OrderRepository
+	paymentUsecase     payment.PaymentUsecase
+	imageLoaderUsecase imageloader.ImageLoaderUsecase
+}
+
+func NewDisputeUsecase(disputeRepository disputes.DisputeRepository, orderRepository orders.OrderRepository,
+	paymentUsecase payment.PaymentUsecase, imageLoaderUsecase imageloader.ImageLoaderUsecase) disputes.DisputeUsecase {
+	return &DisputeUsecase{
+		disputeRepository:  disputeRepository,
+		orderRepository:    orderRepository,
+		paymentUsecase:     paymentUsecase,
+		imageLoaderUsecase: imageLoaderUsecase,
+	}
+}
+
+func (du *DisputeUsecase) Open(orderId int64, buyerId int64, input *models.DisputeInput, files []*multipart.FileHeader) (*models.Dispute, error) {
+	order, err := du.orderRepository.SelectById(orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.BuyerId != buyerId {
+		return nil, internalError.Conflict
+	}
+
+	// спорить можно только о заказе, за который уже заплатили или который уже отправлен
+	switch order.Status {
+	case models.OrderStatusPaid, models.OrderStatusShipped, models.OrderStatusDelivered:
+	default:
+		return nil, internalError.DisputeNotAllowed
+	}
+
+	dispute := &models.Dispute{
+		OrderId: orderId,
+		Reason:  input.Reason,
+		Message: input.Message,
+		Images:  make([]string, 0, len(files)),
+	}
+	for _, file := range files {
+		url, err := du.imageLoaderUsecase.Upload(file, imageloader.DisputeImageDirectory)
+		if err != nil {
+			du.removeImages(dispute.Images)
+			return nil, err
+		}
+		dispute.Images = append(dispute.Images, url)
+	}
+
+	// спор уже открыт или не сохранился, загруженные фото никому не принадлежат
+	err = du.disputeRepository.Insert(dispute)
+	if err != nil {
+		du.removeImages(dispute.Images)
+		return nil, err
+	}
+
+	// спор замораживает заказ: ни смена статуса, ни автоматическое закрытие сделки невозможны
+	from := order.Status
+	order.Status = models.OrderStatusDisputed
+	err = du.orderRepository.UpdateStatus(order, from, buyerId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = du.logEvent(orderId, buyerId, models.OrderEventDisputeOpened, dispute.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return dispute, nil
+}
+
+func (du *DisputeUsecase) Answer(disputeId int64, salesmanId int64, answer *models.DisputeAnswer) (*models.Dispute, error) {
+	dispute, err := du.disputeRepository.SelectById(disputeId)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := du.orderRepository.SelectById(dispute.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.SalesmanId != salesmanId {
+		return nil, internalError.Conflict
+	}
+
+	if dispute.Status != models.DisputeStatusOpen {
+		return nil, internalError.InvalidStatusTransition
+	}
+
+	dispute.Response = answer.Message
+	dispute.Status = models.DisputeStatusAnswered
+	err = du.disputeRepository.Update(dispute)
+	if err != nil {
+		return nil, err
+	}
+
+	err = du.logEvent(order.Id, salesmanId, models.OrderEventDisputeAnswered, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return dispute, nil
+}
+
+func (du *DisputeUsecase) Resolve(disputeId int64, adminId int64, resolution *models.DisputeResolution) (*models.Dispute, error) {
+	dispute, err := du.disputeRepository.SelectById(disputeId)
+	if err != nil {
+		return nil, err
+	}
+
+	if dispute.Status == models.DisputeStatusResolved {
+		return nil, internalError.InvalidStatusTransition
+	}
+
+	order, err := du.orderRepository.SelectById(dispute.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if resolution.Refund < 0 || resolution.Refund > order.Total() {
+		return nil, internalError.BadRequest
+	}
+
+	// деньги двигаются раньше статусов, чтобы неудачный возврат не закрыл спор
+	p, err := du.paymentUsecase.Settle(order, resolution.Refund)
+	if err != nil {
+		return nil, err
+	}
+
+	dispute.Status = models.DisputeStatusResolved
+	dispute.Refund = resolution.Refund
+	dispute.Resolution = resolution.Comment
+
+	switch {
+	case p == nil:
+		order.Status = models.OrderStatusDelivered
+	case p.Status == models.PaymentStatusRefunded:
+		order.Status = models.OrderStatusRefunded
+	default:
+		order.Status = models.OrderStatusReleased
+	}
+
+	// спор, платеж и заказ сохраняются вместе, иначе деньги могли уйти при открытом споре
+	err = du.disputeRepository.Resolve(dispute, order, p, adminId)
+	if err != nil {
+		logger.Errorf("dispute %d settled with refund %d but not saved: %s", dispute.Id, dispute.Refund, err.Error())
+		return nil, err
+	}
+
+	return dispute, nil
+}
+
+func (du *DisputeUsecase) GetDispute(orderId int64, userId int64) (*models.Dispute, error) {
+	order, err := du.orderRepository.SelectById(orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.BuyerId != userId && order.SalesmanId != userId {
+		return nil, internalError.Conflict
+	}
+
+	return du.disputeRepository.SelectByOrderId(orderId)
+}
+
+// removeImages удаляет фото спора, который не удалось сохранить, ошибка удаления только логируется
+func (du *DisputeUsecase) removeImages(images []string) {
+	if len(images) == 0 {
+		return
+	}
+	err := du.imageLoaderUsecase.RemoveAdvertImages(images)
+	if err != nil {
+		logger.Warnf("can not remove dispute images: %s", err.Error())
+	}
+}
+
+func (du *DisputeUsecase) logEvent(orderId int64, actorId int64, event string, details string) error {
+	return du.orderRepository.InsertEvent(&models.OrderEvent{
+		OrderId: orderId,
+		ActorId: actorId,
+		Event:   event,
+		Details: details,
+	})
+}
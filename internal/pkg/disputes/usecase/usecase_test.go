@@ -0,0 +1,260 @@
+package usecase
+
+import (
+	"mime/multipart"
+	"testing"
+	"yula/internal/models"
+	"yula/internal/pkg/disputes/mocks"
+
+	imageloader "yula/internal/pkg/image_loader"
+	imageMocks "yula/internal/pkg/image_loader/mocks"
+	orderMocks "yula/internal/pkg/orders/mocks"
+	paymentMocks "yula/internal/pkg/payment/mocks"
+
+	myerr "yula/internal/error"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestOrder(status string) *models.Order {
+	return &models.Order{
+		Id:         5,
+		BuyerId:    1,
+		SalesmanId: 2,
+		Status:     status,
+		Lines: []*models.OrderLine{
+			{
+				OrderId:  5,
+				AdvertId: 3,
+				Amount:   2,
+				Price:    100,
+			},
+		},
+	}
+}
+
+func newTestDispute(status string) *models.Dispute {
+	return &models.Dispute{
+		Id:      3,
+		OrderId: 5,
+		Reason:  models.DisputeReasonNotAsDescribed,
+		Message: "broken screen",
+		Status:  status,
+	}
+}
+
+type testDeps struct {
+	dr mocks.DisputeRepository
+	or orderMocks.OrderRepository
+	pu paymentMocks.PaymentUsecase
+	il imageMocks.ImageLoaderUsecase
+}
+
+func (d *testDeps) usecase() *DisputeUsecase {
+	return NewDisputeUsecase(&d.dr, &d.or, &d.pu, &d.il).(*DisputeUsecase)
+}
+
+func TestOpenSuccess(t *testing.T) {
+	d := testDeps{}
+	d.or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusShipped), nil)
+	d.il.On("Upload", mock.AnythingOfType("*multipart.FileHeader"), imageloader.DisputeImageDirectory).
+		Return("static/disputeimages/1", nil)
+	d.dr.On("Insert", mock.AnythingOfType("*models.Dispute")).Return(nil)
+	d.or.On("UpdateStatus", mock.MatchedBy(func(o *models.Order) bool {
+		return o.Status == models.OrderStatusDisputed
+	}), models.OrderStatusShipped, int64(1)).Return(nil)
+	d.or.On("InsertEvent", mock.MatchedBy(func(e *models.OrderEvent) bool {
+		return e.Event == models.OrderEventDisputeOpened && e.ActorId == 1
+	})).Return(nil)
+
+	input := &models.DisputeInput{Reason: models.DisputeReasonNotReceived, Message: "parcel never arrived"}
+	dispute, err := d.usecase().Open(5, 1, input, []*multipart.FileHeader{{Filename: "photo.png"}})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"static/disputeimages/1"}, dispute.Images)
+	d.or.AssertExpectations(t)
+}
+
+func TestOpenNotBuyer(t *testing.T) {
+	d := testDeps{}
+	d.or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusShipped), nil)
+
+	input := &models.DisputeInput{Reason: models.DisputeReasonNotReceived, Message: "parcel never arrived"}
+	_, err := d.usecase().Open(5, 2, input, nil)
+	assert.Equal(t, myerr.Conflict, err)
+}
+
+func TestOpenNotPaid(t *testing.T) {
+	d := testDeps{}
+	d.or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusCreated), nil)
+
+	input := &models.DisputeInput{Reason: models.DisputeReasonNotReceived, Message: "parcel never arrived"}
+	_, err := d.usecase().Open(5, 1, input, nil)
+	assert.Equal(t, myerr.DisputeNotAllowed, err)
+}
+
+func TestOpenUploadFail(t *testing.T) {
+	d := testDeps{}
+	d.or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusPaid), nil)
+	d.il.On("Upload", mock.AnythingOfType("*multipart.FileHeader"), imageloader.DisputeImageDirectory).
+		Return("", myerr.UnknownExtension)
+
+	input := &models.DisputeInput{Reason: models.DisputeReasonNotReceived, Message: "parcel never arrived"}
+	_, err := d.usecase().Open(5, 1, input, []*multipart.FileHeader{{Filename: "photo.gif"}})
+	assert.Equal(t, myerr.UnknownExtension, err)
+	d.dr.AssertNotCalled(t, "Insert", mock.Anything)
+}
+
+func TestOpenTwiceRemovesImages(t *testing.T) {
+	d := testDeps{}
+	d.or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusShipped), nil)
+	d.il.On("Upload", mock.AnythingOfType("*multipart.FileHeader"), imageloader.DisputeImageDirectory).
+		Return("static/disputeimages/1", nil)
+	d.dr.On("Insert", mock.AnythingOfType("*models.Dispute")).Return(myerr.AlreadyExist)
+	d.il.On("RemoveAdvertImages", []string{"static/disputeimages/1"}).Return(nil)
+
+	input := &models.DisputeInput{Reason: models.DisputeReasonNotReceived, Message: "parcel never arrived"}
+	_, err := d.usecase().Open(5, 1, input, []*multipart.FileHeader{{Filename: "photo.png"}})
+	assert.Equal(t, myerr.AlreadyExist, err)
+	d.il.AssertExpectations(t)
+	d.or.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAnswerSuccess(t *testing.T) {
+	d := testDeps{}
+	d.dr.On("SelectById", int64(3)).Return(newTestDispute(models.DisputeStatusOpen), nil)
+	d.or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusDisputed), nil)
+	d.dr.On("Update", mock.AnythingOfType("*models.Dispute")).Return(nil)
+	d.or.On("InsertEvent", mock.AnythingOfType("*models.OrderEvent")).Return(nil)
+
+	dispute, err := d.usecase().Answer(3, 2, &models.DisputeAnswer{Message: "it was fine"})
+	assert.Nil(t, err)
+	assert.Equal(t, models.DisputeStatusAnswered, dispute.Status)
+	assert.Equal(t, "it was fine", dispute.Response)
+}
+
+func TestAnswerNotSalesman(t *testing.T) {
+	d := testDeps{}
+	d.dr.On("SelectById", int64(3)).Return(newTestDispute(models.DisputeStatusOpen), nil)
+	d.or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusDisputed), nil)
+
+	_, err := d.usecase().Answer(3, 1, &models.DisputeAnswer{Message: "it was fine"})
+	assert.Equal(t, myerr.Conflict, err)
+}
+
+func TestAnswerTwice(t *testing.T) {
+	d := testDeps{}
+	d.dr.On("SelectById", int64(3)).Return(newTestDispute(models.DisputeStatusAnswered), nil)
+	d.or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusDisputed), nil)
+
+	_, err := d.usecase().Answer(3, 2, &models.DisputeAnswer{Message: "it was fine"})
+	assert.Equal(t, myerr.InvalidStatusTransition, err)
+}
+
+func TestResolveFullRefund(t *testing.T) {
+	d := testDeps{}
+	d.dr.On("SelectById", int64(3)).Return(newTestDispute(models.DisputeStatusAnswered), nil)
+	d.or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusDisputed), nil)
+	p := &models.Payment{Id: 1, Status: models.PaymentStatusRefunded}
+	d.pu.On("Settle", mock.AnythingOfType("*models.Order"), int64(200)).Return(p, nil)
+	d.dr.On("Resolve", mock.MatchedBy(func(dispute *models.Dispute) bool {
+		return dispute.Status == models.DisputeStatusResolved && dispute.Refund == 200
+	}), mock.MatchedBy(func(o *models.Order) bool {
+		return o.Status == models.OrderStatusRefunded
+	}), p, int64(9)).Return(nil)
+
+	dispute, err := d.usecase().Resolve(3, 9, &models.DisputeResolution{Refund: 200})
+	assert.Nil(t, err)
+	assert.Equal(t, models.DisputeStatusResolved, dispute.Status)
+	d.dr.AssertExpectations(t)
+	d.or.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestResolvePartialRefund(t *testing.T) {
+	d := testDeps{}
+	d.dr.On("SelectById", int64(3)).Return(newTestDispute(models.DisputeStatusOpen), nil)
+	d.or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusDisputed), nil)
+	d.pu.On("Settle", mock.AnythingOfType("*models.Order"), int64(50)).
+		Return(&models.Payment{Status: models.PaymentStatusReleased}, nil)
+	d.dr.On("Resolve", mock.AnythingOfType("*models.Dispute"), mock.MatchedBy(func(o *models.Order) bool {
+		return o.Status == models.OrderStatusReleased
+	}), mock.AnythingOfType("*models.Payment"), int64(9)).Return(nil)
+
+	dispute, err := d.usecase().Resolve(3, 9, &models.DisputeResolution{Refund: 50, Comment: "scratches"})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(50), dispute.Refund)
+	d.dr.AssertExpectations(t)
+}
+
+func TestResolveSaveFail(t *testing.T) {
+	d := testDeps{}
+	d.dr.On("SelectById", int64(3)).Return(newTestDispute(models.DisputeStatusOpen), nil)
+	d.or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusDisputed), nil)
+	d.pu.On("Settle", mock.AnythingOfType("*models.Order"), int64(50)).
+		Return(&models.Payment{Status: models.PaymentStatusReleased}, nil)
+	d.dr.On("Resolve", mock.Anything, mock.Anything, mock.Anything, int64(9)).Return(myerr.NotCommited)
+
+	_, err := d.usecase().Resolve(3, 9, &models.DisputeResolution{Refund: 50})
+	assert.Equal(t, myerr.NotCommited, err)
+}
+
+func TestResolveWithoutPayment(t *testing.T) {
+	d := testDeps{}
+	d.dr.On("SelectById", int64(3)).Return(newTestDispute(models.DisputeStatusOpen), nil)
+	d.or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusDisputed), nil)
+	d.pu.On("Settle", mock.AnythingOfType("*models.Order"), int64(0)).Return(nil, nil)
+	d.dr.On("Resolve", mock.AnythingOfType("*models.Dispute"), mock.MatchedBy(func(o *models.Order) bool {
+		return o.Status == models.OrderStatusDelivered
+	}), (*models.Payment)(nil), int64(9)).Return(nil)
+
+	_, err := d.usecase().Resolve(3, 9, &models.DisputeResolution{})
+	assert.Nil(t, err)
+	d.dr.AssertExpectations(t)
+}
+
+func TestResolveTooMuch(t *testing.T) {
+	d := testDeps{}
+	d.dr.On("SelectById", int64(3)).Return(newTestDispute(models.DisputeStatusOpen), nil)
+	d.or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusDisputed), nil)
+
+	_, err := d.usecase().Resolve(3, 9, &models.DisputeResolution{Refund: 201})
+	assert.Equal(t, myerr.BadRequest, err)
+	d.pu.AssertNotCalled(t, "Settle", mock.Anything, mock.Anything)
+}
+
+func TestResolveSettleFail(t *testing.T) {
+	d := testDeps{}
+	d.dr.On("SelectById", int64(3)).Return(newTestDispute(models.DisputeStatusOpen), nil)
+	d.or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusDisputed), nil)
+	d.pu.On("Settle", mock.AnythingOfType("*models.Order"), int64(100)).Return(nil, myerr.PaymentFailed)
+
+	_, err := d.usecase().Resolve(3, 9, &models.DisputeResolution{Refund: 100})
+	assert.Equal(t, myerr.PaymentFailed, err)
+	d.dr.AssertNotCalled(t, "Resolve", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestResolveTwice(t *testing.T) {
+	d := testDeps{}
+	d.dr.On("SelectById", int64(3)).Return(newTestDispute(models.DisputeStatusResolved), nil)
+
+	_, err := d.usecase().Resolve(3, 9, &models.DisputeResolution{})
+	assert.Equal(t, myerr.InvalidStatusTransition, err)
+}
+
+func TestGetDisputeSeller(t *testing.T) {
+	d := testDeps{}
+	d.or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusDisputed), nil)
+	d.dr.On("SelectByOrderId", int64(5)).Return(newTestDispute(models.DisputeStatusOpen), nil)
+
+	dispute, err := d.usecase().GetDispute(5, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), dispute.Id)
+}
+
+func TestGetDisputeStranger(t *testing.T) {
+	d := testDeps{}
+	d.or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusDisputed), nil)
+
+	_, err := d.usecase().GetDispute(5, 10)
+	assert.Equal(t, myerr.Conflict, err)
+}
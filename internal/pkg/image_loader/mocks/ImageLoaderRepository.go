@@ -1,9 +1,12 @@
-// Code generated by mockery v1.0.0. DO NOT EDIT.
+// Code generated by mockery v2.9.4. DO NOT EDIT.
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
-import multipart "mime/multipart"
+import (
+	multipart "mime/multipart"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // ImageLoaderRepository is an autogenerated mock type for the ImageLoaderRepository type
 type ImageLoaderRepository struct {
@@ -37,3 +40,17 @@ func (_m *ImageLoaderRepository) Insert(fileHeader *multipart.FileHeader, dir st
 
 	return r0
 }
+
+// InsertData provides a mock function with given fields: data, dir, name
+func (_m *ImageLoaderRepository) InsertData(data []byte, dir string, name string) error {
+	ret := _m.Called(data, dir, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]byte, string, string) error); ok {
+		r0 = rf(data, dir, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
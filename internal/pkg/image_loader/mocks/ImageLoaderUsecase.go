@@ -62,6 +62,27 @@ func (_m *ImageLoaderUsecase) Upload(headerFile *multipart.FileHeader, dir strin
 	return r0, r1
 }
 
+// UploadAdvertImageData provides a mock function with given fields: data
+func (_m *ImageLoaderUsecase) UploadAdvertImageData(data []byte) (string, error) {
+	ret := _m.Called(data)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func([]byte) string); ok {
+		r0 = rf(data)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UploadAdvertImages provides a mock function with given fields: headerFiles
 func (_m *ImageLoaderUsecase) UploadAdvertImages(headerFiles []*multipart.FileHeader) ([]string, error) {
 	ret := _m.Called(headerFiles)
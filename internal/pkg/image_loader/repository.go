@@ -6,5 +6,6 @@ import "mime/multipart"
 
 type ImageLoaderRepository interface {
 	Insert(fileHeader *multipart.FileHeader, dir string, name string) error
+	InsertData(data []byte, dir string, name string) error
 	Delete(filePath string) error
 }
@@ -38,6 +38,14 @@ func (ilr *ImageLoaderRepository) Insert(fileHeader *multipart.FileHeader, dir s
 	return nil
 }
 
+func (ilr *ImageLoaderRepository) InsertData(data []byte, dir string, name string) error {
+	err := os.WriteFile(dir+"/"+name, data, 0644)
+	if err != nil {
+		return internalError.InternalError
+	}
+	return nil
+}
+
 func (ilr *ImageLoaderRepository) Delete(filePath string) error {
 	if filePath == "" {
 		return nil
@@ -3,11 +3,12 @@ package imageloader
 import "mime/multipart"
 
 const (
-	AvatarsDirectory     string = "static/avatars"
-	DefaultAvatar        string = AvatarsDirectory + "/default_avatar"
-	AdvertImageDirectory string = "static/advertimages"
-	DefaultAdvertImage   string = AdvertImageDirectory + "/default_image"
-	CompressedFormat     string = "webp"
+	AvatarsDirectory      string = "static/avatars"
+	DefaultAvatar         string = AvatarsDirectory + "/default_avatar"
+	AdvertImageDirectory  string = "static/advertimages"
+	DefaultAdvertImage    string = AdvertImageDirectory + "/default_image"
+	DisputeImageDirectory string = "static/disputeimages"
+	CompressedFormat      string = "webp"
 )
 
 //go:generate mockery -name=ImageLoaderUsecase
@@ -18,5 +19,6 @@ type ImageLoaderUsecase interface {
 	RemoveAvatar(filePath string) error
 
 	UploadAdvertImages(headerFiles []*multipart.FileHeader) ([]string, error)
+	UploadAdvertImageData(data []byte) (string, error)
 	RemoveAdvertImages(imageUrls []string) error
 }
@@ -3,6 +3,7 @@ package usecase
 import (
 	"fmt"
 	"mime/multipart"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -30,25 +31,32 @@ func NewImageLoaderUsecase(imageLoaderRepo imageloader.ImageLoaderRepository) im
 }
 
 func (ilu *ImageLoaderUsecase) Upload(headerFile *multipart.FileHeader, dir string) (string, error) {
-	availableFormats := []string{"png", "jpeg"}
+	name, filename, err := imageName(headerFile.Header.Get("Content-Type"))
+	if err != nil {
+		return "", err
+	}
+
+	err = ilu.imageLoaderRepo.Insert(headerFile, dir, filename)
+	if err != nil {
+		return "", err
+	}
 
-	ct := headerFile.Header.Get("Content-Type")
+	return dir + "/" + name, nil
+}
+
+// imageName возвращает имя картинки без расширения и имя файла по ее Content-Type
+func imageName(ct string) (string, string, error) {
+	availableFormats := []string{"png", "jpeg"}
 
 	extension := ct[strings.LastIndex(ct, "/")+1:]
 	if !contains(availableFormats, extension) {
-		return "", internalError.UnknownExtension
+		return "", "", internalError.UnknownExtension
 	}
 
 	timestamp := time.Now().UnixMicro()
 	name := fmt.Sprintf("%s__%s", strconv.FormatInt(timestamp, 10), extension)
 	filename := fmt.Sprintf("%s.%s", name, extension)
-
-	err := ilu.imageLoaderRepo.Insert(headerFile, dir, filename)
-	if err != nil {
-		return "", err
-	}
-
-	return dir + "/" + name, nil
+	return name, filename, nil
 }
 
 func (ilu *ImageLoaderUsecase) UploadAvatar(headerFile *multipart.FileHeader) (string, error) {
@@ -76,6 +84,21 @@ func (ilu *ImageLoaderUsecase) RemoveAdvertImages(imageUrls []string) error {
 	return nil
 }
 
+// UploadAdvertImageData сохраняет картинку объявления, пришедшую не из формы, формат определяется по содержимому
+func (ilu *ImageLoaderUsecase) UploadAdvertImageData(data []byte) (string, error) {
+	name, filename, err := imageName(http.DetectContentType(data))
+	if err != nil {
+		return "", err
+	}
+
+	err = ilu.imageLoaderRepo.InsertData(data, imageloader.AdvertImageDirectory, filename)
+	if err != nil {
+		return "", err
+	}
+
+	return imageloader.AdvertImageDirectory + "/" + name, nil
+}
+
 func (ilu *ImageLoaderUsecase) UploadAdvertImages(headerFiles []*multipart.FileHeader) ([]string, error) {
 	var urls []string
 	for _, file := range headerFiles {
@@ -100,3 +100,24 @@ func TestUploadAdImages(t *testing.T) {
 	_, err := ilu.UploadAdvertImages(files)
 	assert.Nil(t, err)
 }
+
+func TestUploadAdvertImageData(t *testing.T) {
+	ilr := ILMock.ImageLoaderRepository{}
+	ilu := NewImageLoaderUsecase(&ilr)
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+	ilr.On("InsertData", png, imageloader.AdvertImageDirectory, mock.AnythingOfType("string")).Return(nil)
+
+	url, err := ilu.UploadAdvertImageData(png)
+	assert.Nil(t, err)
+	assert.Regexp(t, "^"+imageloader.AdvertImageDirectory+"/[0-9]+__png$", url)
+}
+
+func TestUploadAdvertImageDataBadFormat(t *testing.T) {
+	ilr := ILMock.ImageLoaderRepository{}
+	ilu := NewImageLoaderUsecase(&ilr)
+
+	_, err := ilu.UploadAdvertImageData([]byte("name,price\nt-shirt,100"))
+	assert.Equal(t, myerr.UnknownExtension, err)
+	ilr.AssertNotCalled(t, "InsertData", mock.Anything, mock.Anything, mock.Anything)
+}
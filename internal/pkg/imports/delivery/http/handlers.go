@@ -0,0 +1,182 @@
+package delivery
+
+import (
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/imports"
+	"yula/internal/pkg/logging"
+	"yula/internal/pkg/middleware"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	logger logging.Logger = logging.GetLogger()
+)
+
+type ImportHandler struct {
+	importUsecase imports.ImportUsecase
+}
+
+func NewImportHandler(importUsecase imports.ImportUsecase) *ImportHandler {
+	return &ImportHandler{
+		importUsecase: importUsecase,
+	}
+}
+
+func (ih *ImportHandler) Routing(r *mux.Router, sm *middleware.SessionMiddleware) {
+	r.Handle("/imports", sm.CheckAuthorized(http.HandlerFunc(ih.StartImportHandler))).Methods(http.MethodPost, http.MethodOptions)
+	r.Handle("/imports/{id:[0-9]+}", middleware.SetSCRFToken(sm.CheckAuthorized(http.HandlerFunc(ih.ImportStatusHandler)))).Methods(http.MethodGet, http.MethodOptions)
+}
+
+// StartImportHandler godoc
+// @Summary Import adverts
+// @Description Start asynchronous import of adverts from CSV or JSON file.
+// @Description CSV needs a header with name and category columns, optional columns are description, price,
+// @Description amount, location, latitude, longitude and images separated by ";".
+// @Description Images are http(s) links or file names from the uploaded zip archive.
+// @Tags imports
+// @Accept multipart/form-data
+// @Produce application/json
+// @Param file formData file true "CSV or JSON file with adverts"
+// @Param images formData file false "Zip archive with images"
+// @Param format formData string false "csv or json, by default taken from file extension"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyImportJob}
+// @failure default {object} models.HttpError
+// @Router /imports [post]
+func (ih *ImportHandler) StartImportHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	defer r.Body.Close()
+	r.Body = http.MaxBytesReader(w, r.Body, models.ImportMaxFile+models.ImportMaxZip+(1<<20))
+	err := r.ParseMultipartForm(8 << 20) // 8Мб, остальное уходит во временные файлы
+	if err != nil || len(r.MultipartForm.File["file"]) == 0 {
+		logger.Warnf("invalid import form")
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	file := r.MultipartForm.File["file"][0]
+	format := strings.ToLower(r.FormValue("format"))
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(file.Filename)), ".")
+	}
+
+	// файлы формы удаляются после ответа, а импорт идет в фоне, поэтому читаем их в память
+	data, err := readFormFile(file, models.ImportMaxFile)
+	var archive []byte
+	if err == nil && len(r.MultipartForm.File["images"]) != 0 {
+		archive, err = readFormFile(r.MultipartForm.File["images"][0], models.ImportMaxZip)
+	}
+	if err != nil {
+		logger.Warnf("can not read import files: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	job, err := ih.importUsecase.StartImport(userId, format, data, archive)
+	if err != nil {
+		logger.Warnf("can not start import: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyImportJob{ImportJob: *job}
+	_, err = w.Write(models.ToBytes(http.StatusAccepted, "import started", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// ImportStatusHandler godoc
+// @Summary Import status
+// @Description Import progress with per-row report, row numbers start from 1 and skip CSV header
+// @Tags imports
+// @Produce application/json
+// @Param id path integer true "Import id"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyImportJob}
+// @failure default {object} models.HttpError
+// @Router /imports/{id} [get]
+func (ih *ImportHandler) ImportStatusHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	jobId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse import id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	job, err := ih.importUsecase.GetImport(jobId, userId)
+	if err != nil {
+		logger.Warnf("can not get import %d: %s", jobId, err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyImportJob{ImportJob: *job}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "import got successfully", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+func readFormFile(header *multipart.FileHeader, limit int64) ([]byte, error) {
+	if header.Size > limit {
+		return nil, internalError.InvalidImportFile
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return nil, internalError.UnableToReadFile
+	}
+	defer file.Close()
+
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		return nil, internalError.UnableToReadFile
+	}
+	return data, nil
+}
@@ -0,0 +1,144 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"yula/internal/models"
+	"yula/internal/pkg/middleware"
+
+	importMock "yula/internal/pkg/imports/mocks"
+
+	myerr "yula/internal/error"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func withUser(userId int64) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), middleware.ContextUserId, userId)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func newTestRouter(ih *ImportHandler, userId int64) *mux.Router {
+	router := mux.NewRouter()
+	router.HandleFunc("/imports", ih.StartImportHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.HandleFunc("/imports/{id:[0-9]+}", ih.ImportStatusHandler).Methods(http.MethodGet, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+	router.Use(withUser(userId))
+	return router
+}
+
+func newImportForm(t *testing.T, filename string, data []byte, fields map[string]string) (*bytes.Buffer, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", filename)
+	assert.NoError(t, err)
+	_, err = part.Write(data)
+	assert.NoError(t, err)
+	for name, value := range fields {
+		assert.NoError(t, writer.WriteField(name, value))
+	}
+	assert.NoError(t, writer.Close())
+	return body, writer.FormDataContentType()
+}
+
+func TestStartImportHandlerOk(t *testing.T) {
+	iu := importMock.ImportUsecase{}
+	ih := NewImportHandler(&iu)
+	srv := httptest.NewServer(newTestRouter(ih, 2))
+	defer srv.Close()
+
+	data := []byte("name,category\nХуди,одежда\n")
+	iu.On("StartImport", int64(2), models.ImportFormatCSV, data, []byte(nil)).
+		Return(&models.ImportJob{Id: 1, UserId: 2, Status: models.ImportStatusPending, Total: 1}, nil)
+
+	body, contentType := newImportForm(t, "adverts.CSV", data, nil)
+	res, err := http.Post(fmt.Sprintf("%s/imports", srv.URL), contentType, body)
+	assert.NoError(t, err)
+
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusAccepted, answer.Code)
+	iu.AssertExpectations(t)
+}
+
+func TestStartImportHandlerFormatField(t *testing.T) {
+	iu := importMock.ImportUsecase{}
+	ih := NewImportHandler(&iu)
+	srv := httptest.NewServer(newTestRouter(ih, 2))
+	defer srv.Close()
+
+	data := []byte(`[{"name": "Худи", "category": "одежда"}]`)
+	iu.On("StartImport", int64(2), models.ImportFormatJSON, data, []byte(nil)).Return(nil, myerr.InvalidImportFile)
+
+	body, contentType := newImportForm(t, "adverts.txt", data, map[string]string{"format": "JSON"})
+	res, err := http.Post(fmt.Sprintf("%s/imports", srv.URL), contentType, body)
+	assert.NoError(t, err)
+
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, answer.Code)
+	assert.Equal(t, "import file can not be parsed", answer.Message)
+}
+
+func TestStartImportHandlerNoFile(t *testing.T) {
+	iu := importMock.ImportUsecase{}
+	ih := NewImportHandler(&iu)
+	srv := httptest.NewServer(newTestRouter(ih, 2))
+	defer srv.Close()
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	assert.NoError(t, writer.WriteField("format", "csv"))
+	assert.NoError(t, writer.Close())
+
+	res, err := http.Post(fmt.Sprintf("%s/imports", srv.URL), writer.FormDataContentType(), body)
+	assert.NoError(t, err)
+
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, answer.Code)
+	iu.AssertNotCalled(t, "StartImport", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestImportStatusHandler(t *testing.T) {
+	iu := importMock.ImportUsecase{}
+	ih := NewImportHandler(&iu)
+	srv := httptest.NewServer(newTestRouter(ih, 2))
+	defer srv.Close()
+
+	job := &models.ImportJob{Id: 1, UserId: 2, Status: models.ImportStatusDone, Total: 2, Created: 1, Failed: 1,
+		Report: models.ImportReport{{Row: 1, AdvertId: 10}, {Row: 2, Error: "invalid price"}}}
+	iu.On("GetImport", int64(1), int64(2)).Return(job, nil)
+	iu.On("GetImport", int64(5), int64(2)).Return(nil, myerr.NotExist)
+
+	res, err := http.Get(fmt.Sprintf("%s/imports/1", srv.URL))
+	assert.NoError(t, err)
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, answer.Code)
+
+	res, err = http.Get(fmt.Sprintf("%s/imports/5", srv.URL))
+	assert.NoError(t, err)
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, answer.Code)
+}
@@ -0,0 +1,65 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ImportRepository is an autogenerated mock type for the ImportRepository type
+type ImportRepository struct {
+	mock.Mock
+}
+
+// Insert provides a mock function with given fields: job
+func (_m *ImportRepository) Insert(job *models.ImportJob) error {
+	ret := _m.Called(job)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ImportJob) error); ok {
+		r0 = rf(job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SelectById provides a mock function with given fields: jobId
+func (_m *ImportRepository) SelectById(jobId int64) (*models.ImportJob, error) {
+	ret := _m.Called(jobId)
+
+	var r0 *models.ImportJob
+	if rf, ok := ret.Get(0).(func(int64) *models.ImportJob); ok {
+		r0 = rf(jobId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImportJob)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(jobId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: job
+func (_m *ImportRepository) Update(job *models.ImportJob) error {
+	ret := _m.Called(job)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ImportJob) error); ok {
+		r0 = rf(job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,60 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ImportUsecase is an autogenerated mock type for the ImportUsecase type
+type ImportUsecase struct {
+	mock.Mock
+}
+
+// GetImport provides a mock function with given fields: jobId, userId
+func (_m *ImportUsecase) GetImport(jobId int64, userId int64) (*models.ImportJob, error) {
+	ret := _m.Called(jobId, userId)
+
+	var r0 *models.ImportJob
+	if rf, ok := ret.Get(0).(func(int64, int64) *models.ImportJob); ok {
+		r0 = rf(jobId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImportJob)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(jobId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartImport provides a mock function with given fields: userId, format, data, archive
+func (_m *ImportUsecase) StartImport(userId int64, format string, data []byte, archive []byte) (*models.ImportJob, error) {
+	ret := _m.Called(userId, format, data, archive)
+
+	var r0 *models.ImportJob
+	if rf, ok := ret.Get(0).(func(int64, string, []byte, []byte) *models.ImportJob); ok {
+		r0 = rf(userId, format, data, archive)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImportJob)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, string, []byte, []byte) error); ok {
+		r1 = rf(userId, format, data, archive)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,11 @@
+package imports
+
+import "yula/internal/models"
+
+//go:generate mockery -name=ImportRepository
+
+type ImportRepository interface {
+	Insert(job *models.ImportJob) error
+	SelectById(jobId int64) (*models.ImportJob, error)
+	Update(job *models.ImportJob) error
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/imports"
+
+	"github.com/mailru/easyjson"
+)
+
+type ImportRepository struct {
+	DB *sql.DB
+}
+
+func NewImportRepository(DB *sql.DB) imports.ImportRepository {
+	return &ImportRepository{
+		DB: DB,
+	}
+}
+
+func (ir *ImportRepository) Insert(job *models.ImportJob) error {
+	query := ir.DB.QueryRowContext(context.Background(),
+		`INSERT INTO import_job (user_id, status, total) VALUES ($1, $2, $3) RETURNING id, created_at;`,
+		job.UserId, job.Status, job.Total)
+
+	err := query.Scan(&job.Id, &job.CreatedAt)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+	return nil
+}
+
+func (ir *ImportRepository) SelectById(jobId int64) (*models.ImportJob, error) {
+	query := ir.DB.QueryRowContext(context.Background(),
+		`SELECT id, user_id, status, total, created, failed, report, created_at, finished_at 
+		FROM import_job WHERE id = $1;`, jobId)
+
+	job := &models.ImportJob{}
+	var report []byte
+	err := query.Scan(&job.Id, &job.UserId, &job.Status, &job.Total, &job.Created, &job.Failed, &report,
+		&job.CreatedAt, &job.FinishedAt)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return nil, internalError.EmptyQuery
+		}
+		return nil, internalError.GenInternalError(err)
+	}
+
+	err = easyjson.Unmarshal(report, &job.Report)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+	return job, nil
+}
+
+// Update сохраняет прогресс задачи вместе с отчетом по уже обработанным строкам
+func (ir *ImportRepository) Update(job *models.ImportJob) error {
+	report, err := easyjson.Marshal(job.Report)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	result, err := ir.DB.ExecContext(context.Background(),
+		`UPDATE import_job SET status = $2, created = $3, failed = $4, report = $5, finished_at = $6 WHERE id = $1;`,
+		job.Id, job.Status, job.Created, job.Failed, report, job.FinishedAt)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+	if rows == 0 {
+		return internalError.EmptyQuery
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"testing"
+	"time"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	sqlmock "gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestInsertImportJob(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewImportRepository(db)
+	job := &models.ImportJob{UserId: 2, Status: models.ImportStatusPending, Total: 300}
+	createdAt := time.Now()
+
+	mock.ExpectQuery("INSERT INTO import_job").WithArgs(int64(2), models.ImportStatusPending, int64(300)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(1, createdAt))
+
+	err = repo.Insert(job)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), job.Id)
+	assert.Equal(t, createdAt, job.CreatedAt)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectImportJob(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewImportRepository(db)
+	now := time.Now()
+
+	rows := sqlmock.NewRows([]string{"id", "user_id", "status", "total", "created", "failed", "report", "created_at", "finished_at"}).
+		AddRow(1, 2, models.ImportStatusDone, 2, 1, 1, []byte(`[{"row":1,"advert_id":10},{"row":2,"error":"invalid price"}]`), now, now)
+	mock.ExpectQuery("SELECT (.+) FROM import_job").WithArgs(int64(1)).WillReturnRows(rows)
+
+	job, err := repo.SelectById(1)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ImportReport{
+		{Row: 1, AdvertId: 10},
+		{Row: 2, Error: "invalid price"},
+	}, job.Report)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectImportJobEmpty(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewImportRepository(db)
+
+	mock.ExpectQuery("SELECT (.+) FROM import_job").WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err = repo.SelectById(1)
+	assert.Equal(t, internalError.EmptyQuery, err)
+}
+
+func TestUpdateImportJob(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewImportRepository(db)
+	job := &models.ImportJob{Id: 1, Status: models.ImportStatusRunning, Created: 1,
+		Report: models.ImportReport{{Row: 1, AdvertId: 10}}}
+
+	mock.ExpectExec("UPDATE import_job").
+		WithArgs(int64(1), models.ImportStatusRunning, int64(1), int64(0), []byte(`[{"row":1,"advert_id":10}]`), time.Time{}).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.Update(job)
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateImportJobNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewImportRepository(db)
+
+	mock.ExpectExec("UPDATE import_job").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.Update(&models.ImportJob{Id: 1, Report: models.ImportReport{}})
+	assert.Equal(t, internalError.EmptyQuery, err)
+}
@@ -0,0 +1,10 @@
+package imports
+
+import "yula/internal/models"
+
+//go:generate mockery -name=ImportUsecase
+
+type ImportUsecase interface {
+	StartImport(userId int64, format string, data []byte, archive []byte) (*models.ImportJob, error)
+	GetImport(jobId int64, userId int64) (*models.ImportJob, error)
+}
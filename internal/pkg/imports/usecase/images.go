@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"path"
+	"strings"
+	"syscall"
+	"time"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+)
+
+var errForbiddenAddress = errors.New("address is not allowed")
+
+// newImageClient возвращает клиент для скачивания картинок по ссылкам из файла импорта;
+// ссылки присылает пользователь, поэтому в локальную и внутреннюю сеть не ходим
+func newImageClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: func(network, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+				ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() {
+				return errForbiddenAddress
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: 15 * time.Second,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 5 * time.Second,
+		},
+	}
+}
+
+// openArchive индексирует zip с картинками по полному пути и по имени файла
+func openArchive(archive []byte) (map[string]*zip.File, error) {
+	files := make(map[string]*zip.File)
+	if len(archive) == 0 {
+		return files, nil
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, internalError.InvalidImportFile
+	}
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		files[file.Name] = file
+		if _, ok := files[path.Base(file.Name)]; !ok {
+			files[path.Base(file.Name)] = file
+		}
+	}
+	return files, nil
+}
+
+// loadImage читает картинку строки: http(s) ссылку скачивает, остальное ищет в архиве
+func (iu *ImportUsecase) loadImage(ref string, archive map[string]*zip.File) ([]byte, error) {
+	if strings.HasPrefix(ref, "http://") || strings.HasPrefix(ref, "https://") {
+		return iu.fetchImage(ref)
+	}
+
+	file, ok := archive[ref]
+	if !ok {
+		return nil, fmt.Errorf("image %s not found in archive", ref)
+	}
+	if file.UncompressedSize64 > uint64(models.ImportMaxImage) {
+		return nil, fmt.Errorf("image %s is too large", ref)
+	}
+
+	reader, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("image %s can not be read", ref)
+	}
+	defer reader.Close()
+	return readImage(reader, ref)
+}
+
+func (iu *ImportUsecase) fetchImage(url string) ([]byte, error) {
+	response, err := iu.httpClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("image %s can not be downloaded", url)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("image %s can not be downloaded: status %d", url, response.StatusCode)
+	}
+	return readImage(response.Body, url)
+}
+
+func readImage(reader io.Reader, ref string) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(reader, models.ImportMaxImage+1))
+	if err != nil {
+		return nil, fmt.Errorf("image %s can not be read", ref)
+	}
+	if int64(len(data)) > models.ImportMaxImage {
+		return nil, fmt.Errorf("image %s is too large", ref)
+	}
+	return data, nil
+}
@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+
+	"github.com/mailru/easyjson"
+)
+
+// без этих колонок в CSV нельзя создать ни одного объявления
+var requiredColumns = []string{"name", "category"}
+
+// parseRows разбирает файл импорта целиком; ошибка возвращается, только если файл нельзя прочитать,
+// ошибки отдельных строк попадают в rowErrors по индексу строки
+func parseRows(format string, data []byte) (models.ImportRows, map[int]string, error) {
+	var rows models.ImportRows
+	rowErrors := make(map[int]string)
+	var err error
+
+	switch format {
+	case models.ImportFormatCSV:
+		rows, rowErrors, err = parseCSV(data)
+	case models.ImportFormatJSON:
+		err = easyjson.Unmarshal(data, &rows)
+	default:
+		return nil, nil, internalError.BadRequest
+	}
+	if err != nil {
+		return nil, nil, internalError.InvalidImportFile
+	}
+
+	if len(rows) == 0 || len(rows) > models.ImportMaxRows {
+		return nil, nil, internalError.InvalidImportFile
+	}
+	for i, row := range rows {
+		if row == nil {
+			rows[i] = &models.ImportRow{}
+			rowErrors[i] = "empty row"
+		}
+	}
+	return rows, rowErrors, nil
+}
+
+func parseCSV(data []byte) (models.ImportRows, map[int]string, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, err
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range requiredColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, nil, fmt.Errorf("column %s is required", name)
+		}
+	}
+
+	rows := make(models.ImportRows, 0)
+	rowErrors := make(map[int]string)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		value := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		row := &models.ImportRow{
+			Name:        value("name"),
+			Description: value("description"),
+			Category:    value("category"),
+			Location:    value("location"),
+		}
+		for _, image := range strings.Split(value("images"), ";") {
+			if image = strings.TrimSpace(image); image != "" {
+				row.Images = append(row.Images, image)
+			}
+		}
+
+		var invalid []string
+		if price := value("price"); price != "" {
+			row.Price, err = strconv.Atoi(price)
+			if err != nil {
+				invalid = append(invalid, "price")
+			}
+		}
+		if amount := value("amount"); amount != "" {
+			row.Amount, err = strconv.ParseInt(amount, 10, 64)
+			if err != nil {
+				invalid = append(invalid, "amount")
+			}
+		}
+		if latitude := value("latitude"); latitude != "" {
+			row.Latitude, err = strconv.ParseFloat(latitude, 64)
+			if err != nil {
+				invalid = append(invalid, "latitude")
+			}
+		}
+		if longitude := value("longitude"); longitude != "" {
+			row.Longitude, err = strconv.ParseFloat(longitude, 64)
+			if err != nil {
+				invalid = append(invalid, "longitude")
+			}
+		}
+		if len(invalid) != 0 {
+			rowErrors[len(rows)] = "invalid " + strings.Join(invalid, ", ")
+		}
+
+		rows = append(rows, row)
+	}
+	return rows, rowErrors, nil
+}
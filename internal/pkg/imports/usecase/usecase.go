@@ -0,0 +1,194 @@
+package usecase
+
+import (
+	"archive/zip"
+	"fmt"
+	"net/http"
+	"time"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/advt"
+	imageloader "yula/internal/pkg/image_loader"
+	"yula/internal/pkg/imports"
+	"yula/internal/pkg/logging"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/microcosm-cc/bluemonday"
+)
+
+var logger logging.Logger = logging.GetLogger()
+
+type ImportUsecase struct {
+	importRepository   imports.ImportRepository
+	advtUsecase        advt.AdvtUsecase
+	advtRepository     advt.AdvtRepository
+	imageLoaderUsecase imageloader.ImageLoaderUsecase
+
+	httpClient *http.Client
+	// запускает обработку задачи, в тестах подменяется синхронным вызовом
+	spawn func(func())
+}
+
+func NewImportUsecase(importRepository imports.ImportRepository, advtUsecase advt.AdvtUsecase,
+	advtRepository advt.AdvtRepository, imageLoaderUsecase imageloader.ImageLoaderUsecase) imports.ImportUsecase {
+	return &ImportUsecase{
+		importRepository:   importRepository,
+		advtUsecase:        advtUsecase,
+		advtRepository:     advtRepository,
+		imageLoaderUsecase: imageLoaderUsecase,
+		httpClient:         newImageClient(),
+		spawn:              func(run func()) { go run() },
+	}
+}
+
+// StartImport проверяет файл целиком и заводит задачу, объявления создаются в фоне,
+// ход импорта и построчный отчет видны через GetImport
+func (iu *ImportUsecase) StartImport(userId int64, format string, data []byte, archive []byte) (*models.ImportJob, error) {
+	rows, rowErrors, err := parseRows(format, data)
+	if err != nil {
+		return nil, err
+	}
+	files, err := openArchive(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	job := &models.ImportJob{
+		UserId: userId,
+		Status: models.ImportStatusPending,
+		Total:  int64(len(rows)),
+		Report: models.ImportReport{},
+	}
+	err = iu.importRepository.Insert(job)
+	if err != nil {
+		return nil, err
+	}
+
+	started := *job
+	iu.spawn(func() {
+		iu.run(job, rows, rowErrors, files)
+	})
+	return &started, nil
+}
+
+func (iu *ImportUsecase) GetImport(jobId int64, userId int64) (*models.ImportJob, error) {
+	job, err := iu.importRepository.SelectById(jobId)
+	if err == internalError.EmptyQuery {
+		return nil, internalError.NotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// чужие задачи не показываем, как будто их нет
+	if job.UserId != userId {
+		return nil, internalError.NotExist
+	}
+	return job, nil
+}
+
+func (iu *ImportUsecase) run(job *models.ImportJob, rows models.ImportRows, rowErrors map[int]string,
+	archive map[string]*zip.File) {
+	job.Status = models.ImportStatusRunning
+
+	for start := 0; start < len(rows); start += models.ImportBatchSize {
+		end := start + models.ImportBatchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+
+		for i := start; i < end; i++ {
+			result := &models.ImportRowResult{Row: int64(i + 1)}
+			if rowError, ok := rowErrors[i]; ok {
+				result.Error = rowError
+			} else {
+				result.AdvertId, result.Error = iu.importRow(job.UserId, rows[i], archive)
+			}
+
+			if result.Error != "" {
+				job.Failed++
+			} else {
+				job.Created++
+			}
+			job.Report = append(job.Report, result)
+		}
+
+		if end == len(rows) {
+			job.Status = models.ImportStatusDone
+			job.FinishedAt = time.Now()
+		}
+		err := iu.importRepository.Update(job)
+		if err != nil {
+			logger.Warnf("cannot save import %d progress: %v", job.Id, err)
+		}
+	}
+	logger.Infof("import %d done: %d created, %d failed", job.Id, job.Created, job.Failed)
+}
+
+// importRow создает объявление по строке файла, возвращает его id или текст ошибки для отчета
+func (iu *ImportUsecase) importRow(userId int64, row *models.ImportRow, archive map[string]*zip.File) (int64, string) {
+	sanitize := bluemonday.UGCPolicy()
+	row.Name = sanitize.Sanitize(row.Name)
+	row.Description = sanitize.Sanitize(row.Description)
+	row.Location = sanitize.Sanitize(row.Location)
+	row.Category = sanitize.Sanitize(row.Category)
+
+	// govalidator пропускает пустые поля, а без имени и категории объявление создавать нельзя
+	if row.Name == "" || row.Category == "" {
+		return 0, "name and category are required"
+	}
+
+	// правила те же, что у POST /adverts
+	advert := row.ToAdvert()
+	_, err := govalidator.ValidateStruct(advert)
+	if err != nil {
+		return 0, fmt.Sprintf("invalid data: %s", err.Error())
+	}
+	if len(row.Images) > models.ImportMaxImages {
+		return 0, fmt.Sprintf("too many images, at most %d allowed", models.ImportMaxImages)
+	}
+
+	images := make([]string, 0, len(row.Images))
+	for _, ref := range row.Images {
+		data, err := iu.loadImage(ref, archive)
+		if err == nil {
+			var url string
+			url, err = iu.imageLoaderUsecase.UploadAdvertImageData(data)
+			if err == nil {
+				images = append(images, url)
+				continue
+			}
+			_, message := internalError.ToMetaStatus(err)
+			err = fmt.Errorf("image %s: %s", ref, message)
+		}
+
+		iu.removeImages(images)
+		return 0, err.Error()
+	}
+
+	err = iu.advtUsecase.CreateAdvert(userId, advert)
+	if err != nil {
+		iu.removeImages(images)
+		_, message := internalError.ToMetaStatus(err)
+		return 0, message
+	}
+
+	if len(images) != 0 {
+		err = iu.advtRepository.InsertImages(advert.Id, images)
+		if err != nil {
+			iu.removeImages(images)
+			return advert.Id, "advert created without images"
+		}
+	}
+	return advert.Id, ""
+}
+
+func (iu *ImportUsecase) removeImages(images []string) {
+	if len(images) == 0 {
+		return
+	}
+	err := iu.imageLoaderUsecase.RemoveAdvertImages(images)
+	if err != nil {
+		logger.Warnf("cannot remove imported images: %v", err)
+	}
+}
@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	advtMock "yula/internal/pkg/advt/mocks"
+	ILMock "yula/internal/pkg/image_loader/mocks"
+	importMock "yula/internal/pkg/imports/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var testpng = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+type testDeps struct {
+	ir  *importMock.ImportRepository
+	au  *advtMock.AdvtUsecase
+	ar  *advtMock.AdvtRepository
+	ilu *ILMock.ImageLoaderUsecase
+}
+
+// newTestUsecase возвращает usecase, который обрабатывает задачу синхронно
+func newTestUsecase() (*ImportUsecase, *testDeps) {
+	deps := &testDeps{
+		ir:  &importMock.ImportRepository{},
+		au:  &advtMock.AdvtUsecase{},
+		ar:  &advtMock.AdvtRepository{},
+		ilu: &ILMock.ImageLoaderUsecase{},
+	}
+	iu := NewImportUsecase(deps.ir, deps.au, deps.ar, deps.ilu).(*ImportUsecase)
+	iu.spawn = func(run func()) { run() }
+	return iu, deps
+}
+
+func expectCreate(au *advtMock.AdvtUsecase, name string, advertId int64) {
+	au.On("CreateAdvert", int64(2), mock.MatchedBy(func(a *models.Advert) bool {
+		return a.Name == name
+	})).Run(func(args mock.Arguments) {
+		args.Get(1).(*models.Advert).Id = advertId
+	}).Return(nil).Once()
+}
+
+func TestStartImportCSV(t *testing.T) {
+	iu, deps := newTestUsecase()
+	data := []byte("name,price,category,amount,images\n" +
+		"Худи,1500,одежда,3,\n" +
+		"Кеды,дорого,обувь,1,\n" +
+		",100,одежда,1,\n")
+
+	var saved *models.ImportJob
+	deps.ir.On("Insert", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(*models.ImportJob).Id = 1
+	}).Return(nil)
+	deps.ir.On("Update", mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(0).(*models.ImportJob)
+	}).Return(nil)
+	expectCreate(deps.au, "Худи", 10)
+
+	job, err := iu.StartImport(2, models.ImportFormatCSV, data, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), job.Id)
+	assert.Equal(t, int64(3), job.Total)
+	assert.Equal(t, models.ImportStatusPending, job.Status)
+
+	assert.Equal(t, models.ImportStatusDone, saved.Status)
+	assert.Equal(t, int64(1), saved.Created)
+	assert.Equal(t, int64(2), saved.Failed)
+	assert.Equal(t, int64(10), saved.Report[0].AdvertId)
+	assert.Equal(t, "invalid price", saved.Report[1].Error)
+	assert.Equal(t, "name and category are required", saved.Report[2].Error)
+	deps.au.AssertNumberOfCalls(t, "CreateAdvert", 1)
+}
+
+func TestStartImportBatches(t *testing.T) {
+	iu, deps := newTestUsecase()
+	rows := make(models.ImportRows, models.ImportBatchSize+1)
+	for i := range rows {
+		rows[i] = &models.ImportRow{Name: "Худи", Category: "одежда"}
+	}
+	data, err := rows.MarshalJSON()
+	assert.Nil(t, err)
+
+	deps.ir.On("Insert", mock.Anything).Return(nil)
+	deps.ir.On("Update", mock.Anything).Return(nil)
+	deps.au.On("CreateAdvert", int64(2), mock.Anything).Return(nil)
+
+	_, err = iu.StartImport(2, models.ImportFormatJSON, data, nil)
+	assert.Nil(t, err)
+	// прогресс сохраняется после каждой пачки
+	deps.ir.AssertNumberOfCalls(t, "Update", 2)
+	deps.au.AssertNumberOfCalls(t, "CreateAdvert", models.ImportBatchSize+1)
+}
+
+func TestStartImportInvalidFile(t *testing.T) {
+	iu, deps := newTestUsecase()
+
+	_, err := iu.StartImport(2, models.ImportFormatJSON, []byte(`{"name": "Худи"`), nil)
+	assert.Equal(t, internalError.InvalidImportFile, err)
+
+	_, err = iu.StartImport(2, models.ImportFormatCSV, []byte("name,price\nХуди,100\n"), nil)
+	assert.Equal(t, internalError.InvalidImportFile, err)
+
+	_, err = iu.StartImport(2, "xml", []byte("<adverts/>"), nil)
+	assert.Equal(t, internalError.BadRequest, err)
+
+	_, err = iu.StartImport(2, models.ImportFormatCSV, []byte("name,category\n"), nil)
+	assert.Equal(t, internalError.InvalidImportFile, err)
+	deps.ir.AssertNotCalled(t, "Insert", mock.Anything)
+}
+
+func TestStartImportArchiveImages(t *testing.T) {
+	iu, deps := newTestUsecase()
+
+	var archive bytes.Buffer
+	writer := zip.NewWriter(&archive)
+	file, err := writer.Create("photos/hudi.png")
+	assert.Nil(t, err)
+	_, err = file.Write(testpng)
+	assert.Nil(t, err)
+	assert.Nil(t, writer.Close())
+
+	data := []byte(`[{"name": "Худи", "category": "одежда", "images": ["hudi.png"]},
+		{"name": "Кеды", "category": "обувь", "images": ["kedy.png"]}]`)
+
+	var saved *models.ImportJob
+	deps.ir.On("Insert", mock.Anything).Return(nil)
+	deps.ir.On("Update", mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(0).(*models.ImportJob)
+	}).Return(nil)
+	deps.ilu.On("UploadAdvertImageData", testpng).Return("static/advertimages/1__png", nil)
+	expectCreate(deps.au, "Худи", 10)
+	deps.ar.On("InsertImages", int64(10), []string{"static/advertimages/1__png"}).Return(nil)
+
+	_, err = iu.StartImport(2, models.ImportFormatJSON, data, archive.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), saved.Created)
+	assert.Equal(t, "image kedy.png not found in archive", saved.Report[1].Error)
+	deps.ar.AssertExpectations(t)
+}
+
+func TestStartImportRemoteImage(t *testing.T) {
+	iu, deps := newTestUsecase()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/hudi.png" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(testpng)
+	}))
+	defer srv.Close()
+	iu.httpClient = srv.Client()
+
+	data := []byte(`[{"name": "Худи", "category": "одежда", "images": ["` + srv.URL + `/hudi.png"]},
+		{"name": "Кеды", "category": "обувь", "images": ["` + srv.URL + `/kedy.png"]}]`)
+
+	var saved *models.ImportJob
+	deps.ir.On("Insert", mock.Anything).Return(nil)
+	deps.ir.On("Update", mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(0).(*models.ImportJob)
+	}).Return(nil)
+	deps.ilu.On("UploadAdvertImageData", testpng).Return("static/advertimages/1__png", nil)
+	expectCreate(deps.au, "Худи", 10)
+	deps.ar.On("InsertImages", int64(10), []string{"static/advertimages/1__png"}).Return(nil)
+
+	_, err := iu.StartImport(2, models.ImportFormatJSON, data, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), saved.Created)
+	assert.Contains(t, saved.Report[1].Error, "status 404")
+}
+
+func TestFetchImageLocalAddressRefused(t *testing.T) {
+	iu, _ := newTestUsecase()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(testpng)
+	}))
+	defer srv.Close()
+
+	_, err := iu.fetchImage(srv.URL + "/hudi.png")
+	assert.Error(t, err)
+}
+
+func TestImportRowCreateFailedRemovesImages(t *testing.T) {
+	iu, deps := newTestUsecase()
+	archive, err := openArchive(nil)
+	assert.Nil(t, err)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(testpng)
+	}))
+	defer srv.Close()
+	iu.httpClient = srv.Client()
+
+	deps.ilu.On("UploadAdvertImageData", testpng).Return("static/advertimages/1__png", nil)
+	deps.au.On("CreateAdvert", int64(2), mock.Anything).Return(internalError.BadRequest)
+	deps.ilu.On("RemoveAdvertImages", []string{"static/advertimages/1__png"}).Return(nil)
+
+	row := &models.ImportRow{Name: "Худи", Category: "нет такой", Images: []string{srv.URL + "/hudi.png"}}
+	advertId, rowError := iu.importRow(2, row, archive)
+	assert.Equal(t, int64(0), advertId)
+	assert.Equal(t, "bad request", rowError)
+	deps.ilu.AssertExpectations(t)
+}
+
+func TestGetImportOtherUser(t *testing.T) {
+	iu, deps := newTestUsecase()
+	deps.ir.On("SelectById", int64(1)).Return(&models.ImportJob{Id: 1, UserId: 3}, nil)
+	deps.ir.On("SelectById", int64(2)).Return(nil, internalError.EmptyQuery)
+
+	_, err := iu.GetImport(1, 2)
+	assert.Equal(t, internalError.NotExist, err)
+
+	_, err = iu.GetImport(2, 2)
+	assert.Equal(t, internalError.NotExist, err)
+}
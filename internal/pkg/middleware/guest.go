@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+	"yula/internal/config"
+
+	"github.com/google/uuid"
+)
+
+const ContextGuestToken contextKey = "guest_token"
+
+const (
+	GuestCartCookie   = "guest_cart"
+	guestCartLifetime = 30 * 24 * time.Hour
+)
+
+func signGuestToken(token string) string {
+	mac := hmac.New(sha256.New, []byte(config.Cfg.GetGuestCartSecret()))
+	mac.Write([]byte(token))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// GuestToken достает из куки токен гостевой корзины, если подпись верна
+func GuestToken(r *http.Request) (string, bool) {
+	cookie, err := r.Cookie(GuestCartCookie)
+	if err != nil {
+		return "", false
+	}
+
+	parts := strings.Split(cookie.Value, ".")
+	if len(parts) != 2 || parts[0] == "" {
+		return "", false
+	}
+
+	if !hmac.Equal([]byte(signGuestToken(parts[0])), []byte(parts[1])) {
+		return "", false
+	}
+
+	return parts[0], true
+}
+
+func ClearGuestToken(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     GuestCartCookie,
+		Value:    "",
+		Expires:  time.Unix(0, 0),
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+		Path:     "/",
+		Secure:   true,
+	})
+}
+
+// GuestCart выдает неавторизованному пользователю подписанный токен гостевой корзины
+// и кладет его в контекст; авторизованные запросы пропускаются как есть
+func GuestCart(next http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Context().Value(ContextUserId) != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		token, ok := GuestToken(r)
+		if !ok {
+			token = uuid.NewString()
+			http.SetCookie(w, &http.Cookie{
+				Name:     GuestCartCookie,
+				Value:    token + "." + signGuestToken(token),
+				Expires:  time.Now().Add(guestCartLifetime),
+				HttpOnly: true,
+				SameSite: http.SameSiteStrictMode,
+				Path:     "/",
+				Secure:   true,
+			})
+		}
+
+		ctx := context.WithValue(r.Context(), ContextGuestToken, token)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	}
+}
@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuestCart_IssueToken(t *testing.T) {
+	var token interface{}
+	caller := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token = r.Context().Value(ContextGuestToken)
+	})
+
+	r := httptest.NewRequest("GET", "/", nil)
+	w := httptest.NewRecorder()
+
+	GuestCart(caller).ServeHTTP(w, r)
+
+	assert.NotNil(t, token)
+	cookies := w.Result().Cookies()
+	assert.Equal(t, 1, len(cookies))
+	assert.Equal(t, GuestCartCookie, cookies[0].Name)
+
+	r = httptest.NewRequest("GET", "/", nil)
+	r.AddCookie(cookies[0])
+	parsed, ok := GuestToken(r)
+	assert.True(t, ok)
+	assert.Equal(t, token, parsed)
+}
+
+func TestGuestCart_ReuseToken(t *testing.T) {
+	w := httptest.NewRecorder()
+	GuestCart(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})).
+		ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+	issued := w.Result().Cookies()[0]
+
+	var token interface{}
+	caller := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token = r.Context().Value(ContextGuestToken)
+	})
+
+	r := httptest.NewRequest("GET", "/", nil)
+	r.AddCookie(issued)
+	w = httptest.NewRecorder()
+
+	GuestCart(caller).ServeHTTP(w, r)
+
+	parsed, _ := GuestToken(r)
+	assert.Equal(t, parsed, token)
+	assert.Equal(t, 0, len(w.Result().Cookies()))
+}
+
+func TestGuestCart_ForgedToken(t *testing.T) {
+	r := httptest.NewRequest("GET", "/", nil)
+	r.AddCookie(&http.Cookie{Name: GuestCartCookie, Value: "someone-else.deadbeef"})
+
+	_, ok := GuestToken(r)
+	assert.False(t, ok)
+
+	var token interface{}
+	caller := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token = r.Context().Value(ContextGuestToken)
+	})
+	w := httptest.NewRecorder()
+
+	GuestCart(caller).ServeHTTP(w, r)
+
+	assert.NotEqual(t, "someone-else", token)
+	assert.Equal(t, 1, len(w.Result().Cookies()))
+}
+
+func TestGuestCart_Authorized(t *testing.T) {
+	var token interface{}
+	caller := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token = r.Context().Value(ContextGuestToken)
+	})
+
+	r := httptest.NewRequest("GET", "/", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ContextUserId, int64(1)))
+	w := httptest.NewRecorder()
+
+	GuestCart(caller).ServeHTTP(w, r)
+
+	assert.Nil(t, token)
+	assert.Equal(t, 0, len(w.Result().Cookies()))
+}
+
+func TestClearGuestToken(t *testing.T) {
+	w := httptest.NewRecorder()
+	ClearGuestToken(w)
+
+	cookies := w.Result().Cookies()
+	assert.Equal(t, 1, len(cookies))
+	assert.Equal(t, "", cookies[0].Value)
+}
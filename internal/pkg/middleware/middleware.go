@@ -10,6 +10,7 @@ import (
 	"strings"
 	internalError "yula/internal/error"
 	"yula/internal/models"
+	"yula/internal/pkg/user"
 	proto "yula/proto/generated/auth"
 
 	"github.com/google/uuid"
@@ -21,17 +22,20 @@ import (
 type contextKey string
 
 const ContextUserId contextKey = "user_id"
+const ContextUserRole contextKey = "user_role"
 const ContextLoggerField contextKey = "logger fields"
 
 const SCRFToken = "c4e0344db55a8e7e5b79f5d2c9ff317c"
 
 type SessionMiddleware struct {
 	sessionUsecase proto.AuthClient
+	userUsecase    user.UserUsecase
 }
 
-func NewSessionMiddleware(sessionUsecase proto.AuthClient) *SessionMiddleware {
+func NewSessionMiddleware(sessionUsecase proto.AuthClient, userUsecase user.UserUsecase) *SessionMiddleware {
 	return &SessionMiddleware{
 		sessionUsecase: sessionUsecase,
+		userUsecase:    userUsecase,
 	}
 }
 
@@ -76,9 +80,29 @@ func (sm *SessionMiddleware) CheckAuthorized(next http.Handler) http.Handler {
 			ExpiresAt: protoSession.ExpireAt.AsTime(),
 		}
 
+		// сессия заблокированного пользователя не принимается, даже если еще не истекла
+		role, err := sm.userUsecase.CheckAccess(session.UserId)
+		if err != nil {
+			log.Printf("error middleware 3: %v\n", err.Error())
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+
+			metaCode, metaMessage := http.StatusUnauthorized, "no rights to access this resource"
+			if err == internalError.UserBanned {
+				metaCode, metaMessage = internalError.ToMetaStatus(err)
+			}
+			_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+			if err != nil {
+				log.Printf("error writing response to body: %v\n", err.Error())
+			}
+			return
+		}
+
 		// то есть если нашли куку и она валидна, запишем ее в контекст
 		// чтобы затем использовать в последующих обработчиках
 		ctxId := context.WithValue(r.Context(), ContextUserId, session.UserId)
+		ctxId = context.WithValue(ctxId, ContextUserRole, role)
 		r = r.WithContext(ctxId)
 
 		next.ServeHTTP(w, r)
@@ -105,7 +129,14 @@ func (sm *SessionMiddleware) SoftCheckAuthorized(next http.HandlerFunc) http.Han
 			ExpiresAt: protoSession.ExpireAt.AsTime(),
 		}
 
+		role, err := sm.userUsecase.CheckAccess(session.UserId)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
 		ctxId := context.WithValue(r.Context(), ContextUserId, session.UserId)
+		ctxId = context.WithValue(ctxId, ContextUserRole, role)
 		r = r.WithContext(ctxId)
 		next.ServeHTTP(w, r)
 	})
@@ -117,8 +148,8 @@ func CorsMiddleware(next http.Handler) http.Handler {
 		w.Header().Set("Access-Control-Allow-Origin", "https://volchock.ru")
 		w.Header().Set("Access-Control-Allow-Credentials", "true")
 		w.Header().Set("Access-Control-Allow-Methods", "POST, GET, OPTIONS, PUT, DELETE")
-		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, Authorization, X-CSRF-Token, Location")
-		w.Header().Set("Access-Control-Expose-Headers", "X-CSRF-Token")
+		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, Authorization, X-CSRF-Token, Location, If-None-Match")
+		w.Header().Set("Access-Control-Expose-Headers", "X-CSRF-Token, ETag")
 		w.Header().Set("Access-Control-Max-Age", "600")
 		if r.Method == "OPTIONS" {
 			return
@@ -135,9 +166,12 @@ func ContentTypeMiddleware(next http.Handler) http.Handler {
 
 		isImageUpload, _ := regexp.MatchString("^/adverts/[0-9]+/images$", relativePath)
 		isImageUpload = isImageUpload && (r.Method == "POST")
+		isImport := relativePath == "/imports" && r.Method == "POST"
+		isDispute, _ := regexp.MatchString("^/orders/[0-9]+/dispute$", relativePath)
+		isDispute = isDispute && (r.Method == "POST")
 
 		switch {
-		case relativePath == "/users/profile/upload", isImageUpload:
+		case relativePath == "/users/profile/upload", isImageUpload, isImport, isDispute:
 			log.Println("image upload")
 			if !strings.Contains(contentType, "multipart/form-data") {
 				w.Header().Set("Content-Type", "application/json")
@@ -149,7 +183,8 @@ func ContentTypeMiddleware(next http.Handler) http.Handler {
 				return
 			}
 
-		case strings.Contains(relativePath, "/connect"):
+		// websocket чата и поток уведомлений открываются браузером без заголовков
+		case strings.Contains(relativePath, "/connect"), relativePath == "/notifications/stream":
 			break
 
 		case relativePath == "/promotion":
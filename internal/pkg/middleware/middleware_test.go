@@ -11,6 +11,7 @@ import (
 
 	myerr "yula/internal/error"
 
+	userMock "yula/internal/pkg/user/mocks"
 	sessMock "yula/internal/services/auth/mocks"
 	"yula/proto/generated/auth"
 
@@ -32,9 +33,10 @@ func TestMiddleware_CorsMiddleware_Success(t *testing.T) {
 	mw.ServeHTTP(w, r)
 
 	assert.Equal(t, "true", w.Header().Get("Access-Control-Allow-Credentials"))
-	assert.Equal(t, string("Accept, Content-Type, Content-Length, Accept-Encoding, Authorization, X-CSRF-Token, Location"),
+	assert.Equal(t, string("Accept, Content-Type, Content-Length, Accept-Encoding, Authorization, X-CSRF-Token, Location, If-None-Match"),
 		w.Header().Get("Access-Control-Allow-Headers"))
 	assert.Equal(t, string("POST, GET, OPTIONS, PUT, DELETE"), w.Header().Get("Access-Control-Allow-Methods"))
+	assert.Equal(t, "X-CSRF-Token, ETag", w.Header().Get("Access-Control-Expose-Headers"))
 	assert.Equal(t, string("https://volchock.ru"), w.Header().Get("Access-Control-Allow-Origin"))
 
 }
@@ -70,7 +72,8 @@ func TestMiddleware_JsonMiddleware_NoApplicationJson(t *testing.T) {
 
 func TestMiddleware_CheckAuthorized_Success(t *testing.T) {
 	su := sessMock.AuthClient{}
-	mw := NewSessionMiddleware(&su)
+	uu := userMock.UserUsecase{}
+	mw := NewSessionMiddleware(&su, &uu)
 	caller := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
 	userSession := models.Session{Value: uuid.NewString(), UserId: 0, ExpiresAt: time.Now().Add(time.Hour)}
 
@@ -79,6 +82,7 @@ func TestMiddleware_CheckAuthorized_Success(t *testing.T) {
 		SessionID: userSession.Value,
 		ExpireAt:  timestamppb.New(userSession.ExpiresAt),
 	}, nil)
+	uu.On("CheckAccess", userSession.UserId).Return(models.RoleUser, nil)
 
 	w := httptest.NewRecorder()
 	http.SetCookie(w, &http.Cookie{
@@ -104,7 +108,8 @@ func TestMiddleware_CheckAuthorized_Success(t *testing.T) {
 
 func TestMiddleware_CheckSoftAuthorized_Success(t *testing.T) {
 	su := sessMock.AuthClient{}
-	mw := NewSessionMiddleware(&su)
+	uu := userMock.UserUsecase{}
+	mw := NewSessionMiddleware(&su, &uu)
 	caller := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
 	userSession := models.Session{Value: uuid.NewString(), UserId: 0, ExpiresAt: time.Now().Add(time.Hour)}
 
@@ -113,6 +118,7 @@ func TestMiddleware_CheckSoftAuthorized_Success(t *testing.T) {
 		SessionID: userSession.Value,
 		ExpireAt:  timestamppb.New(userSession.ExpiresAt),
 	}, nil)
+	uu.On("CheckAccess", userSession.UserId).Return(models.RoleUser, nil)
 
 	w := httptest.NewRecorder()
 	http.SetCookie(w, &http.Cookie{
@@ -138,7 +144,8 @@ func TestMiddleware_CheckSoftAuthorized_Success(t *testing.T) {
 
 func TestMiddleware_CheckAuthorized_InvalidCookieName(t *testing.T) {
 	su := sessMock.AuthClient{}
-	mw := NewSessionMiddleware(&su)
+	uu := userMock.UserUsecase{}
+	mw := NewSessionMiddleware(&su, &uu)
 	caller := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
 	userSession := models.Session{Value: uuid.NewString(), UserId: 0, ExpiresAt: time.Now().Add(time.Hour)}
 
@@ -168,7 +175,8 @@ func TestMiddleware_CheckAuthorized_InvalidCookieName(t *testing.T) {
 
 func TestMiddleware_CheckAuthorized_InvalidCookieValue(t *testing.T) {
 	su := sessMock.AuthClient{}
-	mw := NewSessionMiddleware(&su)
+	uu := userMock.UserUsecase{}
+	mw := NewSessionMiddleware(&su, &uu)
 	caller := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
 	userSession := models.Session{Value: uuid.NewString(), UserId: 0, ExpiresAt: time.Now().Add(time.Hour)}
 
@@ -232,3 +240,75 @@ func TestCSRF(t *testing.T) {
 	router.Use(mw)
 	caller(w, r)
 }
+
+func TestMiddleware_CheckAuthorized_Banned(t *testing.T) {
+	su := sessMock.AuthClient{}
+	uu := userMock.UserUsecase{}
+	mw := NewSessionMiddleware(&su, &uu)
+	called := false
+	caller := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { called = true })
+	userSession := models.Session{Value: uuid.NewString(), UserId: 3, ExpiresAt: time.Now().Add(time.Hour)}
+
+	su.On("Check", mock.Anything, &auth.SessionID{ID: userSession.Value}).Return(&auth.Result{
+		UserID:    userSession.UserId,
+		SessionID: userSession.Value,
+		ExpireAt:  timestamppb.New(userSession.ExpiresAt),
+	}, nil)
+	uu.On("CheckAccess", userSession.UserId).Return("", myerr.UserBanned)
+
+	r := httptest.NewRequest("GET", "/", nil)
+	r.AddCookie(&http.Cookie{Name: "session_id", Value: userSession.Value})
+	w := httptest.NewRecorder()
+	mw.CheckAuthorized(caller).ServeHTTP(w, r)
+	assert.False(t, called)
+
+	var Answer models.HttpBodyInterface
+	err := json.NewDecoder(w.Body).Decode(&Answer)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusForbidden, Answer.Code)
+	assert.Equal(t, "user is banned", Answer.Message)
+}
+
+func TestMiddleware_JsonMiddleware_ImportMultipart(t *testing.T) {
+	called := false
+	caller := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { called = true })
+
+	r := httptest.NewRequest("POST", "/imports", nil)
+	r.Header.Add("Content-Type", "multipart/form-data; boundary=xxx")
+	w := httptest.NewRecorder()
+
+	mw := ContentTypeMiddleware(caller)
+	mw.ServeHTTP(w, r)
+
+	assert.True(t, called)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestMiddleware_JsonMiddleware_DisputeMultipart(t *testing.T) {
+	called := false
+	caller := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { called = true })
+
+	r := httptest.NewRequest("POST", "/orders/5/dispute", nil)
+	r.Header.Add("Content-Type", "multipart/form-data; boundary=xxx")
+	w := httptest.NewRecorder()
+
+	mw := ContentTypeMiddleware(caller)
+	mw.ServeHTTP(w, r)
+
+	assert.True(t, called)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestMiddleware_JsonMiddleware_NotificationsStream(t *testing.T) {
+	called := false
+	caller := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { called = true })
+
+	r := httptest.NewRequest("GET", "/notifications/stream", nil)
+	w := httptest.NewRecorder()
+
+	mw := ContentTypeMiddleware(caller)
+	mw.ServeHTTP(w, r)
+
+	assert.True(t, called)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"log"
+	"net/http"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+
+	"github.com/gorilla/mux"
+)
+
+// RequireRole пускает только пользователей с одной из перечисленных ролей,
+// роль кладет в контекст CheckAuthorized, поэтому ставится после него
+func RequireRole(roles ...string) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			role, _ := r.Context().Value(ContextUserRole).(string)
+			for _, allowed := range roles {
+				if role == allowed {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+
+			metaCode, metaMessage := internalError.ToMetaStatus(internalError.Forbidden)
+			_, err := w.Write(models.ToBytes(metaCode, metaMessage, nil))
+			if err != nil {
+				log.Printf("error writing response to body: %v\n", err.Error())
+			}
+		})
+	}
+}
+
+// RequireModerator - модерация доступна модераторам и администраторам
+var RequireModerator = RequireRole(models.RoleModerator, models.RoleAdmin)
+
+// RequireAdmin - управление пользователями и категориями только для администраторов
+var RequireAdmin = RequireRole(models.RoleAdmin)
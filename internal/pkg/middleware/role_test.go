@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"yula/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireRole(t *testing.T) {
+	called := false
+	handler := RequireModerator(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	r := httptest.NewRequest("POST", "/", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ContextUserRole, models.RoleModerator))
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	assert.True(t, called)
+}
+
+func TestRequireRoleForbidden(t *testing.T) {
+	called := false
+	handler := RequireAdmin(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	r := httptest.NewRequest("POST", "/", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ContextUserRole, models.RoleModerator))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.False(t, called)
+
+	var Answer models.HttpBodyInterface
+	err := json.NewDecoder(w.Body).Decode(&Answer)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusForbidden, Answer.Code)
+}
+
+func TestRequireRoleNoRole(t *testing.T) {
+	called := false
+	handler := RequireAdmin(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	r := httptest.NewRequest("POST", "/", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	assert.False(t, called)
+}
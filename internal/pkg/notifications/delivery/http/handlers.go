@@ -0,0 +1,244 @@
+package delivery
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/logging"
+	"yula/internal/pkg/middleware"
+	"yula/internal/pkg/notifications"
+
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	logger logging.Logger = logging.GetLogger()
+)
+
+type NotificationHandler struct {
+	notificationUsecase notifications.NotificationUsecase
+}
+
+func NewNotificationHandler(notificationUsecase notifications.NotificationUsecase) *NotificationHandler {
+	return &NotificationHandler{
+		notificationUsecase: notificationUsecase,
+	}
+}
+
+func (nh *NotificationHandler) Routing(r *mux.Router, sm *middleware.SessionMiddleware) {
+	s := r.PathPrefix("/notifications").Subrouter()
+	s.Use(sm.CheckAuthorized)
+
+	s.HandleFunc("", middleware.SetSCRFToken(http.HandlerFunc(nh.NotificationsHandler))).Methods(http.MethodGet, http.MethodOptions)
+	s.HandleFunc("/stream", nh.StreamHandler).Methods(http.MethodGet, http.MethodOptions)
+	s.HandleFunc("/read", nh.MarkAllReadHandler).Methods(http.MethodPost, http.MethodOptions)
+	s.HandleFunc("/{id:[0-9]+}/read", nh.MarkReadHandler).Methods(http.MethodPost, http.MethodOptions)
+}
+
+// NotificationsHandler godoc
+// @Summary Notifications
+// @Description Notifications of current user from newest to oldest with unread counter
+// @Tags notifications
+// @Produce application/json
+// @Param page query string false "Page num"
+// @Param count query string false "Count notifications per page"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyNotifications}
+// @failure default {object} models.HttpError
+// @Router /notifications [get]
+func (nh *NotificationHandler) NotificationsHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	query := r.URL.Query()
+	page, err := models.NewPage(query.Get("page"), query.Get("count"))
+	if err != nil {
+		logger.Warnf("can not create page: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	list, unread, err := nh.notificationUsecase.GetNotifications(userId, page)
+	if err != nil {
+		logger.Warnf("can not get notifications: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyNotifications{Notifications: list, Unread: unread}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "notifications got successfully", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// MarkReadHandler godoc
+// @Summary Mark notification read
+// @Description Mark notification of current user as read
+// @Tags notifications
+// @Produce application/json
+// @Param id path integer true "Notification id"
+// @Success 200 {object} models.HttpBodyInterface
+// @failure default {object} models.HttpError
+// @Router /notifications/{id}/read [post]
+func (nh *NotificationHandler) MarkReadHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	notificationId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse notification id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	err = nh.notificationUsecase.MarkRead(userId, notificationId)
+	if err != nil {
+		logger.Warnf("can not mark notification %d read: %s", notificationId, err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(models.ToBytes(http.StatusOK, "notification marked read", nil))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// MarkAllReadHandler godoc
+// @Summary Mark all notifications read
+// @Description Mark all notifications of current user as read
+// @Tags notifications
+// @Produce application/json
+// @Success 200 {object} models.HttpBodyInterface
+// @failure default {object} models.HttpError
+// @Router /notifications/read [post]
+func (nh *NotificationHandler) MarkAllReadHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	err := nh.notificationUsecase.MarkAllRead(userId)
+	if err != nil {
+		logger.Warnf("can not mark notifications read: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(models.ToBytes(http.StatusOK, "all notifications marked read", nil))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// StreamHandler godoc
+// @Summary Notifications stream
+// @Description Server-Sent Events stream of new notifications of current user.
+// @Description Every notification comes as "notification" event with JSON in data, missed ones are available in GET /notifications
+// @Tags notifications
+// @Produce text/event-stream
+// @Success 200 {object} models.Notification
+// @failure default {object} models.HttpError
+// @Router /notifications/stream [get]
+func (nh *NotificationHandler) StreamHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		logger.Warnf("response writer does not support streaming")
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.InternalError)
+		_, err := w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	stream, unsubscribe := nh.notificationUsecase.Subscribe(userId)
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// nginx иначе копит ответ в буфере и события не доходят до клиента
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ping := time.NewTicker(models.NotificationStreamPing)
+	defer ping.Stop()
+
+	for {
+		var err error
+		select {
+		case <-r.Context().Done():
+			return
+
+		case notification, ok := <-stream:
+			if !ok {
+				return
+			}
+			var data []byte
+			data, err = easyjson.Marshal(notification)
+			if err != nil {
+				logger.Warnf("cannot marshal notification %d: %s", notification.Id, err.Error())
+				continue
+			}
+			_, err = fmt.Fprintf(w, "id: %d\nevent: notification\ndata: %s\n\n", notification.Id, data)
+
+		case <-ping.C:
+			_, err = fmt.Fprint(w, ": ping\n\n")
+		}
+
+		if err != nil {
+			logger.Debugf("notification stream of user %d closed: %s", userId, err.Error())
+			return
+		}
+		flusher.Flush()
+	}
+}
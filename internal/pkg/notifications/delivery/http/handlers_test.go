@@ -0,0 +1,158 @@
+package delivery
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"yula/internal/models"
+	"yula/internal/pkg/middleware"
+	"yula/internal/pkg/notifications/mocks"
+
+	myerr "yula/internal/error"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newNotificationRouter(nh *NotificationHandler, userId int64) *mux.Router {
+	router := mux.NewRouter()
+	router.HandleFunc("/notifications", nh.NotificationsHandler).Methods(http.MethodGet, http.MethodOptions)
+	router.HandleFunc("/notifications/stream", nh.StreamHandler).Methods(http.MethodGet, http.MethodOptions)
+	router.HandleFunc("/notifications/read", nh.MarkAllReadHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.HandleFunc("/notifications/{id:[0-9]+}/read", nh.MarkReadHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+	router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), middleware.ContextUserId, userId)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	})
+	return router
+}
+
+func TestNotificationsHandlerOk(t *testing.T) {
+	nu := mocks.NotificationUsecase{}
+	nh := NewNotificationHandler(&nu)
+	srv := httptest.NewServer(newNotificationRouter(nh, 1))
+	defer srv.Close()
+
+	list := []*models.Notification{{Id: 2, Kind: models.NotificationOrder, Text: "Order #5 is now shipped", OrderId: 5}}
+	nu.On("GetNotifications", int64(1), &models.Page{PageNum: 1, Count: 10}).Return(list, int64(1), nil)
+
+	res, err := http.Get(fmt.Sprintf("%s/notifications?page=2&count=10", srv.URL))
+	assert.NoError(t, err)
+
+	var answer struct {
+		Code int                          `json:"code"`
+		Body models.HttpBodyNotifications `json:"body"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, answer.Code)
+	assert.Equal(t, int64(1), answer.Body.Unread)
+	assert.Equal(t, 1, len(answer.Body.Notifications))
+	assert.Equal(t, int64(5), answer.Body.Notifications[0].OrderId)
+}
+
+func TestNotificationsHandlerBadPage(t *testing.T) {
+	nu := mocks.NotificationUsecase{}
+	nh := NewNotificationHandler(&nu)
+	srv := httptest.NewServer(newNotificationRouter(nh, 1))
+	defer srv.Close()
+
+	res, err := http.Get(fmt.Sprintf("%s/notifications?page=abc", srv.URL))
+	assert.NoError(t, err)
+
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, answer.Code)
+	nu.AssertNotCalled(t, "GetNotifications", mock.Anything, mock.Anything)
+}
+
+func TestMarkReadHandler(t *testing.T) {
+	nu := mocks.NotificationUsecase{}
+	nh := NewNotificationHandler(&nu)
+	srv := httptest.NewServer(newNotificationRouter(nh, 1))
+	defer srv.Close()
+
+	nu.On("MarkRead", int64(1), int64(3)).Return(nil)
+	nu.On("MarkRead", int64(1), int64(4)).Return(myerr.NotExist)
+
+	res, err := http.Post(fmt.Sprintf("%s/notifications/3/read", srv.URL), "application/json", nil)
+	assert.NoError(t, err)
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, answer.Code)
+
+	res, err = http.Post(fmt.Sprintf("%s/notifications/4/read", srv.URL), "application/json", nil)
+	assert.NoError(t, err)
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+	metaCode, _ := myerr.ToMetaStatus(myerr.NotExist)
+	assert.Equal(t, metaCode, answer.Code)
+}
+
+func TestMarkAllReadHandler(t *testing.T) {
+	nu := mocks.NotificationUsecase{}
+	nh := NewNotificationHandler(&nu)
+	srv := httptest.NewServer(newNotificationRouter(nh, 1))
+	defer srv.Close()
+
+	nu.On("MarkAllRead", int64(1)).Return(nil)
+
+	res, err := http.Post(fmt.Sprintf("%s/notifications/read", srv.URL), "application/json", nil)
+	assert.NoError(t, err)
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, answer.Code)
+	nu.AssertExpectations(t)
+}
+
+func TestStreamHandler(t *testing.T) {
+	nu := mocks.NotificationUsecase{}
+	nh := NewNotificationHandler(&nu)
+	srv := httptest.NewServer(newNotificationRouter(nh, 1))
+	defer srv.Close()
+
+	stream := make(chan *models.Notification, 1)
+	unsubscribed := make(chan struct{})
+	nu.On("Subscribe", int64(1)).Return((<-chan *models.Notification)(stream), func() { close(unsubscribed) })
+
+	res, err := http.Get(fmt.Sprintf("%s/notifications/stream", srv.URL))
+	assert.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+	stream <- &models.Notification{Id: 7, UserId: 1, Kind: models.NotificationChatMessage, Text: "New message: привет", SenderId: 2}
+
+	reader := bufio.NewReader(res.Body)
+	lines := make([]string, 0, 3)
+	for len(lines) < 3 {
+		line, err := reader.ReadString('\n')
+		assert.NoError(t, err)
+		lines = append(lines, strings.TrimSpace(line))
+	}
+	assert.Equal(t, "id: 7", lines[0])
+	assert.Equal(t, "event: notification", lines[1])
+
+	var notification models.Notification
+	err = json.Unmarshal([]byte(strings.TrimPrefix(lines[2], "data: ")), &notification)
+	assert.NoError(t, err)
+	assert.Equal(t, "New message: привет", notification.Text)
+	assert.Equal(t, int64(2), notification.SenderId)
+
+	// закрытый поток завершает ответ и снимает подписку
+	close(stream)
+	<-unsubscribed
+}
@@ -0,0 +1,114 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NotificationRepository is an autogenerated mock type for the NotificationRepository type
+type NotificationRepository struct {
+	mock.Mock
+}
+
+// CountUnread provides a mock function with given fields: userId
+func (_m *NotificationRepository) CountUnread(userId int64) (int64, error) {
+	ret := _m.Called(userId)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(int64) int64); ok {
+		r0 = rf(userId)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: notification
+func (_m *NotificationRepository) Insert(notification *models.Notification) error {
+	ret := _m.Called(notification)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Notification) error); ok {
+		r0 = rf(notification)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SelectByUser provides a mock function with given fields: userId, from, count
+func (_m *NotificationRepository) SelectByUser(userId int64, from int64, count int64) ([]*models.Notification, error) {
+	ret := _m.Called(userId, from, count)
+
+	var r0 []*models.Notification
+	if rf, ok := ret.Get(0).(func(int64, int64, int64) []*models.Notification); ok {
+		r0 = rf(userId, from, count)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Notification)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64, int64) error); ok {
+		r1 = rf(userId, from, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateAllRead provides a mock function with given fields: userId
+func (_m *NotificationRepository) UpdateAllRead(userId int64) error {
+	ret := _m.Called(userId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateRead provides a mock function with given fields: notificationId, userId
+func (_m *NotificationRepository) UpdateRead(notificationId int64, userId int64) error {
+	ret := _m.Called(notificationId, userId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(notificationId, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateUnreadChat provides a mock function with given fields: notification
+func (_m *NotificationRepository) UpdateUnreadChat(notification *models.Notification) error {
+	ret := _m.Called(notification)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Notification) error); ok {
+		r0 = rf(notification)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,111 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NotificationUsecase is an autogenerated mock type for the NotificationUsecase type
+type NotificationUsecase struct {
+	mock.Mock
+}
+
+// GetNotifications provides a mock function with given fields: userId, page
+func (_m *NotificationUsecase) GetNotifications(userId int64, page *models.Page) ([]*models.Notification, int64, error) {
+	ret := _m.Called(userId, page)
+
+	var r0 []*models.Notification
+	if rf, ok := ret.Get(0).(func(int64, *models.Page) []*models.Notification); ok {
+		r0 = rf(userId, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Notification)
+		}
+	}
+
+	var r1 int64
+	if rf, ok := ret.Get(1).(func(int64, *models.Page) int64); ok {
+		r1 = rf(userId, page)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(int64, *models.Page) error); ok {
+		r2 = rf(userId, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MarkAllRead provides a mock function with given fields: userId
+func (_m *NotificationUsecase) MarkAllRead(userId int64) error {
+	ret := _m.Called(userId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkRead provides a mock function with given fields: userId, notificationId
+func (_m *NotificationUsecase) MarkRead(userId int64, notificationId int64) error {
+	ret := _m.Called(userId, notificationId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(userId, notificationId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Notify provides a mock function with given fields: notification
+func (_m *NotificationUsecase) Notify(notification *models.Notification) error {
+	ret := _m.Called(notification)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Notification) error); ok {
+		r0 = rf(notification)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Subscribe provides a mock function with given fields: userId
+func (_m *NotificationUsecase) Subscribe(userId int64) (<-chan *models.Notification, func()) {
+	ret := _m.Called(userId)
+
+	var r0 <-chan *models.Notification
+	if rf, ok := ret.Get(0).(func(int64) <-chan *models.Notification); ok {
+		r0 = rf(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *models.Notification)
+		}
+	}
+
+	var r1 func()
+	if rf, ok := ret.Get(1).(func(int64) func()); ok {
+		r1 = rf(userId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
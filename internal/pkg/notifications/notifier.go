@@ -0,0 +1,10 @@
+package notifications
+
+import "yula/internal/models"
+
+//go:generate mockery -name=Notifier
+
+// Notifier - единая точка, через которую любой модуль отправляет пользователю уведомление
+type Notifier interface {
+	Notify(notification *models.Notification) error
+}
@@ -0,0 +1,14 @@
+package notifications
+
+import "yula/internal/models"
+
+//go:generate mockery -name=NotificationRepository
+
+type NotificationRepository interface {
+	Insert(notification *models.Notification) error
+	UpdateUnreadChat(notification *models.Notification) error
+	SelectByUser(userId int64, from, count int64) ([]*models.Notification, error)
+	CountUnread(userId int64) (int64, error)
+	UpdateRead(notificationId int64, userId int64) error
+	UpdateAllRead(userId int64) error
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/notifications"
+)
+
+type NotificationRepository struct {
+	DB *sql.DB
+}
+
+func NewNotificationRepository(DB *sql.DB) notifications.NotificationRepository {
+	return &NotificationRepository{
+		DB: DB,
+	}
+}
+
+func (nr *NotificationRepository) Insert(n *models.Notification) error {
+	queryStr := `INSERT INTO notifications (user_id, kind, text, advert_id, order_id, sender_id)
+				VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, created_at;`
+	query := nr.DB.QueryRowContext(context.Background(), queryStr,
+		n.UserId, n.Kind, n.Text, n.AdvertId, n.OrderId, n.SenderId)
+
+	err := query.Scan(&n.Id, &n.CreatedAt)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+	return nil
+}
+
+// UpdateUnreadChat переписывает непрочитанное уведомление о сообщениях того же собеседника по тому же объявлению,
+// EmptyQuery - такого уведомления нет
+func (nr *NotificationRepository) UpdateUnreadChat(n *models.Notification) error {
+	queryStr := `UPDATE notifications SET text = $4, created_at = CURRENT_TIMESTAMP
+				WHERE id = (
+					SELECT id FROM notifications
+					WHERE user_id = $1 AND kind = 'chat_message' AND sender_id = $2 AND advert_id = $3 AND NOT is_read
+					ORDER BY id DESC LIMIT 1
+				) RETURNING id, created_at;`
+	query := nr.DB.QueryRowContext(context.Background(), queryStr, n.UserId, n.SenderId, n.AdvertId, n.Text)
+
+	err := query.Scan(&n.Id, &n.CreatedAt)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return internalError.EmptyQuery
+		}
+		return internalError.GenInternalError(err)
+	}
+	return nil
+}
+
+// SelectByUser отдает уведомления пользователя от новых к старым, обновленное уведомление поднимается наверх
+func (nr *NotificationRepository) SelectByUser(userId int64, from, count int64) ([]*models.Notification, error) {
+	queryStr := `SELECT id, user_id, kind, text, advert_id, order_id, sender_id, is_read, created_at
+				FROM notifications WHERE user_id = $1
+				ORDER BY created_at DESC, id DESC LIMIT $2 OFFSET $3;`
+	rows, err := nr.DB.QueryContext(context.Background(), queryStr, userId, count, from*count)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+
+	defer rows.Close()
+	result := make([]*models.Notification, 0)
+	for rows.Next() {
+		var n models.Notification
+		err = rows.Scan(&n.Id, &n.UserId, &n.Kind, &n.Text, &n.AdvertId, &n.OrderId, &n.SenderId, &n.IsRead, &n.CreatedAt)
+		if err != nil {
+			return nil, internalError.GenInternalError(err)
+		}
+
+		result = append(result, &n)
+	}
+	return result, nil
+}
+
+func (nr *NotificationRepository) CountUnread(userId int64) (int64, error) {
+	query := nr.DB.QueryRowContext(context.Background(),
+		"SELECT count(*) FROM notifications WHERE user_id = $1 AND NOT is_read;", userId)
+
+	var unread int64
+	err := query.Scan(&unread)
+	if err != nil {
+		return 0, internalError.GenInternalError(err)
+	}
+	return unread, nil
+}
+
+func (nr *NotificationRepository) UpdateRead(notificationId int64, userId int64) error {
+	result, err := nr.DB.ExecContext(context.Background(),
+		"UPDATE notifications SET is_read = true WHERE id = $1 AND user_id = $2;", notificationId, userId)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+	if updated == 0 {
+		return internalError.EmptyQuery
+	}
+	return nil
+}
+
+func (nr *NotificationRepository) UpdateAllRead(userId int64) error {
+	_, err := nr.DB.ExecContext(context.Background(),
+		"UPDATE notifications SET is_read = true WHERE user_id = $1 AND NOT is_read;", userId)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+	return nil
+}
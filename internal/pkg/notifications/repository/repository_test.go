@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"testing"
+	"time"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	sqlmock "gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+var testColumns = []string{"id", "user_id", "kind", "text", "advert_id", "order_id", "sender_id", "is_read", "created_at"}
+
+func TestInsertOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+	n := &models.Notification{UserId: 1, Kind: models.NotificationOrder, Text: "Order #5 is now shipped", OrderId: 5, SenderId: 2}
+
+	mock.ExpectQuery("INSERT INTO notifications").
+		WithArgs(n.UserId, n.Kind, n.Text, n.AdvertId, n.OrderId, n.SenderId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(3, time.Now()))
+
+	err = repo.Insert(n)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), n.Id)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateUnreadChatOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+	n := &models.Notification{UserId: 1, Kind: models.NotificationChatMessage, Text: "New message: hi", AdvertId: 3, SenderId: 2}
+
+	mock.ExpectQuery("UPDATE notifications SET text").
+		WithArgs(n.UserId, n.SenderId, n.AdvertId, n.Text).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(5, time.Now()))
+
+	err = repo.UpdateUnreadChat(n)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), n.Id)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateUnreadChatNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+	n := &models.Notification{UserId: 1, Kind: models.NotificationChatMessage, Text: "New message: hi", AdvertId: 3, SenderId: 2}
+
+	mock.ExpectQuery("UPDATE notifications SET text").
+		WithArgs(n.UserId, n.SenderId, n.AdvertId, n.Text).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}))
+
+	err = repo.UpdateUnreadChat(n)
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectByUserOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+
+	rows := sqlmock.NewRows(testColumns).
+		AddRow(4, 1, models.NotificationFavorite, "Price dropped", 2, 0, 0, false, time.Now()).
+		AddRow(3, 1, models.NotificationOrder, "Order #5 is now shipped", 0, 5, 2, true, time.Now())
+	// вторая страница по 2 уведомления
+	mock.ExpectQuery("FROM notifications WHERE user_id = \\$1").WithArgs(1, 2, 2).WillReturnRows(rows)
+
+	list, err := repo.SelectByUser(1, 1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(list))
+	assert.Equal(t, int64(5), list[1].OrderId)
+	assert.True(t, list[1].IsRead)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestCountUnreadOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+
+	mock.ExpectQuery("SELECT count").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+
+	unread, err := repo.CountUnread(1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), unread)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateReadNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+
+	mock.ExpectExec("UPDATE notifications SET is_read = true WHERE id").WithArgs(3, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// чужое уведомление не отмечается
+	mock.ExpectExec("UPDATE notifications SET is_read = true WHERE id").WithArgs(3, 2).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.UpdateRead(3, 1)
+	assert.NoError(t, err)
+	err = repo.UpdateRead(3, 2)
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateAllReadOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+
+	mock.ExpectExec("UPDATE notifications SET is_read = true WHERE user_id").WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.UpdateAllRead(1)
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
@@ -0,0 +1,16 @@
+package notifications
+
+import "yula/internal/models"
+
+//go:generate mockery -name=NotificationUsecase
+
+type NotificationUsecase interface {
+	Notifier
+
+	GetNotifications(userId int64, page *models.Page) ([]*models.Notification, int64, error)
+	MarkRead(userId int64, notificationId int64) error
+	MarkAllRead(userId int64) error
+
+	// Subscribe открывает поток новых уведомлений пользователя, поток закрывается вызовом возвращенной функции
+	Subscribe(userId int64) (<-chan *models.Notification, func())
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"sync"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/logging"
+	"yula/internal/pkg/notifications"
+)
+
+var logger logging.Logger = logging.GetLogger()
+
+type NotificationUsecase struct {
+	notificationRepository notifications.NotificationRepository
+
+	// открытые потоки пользователей, у одного пользователя их может быть несколько (вкладки, устройства)
+	mu          sync.Mutex
+	subscribers map[int64]map[chan *models.Notification]struct{}
+}
+
+func NewNotificationUsecase(notificationRepository notifications.NotificationRepository) notifications.NotificationUsecase {
+	return &NotificationUsecase{
+		notificationRepository: notificationRepository,
+		subscribers:            make(map[int64]map[chan *models.Notification]struct{}),
+	}
+}
+
+// Notify сохраняет уведомление и сразу отправляет его во все открытые потоки пользователя.
+// Сообщения чата копятся в одном непрочитанном уведомлении на собеседника и объявление,
+// в поток уходит оно же с тем же id и последним сообщением
+func (nu *NotificationUsecase) Notify(notification *models.Notification) error {
+	err := internalError.EmptyQuery
+	if notification.Kind == models.NotificationChatMessage {
+		err = nu.notificationRepository.UpdateUnreadChat(notification)
+	}
+	if err == internalError.EmptyQuery {
+		err = nu.notificationRepository.Insert(notification)
+	}
+	if err != nil {
+		return err
+	}
+
+	nu.publish(notification)
+	return nil
+}
+
+func (nu *NotificationUsecase) publish(notification *models.Notification) {
+	nu.mu.Lock()
+	defer nu.mu.Unlock()
+
+	for stream := range nu.subscribers[notification.UserId] {
+		// медленный клиент не держит отправителя, пропущенное он увидит в списке уведомлений
+		select {
+		case stream <- notification:
+		default:
+			logger.Warnf("notification stream of user %d is full, notification %d skipped",
+				notification.UserId, notification.Id)
+		}
+	}
+}
+
+func (nu *NotificationUsecase) Subscribe(userId int64) (<-chan *models.Notification, func()) {
+	stream := make(chan *models.Notification, models.NotificationStreamBuffer)
+
+	nu.mu.Lock()
+	if nu.subscribers[userId] == nil {
+		nu.subscribers[userId] = make(map[chan *models.Notification]struct{})
+	}
+	nu.subscribers[userId][stream] = struct{}{}
+	nu.mu.Unlock()
+
+	var once sync.Once
+	return stream, func() {
+		once.Do(func() {
+			nu.mu.Lock()
+			defer nu.mu.Unlock()
+
+			delete(nu.subscribers[userId], stream)
+			if len(nu.subscribers[userId]) == 0 {
+				delete(nu.subscribers, userId)
+			}
+			close(stream)
+		})
+	}
+}
+
+func (nu *NotificationUsecase) GetNotifications(userId int64, page *models.Page) ([]*models.Notification, int64, error) {
+	list, err := nu.notificationRepository.SelectByUser(userId, page.PageNum, page.Count)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	unread, err := nu.notificationRepository.CountUnread(userId)
+	if err != nil {
+		return nil, 0, err
+	}
+	return list, unread, nil
+}
+
+func (nu *NotificationUsecase) MarkRead(userId int64, notificationId int64) error {
+	err := nu.notificationRepository.UpdateRead(notificationId, userId)
+	if err == internalError.EmptyQuery {
+		return internalError.NotExist
+	}
+	return err
+}
+
+func (nu *NotificationUsecase) MarkAllRead(userId int64) error {
+	return nu.notificationRepository.UpdateAllRead(userId)
+}
@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"testing"
+	"yula/internal/models"
+	"yula/internal/pkg/notifications/mocks"
+
+	myerr "yula/internal/error"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNotifyPublishesToSubscribers(t *testing.T) {
+	nr := mocks.NotificationRepository{}
+	nu := NewNotificationUsecase(&nr)
+
+	nr.On("Insert", mock.AnythingOfType("*models.Notification")).Return(nil)
+
+	first, closeFirst := nu.Subscribe(1)
+	second, closeSecond := nu.Subscribe(1)
+	other, closeOther := nu.Subscribe(2)
+	defer closeFirst()
+	defer closeSecond()
+	defer closeOther()
+
+	n := &models.Notification{UserId: 1, Kind: models.NotificationOrder, Text: "Order #5 is now shipped"}
+	err := nu.Notify(n)
+	assert.NoError(t, err)
+
+	assert.Equal(t, n, <-first)
+	assert.Equal(t, n, <-second)
+	assert.Equal(t, 0, len(other))
+}
+
+func TestNotifyInsertFail(t *testing.T) {
+	nr := mocks.NotificationRepository{}
+	nu := NewNotificationUsecase(&nr)
+
+	nr.On("Insert", mock.AnythingOfType("*models.Notification")).Return(myerr.InternalError)
+
+	stream, unsubscribe := nu.Subscribe(1)
+	defer unsubscribe()
+
+	err := nu.Notify(&models.Notification{UserId: 1})
+	assert.Equal(t, myerr.InternalError, err)
+	assert.Equal(t, 0, len(stream))
+}
+
+func TestNotifyMergesUnreadChatMessages(t *testing.T) {
+	nr := mocks.NotificationRepository{}
+	nu := NewNotificationUsecase(&nr)
+
+	nr.On("UpdateUnreadChat", mock.AnythingOfType("*models.Notification")).Run(func(args mock.Arguments) {
+		args.Get(0).(*models.Notification).Id = 7
+	}).Return(nil)
+
+	stream, unsubscribe := nu.Subscribe(1)
+	defer unsubscribe()
+
+	n := &models.Notification{UserId: 1, Kind: models.NotificationChatMessage, Text: "New message: hi", AdvertId: 3, SenderId: 2}
+	err := nu.Notify(n)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), (<-stream).Id)
+	nr.AssertNotCalled(t, "Insert", mock.Anything)
+}
+
+func TestNotifyFirstChatMessageInserted(t *testing.T) {
+	nr := mocks.NotificationRepository{}
+	nu := NewNotificationUsecase(&nr)
+
+	nr.On("UpdateUnreadChat", mock.AnythingOfType("*models.Notification")).Return(myerr.EmptyQuery)
+	nr.On("Insert", mock.AnythingOfType("*models.Notification")).Return(nil)
+
+	err := nu.Notify(&models.Notification{UserId: 1, Kind: models.NotificationChatMessage, AdvertId: 3, SenderId: 2})
+	assert.NoError(t, err)
+	nr.AssertExpectations(t)
+}
+
+func TestNotifyFullStreamDoesNotBlock(t *testing.T) {
+	nr := mocks.NotificationRepository{}
+	nu := NewNotificationUsecase(&nr)
+
+	nr.On("Insert", mock.AnythingOfType("*models.Notification")).Return(nil)
+
+	stream, unsubscribe := nu.Subscribe(1)
+	defer unsubscribe()
+
+	for i := 0; i < models.NotificationStreamBuffer+5; i++ {
+		err := nu.Notify(&models.Notification{UserId: 1})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, models.NotificationStreamBuffer, len(stream))
+}
+
+func TestUnsubscribeClosesStream(t *testing.T) {
+	nr := mocks.NotificationRepository{}
+	nu := NewNotificationUsecase(&nr)
+
+	nr.On("Insert", mock.AnythingOfType("*models.Notification")).Return(nil)
+
+	stream, unsubscribe := nu.Subscribe(1)
+	unsubscribe()
+	// повторный вызов безопасен
+	unsubscribe()
+
+	_, ok := <-stream
+	assert.False(t, ok)
+
+	err := nu.Notify(&models.Notification{UserId: 1})
+	assert.NoError(t, err)
+}
+
+func TestGetNotifications(t *testing.T) {
+	nr := mocks.NotificationRepository{}
+	nu := NewNotificationUsecase(&nr)
+
+	list := []*models.Notification{{Id: 2}, {Id: 1, IsRead: true}}
+	nr.On("SelectByUser", int64(1), int64(0), int64(20)).Return(list, nil)
+	nr.On("CountUnread", int64(1)).Return(int64(1), nil)
+
+	result, unread, err := nu.GetNotifications(1, &models.Page{PageNum: 0, Count: 20})
+	assert.NoError(t, err)
+	assert.Equal(t, list, result)
+	assert.Equal(t, int64(1), unread)
+}
+
+func TestMarkRead(t *testing.T) {
+	nr := mocks.NotificationRepository{}
+	nu := NewNotificationUsecase(&nr)
+
+	nr.On("UpdateRead", int64(3), int64(1)).Return(nil)
+	nr.On("UpdateRead", int64(4), int64(1)).Return(myerr.EmptyQuery)
+	nr.On("UpdateAllRead", int64(1)).Return(nil)
+
+	err := nu.MarkRead(1, 3)
+	assert.NoError(t, err)
+
+	err = nu.MarkRead(1, 4)
+	assert.Equal(t, myerr.NotExist, err)
+
+	err = nu.MarkAllRead(1)
+	assert.NoError(t, err)
+}
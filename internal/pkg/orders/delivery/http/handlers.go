@@ -0,0 +1,315 @@
+package delivery
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/logging"
+	"yula/internal/pkg/middleware"
+	"yula/internal/pkg/orders"
+	"yula/internal/pkg/user"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	logger logging.Logger = logging.GetLogger()
+)
+
+type OrderHandler struct {
+	orderUsecase orders.OrderUsecase
+	userUsecase  user.UserUsecase
+}
+
+func NewOrderHandler(orderUsecase orders.OrderUsecase, userUsecase user.UserUsecase) *OrderHandler {
+	return &OrderHandler{
+		orderUsecase: orderUsecase,
+		userUsecase:  userUsecase,
+	}
+}
+
+func (oh *OrderHandler) Routing(r *mux.Router, sm *middleware.SessionMiddleware) {
+	s := r.PathPrefix("/orders").Subrouter()
+	s.Use(sm.CheckAuthorized)
+
+	s.HandleFunc("", middleware.SetSCRFToken(http.HandlerFunc(oh.PurchasesHandler))).Methods(http.MethodGet, http.MethodOptions)
+	s.HandleFunc("/sales", middleware.SetSCRFToken(http.HandlerFunc(oh.SalesHandler))).Methods(http.MethodGet, http.MethodOptions)
+	s.HandleFunc("/{id:[0-9]+}", middleware.SetSCRFToken(http.HandlerFunc(oh.GetOrderHandler))).Methods(http.MethodGet, http.MethodOptions)
+	s.HandleFunc("/{id:[0-9]+}/status", oh.ChangeStatusHandler).Methods(http.MethodPost, http.MethodOptions)
+	s.HandleFunc("/{id:[0-9]+}/events", middleware.SetSCRFToken(http.HandlerFunc(oh.EventsHandler))).Methods(http.MethodGet, http.MethodOptions)
+}
+
+// PurchasesHandler godoc
+// @Summary Buyer's orders
+// @Description Buyer's orders
+// @Tags orders
+// @Accept application/json
+// @Produce application/json
+// @Param page query string false "Page num"
+// @Param count query string false "Count orders per page"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyOrders}
+// @failure default {object} models.HttpError
+// @Router /orders [get]
+func (oh *OrderHandler) PurchasesHandler(w http.ResponseWriter, r *http.Request) {
+	oh.ordersListHandler(w, r, oh.orderUsecase.GetPurchases)
+}
+
+// SalesHandler godoc
+// @Summary Salesman's orders
+// @Description Salesman's orders
+// @Tags orders
+// @Accept application/json
+// @Produce application/json
+// @Param page query string false "Page num"
+// @Param count query string false "Count orders per page"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyOrders}
+// @failure default {object} models.HttpError
+// @Router /orders/sales [get]
+func (oh *OrderHandler) SalesHandler(w http.ResponseWriter, r *http.Request) {
+	oh.ordersListHandler(w, r, oh.orderUsecase.GetSales)
+}
+
+func (oh *OrderHandler) ordersListHandler(w http.ResponseWriter, r *http.Request,
+	getOrders func(userId int64, page *models.Page) ([]*models.Order, error)) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	query := r.URL.Query()
+	page, err := models.NewPage(query.Get("page"), query.Get("count"))
+	if err != nil {
+		logger.Warnf("can not create page: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	userOrders, err := getOrders(userId, page)
+	if err != nil {
+		logger.Warnf("can not get orders: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyOrders{Orders: userOrders}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "orders got successfully", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// GetOrderHandler godoc
+// @Summary Get order
+// @Description Get order with salesman profile
+// @Tags orders
+// @Accept application/json
+// @Produce application/json
+// @Param id path integer true "Order id"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyOrder}
+// @failure default {object} models.HttpError
+// @Router /orders/{id} [get]
+func (oh *OrderHandler) GetOrderHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	orderId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse id order: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	order, err := oh.orderUsecase.GetOrder(orderId, userId)
+	if err != nil {
+		logger.Warnf("error with getting order: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	salesman, err := oh.userUsecase.GetById(order.SalesmanId)
+	if err != nil {
+		logger.Warnf("error with getting salesman: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyOrder{Salesman: *salesman, Order: *order}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "order got successfully", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// ChangeStatusHandler godoc
+// @Summary Change order status
+// @Description Salesman confirms, ships or cancels the order, buyer marks it delivered or cancels it
+// @Tags orders
+// @Accept application/json
+// @Produce application/json
+// @Param id path integer true "Order id"
+// @Param status body models.OrderStatusChange true "New status"
+// @Success 200 {object} models.HttpBodyInterface{body=models.Order}
+// @failure default {object} models.HttpError
+// @Router /orders/{id}/status [post]
+func (oh *OrderHandler) ChangeStatusHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	orderId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse id order: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	var statusChange models.OrderStatusChange
+	defer r.Body.Close()
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		logger.Warnf("cannot convert body to bytes: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	err = easyjson.Unmarshal(buf, &statusChange)
+	if err != nil {
+		logger.Warnf("cannot unmarshal: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	_, err = govalidator.ValidateStruct(statusChange)
+	if err != nil {
+		logger.Warnf("invalid data: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	order, err := oh.orderUsecase.ChangeStatus(orderId, userId, statusChange.Status)
+	if err != nil {
+		logger.Warnf("can not change order status: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(models.ToBytes(http.StatusOK, "order status changed", order))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// EventsHandler godoc
+// @Summary Order history
+// @Description Status changes and dispute actions of the order, oldest first
+// @Tags orders
+// @Accept application/json
+// @Produce application/json
+// @Param id path integer true "Order id"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyOrderEvents}
+// @failure default {object} models.HttpError
+// @Router /orders/{id}/events [get]
+func (oh *OrderHandler) EventsHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	orderId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse id order: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	events, err := oh.orderUsecase.GetEvents(orderId, userId)
+	if err != nil {
+		logger.Warnf("error with getting order events: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyOrderEvents{Events: events}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "order events got successfully", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
@@ -0,0 +1,337 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"yula/internal/models"
+	"yula/internal/pkg/middleware"
+
+	orderMock "yula/internal/pkg/orders/mocks"
+
+	userMock "yula/internal/pkg/user/mocks"
+
+	myerr "yula/internal/error"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func withUser(userId int64) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), middleware.ContextUserId, userId)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func newTestRouter(oh *OrderHandler, userId int64) *mux.Router {
+	router := mux.NewRouter().PathPrefix("/orders").Subrouter()
+	router.HandleFunc("", oh.PurchasesHandler).Methods(http.MethodGet, http.MethodOptions)
+	router.HandleFunc("/sales", oh.SalesHandler).Methods(http.MethodGet, http.MethodOptions)
+	router.HandleFunc("/{id:[0-9]+}", oh.GetOrderHandler).Methods(http.MethodGet, http.MethodOptions)
+	router.HandleFunc("/{id:[0-9]+}/status", oh.ChangeStatusHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.HandleFunc("/{id:[0-9]+}/events", oh.EventsHandler).Methods(http.MethodGet, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+	router.Use(withUser(userId))
+	return router
+}
+
+var testOrder = models.Order{
+	Id:         5,
+	BuyerId:    1,
+	SalesmanId: 2,
+	Status:     models.OrderStatusCreated,
+	Lines: []*models.OrderLine{
+		{
+			OrderId:  5,
+			AdvertId: 3,
+			Amount:   2,
+			Price:    100,
+		},
+	},
+}
+
+func TestPurchasesSuccess(t *testing.T) {
+	ou := orderMock.OrderUsecase{}
+	uu := userMock.UserUsecase{}
+	oh := NewOrderHandler(&ou, &uu)
+
+	srv := httptest.NewServer(newTestRouter(oh, 1))
+	defer srv.Close()
+
+	ou.On("GetPurchases", int64(1), &models.Page{PageNum: 0, Count: 50}).Return([]*models.Order{&testOrder}, nil)
+
+	res, err := http.Get(fmt.Sprintf("%s/orders", srv.URL))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "orders got successfully", Answer.Message)
+}
+
+func TestPurchasesFailPage(t *testing.T) {
+	ou := orderMock.OrderUsecase{}
+	uu := userMock.UserUsecase{}
+	oh := NewOrderHandler(&ou, &uu)
+
+	srv := httptest.NewServer(newTestRouter(oh, 1))
+	defer srv.Close()
+
+	res, err := http.Get(fmt.Sprintf("%s/orders?page=abc", srv.URL))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, Answer.Code)
+}
+
+func TestSalesFail(t *testing.T) {
+	ou := orderMock.OrderUsecase{}
+	uu := userMock.UserUsecase{}
+	oh := NewOrderHandler(&ou, &uu)
+
+	srv := httptest.NewServer(newTestRouter(oh, 2))
+	defer srv.Close()
+
+	ou.On("GetSales", int64(2), &models.Page{PageNum: 1, Count: 10}).Return(nil, myerr.DatabaseError)
+
+	res, err := http.Get(fmt.Sprintf("%s/orders/sales?page=2&count=10", srv.URL))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusInternalServerError, Answer.Code)
+	assert.Equal(t, "database error", Answer.Message)
+}
+
+func TestGetOrderSuccess(t *testing.T) {
+	ou := orderMock.OrderUsecase{}
+	uu := userMock.UserUsecase{}
+	oh := NewOrderHandler(&ou, &uu)
+
+	srv := httptest.NewServer(newTestRouter(oh, 1))
+	defer srv.Close()
+
+	profile := models.Profile{
+		Id:        2,
+		Email:     "aboba@baobab.com",
+		CreatedAt: time.Now(),
+	}
+
+	ou.On("GetOrder", int64(5), int64(1)).Return(&testOrder, nil)
+	uu.On("GetById", int64(2)).Return(&profile, nil)
+
+	res, err := http.Get(fmt.Sprintf("%s/orders/5", srv.URL))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "order got successfully", Answer.Message)
+}
+
+func TestGetOrderFailParseId(t *testing.T) {
+	ou := orderMock.OrderUsecase{}
+	uu := userMock.UserUsecase{}
+	oh := NewOrderHandler(&ou, &uu)
+
+	srv := httptest.NewServer(newTestRouter(oh, 1))
+	defer srv.Close()
+
+	res, err := http.Get(fmt.Sprintf("%s/orders/2418594151898483818491", srv.URL))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, Answer.Code)
+	assert.Equal(t, "bad request", Answer.Message)
+}
+
+func TestGetOrderFailConflict(t *testing.T) {
+	ou := orderMock.OrderUsecase{}
+	uu := userMock.UserUsecase{}
+	oh := NewOrderHandler(&ou, &uu)
+
+	srv := httptest.NewServer(newTestRouter(oh, 10))
+	defer srv.Close()
+
+	ou.On("GetOrder", int64(5), int64(10)).Return(nil, myerr.Conflict)
+
+	res, err := http.Get(fmt.Sprintf("%s/orders/5", srv.URL))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusConflict, Answer.Code)
+}
+
+func TestGetOrderFailGetSalesman(t *testing.T) {
+	ou := orderMock.OrderUsecase{}
+	uu := userMock.UserUsecase{}
+	oh := NewOrderHandler(&ou, &uu)
+
+	srv := httptest.NewServer(newTestRouter(oh, 1))
+	defer srv.Close()
+
+	ou.On("GetOrder", int64(5), int64(1)).Return(&testOrder, nil)
+	uu.On("GetById", int64(2)).Return(nil, myerr.EmptyQuery)
+
+	res, err := http.Get(fmt.Sprintf("%s/orders/5", srv.URL))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusNotFound, Answer.Code)
+}
+
+func TestChangeStatusSuccess(t *testing.T) {
+	ou := orderMock.OrderUsecase{}
+	uu := userMock.UserUsecase{}
+	oh := NewOrderHandler(&ou, &uu)
+
+	srv := httptest.NewServer(newTestRouter(oh, 2))
+	defer srv.Close()
+
+	confirmed := testOrder
+	confirmed.Status = models.OrderStatusConfirmed
+	ou.On("ChangeStatus", int64(5), int64(2), models.OrderStatusConfirmed).Return(&confirmed, nil)
+
+	body := bytes.NewReader([]byte(`{"status": "confirmed"}`))
+	res, err := http.Post(fmt.Sprintf("%s/orders/5/status", srv.URL), "application/json", body)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "order status changed", Answer.Message)
+}
+
+func TestChangeStatusFailUnmarshal(t *testing.T) {
+	ou := orderMock.OrderUsecase{}
+	uu := userMock.UserUsecase{}
+	oh := NewOrderHandler(&ou, &uu)
+
+	srv := httptest.NewServer(newTestRouter(oh, 2))
+	defer srv.Close()
+
+	body := bytes.NewReader([]byte(`{"status": 1`))
+	res, err := http.Post(fmt.Sprintf("%s/orders/5/status", srv.URL), "application/json", body)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusInternalServerError, Answer.Code)
+}
+
+func TestChangeStatusFailValidation(t *testing.T) {
+	ou := orderMock.OrderUsecase{}
+	uu := userMock.UserUsecase{}
+	oh := NewOrderHandler(&ou, &uu)
+
+	srv := httptest.NewServer(newTestRouter(oh, 2))
+	defer srv.Close()
+
+	body := bytes.NewReader([]byte(`{"status": "lost"}`))
+	res, err := http.Post(fmt.Sprintf("%s/orders/5/status", srv.URL), "application/json", body)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, Answer.Code)
+	assert.Equal(t, "invalid data", Answer.Message)
+}
+
+func TestChangeStatusFailTransition(t *testing.T) {
+	ou := orderMock.OrderUsecase{}
+	uu := userMock.UserUsecase{}
+	oh := NewOrderHandler(&ou, &uu)
+
+	srv := httptest.NewServer(newTestRouter(oh, 1))
+	defer srv.Close()
+
+	ou.On("ChangeStatus", int64(5), int64(1), models.OrderStatusShipped).Return(nil, myerr.InvalidStatusTransition)
+
+	body := bytes.NewReader([]byte(`{"status": "shipped"}`))
+	res, err := http.Post(fmt.Sprintf("%s/orders/5/status", srv.URL), "application/json", body)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusConflict, Answer.Code)
+	assert.Equal(t, "invalid order status transition", Answer.Message)
+}
+
+func TestEventsSuccess(t *testing.T) {
+	ou := orderMock.OrderUsecase{}
+	uu := userMock.UserUsecase{}
+	oh := NewOrderHandler(&ou, &uu)
+
+	srv := httptest.NewServer(newTestRouter(oh, 1))
+	defer srv.Close()
+
+	events := []*models.OrderEvent{
+		{Id: 1, OrderId: 5, ActorId: 2, Event: models.OrderEventStatus, Details: models.OrderStatusShipped},
+		{Id: 2, OrderId: 5, ActorId: 1, Event: models.OrderEventDisputeOpened, Details: models.DisputeReasonNotReceived},
+	}
+	ou.On("GetEvents", int64(5), int64(1)).Return(events, nil)
+
+	res, err := http.Get(fmt.Sprintf("%s/orders/5/events", srv.URL))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "order events got successfully", Answer.Message)
+}
+
+func TestEventsFailConflict(t *testing.T) {
+	ou := orderMock.OrderUsecase{}
+	uu := userMock.UserUsecase{}
+	oh := NewOrderHandler(&ou, &uu)
+
+	srv := httptest.NewServer(newTestRouter(oh, 10))
+	defer srv.Close()
+
+	ou.On("GetEvents", int64(5), int64(10)).Return(nil, myerr.Conflict)
+
+	res, err := http.Get(fmt.Sprintf("%s/orders/5/events", srv.URL))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusConflict, Answer.Code)
+}
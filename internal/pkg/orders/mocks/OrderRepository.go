@@ -0,0 +1,134 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OrderRepository is an autogenerated mock type for the OrderRepository type
+type OrderRepository struct {
+	mock.Mock
+}
+
+// InsertEvent provides a mock function with given fields: event
+func (_m *OrderRepository) InsertEvent(event *models.OrderEvent) error {
+	ret := _m.Called(event)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.OrderEvent) error); ok {
+		r0 = rf(event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SelectByBuyerId provides a mock function with given fields: buyerId, from, count
+func (_m *OrderRepository) SelectByBuyerId(buyerId int64, from int64, count int64) ([]*models.Order, error) {
+	ret := _m.Called(buyerId, from, count)
+
+	var r0 []*models.Order
+	if rf, ok := ret.Get(0).(func(int64, int64, int64) []*models.Order); ok {
+		r0 = rf(buyerId, from, count)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Order)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64, int64) error); ok {
+		r1 = rf(buyerId, from, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SelectById provides a mock function with given fields: orderId
+func (_m *OrderRepository) SelectById(orderId int64) (*models.Order, error) {
+	ret := _m.Called(orderId)
+
+	var r0 *models.Order
+	if rf, ok := ret.Get(0).(func(int64) *models.Order); ok {
+		r0 = rf(orderId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Order)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(orderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SelectBySalesmanId provides a mock function with given fields: salesmanId, from, count
+func (_m *OrderRepository) SelectBySalesmanId(salesmanId int64, from int64, count int64) ([]*models.Order, error) {
+	ret := _m.Called(salesmanId, from, count)
+
+	var r0 []*models.Order
+	if rf, ok := ret.Get(0).(func(int64, int64, int64) []*models.Order); ok {
+		r0 = rf(salesmanId, from, count)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Order)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64, int64) error); ok {
+		r1 = rf(salesmanId, from, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SelectEvents provides a mock function with given fields: orderId
+func (_m *OrderRepository) SelectEvents(orderId int64) ([]*models.OrderEvent, error) {
+	ret := _m.Called(orderId)
+
+	var r0 []*models.OrderEvent
+	if rf, ok := ret.Get(0).(func(int64) []*models.OrderEvent); ok {
+		r0 = rf(orderId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.OrderEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(orderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateStatus provides a mock function with given fields: order, from, actorId
+func (_m *OrderRepository) UpdateStatus(order *models.Order, from string, actorId int64) error {
+	ret := _m.Called(order, from, actorId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Order, string, int64) error); ok {
+		r0 = rf(order, from, actorId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,129 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OrderUsecase is an autogenerated mock type for the OrderUsecase type
+type OrderUsecase struct {
+	mock.Mock
+}
+
+// ChangeStatus provides a mock function with given fields: orderId, userId, status
+func (_m *OrderUsecase) ChangeStatus(orderId int64, userId int64, status string) (*models.Order, error) {
+	ret := _m.Called(orderId, userId, status)
+
+	var r0 *models.Order
+	if rf, ok := ret.Get(0).(func(int64, int64, string) *models.Order); ok {
+		r0 = rf(orderId, userId, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Order)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64, string) error); ok {
+		r1 = rf(orderId, userId, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEvents provides a mock function with given fields: orderId, userId
+func (_m *OrderUsecase) GetEvents(orderId int64, userId int64) ([]*models.OrderEvent, error) {
+	ret := _m.Called(orderId, userId)
+
+	var r0 []*models.OrderEvent
+	if rf, ok := ret.Get(0).(func(int64, int64) []*models.OrderEvent); ok {
+		r0 = rf(orderId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.OrderEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(orderId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrder provides a mock function with given fields: orderId, userId
+func (_m *OrderUsecase) GetOrder(orderId int64, userId int64) (*models.Order, error) {
+	ret := _m.Called(orderId, userId)
+
+	var r0 *models.Order
+	if rf, ok := ret.Get(0).(func(int64, int64) *models.Order); ok {
+		r0 = rf(orderId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Order)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(orderId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPurchases provides a mock function with given fields: buyerId, page
+func (_m *OrderUsecase) GetPurchases(buyerId int64, page *models.Page) ([]*models.Order, error) {
+	ret := _m.Called(buyerId, page)
+
+	var r0 []*models.Order
+	if rf, ok := ret.Get(0).(func(int64, *models.Page) []*models.Order); ok {
+		r0 = rf(buyerId, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Order)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, *models.Page) error); ok {
+		r1 = rf(buyerId, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSales provides a mock function with given fields: salesmanId, page
+func (_m *OrderUsecase) GetSales(salesmanId int64, page *models.Page) ([]*models.Order, error) {
+	ret := _m.Called(salesmanId, page)
+
+	var r0 []*models.Order
+	if rf, ok := ret.Get(0).(func(int64, *models.Page) []*models.Order); ok {
+		r0 = rf(salesmanId, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Order)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, *models.Page) error); ok {
+		r1 = rf(salesmanId, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,14 @@
+package orders
+
+import "yula/internal/models"
+
+//go:generate mockery -name=OrderRepository
+
+type OrderRepository interface {
+	SelectById(orderId int64) (*models.Order, error)
+	SelectByBuyerId(buyerId int64, from, count int64) ([]*models.Order, error)
+	SelectBySalesmanId(salesmanId int64, from, count int64) ([]*models.Order, error)
+	UpdateStatus(order *models.Order, from string, actorId int64) error
+	InsertEvent(event *models.OrderEvent) error
+	SelectEvents(orderId int64) ([]*models.OrderEvent, error)
+}
@@ -0,0 +1,221 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/orders"
+)
+
+type OrderRepository struct {
+	DB *sql.DB
+}
+
+func NewOrderRepository(DB *sql.DB) orders.OrderRepository {
+	return &OrderRepository{
+		DB: DB,
+	}
+}
+
+func (or *OrderRepository) selectLines(orderId int64) ([]*models.OrderLine, error) {
+	queryStr := `SELECT order_id, advert_id, amount, price, list_price, COALESCE(coupon_id, 0)
+				FROM order_line WHERE order_id = $1 ORDER BY advert_id;`
+	rows, err := or.DB.QueryContext(context.Background(), queryStr, orderId)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+
+	defer rows.Close()
+	lines := make([]*models.OrderLine, 0)
+	for rows.Next() {
+		var line models.OrderLine
+
+		err = rows.Scan(&line.OrderId, &line.AdvertId, &line.Amount, &line.Price, &line.ListPrice, &line.CouponId)
+		if err != nil {
+			return nil, internalError.GenInternalError(err)
+		}
+
+		lines = append(lines, &line)
+	}
+
+	return lines, nil
+}
+
+func (or *OrderRepository) SelectById(orderId int64) (*models.Order, error) {
+	queryStr := `SELECT id, buyer_id, salesman_id, status, delivery_method, delivery_address, created_at, updated_at
+				FROM orders WHERE id = $1;`
+	query := or.DB.QueryRowContext(context.Background(), queryStr, orderId)
+
+	var order models.Order
+	err := query.Scan(&order.Id, &order.BuyerId, &order.SalesmanId, &order.Status,
+		&order.DeliveryMethod, &order.DeliveryAddress, &order.CreatedAt, &order.UpdatedAt)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return nil, internalError.EmptyQuery
+		}
+		return nil, internalError.GenInternalError(err)
+	}
+
+	order.Lines, err = or.selectLines(order.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+const (
+	defaultOrdersQuery string = `
+		SELECT id, buyer_id, salesman_id, status, delivery_method, delivery_address, created_at, updated_at
+		FROM orders
+		WHERE %s = $1
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3;
+	`
+)
+
+func (or *OrderRepository) selectOrders(queryStr string, userId int64, from, count int64) ([]*models.Order, error) {
+	rows, err := or.DB.QueryContext(context.Background(), queryStr, userId, count, from*count)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+
+	userOrders := make([]*models.Order, 0)
+	for rows.Next() {
+		var order models.Order
+
+		err = rows.Scan(&order.Id, &order.BuyerId, &order.SalesmanId, &order.Status,
+			&order.DeliveryMethod, &order.DeliveryAddress, &order.CreatedAt, &order.UpdatedAt)
+		if err != nil {
+			rows.Close()
+			return nil, internalError.GenInternalError(err)
+		}
+
+		userOrders = append(userOrders, &order)
+	}
+	rows.Close()
+
+	for _, order := range userOrders {
+		order.Lines, err = or.selectLines(order.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return userOrders, nil
+}
+
+func (or *OrderRepository) SelectByBuyerId(buyerId int64, from, count int64) ([]*models.Order, error) {
+	return or.selectOrders(fmt.Sprintf(defaultOrdersQuery, "buyer_id"), buyerId, from, count)
+}
+
+func (or *OrderRepository) SelectBySalesmanId(salesmanId int64, from, count int64) ([]*models.Order, error) {
+	return or.selectOrders(fmt.Sprintf(defaultOrdersQuery, "salesman_id"), salesmanId, from, count)
+}
+
+const (
+	insertEventQuery string = `INSERT INTO order_event (order_id, actor_id, event, details)
+		VALUES ($1, NULLIF($2, 0), $3, $4) RETURNING id, created_at;`
+)
+
+func (or *OrderRepository) UpdateStatus(order *models.Order, from string, actorId int64) error {
+	tx, err := or.DB.BeginTx(context.Background(), nil)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	// статус меняется, только если его не успели сменить параллельно:
+	// иначе две отмены подряд вернули бы экземпляры в продажу дважды
+	query := tx.QueryRowContext(context.Background(),
+		"UPDATE orders SET status = $2, updated_at = CURRENT_TIMESTAMP WHERE id = $1 AND status = $3 RETURNING updated_at;",
+		order.Id, order.Status, from)
+	if err := query.Scan(&order.UpdatedAt); err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return internalError.RollbackError
+		}
+
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return internalError.InvalidStatusTransition
+		}
+		return internalError.GenInternalError(err)
+	}
+
+	// смена статуса попадает в журнал заказа в той же транзакции
+	_, err = tx.ExecContext(context.Background(), insertEventQuery,
+		order.Id, actorId, models.OrderEventStatus, order.Status)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return internalError.RollbackError
+		}
+		return internalError.GenInternalError(err)
+	}
+
+	// отмененный заказ возвращает экземпляры в продажу
+	if order.Status == models.OrderStatusCancelled {
+		for _, line := range order.Lines {
+			_, err = tx.ExecContext(context.Background(),
+				`UPDATE advert SET is_active = is_active OR amount = 0,
+					status = CASE WHEN amount = 0 AND status = 'closed' THEN 'published' ELSE status END,
+					close_reason = CASE WHEN amount = 0 AND status = 'closed' THEN '' ELSE close_reason END,
+					amount = amount + $2 WHERE id = $1;`,
+				line.AdvertId, line.Amount)
+			if err != nil {
+				rollbackErr := tx.Rollback()
+				if rollbackErr != nil {
+					return internalError.RollbackError
+				}
+				return internalError.GenInternalError(err)
+			}
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return internalError.NotCommited
+	}
+
+	return nil
+}
+
+func (or *OrderRepository) InsertEvent(event *models.OrderEvent) error {
+	query := or.DB.QueryRowContext(context.Background(), insertEventQuery,
+		event.OrderId, event.ActorId, event.Event, event.Details)
+
+	err := query.Scan(&event.Id, &event.CreatedAt)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	return nil
+}
+
+func (or *OrderRepository) SelectEvents(orderId int64) ([]*models.OrderEvent, error) {
+	queryStr := `SELECT id, order_id, COALESCE(actor_id, 0), event, details, created_at
+				FROM order_event WHERE order_id = $1 ORDER BY created_at, id;`
+	rows, err := or.DB.QueryContext(context.Background(), queryStr, orderId)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+
+	defer rows.Close()
+	events := make([]*models.OrderEvent, 0)
+	for rows.Next() {
+		var event models.OrderEvent
+
+		err = rows.Scan(&event.Id, &event.OrderId, &event.ActorId, &event.Event, &event.Details, &event.CreatedAt)
+		if err != nil {
+			return nil, internalError.GenInternalError(err)
+		}
+
+		events = append(events, &event)
+	}
+
+	return events, nil
+}
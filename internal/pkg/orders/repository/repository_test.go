@@ -0,0 +1,330 @@
+package repository
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	sqlmock "gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func newTestOrder() *models.Order {
+	return &models.Order{
+		BuyerId:    1,
+		SalesmanId: 2,
+		Status:     models.OrderStatusCreated,
+		Lines: []*models.OrderLine{
+			{
+				AdvertId: 3,
+				Amount:   2,
+				Price:    100,
+			},
+		},
+	}
+}
+
+var orderColumns = []string{"id", "buyer_id", "salesman_id", "status", "delivery_method", "delivery_address",
+	"created_at", "updated_at"}
+var lineColumns = []string{"order_id", "advert_id", "amount", "price", "list_price", "coupon_id"}
+
+func TestSelectByIdOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewOrderRepository(db)
+
+	mock.ExpectQuery("SELECT id, buyer_id").WithArgs(int64(5)).
+		WillReturnRows(sqlmock.NewRows(orderColumns).AddRow(5, 1, 2, "created", "pickup", "", time.Now(), time.Now()))
+	mock.ExpectQuery("SELECT order_id").WithArgs(int64(5)).
+		WillReturnRows(sqlmock.NewRows(lineColumns).AddRow(5, 3, 2, 100, 100, 0))
+
+	order, err := repo.SelectById(5)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), order.BuyerId)
+	assert.Equal(t, 1, len(order.Lines))
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectByIdEmpty(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewOrderRepository(db)
+
+	mock.ExpectQuery("SELECT id, buyer_id").WithArgs(int64(5)).
+		WillReturnRows(sqlmock.NewRows(orderColumns))
+
+	_, err = repo.SelectById(5)
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectByIdLinesError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewOrderRepository(db)
+
+	mock.ExpectQuery("SELECT id, buyer_id").WithArgs(int64(5)).
+		WillReturnRows(sqlmock.NewRows(orderColumns).AddRow(5, 1, 2, "created", "pickup", "", time.Now(), time.Now()))
+	mock.ExpectQuery("SELECT order_id").WithArgs(int64(5)).WillReturnError(sql.ErrConnDone)
+
+	_, err = repo.SelectById(5)
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectByBuyerIdOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewOrderRepository(db)
+
+	mock.ExpectQuery("WHERE buyer_id").WithArgs(int64(1), int64(10), int64(10)).
+		WillReturnRows(sqlmock.NewRows(orderColumns).AddRow(5, 1, 2, "created", "pickup", "", time.Now(), time.Now()))
+	mock.ExpectQuery("SELECT order_id").WithArgs(int64(5)).
+		WillReturnRows(sqlmock.NewRows(lineColumns).AddRow(5, 3, 2, 100, 100, 0))
+
+	userOrders, err := repo.SelectByBuyerId(1, 1, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(userOrders))
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectBySalesmanIdOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewOrderRepository(db)
+
+	mock.ExpectQuery("WHERE salesman_id").WithArgs(int64(2), int64(50), int64(0)).
+		WillReturnRows(sqlmock.NewRows(orderColumns))
+
+	userOrders, err := repo.SelectBySalesmanId(2, 0, 50)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(userOrders))
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectBySalesmanIdError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewOrderRepository(db)
+
+	mock.ExpectQuery("WHERE salesman_id").WithArgs(int64(2), int64(50), int64(0)).
+		WillReturnError(sql.ErrConnDone)
+
+	_, err = repo.SelectBySalesmanId(2, 0, 50)
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateStatusOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewOrderRepository(db)
+	order := newTestOrder()
+	order.Id = 5
+	order.Status = models.OrderStatusConfirmed
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE orders").WithArgs(order.Id, order.Status, models.OrderStatusCreated).
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(time.Now()))
+	mock.ExpectExec("INSERT INTO order_event").WithArgs(order.Id, int64(2), models.OrderEventStatus, order.Status).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = repo.UpdateStatus(order, models.OrderStatusCreated, 2)
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateStatusCancelledOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewOrderRepository(db)
+	order := newTestOrder()
+	order.Id = 5
+	order.Status = models.OrderStatusCancelled
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE orders").WithArgs(order.Id, order.Status, models.OrderStatusCreated).
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(time.Now()))
+	mock.ExpectExec("INSERT INTO order_event").WithArgs(order.Id, int64(2), models.OrderEventStatus, order.Status).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE advert").WithArgs(int64(3), int64(2)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = repo.UpdateStatus(order, models.OrderStatusCreated, 2)
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateStatusError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewOrderRepository(db)
+	order := newTestOrder()
+	order.Id = 5
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE orders").WithArgs(order.Id, order.Status, models.OrderStatusCreated).WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	err = repo.UpdateStatus(order, models.OrderStatusCreated, 2)
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateStatusConcurrentChange(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewOrderRepository(db)
+	order := newTestOrder()
+	order.Id = 5
+	order.Status = models.OrderStatusCancelled
+
+	// заказ уже отменили параллельно: ни журнала, ни повторного возврата экземпляров
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE orders").WithArgs(order.Id, order.Status, models.OrderStatusCreated).
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}))
+	mock.ExpectRollback()
+
+	err = repo.UpdateStatus(order, models.OrderStatusCreated, 2)
+	assert.Equal(t, internalError.InvalidStatusTransition, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateStatusReturnError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewOrderRepository(db)
+	order := newTestOrder()
+	order.Id = 5
+	order.Status = models.OrderStatusCancelled
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE orders").WithArgs(order.Id, order.Status, models.OrderStatusCreated).
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(time.Now()))
+	mock.ExpectExec("INSERT INTO order_event").WithArgs(order.Id, int64(2), models.OrderEventStatus, order.Status).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE advert").WithArgs(int64(3), int64(2)).WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	err = repo.UpdateStatus(order, models.OrderStatusCreated, 2)
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestInsertEventOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewOrderRepository(db)
+
+	event := &models.OrderEvent{OrderId: 5, Event: models.OrderEventDisputeResolved, Details: "100"}
+	mock.ExpectQuery("INSERT INTO order_event").WithArgs(int64(5), int64(0), models.OrderEventDisputeResolved, "100").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(1, time.Now()))
+
+	err = repo.InsertEvent(event)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), event.Id)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectEventsOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewOrderRepository(db)
+
+	rows := sqlmock.NewRows([]string{"id", "order_id", "actor_id", "event", "details", "created_at"}).
+		AddRow(1, 5, 2, "status", "shipped", time.Now()).
+		AddRow(2, 5, 0, "status", "released", time.Now())
+	mock.ExpectQuery("SELECT id, order_id, COALESCE").WithArgs(int64(5)).WillReturnRows(rows)
+
+	events, err := repo.SelectEvents(5)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(events))
+	assert.Equal(t, int64(0), events[1].ActorId)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectEventsError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewOrderRepository(db)
+
+	mock.ExpectQuery("SELECT id, order_id, COALESCE").WithArgs(int64(5)).WillReturnError(sql.ErrConnDone)
+
+	_, err = repo.SelectEvents(5)
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
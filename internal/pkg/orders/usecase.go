@@ -0,0 +1,13 @@
+package orders
+
+import "yula/internal/models"
+
+//go:generate mockery -name=OrderUsecase
+
+type OrderUsecase interface {
+	GetOrder(orderId int64, userId int64) (*models.Order, error)
+	GetPurchases(buyerId int64, page *models.Page) ([]*models.Order, error)
+	GetSales(salesmanId int64, page *models.Page) ([]*models.Order, error)
+	ChangeStatus(orderId int64, userId int64, status string) (*models.Order, error)
+	GetEvents(orderId int64, userId int64) ([]*models.OrderEvent, error)
+}
@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"fmt"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/logging"
+	"yula/internal/pkg/notifications"
+	"yula/internal/pkg/orders"
+	"yula/internal/pkg/payment"
+)
+
+var logger logging.Logger = logging.GetLogger()
+
+type OrderUsecase struct {
+	orderRepository orders.OrderRepository
+	notifier        notifications.Notifier
+	paymentUsecase  payment.PaymentUsecase
+}
+
+func NewOrderUsecase(orderRepository orders.OrderRepository, notifier notifications.Notifier,
+	paymentUsecase payment.PaymentUsecase) orders.OrderUsecase {
+	return &OrderUsecase{
+		orderRepository: orderRepository,
+		notifier:        notifier,
+		paymentUsecase:  paymentUsecase,
+	}
+}
+
+// допустимые переходы статусов для продавца и покупателя,
+// подтвержденный до оплаты заказ переходит в оплаченный вместе с уведомлением провайдера
+var (
+	salesmanTransitions = map[string][]string{
+		models.OrderStatusCreated:   {models.OrderStatusConfirmed, models.OrderStatusCancelled},
+		models.OrderStatusConfirmed: {models.OrderStatusShipped, models.OrderStatusCancelled},
+		// оплаченный заказ отменяется только возвратом денег
+		models.OrderStatusPaid: {models.OrderStatusShipped},
+	}
+
+	buyerTransitions = map[string][]string{
+		models.OrderStatusCreated: {models.OrderStatusCancelled},
+		models.OrderStatusShipped: {models.OrderStatusDelivered},
+	}
+)
+
+func (ou *OrderUsecase) GetOrder(orderId, userId int64) (*models.Order, error) {
+	order, err := ou.orderRepository.SelectById(orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.BuyerId != userId && order.SalesmanId != userId {
+		return nil, internalError.Conflict
+	}
+
+	return order, nil
+}
+
+func (ou *OrderUsecase) GetPurchases(buyerId int64, page *models.Page) ([]*models.Order, error) {
+	return ou.orderRepository.SelectByBuyerId(buyerId, page.PageNum, page.Count)
+}
+
+func (ou *OrderUsecase) GetSales(salesmanId int64, page *models.Page) ([]*models.Order, error) {
+	return ou.orderRepository.SelectBySalesmanId(salesmanId, page.PageNum, page.Count)
+}
+
+func (ou *OrderUsecase) ChangeStatus(orderId, userId int64, status string) (*models.Order, error) {
+	order, err := ou.GetOrder(orderId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	transitions := buyerTransitions
+	if order.SalesmanId == userId {
+		transitions = salesmanTransitions
+	}
+
+	allowed := false
+	for _, next := range transitions[order.Status] {
+		if next == status {
+			allowed = true
+			break
+		}
+	}
+
+	if !allowed {
+		return nil, internalError.InvalidStatusTransition
+	}
+
+	from := order.Status
+	order.Status = status
+	err = ou.orderRepository.UpdateStatus(order, from, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == models.OrderStatusDelivered {
+		ou.releasePayment(order)
+	}
+
+	ou.notifyCounterpart(order, userId)
+	return order, nil
+}
+
+// releasePayment переводит продавцу деньги, удержанные по безопасной сделке, когда покупатель подтвердил получение.
+// Получение уже сохранено, поэтому ошибка только логируется: деньги уйдут продавцу по таймауту
+func (ou *OrderUsecase) releasePayment(order *models.Order) {
+	_, err := ou.paymentUsecase.Release(order.Id, order.BuyerId)
+	switch err {
+	case nil:
+		order.Status = models.OrderStatusReleased
+	// заказ без оплаты или оплата уже закрыта
+	case internalError.EmptyQuery, internalError.InvalidStatusTransition:
+	default:
+		logger.Warnf("can not release payment for order %d: %s", order.Id, err.Error())
+	}
+}
+
+// notifyCounterpart сообщает о смене статуса второй стороне заказа,
+// статус уже сохранен, поэтому ошибка доставки только логируется
+func (ou *OrderUsecase) notifyCounterpart(order *models.Order, userId int64) {
+	recipientId := order.SalesmanId
+	if order.SalesmanId == userId {
+		recipientId = order.BuyerId
+	}
+
+	err := ou.notifier.Notify(&models.Notification{
+		UserId:   recipientId,
+		Kind:     models.NotificationOrder,
+		Text:     fmt.Sprintf("Order #%d is now %s", order.Id, order.Status),
+		OrderId:  order.Id,
+		SenderId: userId,
+	})
+	if err != nil {
+		logger.Warnf("can not notify user %d about order %d: %s", recipientId, order.Id, err.Error())
+	}
+}
+
+func (ou *OrderUsecase) GetEvents(orderId, userId int64) ([]*models.OrderEvent, error) {
+	_, err := ou.GetOrder(orderId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return ou.orderRepository.SelectEvents(orderId)
+}
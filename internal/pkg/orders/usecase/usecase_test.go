@@ -0,0 +1,273 @@
+package usecase
+
+import (
+	"testing"
+	"yula/internal/models"
+	"yula/internal/pkg/orders/mocks"
+
+	myerr "yula/internal/error"
+
+	notifMock "yula/internal/pkg/notifications/mocks"
+	payMock "yula/internal/pkg/payment/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestOrder(status string) *models.Order {
+	return &models.Order{
+		Id:         5,
+		BuyerId:    1,
+		SalesmanId: 2,
+		Status:     status,
+		Lines: []*models.OrderLine{
+			{
+				OrderId:  5,
+				AdvertId: 3,
+				Amount:   2,
+				Price:    100,
+			},
+		},
+	}
+}
+
+func newNotifier() *notifMock.Notifier {
+	nt := &notifMock.Notifier{}
+	nt.On("Notify", mock.Anything).Return(nil)
+	return nt
+}
+
+func TestGetOrderSuccess(t *testing.T) {
+	or := mocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusCreated), nil)
+
+	ou := NewOrderUsecase(&or, newNotifier(), &payMock.PaymentUsecase{})
+	order, err := ou.GetOrder(5, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), order.Id)
+}
+
+func TestGetOrderStranger(t *testing.T) {
+	or := mocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusCreated), nil)
+
+	ou := NewOrderUsecase(&or, newNotifier(), &payMock.PaymentUsecase{})
+	order, err := ou.GetOrder(5, 10)
+	assert.Equal(t, myerr.Conflict, err)
+	assert.Nil(t, order)
+}
+
+func TestGetOrderFail(t *testing.T) {
+	or := mocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(nil, myerr.EmptyQuery)
+
+	ou := NewOrderUsecase(&or, newNotifier(), &payMock.PaymentUsecase{})
+	order, err := ou.GetOrder(5, 1)
+	assert.Equal(t, myerr.EmptyQuery, err)
+	assert.Nil(t, order)
+}
+
+func TestGetPurchases(t *testing.T) {
+	userOrders := []*models.Order{newTestOrder(models.OrderStatusCreated)}
+	or := mocks.OrderRepository{}
+	or.On("SelectByBuyerId", int64(1), int64(0), int64(50)).Return(userOrders, nil)
+
+	ou := NewOrderUsecase(&or, newNotifier(), &payMock.PaymentUsecase{})
+	res, err := ou.GetPurchases(1, &models.Page{PageNum: 0, Count: 50})
+	assert.Nil(t, err)
+	assert.Equal(t, userOrders, res)
+}
+
+func TestGetSales(t *testing.T) {
+	or := mocks.OrderRepository{}
+	or.On("SelectBySalesmanId", int64(2), int64(0), int64(50)).Return(nil, myerr.DatabaseError)
+
+	ou := NewOrderUsecase(&or, newNotifier(), &payMock.PaymentUsecase{})
+	res, err := ou.GetSales(2, &models.Page{PageNum: 0, Count: 50})
+	assert.Equal(t, myerr.DatabaseError, err)
+	assert.Nil(t, res)
+}
+
+func TestChangeStatusSalesmanConfirm(t *testing.T) {
+	or := mocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusCreated), nil)
+	or.On("UpdateStatus", mock.AnythingOfType("*models.Order"), mock.AnythingOfType("string"), mock.AnythingOfType("int64")).Return(nil)
+
+	ou := NewOrderUsecase(&or, newNotifier(), &payMock.PaymentUsecase{})
+	order, err := ou.ChangeStatus(5, 2, models.OrderStatusConfirmed)
+	assert.Nil(t, err)
+	assert.Equal(t, models.OrderStatusConfirmed, order.Status)
+}
+
+func TestChangeStatusNotifiesCounterpart(t *testing.T) {
+	or := mocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusShipped), nil)
+	or.On("UpdateStatus", mock.AnythingOfType("*models.Order"), mock.AnythingOfType("string"), mock.AnythingOfType("int64")).Return(nil)
+
+	// покупатель подтвердил получение, уведомление получает продавец
+	nt := &notifMock.Notifier{}
+	nt.On("Notify", mock.MatchedBy(func(n *models.Notification) bool {
+		return n.UserId == 2 && n.SenderId == 1 && n.OrderId == 5 && n.Kind == models.NotificationOrder
+	})).Return(myerr.InternalError)
+
+	pu := &payMock.PaymentUsecase{}
+	pu.On("Release", int64(5), int64(1)).Return(nil, myerr.EmptyQuery)
+
+	ou := NewOrderUsecase(&or, nt, pu)
+	order, err := ou.ChangeStatus(5, 1, models.OrderStatusDelivered)
+	assert.Nil(t, err)
+	assert.Equal(t, models.OrderStatusDelivered, order.Status)
+	nt.AssertExpectations(t)
+}
+
+func TestChangeStatusNotNotifiedOnFail(t *testing.T) {
+	or := mocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusCreated), nil)
+
+	nt := &notifMock.Notifier{}
+	ou := NewOrderUsecase(&or, nt, &payMock.PaymentUsecase{})
+	_, err := ou.ChangeStatus(5, 1, models.OrderStatusConfirmed)
+	assert.Equal(t, myerr.InvalidStatusTransition, err)
+	nt.AssertNotCalled(t, "Notify", mock.Anything)
+}
+
+func TestChangeStatusSalesmanShipsPaid(t *testing.T) {
+	or := mocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusPaid), nil)
+	or.On("UpdateStatus", mock.AnythingOfType("*models.Order"), mock.AnythingOfType("string"), mock.AnythingOfType("int64")).Return(nil)
+
+	ou := NewOrderUsecase(&or, newNotifier(), &payMock.PaymentUsecase{})
+	order, err := ou.ChangeStatus(5, 2, models.OrderStatusShipped)
+	assert.Nil(t, err)
+	assert.Equal(t, models.OrderStatusShipped, order.Status)
+}
+
+func TestChangeStatusSalesmanCannotCancelPaid(t *testing.T) {
+	or := mocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusPaid), nil)
+
+	ou := NewOrderUsecase(&or, newNotifier(), &payMock.PaymentUsecase{})
+	_, err := ou.ChangeStatus(5, 2, models.OrderStatusCancelled)
+	assert.Equal(t, myerr.InvalidStatusTransition, err)
+}
+
+func TestChangeStatusBuyerDelivered(t *testing.T) {
+	or := mocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusShipped), nil)
+	or.On("UpdateStatus", mock.AnythingOfType("*models.Order"), mock.AnythingOfType("string"), mock.AnythingOfType("int64")).Return(nil)
+
+	pu := &payMock.PaymentUsecase{}
+	pu.On("Release", int64(5), int64(1)).Return(nil, myerr.EmptyQuery)
+
+	ou := NewOrderUsecase(&or, newNotifier(), pu)
+	order, err := ou.ChangeStatus(5, 1, models.OrderStatusDelivered)
+	assert.Nil(t, err)
+	assert.Equal(t, models.OrderStatusDelivered, order.Status)
+}
+
+func TestChangeStatusBuyerDeliveredReleasesPayment(t *testing.T) {
+	or := mocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusShipped), nil)
+	or.On("UpdateStatus", mock.AnythingOfType("*models.Order"), models.OrderStatusShipped, int64(1)).Return(nil)
+
+	pu := &payMock.PaymentUsecase{}
+	pu.On("Release", int64(5), int64(1)).Return(&models.Payment{Id: 1, Status: models.PaymentStatusReleased}, nil)
+
+	ou := NewOrderUsecase(&or, newNotifier(), pu)
+	order, err := ou.ChangeStatus(5, 1, models.OrderStatusDelivered)
+	assert.Nil(t, err)
+	assert.Equal(t, models.OrderStatusReleased, order.Status)
+	pu.AssertExpectations(t)
+}
+
+func TestChangeStatusBuyerDeliveredReleaseFail(t *testing.T) {
+	or := mocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusShipped), nil)
+	or.On("UpdateStatus", mock.AnythingOfType("*models.Order"), models.OrderStatusShipped, int64(1)).Return(nil)
+
+	// списать деньги не вышло, получение все равно подтверждено
+	pu := &payMock.PaymentUsecase{}
+	pu.On("Release", int64(5), int64(1)).Return(nil, myerr.PaymentFailed)
+
+	ou := NewOrderUsecase(&or, newNotifier(), pu)
+	order, err := ou.ChangeStatus(5, 1, models.OrderStatusDelivered)
+	assert.Nil(t, err)
+	assert.Equal(t, models.OrderStatusDelivered, order.Status)
+}
+
+func TestChangeStatusBuyerCannotConfirm(t *testing.T) {
+	or := mocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusCreated), nil)
+
+	ou := NewOrderUsecase(&or, newNotifier(), &payMock.PaymentUsecase{})
+	order, err := ou.ChangeStatus(5, 1, models.OrderStatusConfirmed)
+	assert.Equal(t, myerr.InvalidStatusTransition, err)
+	assert.Nil(t, order)
+}
+
+func TestChangeStatusSalesmanCannotCancelShipped(t *testing.T) {
+	or := mocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusShipped), nil)
+
+	ou := NewOrderUsecase(&or, newNotifier(), &payMock.PaymentUsecase{})
+	order, err := ou.ChangeStatus(5, 2, models.OrderStatusCancelled)
+	assert.Equal(t, myerr.InvalidStatusTransition, err)
+	assert.Nil(t, order)
+}
+
+func TestChangeStatusStranger(t *testing.T) {
+	or := mocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusCreated), nil)
+
+	ou := NewOrderUsecase(&or, newNotifier(), &payMock.PaymentUsecase{})
+	order, err := ou.ChangeStatus(5, 10, models.OrderStatusCancelled)
+	assert.Equal(t, myerr.Conflict, err)
+	assert.Nil(t, order)
+}
+
+func TestChangeStatusUpdateFail(t *testing.T) {
+	or := mocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusCreated), nil)
+	or.On("UpdateStatus", mock.AnythingOfType("*models.Order"), mock.AnythingOfType("string"), mock.AnythingOfType("int64")).Return(myerr.DatabaseError)
+
+	ou := NewOrderUsecase(&or, newNotifier(), &payMock.PaymentUsecase{})
+	order, err := ou.ChangeStatus(5, 1, models.OrderStatusCancelled)
+	assert.Equal(t, myerr.DatabaseError, err)
+	assert.Nil(t, order)
+}
+
+func TestChangeStatusConcurrentCancel(t *testing.T) {
+	or := mocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusCreated), nil)
+	// продавец успел отменить заказ между чтением и обновлением
+	or.On("UpdateStatus", mock.AnythingOfType("*models.Order"), models.OrderStatusCreated, int64(1)).
+		Return(myerr.InvalidStatusTransition)
+
+	nt := &notifMock.Notifier{}
+	ou := NewOrderUsecase(&or, nt, &payMock.PaymentUsecase{})
+	order, err := ou.ChangeStatus(5, 1, models.OrderStatusCancelled)
+	assert.Equal(t, myerr.InvalidStatusTransition, err)
+	assert.Nil(t, order)
+	nt.AssertNotCalled(t, "Notify", mock.Anything)
+}
+
+func TestGetEventsSuccess(t *testing.T) {
+	or := mocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusShipped), nil)
+	or.On("SelectEvents", int64(5)).Return([]*models.OrderEvent{{Id: 1, OrderId: 5}}, nil)
+
+	ou := NewOrderUsecase(&or, newNotifier(), &payMock.PaymentUsecase{})
+	events, err := ou.GetEvents(5, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(events))
+}
+
+func TestGetEventsStranger(t *testing.T) {
+	or := mocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusShipped), nil)
+
+	ou := NewOrderUsecase(&or, newNotifier(), &payMock.PaymentUsecase{})
+	_, err := ou.GetEvents(5, 10)
+	assert.Equal(t, myerr.Conflict, err)
+	or.AssertNotCalled(t, "SelectEvents", mock.Anything)
+}
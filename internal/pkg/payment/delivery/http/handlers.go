@@ -0,0 +1,151 @@
+package delivery
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/logging"
+	"yula/internal/pkg/middleware"
+	"yula/internal/pkg/payment"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	logger logging.Logger = logging.GetLogger()
+)
+
+type PaymentHandler struct {
+	paymentUsecase payment.PaymentUsecase
+}
+
+func NewPaymentHandler(paymentUsecase payment.PaymentUsecase) *PaymentHandler {
+	return &PaymentHandler{
+		paymentUsecase: paymentUsecase,
+	}
+}
+
+func (ph *PaymentHandler) Routing(r *mux.Router, sm *middleware.SessionMiddleware) {
+	r.Handle("/orders/{id:[0-9]+}/pay", sm.CheckAuthorized(http.HandlerFunc(ph.PayHandler))).Methods(http.MethodPost, http.MethodOptions)
+	r.Handle("/orders/{id:[0-9]+}/release", sm.CheckAuthorized(http.HandlerFunc(ph.ReleaseHandler))).Methods(http.MethodPost, http.MethodOptions)
+
+	// уведомления приходят от платежного провайдера, а не от пользователя
+	r.HandleFunc("/payments/notification", ph.NotificationHandler).Methods(http.MethodPost, http.MethodOptions)
+}
+
+// PayHandler godoc
+// @Summary Pay order
+// @Description Start safe deal payment, money is held until buyer confirms receipt
+// @Tags payments
+// @Accept application/json
+// @Produce application/json
+// @Param id path integer true "Order id"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyPayment}
+// @failure default {object} models.HttpError
+// @Router /orders/{id}/pay [post]
+func (ph *PaymentHandler) PayHandler(w http.ResponseWriter, r *http.Request) {
+	ph.orderPaymentHandler(w, r, ph.paymentUsecase.Pay, "payment started")
+}
+
+// ReleaseHandler godoc
+// @Summary Confirm receipt
+// @Description Buyer confirms receipt, held money goes to salesman
+// @Tags payments
+// @Accept application/json
+// @Produce application/json
+// @Param id path integer true "Order id"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyPayment}
+// @failure default {object} models.HttpError
+// @Router /orders/{id}/release [post]
+func (ph *PaymentHandler) ReleaseHandler(w http.ResponseWriter, r *http.Request) {
+	ph.orderPaymentHandler(w, r, ph.paymentUsecase.Release, "payment released")
+}
+
+func (ph *PaymentHandler) orderPaymentHandler(w http.ResponseWriter, r *http.Request,
+	action func(orderId int64, buyerId int64) (*models.Payment, error), message string) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	orderId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse order id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	p, err := action(orderId, userId)
+	if err != nil {
+		logger.Warnf("payment action failed: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyPayment{Payment: *p}
+	_, err = w.Write(models.ToBytes(http.StatusOK, message, body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// NotificationHandler godoc
+// @Summary Payment notification
+// @Description Payment provider notifies about payment status
+// @Tags payments
+// @Accept application/json
+// @Produce application/json
+// @Param body body models.PaymentNotification true "Notification"
+// @Success 200 {object} models.HttpBodyInterface
+// @failure default {object} models.HttpError
+// @Router /payments/notification [post]
+func (ph *PaymentHandler) NotificationHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+
+	defer r.Body.Close()
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		logger.Warnf("cannot convert body to bytes: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	err = ph.paymentUsecase.HandleNotification(buf)
+	if err != nil {
+		logger.Warnf("cannot handle payment notification: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(models.ToBytes(http.StatusOK, "notification accepted", nil))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
@@ -0,0 +1,168 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"yula/internal/models"
+	"yula/internal/pkg/middleware"
+
+	paymentMock "yula/internal/pkg/payment/mocks"
+
+	myerr "yula/internal/error"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func withUser(userId int64) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), middleware.ContextUserId, userId)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func newTestRouter(ph *PaymentHandler, userId int64) *mux.Router {
+	router := mux.NewRouter()
+	router.HandleFunc("/orders/{id:[0-9]+}/pay", ph.PayHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.HandleFunc("/orders/{id:[0-9]+}/release", ph.ReleaseHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.HandleFunc("/payments/notification", ph.NotificationHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+	router.Use(withUser(userId))
+	return router
+}
+
+var testPayment = models.Payment{
+	Id:              1,
+	OrderId:         5,
+	ExternalId:      "fake-1",
+	Amount:          200,
+	Status:          models.PaymentStatusPending,
+	ConfirmationUrl: "fake://pay/fake-1",
+}
+
+func TestPaySuccess(t *testing.T) {
+	pu := paymentMock.PaymentUsecase{}
+	ph := NewPaymentHandler(&pu)
+
+	srv := httptest.NewServer(newTestRouter(ph, 1))
+	defer srv.Close()
+
+	pu.On("Pay", int64(5), int64(1)).Return(&testPayment, nil)
+
+	res, err := http.Post(fmt.Sprintf("%s/orders/5/pay", srv.URL), "application/json", nil)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "payment started", Answer.Message)
+}
+
+func TestPayFailParseId(t *testing.T) {
+	pu := paymentMock.PaymentUsecase{}
+	ph := NewPaymentHandler(&pu)
+
+	srv := httptest.NewServer(newTestRouter(ph, 1))
+	defer srv.Close()
+
+	res, err := http.Post(fmt.Sprintf("%s/orders/2418594151898483818491/pay", srv.URL), "application/json", nil)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, Answer.Code)
+}
+
+func TestPayFailNotAllowed(t *testing.T) {
+	pu := paymentMock.PaymentUsecase{}
+	ph := NewPaymentHandler(&pu)
+
+	srv := httptest.NewServer(newTestRouter(ph, 1))
+	defer srv.Close()
+
+	pu.On("Pay", int64(5), int64(1)).Return(nil, myerr.PaymentNotAllowed)
+
+	res, err := http.Post(fmt.Sprintf("%s/orders/5/pay", srv.URL), "application/json", nil)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusConflict, Answer.Code)
+	assert.Equal(t, "order can not be paid", Answer.Message)
+}
+
+func TestReleaseSuccess(t *testing.T) {
+	pu := paymentMock.PaymentUsecase{}
+	ph := NewPaymentHandler(&pu)
+
+	srv := httptest.NewServer(newTestRouter(ph, 1))
+	defer srv.Close()
+
+	released := testPayment
+	released.Status = models.PaymentStatusReleased
+	pu.On("Release", int64(5), int64(1)).Return(&released, nil)
+
+	res, err := http.Post(fmt.Sprintf("%s/orders/5/release", srv.URL), "application/json", nil)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "payment released", Answer.Message)
+}
+
+func TestNotificationSuccess(t *testing.T) {
+	pu := paymentMock.PaymentUsecase{}
+	ph := NewPaymentHandler(&pu)
+
+	srv := httptest.NewServer(newTestRouter(ph, 0))
+	defer srv.Close()
+
+	body := []byte(`{"payment_id": "fake-1", "event": "payment.succeeded"}`)
+	pu.On("HandleNotification", body).Return(nil)
+
+	res, err := http.Post(fmt.Sprintf("%s/payments/notification", srv.URL), "application/json", bytes.NewReader(body))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "notification accepted", Answer.Message)
+}
+
+func TestNotificationFail(t *testing.T) {
+	pu := paymentMock.PaymentUsecase{}
+	ph := NewPaymentHandler(&pu)
+
+	srv := httptest.NewServer(newTestRouter(ph, 0))
+	defer srv.Close()
+
+	body := []byte(`garbage`)
+	pu.On("HandleNotification", body).Return(myerr.BadRequest)
+
+	res, err := http.Post(fmt.Sprintf("%s/payments/notification", srv.URL), "application/json", bytes.NewReader(body))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, Answer.Code)
+}
@@ -0,0 +1,79 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PaymentProvider is an autogenerated mock type for the PaymentProvider type
+type PaymentProvider struct {
+	mock.Mock
+}
+
+// Capture provides a mock function with given fields: _a0
+func (_m *PaymentProvider) Capture(_a0 *models.Payment) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Payment) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreatePayment provides a mock function with given fields: _a0
+func (_m *PaymentProvider) CreatePayment(_a0 *models.Payment) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Payment) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ParseNotification provides a mock function with given fields: body
+func (_m *PaymentProvider) ParseNotification(body []byte) (*models.PaymentNotification, error) {
+	ret := _m.Called(body)
+
+	var r0 *models.PaymentNotification
+	if rf, ok := ret.Get(0).(func([]byte) *models.PaymentNotification); ok {
+		r0 = rf(body)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PaymentNotification)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Refund provides a mock function with given fields: _a0, amount
+func (_m *PaymentProvider) Refund(_a0 *models.Payment, amount int64) error {
+	ret := _m.Called(_a0, amount)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Payment, int64) error); ok {
+		r0 = rf(_a0, amount)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
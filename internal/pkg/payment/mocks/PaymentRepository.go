@@ -0,0 +1,113 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// PaymentRepository is an autogenerated mock type for the PaymentRepository type
+type PaymentRepository struct {
+	mock.Mock
+}
+
+// Insert provides a mock function with given fields: _a0
+func (_m *PaymentRepository) Insert(_a0 *models.Payment) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Payment) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SelectByExternalId provides a mock function with given fields: externalId
+func (_m *PaymentRepository) SelectByExternalId(externalId string) (*models.Payment, error) {
+	ret := _m.Called(externalId)
+
+	var r0 *models.Payment
+	if rf, ok := ret.Get(0).(func(string) *models.Payment); ok {
+		r0 = rf(externalId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Payment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(externalId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SelectByOrderId provides a mock function with given fields: orderId
+func (_m *PaymentRepository) SelectByOrderId(orderId int64) (*models.Payment, error) {
+	ret := _m.Called(orderId)
+
+	var r0 *models.Payment
+	if rf, ok := ret.Get(0).(func(int64) *models.Payment); ok {
+		r0 = rf(orderId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Payment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(orderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SelectExpired provides a mock function with given fields: status, before
+func (_m *PaymentRepository) SelectExpired(status string, before time.Time) ([]*models.Payment, error) {
+	ret := _m.Called(status, before)
+
+	var r0 []*models.Payment
+	if rf, ok := ret.Get(0).(func(string, time.Time) []*models.Payment); ok {
+		r0 = rf(status, before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Payment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(status, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateStatus provides a mock function with given fields: _a0
+func (_m *PaymentRepository) UpdateStatus(_a0 *models.Payment) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Payment) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,111 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PaymentUsecase is an autogenerated mock type for the PaymentUsecase type
+type PaymentUsecase struct {
+	mock.Mock
+}
+
+// HandleNotification provides a mock function with given fields: body
+func (_m *PaymentUsecase) HandleNotification(body []byte) error {
+	ret := _m.Called(body)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]byte) error); ok {
+		r0 = rf(body)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Pay provides a mock function with given fields: orderId, buyerId
+func (_m *PaymentUsecase) Pay(orderId int64, buyerId int64) (*models.Payment, error) {
+	ret := _m.Called(orderId, buyerId)
+
+	var r0 *models.Payment
+	if rf, ok := ret.Get(0).(func(int64, int64) *models.Payment); ok {
+		r0 = rf(orderId, buyerId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Payment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(orderId, buyerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProcessTimeouts provides a mock function with given fields:
+func (_m *PaymentUsecase) ProcessTimeouts() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Release provides a mock function with given fields: orderId, buyerId
+func (_m *PaymentUsecase) Release(orderId int64, buyerId int64) (*models.Payment, error) {
+	ret := _m.Called(orderId, buyerId)
+
+	var r0 *models.Payment
+	if rf, ok := ret.Get(0).(func(int64, int64) *models.Payment); ok {
+		r0 = rf(orderId, buyerId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Payment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(orderId, buyerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Settle provides a mock function with given fields: order, refund
+func (_m *PaymentUsecase) Settle(order *models.Order, refund int64) (*models.Payment, error) {
+	ret := _m.Called(order, refund)
+
+	var r0 *models.Payment
+	if rf, ok := ret.Get(0).(func(*models.Order, int64) *models.Payment); ok {
+		r0 = rf(order, refund)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Payment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.Order, int64) error); ok {
+		r1 = rf(order, refund)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,13 @@
+package payment
+
+import "yula/internal/models"
+
+//go:generate mockery -name=PaymentProvider
+
+// платежный провайдер: создает платеж с удержанием средств, списывает их продавцу или возвращает покупателю
+type PaymentProvider interface {
+	CreatePayment(payment *models.Payment) error
+	Capture(payment *models.Payment) error
+	Refund(payment *models.Payment, amount int64) error
+	ParseNotification(body []byte) (*models.PaymentNotification, error)
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"fmt"
+	"sync"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/payment"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/mailru/easyjson"
+)
+
+type fakePayment struct {
+	amount   int64
+	captured bool
+	refunded int64
+}
+
+// FakeProvider держит платежи в памяти, подтверждение оплаты имитируется
+// уведомлением на /payments/notification
+type FakeProvider struct {
+	mu       sync.Mutex
+	lastId   int64
+	payments map[string]*fakePayment
+}
+
+func NewFakeProvider() payment.PaymentProvider {
+	return &FakeProvider{
+		payments: make(map[string]*fakePayment),
+	}
+}
+
+// после перезапуска сервера платежи теряются, поэтому неизвестный платеж заводим заново
+func (fp *FakeProvider) get(p *models.Payment) *fakePayment {
+	state, ok := fp.payments[p.ExternalId]
+	if !ok {
+		state = &fakePayment{amount: p.Amount}
+		fp.payments[p.ExternalId] = state
+	}
+	return state
+}
+
+func (fp *FakeProvider) CreatePayment(p *models.Payment) error {
+	fp.mu.Lock()
+	defer fp.mu.Unlock()
+
+	fp.lastId++
+	p.ExternalId = fmt.Sprintf("fake-%d", fp.lastId)
+	p.ConfirmationUrl = fmt.Sprintf("fake://pay/%s", p.ExternalId)
+	fp.payments[p.ExternalId] = &fakePayment{amount: p.Amount}
+	return nil
+}
+
+func (fp *FakeProvider) Capture(p *models.Payment) error {
+	fp.mu.Lock()
+	defer fp.mu.Unlock()
+
+	state := fp.get(p)
+	if state.refunded >= state.amount {
+		return internalError.PaymentFailed
+	}
+
+	state.captured = true
+	return nil
+}
+
+func (fp *FakeProvider) Refund(p *models.Payment, amount int64) error {
+	fp.mu.Lock()
+	defer fp.mu.Unlock()
+
+	state := fp.get(p)
+	if amount <= 0 || state.refunded+amount > state.amount {
+		return internalError.PaymentFailed
+	}
+
+	state.refunded += amount
+	return nil
+}
+
+func (fp *FakeProvider) ParseNotification(body []byte) (*models.PaymentNotification, error) {
+	notification := &models.PaymentNotification{}
+	err := easyjson.Unmarshal(body, notification)
+	if err != nil {
+		return nil, internalError.BadRequest
+	}
+
+	_, err = govalidator.ValidateStruct(notification)
+	if err != nil {
+		return nil, internalError.BadRequest
+	}
+
+	return notification, nil
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"testing"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeCreateAndCapture(t *testing.T) {
+	fp := NewFakeProvider()
+	p := &models.Payment{Amount: 200}
+
+	err := fp.CreatePayment(p)
+	assert.Nil(t, err)
+	assert.Equal(t, "fake-1", p.ExternalId)
+	assert.NotEmpty(t, p.ConfirmationUrl)
+
+	err = fp.Capture(p)
+	assert.Nil(t, err)
+}
+
+func TestFakeRefund(t *testing.T) {
+	fp := NewFakeProvider()
+	p := &models.Payment{Amount: 200}
+	_ = fp.CreatePayment(p)
+
+	err := fp.Refund(p, 150)
+	assert.Nil(t, err)
+
+	err = fp.Refund(p, 100)
+	assert.Equal(t, internalError.PaymentFailed, err)
+
+	err = fp.Refund(p, 50)
+	assert.Nil(t, err)
+
+	err = fp.Capture(p)
+	assert.Equal(t, internalError.PaymentFailed, err)
+}
+
+func TestFakeParseNotification(t *testing.T) {
+	fp := NewFakeProvider()
+
+	n, err := fp.ParseNotification([]byte(`{"payment_id": "fake-1", "event": "payment.succeeded"}`))
+	assert.Nil(t, err)
+	assert.Equal(t, "fake-1", n.ExternalId)
+	assert.Equal(t, models.PaymentEventSucceeded, n.Event)
+
+	_, err = fp.ParseNotification([]byte(`{"payment_id": "fake-1", "event": "payment.stolen"}`))
+	assert.Equal(t, internalError.BadRequest, err)
+
+	_, err = fp.ParseNotification([]byte(`not a json`))
+	assert.Equal(t, internalError.BadRequest, err)
+}
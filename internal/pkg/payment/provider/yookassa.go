@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/logging"
+	"yula/internal/pkg/payment"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/mailru/easyjson"
+)
+
+const (
+	yooKassaApiUrl   = "https://api.yookassa.ru/v3"
+	yooKassaCurrency = "RUB"
+	yooKassaTimeout  = 10 * time.Second
+
+	yooKassaStatusWaitingForCapture = "waiting_for_capture"
+	yooKassaStatusSucceeded         = "succeeded"
+	yooKassaStatusCanceled          = "canceled"
+)
+
+var logger logging.Logger = logging.GetLogger()
+
+// YooKassaProvider проводит оплату заказов двухстадийными платежами ЮKassa: деньги удерживаются
+// на карте покупателя до Capture. Уведомления ЮKassa не подписаны, поэтому из уведомления берется
+// только id платежа, а статус запрашивается у API с ключом магазина
+type YooKassaProvider struct {
+	shopId    string
+	secretKey string
+	returnUrl string
+	apiUrl    string
+	client    *http.Client
+}
+
+func NewYooKassaProvider(shopId string, secretKey string, returnUrl string) payment.PaymentProvider {
+	return &YooKassaProvider{
+		shopId:    shopId,
+		secretKey: secretKey,
+		returnUrl: returnUrl,
+		apiUrl:    yooKassaApiUrl,
+		client:    &http.Client{Timeout: yooKassaTimeout},
+	}
+}
+
+func yooKassaAmount(rubles int64) models.YooKassaAmount {
+	return models.YooKassaAmount{
+		Value:    fmt.Sprintf("%d.00", rubles),
+		Currency: yooKassaCurrency,
+	}
+}
+
+// ключ идемпотентности для создания платежа: повторная оплата заказа должна завести новый платеж
+func newIdempotenceKey() (string, error) {
+	key := make([]byte, 16)
+	_, err := rand.Read(key)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(key), nil
+}
+
+func (yp *YooKassaProvider) do(method string, path string, idempotenceKey string,
+	body easyjson.Marshaler, result easyjson.Unmarshaler) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := easyjson.Marshal(body)
+		if err != nil {
+			return internalError.GenInternalError(err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, yp.apiUrl+path, reader)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+	req.SetBasicAuth(yp.shopId, yp.secretKey)
+	req.Header.Set("Content-Type", "application/json")
+	if idempotenceKey != "" {
+		req.Header.Set("Idempotence-Key", idempotenceKey)
+	}
+
+	resp, err := yp.client.Do(req)
+	if err != nil {
+		logger.Warnf("yookassa %s %s: %v", method, path, err)
+		return internalError.PaymentFailed
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		logger.Warnf("yookassa %s %s: status %d", method, path, resp.StatusCode)
+		return internalError.PaymentFailed
+	}
+
+	if result == nil {
+		return nil
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return internalError.PaymentFailed
+	}
+	if err := easyjson.Unmarshal(data, result); err != nil {
+		logger.Warnf("yookassa %s %s: %v", method, path, err)
+		return internalError.PaymentFailed
+	}
+	return nil
+}
+
+func (yp *YooKassaProvider) CreatePayment(p *models.Payment) error {
+	key, err := newIdempotenceKey()
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	request := &models.YooKassaPaymentRequest{
+		Amount:  yooKassaAmount(p.Amount),
+		Capture: false,
+		Confirmation: models.YooKassaConfirmation{
+			Type:      "redirect",
+			ReturnUrl: yp.returnUrl,
+		},
+		Description: fmt.Sprintf("Заказ %d", p.OrderId),
+	}
+	created := &models.YooKassaPayment{}
+	err = yp.do(http.MethodPost, "/payments", key, request, created)
+	if err != nil {
+		return err
+	}
+
+	if created.Id == "" {
+		return internalError.PaymentFailed
+	}
+	p.ExternalId = created.Id
+	p.ConfirmationUrl = created.Confirmation.ConfirmationUrl
+	return nil
+}
+
+// Capture списывает удержанные деньги за вычетом того, что уже решено вернуть покупателю
+func (yp *YooKassaProvider) Capture(p *models.Payment) error {
+	amount := p.Amount - p.Refunded
+	if amount <= 0 {
+		return internalError.PaymentFailed
+	}
+
+	request := &models.YooKassaCaptureRequest{Amount: yooKassaAmount(amount)}
+	return yp.do(http.MethodPost, "/payments/"+p.ExternalId+"/capture", "capture-"+p.ExternalId, request, nil)
+}
+
+// Refund возвращает деньги покупателю. Списанный платеж возвращается через refunds,
+// удержанный целиком отменяется, а частичный возврат удержанного платежа сделает Capture на меньшую сумму
+func (yp *YooKassaProvider) Refund(p *models.Payment, amount int64) error {
+	if amount <= 0 || p.Refunded+amount > p.Amount {
+		return internalError.PaymentFailed
+	}
+
+	if p.Status == models.PaymentStatusReleased {
+		request := &models.YooKassaRefundRequest{
+			PaymentId: p.ExternalId,
+			Amount:    yooKassaAmount(amount),
+		}
+		key := fmt.Sprintf("refund-%s-%d", p.ExternalId, p.Refunded+amount)
+		return yp.do(http.MethodPost, "/refunds", key, request, nil)
+	}
+
+	if p.Refunded+amount < p.Amount {
+		return nil
+	}
+	return yp.do(http.MethodPost, "/payments/"+p.ExternalId+"/cancel", "cancel-"+p.ExternalId, nil, nil)
+}
+
+func (yp *YooKassaProvider) ParseNotification(body []byte) (*models.PaymentNotification, error) {
+	notification := &models.YooKassaNotification{}
+	err := easyjson.Unmarshal(body, notification)
+	// id подставляется в адрес запроса к API, у ЮKassa это всегда uuid
+	if err != nil || !govalidator.IsUUID(notification.Object.Id) {
+		return nil, internalError.BadRequest
+	}
+
+	actual := &models.YooKassaPayment{}
+	err = yp.do(http.MethodGet, "/payments/"+notification.Object.Id, "", nil, actual)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.PaymentNotification{ExternalId: notification.Object.Id}
+	switch actual.Status {
+	case yooKassaStatusWaitingForCapture, yooKassaStatusSucceeded:
+		result.Event = models.PaymentEventSucceeded
+	case yooKassaStatusCanceled:
+		result.Event = models.PaymentEventCanceled
+	default:
+		return nil, internalError.BadRequest
+	}
+	return result, nil
+}
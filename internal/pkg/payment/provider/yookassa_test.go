@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+
+	"github.com/mailru/easyjson"
+	"github.com/stretchr/testify/assert"
+)
+
+const testPaymentId = "22d6d597-000f-5000-9000-145f6df21d6f"
+
+type yooKassaRequest struct {
+	method string
+	path   string
+	key    string
+	body   string
+}
+
+func newTestYooKassa(t *testing.T, status string) (*YooKassaProvider, *[]yooKassaRequest) {
+	requests := &[]yooKassaRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		shopId, secretKey, ok := r.BasicAuth()
+		if !ok || shopId != "shop" || secretKey != "key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		*requests = append(*requests, yooKassaRequest{r.Method, r.URL.Path, r.Header.Get("Idempotence-Key"), string(body)})
+
+		payment := models.YooKassaPayment{
+			Id:           testPaymentId,
+			Status:       status,
+			Confirmation: models.YooKassaConfirmation{Type: "redirect", ConfirmationUrl: "https://yoomoney.ru/checkout"},
+		}
+		data, _ := easyjson.Marshal(payment)
+		_, _ = w.Write(data)
+	}))
+	t.Cleanup(server.Close)
+
+	yp := NewYooKassaProvider("shop", "key", "https://volchock.ru/orders").(*YooKassaProvider)
+	yp.apiUrl = server.URL
+	return yp, requests
+}
+
+func TestYooKassaCreatePayment(t *testing.T) {
+	yp, requests := newTestYooKassa(t, "pending")
+	p := &models.Payment{OrderId: 1, Amount: 200}
+
+	err := yp.CreatePayment(p)
+	assert.Nil(t, err)
+	assert.Equal(t, testPaymentId, p.ExternalId)
+	assert.Equal(t, "https://yoomoney.ru/checkout", p.ConfirmationUrl)
+
+	assert.Len(t, *requests, 1)
+	request := (*requests)[0]
+	assert.Equal(t, "/payments", request.path)
+	assert.NotEmpty(t, request.key)
+	assert.Contains(t, request.body, `"value":"200.00"`)
+	assert.Contains(t, request.body, `"capture":false`)
+}
+
+func TestYooKassaRefund(t *testing.T) {
+	yp, requests := newTestYooKassa(t, "")
+
+	// частичный возврат удержанного платежа уйдет в меньший Capture
+	held := &models.Payment{ExternalId: testPaymentId, Amount: 200, Status: models.PaymentStatusHeld}
+	err := yp.Refund(held, 50)
+	assert.Nil(t, err)
+	assert.Len(t, *requests, 0)
+
+	err = yp.Refund(held, 200)
+	assert.Nil(t, err)
+	assert.Equal(t, "/payments/"+testPaymentId+"/cancel", (*requests)[0].path)
+
+	released := &models.Payment{ExternalId: testPaymentId, Amount: 200, Status: models.PaymentStatusReleased}
+	err = yp.Refund(released, 50)
+	assert.Nil(t, err)
+	assert.Equal(t, "/refunds", (*requests)[1].path)
+	assert.Contains(t, (*requests)[1].body, `"value":"50.00"`)
+
+	err = yp.Refund(released, 300)
+	assert.Equal(t, internalError.PaymentFailed, err)
+}
+
+func TestYooKassaParseNotification(t *testing.T) {
+	yp, requests := newTestYooKassa(t, "canceled")
+
+	// статус берется из API, а не из тела уведомления
+	n, err := yp.ParseNotification([]byte(`{"event": "payment.waiting_for_capture",
+		"object": {"id": "` + testPaymentId + `", "status": "waiting_for_capture"}}`))
+	assert.Nil(t, err)
+	assert.Equal(t, testPaymentId, n.ExternalId)
+	assert.Equal(t, models.PaymentEventCanceled, n.Event)
+	assert.Equal(t, http.MethodGet, (*requests)[0].method)
+
+	_, err = yp.ParseNotification([]byte(`{"object": {"id": "../refunds"}}`))
+	assert.Equal(t, internalError.BadRequest, err)
+}
+
+func TestYooKassaUnauthorized(t *testing.T) {
+	yp, _ := newTestYooKassa(t, "pending")
+	yp.secretKey = "other"
+
+	err := yp.CreatePayment(&models.Payment{OrderId: 1, Amount: 200})
+	assert.Equal(t, internalError.PaymentFailed, err)
+}
@@ -0,0 +1,16 @@
+package payment
+
+import (
+	"time"
+	"yula/internal/models"
+)
+
+//go:generate mockery -name=PaymentRepository
+
+type PaymentRepository interface {
+	Insert(payment *models.Payment) error
+	SelectByOrderId(orderId int64) (*models.Payment, error)
+	SelectByExternalId(externalId string) (*models.Payment, error)
+	SelectExpired(status string, before time.Time) ([]*models.Payment, error)
+	UpdateStatus(payment *models.Payment) error
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"time"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/payment"
+)
+
+type PaymentRepository struct {
+	DB *sql.DB
+}
+
+func NewPaymentRepository(DB *sql.DB) payment.PaymentRepository {
+	return &PaymentRepository{
+		DB: DB,
+	}
+}
+
+const (
+	paymentColumns string = "id, order_id, external_id, amount, refunded, status, confirmation_url, created_at, updated_at"
+)
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanPayment(row scanner) (*models.Payment, error) {
+	var p models.Payment
+	err := row.Scan(&p.Id, &p.OrderId, &p.ExternalId, &p.Amount, &p.Refunded, &p.Status,
+		&p.ConfirmationUrl, &p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (pr *PaymentRepository) Insert(p *models.Payment) error {
+	queryStr := `INSERT INTO escrow_payment (order_id, external_id, amount, status, confirmation_url)
+				VALUES ($1, $2, $3, $4, $5) RETURNING id, created_at, updated_at;`
+	query := pr.DB.QueryRowContext(context.Background(), queryStr,
+		p.OrderId, p.ExternalId, p.Amount, p.Status, p.ConfirmationUrl)
+
+	err := query.Scan(&p.Id, &p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	return nil
+}
+
+func (pr *PaymentRepository) selectOne(queryStr string, arg interface{}) (*models.Payment, error) {
+	query := pr.DB.QueryRowContext(context.Background(), queryStr, arg)
+
+	p, err := scanPayment(query)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return nil, internalError.EmptyQuery
+		}
+		return nil, internalError.GenInternalError(err)
+	}
+
+	return p, nil
+}
+
+// по заказу берем последнюю попытку оплаты
+func (pr *PaymentRepository) SelectByOrderId(orderId int64) (*models.Payment, error) {
+	return pr.selectOne("SELECT "+paymentColumns+
+		" FROM escrow_payment WHERE order_id = $1 ORDER BY created_at DESC, id DESC LIMIT 1;", orderId)
+}
+
+func (pr *PaymentRepository) SelectByExternalId(externalId string) (*models.Payment, error) {
+	return pr.selectOne("SELECT "+paymentColumns+" FROM escrow_payment WHERE external_id = $1;", externalId)
+}
+
+func (pr *PaymentRepository) SelectExpired(status string, before time.Time) ([]*models.Payment, error) {
+	rows, err := pr.DB.QueryContext(context.Background(),
+		"SELECT "+paymentColumns+" FROM escrow_payment WHERE status = $1 AND updated_at < $2 ORDER BY id;",
+		status, before)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+
+	defer rows.Close()
+	payments := make([]*models.Payment, 0)
+	for rows.Next() {
+		p, err := scanPayment(rows)
+		if err != nil {
+			return nil, internalError.GenInternalError(err)
+		}
+
+		payments = append(payments, p)
+	}
+
+	return payments, nil
+}
+
+func (pr *PaymentRepository) UpdateStatus(p *models.Payment) error {
+	query := pr.DB.QueryRowContext(context.Background(),
+		`UPDATE escrow_payment SET status = $2, refunded = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 RETURNING updated_at;`,
+		p.Id, p.Status, p.Refunded)
+
+	err := query.Scan(&p.UpdatedAt)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return internalError.EmptyQuery
+		}
+		return internalError.GenInternalError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	sqlmock "gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+var testColumns = []string{"id", "order_id", "external_id", "amount", "refunded", "status", "confirmation_url",
+	"created_at", "updated_at"}
+
+func TestInsertOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewPaymentRepository(db)
+	p := &models.Payment{OrderId: 5, ExternalId: "fake-1", Amount: 200, Status: models.PaymentStatusPending,
+		ConfirmationUrl: "fake://pay/fake-1"}
+
+	mock.ExpectQuery("INSERT INTO escrow_payment").
+		WithArgs(p.OrderId, p.ExternalId, p.Amount, p.Status, p.ConfirmationUrl).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(1, time.Now(), time.Now()))
+
+	err = repo.Insert(p)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), p.Id)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestInsertError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewPaymentRepository(db)
+	p := &models.Payment{OrderId: 5, ExternalId: "fake-1", Amount: 200, Status: models.PaymentStatusPending}
+
+	mock.ExpectQuery("INSERT INTO escrow_payment").
+		WithArgs(p.OrderId, p.ExternalId, p.Amount, p.Status, p.ConfirmationUrl).
+		WillReturnError(sql.ErrConnDone)
+
+	err = repo.Insert(p)
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectByOrderIdOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewPaymentRepository(db)
+
+	mock.ExpectQuery("SELECT id, order_id").WithArgs(int64(5)).
+		WillReturnRows(sqlmock.NewRows(testColumns).
+			AddRow(1, 5, "fake-1", 200, 0, "held", "", time.Now(), time.Now()))
+
+	p, err := repo.SelectByOrderId(5)
+	assert.NoError(t, err)
+	assert.Equal(t, models.PaymentStatusHeld, p.Status)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectByExternalIdEmpty(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewPaymentRepository(db)
+
+	mock.ExpectQuery("SELECT id, order_id").WithArgs("fake-1").
+		WillReturnRows(sqlmock.NewRows(testColumns))
+
+	_, err = repo.SelectByExternalId("fake-1")
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectExpiredOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewPaymentRepository(db)
+	before := time.Now()
+
+	mock.ExpectQuery("SELECT id, order_id").WithArgs(models.PaymentStatusPending, before).
+		WillReturnRows(sqlmock.NewRows(testColumns).
+			AddRow(1, 5, "fake-1", 200, 0, "pending", "", time.Now(), time.Now()).
+			AddRow(2, 6, "fake-2", 300, 0, "pending", "", time.Now(), time.Now()))
+
+	payments, err := repo.SelectExpired(models.PaymentStatusPending, before)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(payments))
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateStatusOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewPaymentRepository(db)
+	p := &models.Payment{Id: 1, Status: models.PaymentStatusRefunded, Refunded: 200}
+
+	mock.ExpectQuery("UPDATE escrow_payment").WithArgs(p.Id, p.Status, p.Refunded).
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(time.Now()))
+
+	err = repo.UpdateStatus(p)
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateStatusEmpty(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewPaymentRepository(db)
+	p := &models.Payment{Id: 1, Status: models.PaymentStatusHeld}
+
+	mock.ExpectQuery("UPDATE escrow_payment").WithArgs(p.Id, p.Status, p.Refunded).
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}))
+
+	err = repo.UpdateStatus(p)
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
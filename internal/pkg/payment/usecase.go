@@ -0,0 +1,13 @@
+package payment
+
+import "yula/internal/models"
+
+//go:generate mockery -name=PaymentUsecase
+
+type PaymentUsecase interface {
+	Pay(orderId int64, buyerId int64) (*models.Payment, error)
+	HandleNotification(body []byte) error
+	Release(orderId int64, buyerId int64) (*models.Payment, error)
+	Settle(order *models.Order, refund int64) (*models.Payment, error)
+	ProcessTimeouts() error
+}
@@ -0,0 +1,286 @@
+package usecase
+
+import (
+	"time"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/logging"
+	"yula/internal/pkg/orders"
+	"yula/internal/pkg/payment"
+)
+
+const (
+	// столько ждем подтверждения оплаты от провайдера
+	paymentLifetime = 30 * time.Minute
+	// если продавец не отправил оплаченный заказ, деньги возвращаются покупателю
+	shippingTimeout = 7 * 24 * time.Hour
+	// если покупатель не подтвердил получение, деньги уходят продавцу
+	releaseTimeout = 14 * 24 * time.Hour
+)
+
+var logger logging.Logger = logging.GetLogger()
+
+type PaymentUsecase struct {
+	paymentRepository payment.PaymentRepository
+	orderRepository   orders.OrderRepository
+	provider          payment.PaymentProvider
+}
+
+func NewPaymentUsecase(paymentRepository payment.PaymentRepository, orderRepository orders.OrderRepository,
+	provider payment.PaymentProvider) payment.PaymentUsecase {
+	return &PaymentUsecase{
+		paymentRepository: paymentRepository,
+		orderRepository:   orderRepository,
+		provider:          provider,
+	}
+}
+
+func (pu *PaymentUsecase) buyerOrder(orderId int64, buyerId int64) (*models.Order, error) {
+	order, err := pu.orderRepository.SelectById(orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.BuyerId != buyerId {
+		return nil, internalError.Conflict
+	}
+	return order, nil
+}
+
+// оплатить можно новый заказ и заказ, который продавец успел подтвердить до оплаты
+func payable(order *models.Order) bool {
+	return order.Status == models.OrderStatusCreated || order.Status == models.OrderStatusConfirmed
+}
+
+func (pu *PaymentUsecase) Pay(orderId int64, buyerId int64) (*models.Payment, error) {
+	order, err := pu.buyerOrder(orderId, buyerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !payable(order) {
+		return nil, internalError.PaymentNotAllowed
+	}
+
+	// повторный запрос отдает уже начатую оплату
+	last, err := pu.paymentRepository.SelectByOrderId(orderId)
+	switch {
+	case err == nil && last.Status == models.PaymentStatusPending:
+		return last, nil
+	case err != nil && err != internalError.EmptyQuery:
+		return nil, err
+	}
+
+	p := &models.Payment{
+		OrderId: orderId,
+		Amount:  order.Total(),
+		Status:  models.PaymentStatusPending,
+	}
+	err = pu.provider.CreatePayment(p)
+	if err != nil {
+		return nil, err
+	}
+
+	err = pu.paymentRepository.Insert(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// статусы, которые меняет оплата, записываются в журнал от имени системы
+func (pu *PaymentUsecase) setOrderStatus(order *models.Order, status string) error {
+	from := order.Status
+	order.Status = status
+	return pu.orderRepository.UpdateStatus(order, from, 0)
+}
+
+func (pu *PaymentUsecase) HandleNotification(body []byte) error {
+	notification, err := pu.provider.ParseNotification(body)
+	if err != nil {
+		return err
+	}
+
+	p, err := pu.paymentRepository.SelectByExternalId(notification.ExternalId)
+	if err != nil {
+		return err
+	}
+
+	// провайдер может прислать уведомление повторно
+	if p.Status != models.PaymentStatusPending {
+		return nil
+	}
+
+	if notification.Event == models.PaymentEventCanceled {
+		p.Status = models.PaymentStatusCancelled
+		return pu.paymentRepository.UpdateStatus(p)
+	}
+
+	order, err := pu.orderRepository.SelectById(p.OrderId)
+	if err != nil {
+		return err
+	}
+
+	// заказ успели отменить, пока шла оплата
+	if !payable(order) {
+		return pu.refund(p, p.Amount)
+	}
+
+	p.Status = models.PaymentStatusHeld
+	err = pu.paymentRepository.UpdateStatus(p)
+	if err != nil {
+		return err
+	}
+
+	return pu.setOrderStatus(order, models.OrderStatusPaid)
+}
+
+func (pu *PaymentUsecase) refund(p *models.Payment, amount int64) error {
+	err := pu.provider.Refund(p, amount)
+	if err != nil {
+		return err
+	}
+
+	p.Refunded += amount
+	p.Status = models.PaymentStatusRefunded
+	return pu.paymentRepository.UpdateStatus(p)
+}
+
+func (pu *PaymentUsecase) release(p *models.Payment, order *models.Order) error {
+	err := pu.provider.Capture(p)
+	if err != nil {
+		return err
+	}
+
+	p.Status = models.PaymentStatusReleased
+	err = pu.paymentRepository.UpdateStatus(p)
+	if err != nil {
+		return err
+	}
+
+	return pu.setOrderStatus(order, models.OrderStatusReleased)
+}
+
+func (pu *PaymentUsecase) Release(orderId int64, buyerId int64) (*models.Payment, error) {
+	order, err := pu.buyerOrder(orderId, buyerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.Status != models.OrderStatusShipped && order.Status != models.OrderStatusDelivered {
+		return nil, internalError.InvalidStatusTransition
+	}
+
+	p, err := pu.paymentRepository.SelectByOrderId(orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Status != models.PaymentStatusHeld {
+		return nil, internalError.InvalidStatusTransition
+	}
+
+	err = pu.release(p, order)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Settle закрывает удержанный платеж по решению спора: часть возвращается покупателю,
+// остаток уходит продавцу; без безопасной сделки вернуть деньги нельзя.
+// Новый статус платежа сохраняет вызывающий, в одной транзакции со спором и заказом
+func (pu *PaymentUsecase) Settle(order *models.Order, refund int64) (*models.Payment, error) {
+	p, err := pu.paymentRepository.SelectByOrderId(order.Id)
+	if err != nil && err != internalError.EmptyQuery {
+		return nil, err
+	}
+
+	if err == internalError.EmptyQuery || p.Status != models.PaymentStatusHeld {
+		if refund > 0 {
+			return nil, internalError.PaymentNotAllowed
+		}
+		return nil, nil
+	}
+
+	if refund < 0 || refund > p.Amount {
+		return nil, internalError.BadRequest
+	}
+
+	if refund > 0 {
+		err = pu.provider.Refund(p, refund)
+		if err != nil {
+			return nil, err
+		}
+		p.Refunded += refund
+	}
+
+	p.Status = models.PaymentStatusRefunded
+	if refund < p.Amount {
+		err = pu.provider.Capture(p)
+		if err != nil {
+			return nil, err
+		}
+		p.Status = models.PaymentStatusReleased
+	}
+
+	return p, nil
+}
+
+func (pu *PaymentUsecase) ProcessTimeouts() error {
+	now := time.Now()
+
+	// неоплаченные платежи просто закрываем, заказ остается ждать новой оплаты
+	pending, err := pu.paymentRepository.SelectExpired(models.PaymentStatusPending, now.Add(-paymentLifetime))
+	if err != nil {
+		logger.Errorf("cannot select expired payments: %v", err)
+		return err
+	}
+
+	for _, p := range pending {
+		p.Status = models.PaymentStatusCancelled
+		if err := pu.paymentRepository.UpdateStatus(p); err != nil {
+			logger.Warnf("cannot cancel payment %d: %v", p.Id, err)
+		}
+	}
+
+	held, err := pu.paymentRepository.SelectExpired(models.PaymentStatusHeld, now.Add(-shippingTimeout))
+	if err != nil {
+		logger.Errorf("cannot select held payments: %v", err)
+		return err
+	}
+
+	for _, p := range held {
+		order, err := pu.orderRepository.SelectById(p.OrderId)
+		if err != nil {
+			logger.Warnf("cannot get order %d: %v", p.OrderId, err)
+			continue
+		}
+
+		switch {
+		case order.Status == models.OrderStatusPaid:
+			err = pu.refund(p, p.Amount-p.Refunded)
+			if err == nil {
+				err = pu.setOrderStatus(order, models.OrderStatusCancelled)
+			}
+			if err == nil {
+				logger.Infof("order %d was not shipped in time, payment %d refunded", order.Id, p.Id)
+			}
+
+		case (order.Status == models.OrderStatusShipped || order.Status == models.OrderStatusDelivered) &&
+			order.UpdatedAt.Before(now.Add(-releaseTimeout)):
+			err = pu.release(p, order)
+			if err == nil {
+				logger.Infof("order %d was not confirmed in time, payment %d released", order.Id, p.Id)
+			}
+		}
+
+		if err != nil {
+			logger.Warnf("cannot process payment %d timeout: %v", p.Id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,403 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+	"yula/internal/models"
+	orderMocks "yula/internal/pkg/orders/mocks"
+	"yula/internal/pkg/payment/mocks"
+
+	myerr "yula/internal/error"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestOrder(status string) *models.Order {
+	return &models.Order{
+		Id:         5,
+		BuyerId:    1,
+		SalesmanId: 2,
+		Status:     status,
+		UpdatedAt:  time.Now(),
+		Lines: []*models.OrderLine{
+			{
+				OrderId:  5,
+				AdvertId: 3,
+				Amount:   2,
+				Price:    100,
+			},
+		},
+	}
+}
+
+func newTestPayment(status string) *models.Payment {
+	return &models.Payment{
+		Id:         1,
+		OrderId:    5,
+		ExternalId: "fake-1",
+		Amount:     200,
+		Status:     status,
+	}
+}
+
+func TestPaySuccess(t *testing.T) {
+	pr := mocks.PaymentRepository{}
+	or := orderMocks.OrderRepository{}
+	pp := mocks.PaymentProvider{}
+
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusCreated), nil)
+	pr.On("SelectByOrderId", int64(5)).Return(nil, myerr.EmptyQuery)
+	pp.On("CreatePayment", mock.AnythingOfType("*models.Payment")).Return(nil)
+	pr.On("Insert", mock.AnythingOfType("*models.Payment")).Return(nil)
+
+	pu := NewPaymentUsecase(&pr, &or, &pp)
+	p, err := pu.Pay(5, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(200), p.Amount)
+	assert.Equal(t, models.PaymentStatusPending, p.Status)
+}
+
+func TestPayConfirmedOrder(t *testing.T) {
+	pr := mocks.PaymentRepository{}
+	or := orderMocks.OrderRepository{}
+	pp := mocks.PaymentProvider{}
+
+	// продавец подтвердил заказ раньше, чем покупатель его оплатил
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusConfirmed), nil)
+	pr.On("SelectByOrderId", int64(5)).Return(nil, myerr.EmptyQuery)
+	pp.On("CreatePayment", mock.AnythingOfType("*models.Payment")).Return(nil)
+	pr.On("Insert", mock.AnythingOfType("*models.Payment")).Return(nil)
+
+	pu := NewPaymentUsecase(&pr, &or, &pp)
+	p, err := pu.Pay(5, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, models.PaymentStatusPending, p.Status)
+}
+
+func TestPayReturnsPending(t *testing.T) {
+	pr := mocks.PaymentRepository{}
+	or := orderMocks.OrderRepository{}
+	pp := mocks.PaymentProvider{}
+
+	pending := newTestPayment(models.PaymentStatusPending)
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusCreated), nil)
+	pr.On("SelectByOrderId", int64(5)).Return(pending, nil)
+
+	pu := NewPaymentUsecase(&pr, &or, &pp)
+	p, err := pu.Pay(5, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, pending, p)
+	pp.AssertNotCalled(t, "CreatePayment", mock.Anything)
+}
+
+func TestPayStranger(t *testing.T) {
+	pr := mocks.PaymentRepository{}
+	or := orderMocks.OrderRepository{}
+	pp := mocks.PaymentProvider{}
+
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusCreated), nil)
+
+	pu := NewPaymentUsecase(&pr, &or, &pp)
+	_, err := pu.Pay(5, 2)
+	assert.Equal(t, myerr.Conflict, err)
+}
+
+func TestPayAlreadyPaid(t *testing.T) {
+	pr := mocks.PaymentRepository{}
+	or := orderMocks.OrderRepository{}
+	pp := mocks.PaymentProvider{}
+
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusPaid), nil)
+
+	pu := NewPaymentUsecase(&pr, &or, &pp)
+	_, err := pu.Pay(5, 1)
+	assert.Equal(t, myerr.PaymentNotAllowed, err)
+}
+
+func TestPayProviderError(t *testing.T) {
+	pr := mocks.PaymentRepository{}
+	or := orderMocks.OrderRepository{}
+	pp := mocks.PaymentProvider{}
+
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusCreated), nil)
+	pr.On("SelectByOrderId", int64(5)).Return(newTestPayment(models.PaymentStatusCancelled), nil)
+	pp.On("CreatePayment", mock.AnythingOfType("*models.Payment")).Return(myerr.PaymentFailed)
+
+	pu := NewPaymentUsecase(&pr, &or, &pp)
+	_, err := pu.Pay(5, 1)
+	assert.Equal(t, myerr.PaymentFailed, err)
+}
+
+func TestHandleNotificationSucceeded(t *testing.T) {
+	pr := mocks.PaymentRepository{}
+	or := orderMocks.OrderRepository{}
+	pp := mocks.PaymentProvider{}
+
+	body := []byte("notification")
+	pp.On("ParseNotification", body).Return(&models.PaymentNotification{ExternalId: "fake-1",
+		Event: models.PaymentEventSucceeded}, nil)
+	pr.On("SelectByExternalId", "fake-1").Return(newTestPayment(models.PaymentStatusPending), nil)
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusCreated), nil)
+	pr.On("UpdateStatus", mock.MatchedBy(func(p *models.Payment) bool {
+		return p.Status == models.PaymentStatusHeld
+	})).Return(nil)
+	or.On("UpdateStatus", mock.MatchedBy(func(o *models.Order) bool {
+		return o.Status == models.OrderStatusPaid
+	}), models.OrderStatusCreated, int64(0)).Return(nil)
+
+	pu := NewPaymentUsecase(&pr, &or, &pp)
+	err := pu.HandleNotification(body)
+	assert.Nil(t, err)
+	pr.AssertExpectations(t)
+	or.AssertExpectations(t)
+}
+
+func TestHandleNotificationConfirmedOrder(t *testing.T) {
+	pr := mocks.PaymentRepository{}
+	or := orderMocks.OrderRepository{}
+	pp := mocks.PaymentProvider{}
+
+	// продавец подтвердил заказ, пока шла оплата: деньги удерживаются, а не возвращаются
+	body := []byte("notification")
+	pp.On("ParseNotification", body).Return(&models.PaymentNotification{ExternalId: "fake-1",
+		Event: models.PaymentEventSucceeded}, nil)
+	pr.On("SelectByExternalId", "fake-1").Return(newTestPayment(models.PaymentStatusPending), nil)
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusConfirmed), nil)
+	pr.On("UpdateStatus", mock.MatchedBy(func(p *models.Payment) bool {
+		return p.Status == models.PaymentStatusHeld
+	})).Return(nil)
+	or.On("UpdateStatus", mock.MatchedBy(func(o *models.Order) bool {
+		return o.Status == models.OrderStatusPaid
+	}), models.OrderStatusConfirmed, int64(0)).Return(nil)
+
+	pu := NewPaymentUsecase(&pr, &or, &pp)
+	err := pu.HandleNotification(body)
+	assert.Nil(t, err)
+	pr.AssertExpectations(t)
+	or.AssertExpectations(t)
+	pp.AssertNotCalled(t, "Refund", mock.Anything, mock.Anything)
+}
+
+func TestHandleNotificationRepeated(t *testing.T) {
+	pr := mocks.PaymentRepository{}
+	or := orderMocks.OrderRepository{}
+	pp := mocks.PaymentProvider{}
+
+	body := []byte("notification")
+	pp.On("ParseNotification", body).Return(&models.PaymentNotification{ExternalId: "fake-1",
+		Event: models.PaymentEventSucceeded}, nil)
+	pr.On("SelectByExternalId", "fake-1").Return(newTestPayment(models.PaymentStatusHeld), nil)
+
+	pu := NewPaymentUsecase(&pr, &or, &pp)
+	err := pu.HandleNotification(body)
+	assert.Nil(t, err)
+	pr.AssertNotCalled(t, "UpdateStatus", mock.Anything)
+}
+
+func TestHandleNotificationCancelledOrder(t *testing.T) {
+	pr := mocks.PaymentRepository{}
+	or := orderMocks.OrderRepository{}
+	pp := mocks.PaymentProvider{}
+
+	body := []byte("notification")
+	pp.On("ParseNotification", body).Return(&models.PaymentNotification{ExternalId: "fake-1",
+		Event: models.PaymentEventSucceeded}, nil)
+	pr.On("SelectByExternalId", "fake-1").Return(newTestPayment(models.PaymentStatusPending), nil)
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusCancelled), nil)
+	pp.On("Refund", mock.AnythingOfType("*models.Payment"), int64(200)).Return(nil)
+	pr.On("UpdateStatus", mock.MatchedBy(func(p *models.Payment) bool {
+		return p.Status == models.PaymentStatusRefunded && p.Refunded == 200
+	})).Return(nil)
+
+	pu := NewPaymentUsecase(&pr, &or, &pp)
+	err := pu.HandleNotification(body)
+	assert.Nil(t, err)
+	pp.AssertExpectations(t)
+}
+
+func TestHandleNotificationCanceled(t *testing.T) {
+	pr := mocks.PaymentRepository{}
+	or := orderMocks.OrderRepository{}
+	pp := mocks.PaymentProvider{}
+
+	body := []byte("notification")
+	pp.On("ParseNotification", body).Return(&models.PaymentNotification{ExternalId: "fake-1",
+		Event: models.PaymentEventCanceled}, nil)
+	pr.On("SelectByExternalId", "fake-1").Return(newTestPayment(models.PaymentStatusPending), nil)
+	pr.On("UpdateStatus", mock.MatchedBy(func(p *models.Payment) bool {
+		return p.Status == models.PaymentStatusCancelled
+	})).Return(nil)
+
+	pu := NewPaymentUsecase(&pr, &or, &pp)
+	err := pu.HandleNotification(body)
+	assert.Nil(t, err)
+	or.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandleNotificationInvalid(t *testing.T) {
+	pr := mocks.PaymentRepository{}
+	or := orderMocks.OrderRepository{}
+	pp := mocks.PaymentProvider{}
+
+	body := []byte("notification")
+	pp.On("ParseNotification", body).Return(nil, myerr.BadRequest)
+
+	pu := NewPaymentUsecase(&pr, &or, &pp)
+	err := pu.HandleNotification(body)
+	assert.Equal(t, myerr.BadRequest, err)
+}
+
+func TestReleaseSuccess(t *testing.T) {
+	pr := mocks.PaymentRepository{}
+	or := orderMocks.OrderRepository{}
+	pp := mocks.PaymentProvider{}
+
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusShipped), nil)
+	pr.On("SelectByOrderId", int64(5)).Return(newTestPayment(models.PaymentStatusHeld), nil)
+	pp.On("Capture", mock.AnythingOfType("*models.Payment")).Return(nil)
+	pr.On("UpdateStatus", mock.AnythingOfType("*models.Payment")).Return(nil)
+	or.On("UpdateStatus", mock.MatchedBy(func(o *models.Order) bool {
+		return o.Status == models.OrderStatusReleased
+	}), models.OrderStatusShipped, int64(0)).Return(nil)
+
+	pu := NewPaymentUsecase(&pr, &or, &pp)
+	p, err := pu.Release(5, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, models.PaymentStatusReleased, p.Status)
+	or.AssertExpectations(t)
+}
+
+func TestReleaseNotShipped(t *testing.T) {
+	pr := mocks.PaymentRepository{}
+	or := orderMocks.OrderRepository{}
+	pp := mocks.PaymentProvider{}
+
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusPaid), nil)
+
+	pu := NewPaymentUsecase(&pr, &or, &pp)
+	_, err := pu.Release(5, 1)
+	assert.Equal(t, myerr.InvalidStatusTransition, err)
+}
+
+func TestReleaseNotHeld(t *testing.T) {
+	pr := mocks.PaymentRepository{}
+	or := orderMocks.OrderRepository{}
+	pp := mocks.PaymentProvider{}
+
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusDelivered), nil)
+	pr.On("SelectByOrderId", int64(5)).Return(newTestPayment(models.PaymentStatusReleased), nil)
+
+	pu := NewPaymentUsecase(&pr, &or, &pp)
+	_, err := pu.Release(5, 1)
+	assert.Equal(t, myerr.InvalidStatusTransition, err)
+}
+
+func TestProcessTimeouts(t *testing.T) {
+	pr := mocks.PaymentRepository{}
+	or := orderMocks.OrderRepository{}
+	pp := mocks.PaymentProvider{}
+
+	notShipped := newTestPayment(models.PaymentStatusHeld)
+	notConfirmed := newTestPayment(models.PaymentStatusHeld)
+	notConfirmed.Id = 2
+	notConfirmed.OrderId = 6
+
+	paidOrder := newTestOrder(models.OrderStatusPaid)
+	shippedOrder := newTestOrder(models.OrderStatusShipped)
+	shippedOrder.Id = 6
+	shippedOrder.UpdatedAt = time.Now().Add(-releaseTimeout - time.Hour)
+
+	pr.On("SelectExpired", models.PaymentStatusPending, mock.AnythingOfType("time.Time")).
+		Return([]*models.Payment{newTestPayment(models.PaymentStatusPending)}, nil)
+	pr.On("SelectExpired", models.PaymentStatusHeld, mock.AnythingOfType("time.Time")).
+		Return([]*models.Payment{notShipped, notConfirmed}, nil)
+	pr.On("UpdateStatus", mock.AnythingOfType("*models.Payment")).Return(nil)
+	or.On("SelectById", int64(5)).Return(paidOrder, nil)
+	or.On("SelectById", int64(6)).Return(shippedOrder, nil)
+	pp.On("Refund", notShipped, int64(200)).Return(nil)
+	pp.On("Capture", notConfirmed).Return(nil)
+	or.On("UpdateStatus", mock.AnythingOfType("*models.Order"), mock.AnythingOfType("string"), mock.AnythingOfType("int64")).Return(nil)
+
+	pu := NewPaymentUsecase(&pr, &or, &pp)
+	err := pu.ProcessTimeouts()
+	assert.Nil(t, err)
+	assert.Equal(t, models.PaymentStatusRefunded, notShipped.Status)
+	assert.Equal(t, models.OrderStatusCancelled, paidOrder.Status)
+	assert.Equal(t, models.PaymentStatusReleased, notConfirmed.Status)
+	assert.Equal(t, models.OrderStatusReleased, shippedOrder.Status)
+}
+
+func TestProcessTimeoutsError(t *testing.T) {
+	pr := mocks.PaymentRepository{}
+	or := orderMocks.OrderRepository{}
+	pp := mocks.PaymentProvider{}
+
+	pr.On("SelectExpired", models.PaymentStatusPending, mock.AnythingOfType("time.Time")).
+		Return(nil, myerr.DatabaseError)
+
+	pu := NewPaymentUsecase(&pr, &or, &pp)
+	err := pu.ProcessTimeouts()
+	assert.Equal(t, myerr.DatabaseError, err)
+}
+
+func TestSettlePartialRefund(t *testing.T) {
+	pr := mocks.PaymentRepository{}
+	or := orderMocks.OrderRepository{}
+	pp := mocks.PaymentProvider{}
+
+	pr.On("SelectByOrderId", int64(5)).Return(newTestPayment(models.PaymentStatusHeld), nil)
+	pp.On("Refund", mock.AnythingOfType("*models.Payment"), int64(50)).Return(nil)
+	pp.On("Capture", mock.AnythingOfType("*models.Payment")).Return(nil)
+
+	pu := NewPaymentUsecase(&pr, &or, &pp)
+	p, err := pu.Settle(newTestOrder(models.OrderStatusDisputed), 50)
+	assert.Nil(t, err)
+	assert.Equal(t, models.PaymentStatusReleased, p.Status)
+	assert.Equal(t, int64(50), p.Refunded)
+	pp.AssertExpectations(t)
+	pr.AssertNotCalled(t, "UpdateStatus", mock.Anything)
+}
+
+func TestSettleFullRefund(t *testing.T) {
+	pr := mocks.PaymentRepository{}
+	or := orderMocks.OrderRepository{}
+	pp := mocks.PaymentProvider{}
+
+	pr.On("SelectByOrderId", int64(5)).Return(newTestPayment(models.PaymentStatusHeld), nil)
+	pp.On("Refund", mock.AnythingOfType("*models.Payment"), int64(200)).Return(nil)
+
+	pu := NewPaymentUsecase(&pr, &or, &pp)
+	p, err := pu.Settle(newTestOrder(models.OrderStatusDisputed), 200)
+	assert.Nil(t, err)
+	assert.Equal(t, models.PaymentStatusRefunded, p.Status)
+	pp.AssertNotCalled(t, "Capture", mock.Anything)
+}
+
+func TestSettleTooMuch(t *testing.T) {
+	pr := mocks.PaymentRepository{}
+	or := orderMocks.OrderRepository{}
+	pp := mocks.PaymentProvider{}
+
+	pr.On("SelectByOrderId", int64(5)).Return(newTestPayment(models.PaymentStatusHeld), nil)
+
+	pu := NewPaymentUsecase(&pr, &or, &pp)
+	_, err := pu.Settle(newTestOrder(models.OrderStatusDisputed), 300)
+	assert.Equal(t, myerr.BadRequest, err)
+}
+
+func TestSettleWithoutPayment(t *testing.T) {
+	pr := mocks.PaymentRepository{}
+	or := orderMocks.OrderRepository{}
+	pp := mocks.PaymentProvider{}
+
+	pr.On("SelectByOrderId", int64(5)).Return(nil, myerr.EmptyQuery)
+
+	pu := NewPaymentUsecase(&pr, &or, &pp)
+	p, err := pu.Settle(newTestOrder(models.OrderStatusDisputed), 0)
+	assert.Nil(t, err)
+	assert.Nil(t, p)
+
+	_, err = pu.Settle(newTestOrder(models.OrderStatusDisputed), 100)
+	assert.Equal(t, myerr.PaymentNotAllowed, err)
+}
@@ -0,0 +1,157 @@
+package delivery
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/logging"
+	"yula/internal/pkg/middleware"
+	"yula/internal/pkg/promotion"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	logger logging.Logger = logging.GetLogger()
+)
+
+type PromotionHandler struct {
+	promotionUsecase promotion.PromotionUsecase
+}
+
+func NewPromotionHandler(promotionUsecase promotion.PromotionUsecase) *PromotionHandler {
+	return &PromotionHandler{
+		promotionUsecase: promotionUsecase,
+	}
+}
+
+func (ph *PromotionHandler) Routing(r *mux.Router, sm *middleware.SessionMiddleware) {
+	r.Handle("/adverts/{id:[0-9]+}/promotion", sm.CheckAuthorized(http.HandlerFunc(ph.CheckoutHandler))).Methods(http.MethodPost, http.MethodOptions)
+
+	// уведомления приходят от платежного провайдера, подлинность проверяется подписью
+	r.HandleFunc("/promotion", ph.NotificationHandler).Methods(http.MethodPost, http.MethodOptions)
+}
+
+// CheckoutHandler godoc
+// @Summary Buy promotion
+// @Description Returns payment link for promotion package of own advert
+// @Tags adverts
+// @Accept application/json
+// @Produce application/json
+// @Param id path integer true "Advert id"
+// @Param body body models.PromotionCheckoutRequest true "Package"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyPromotionCheckout}
+// @failure default {object} models.HttpError
+// @Router /adverts/{id}/promotion [post]
+func (ph *PromotionHandler) CheckoutHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	advertId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse advert id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	defer r.Body.Close()
+	input := &models.PromotionCheckoutRequest{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = easyjson.Unmarshal(buf, input)
+	}
+	if err == nil {
+		_, err = govalidator.ValidateStruct(input)
+	}
+	if err != nil {
+		logger.Warnf("invalid data: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	checkout, err := ph.promotionUsecase.Checkout(userId, advertId, input.Package)
+	if err != nil {
+		logger.Warnf("can not start promotion checkout: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyPromotionCheckout{PromotionCheckout: *checkout}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "checkout created", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// NotificationHandler godoc
+// @Summary Promotion payment notification
+// @Description Payment provider notifies about promotion payment, label is userId__advertId__packageId.
+// @Description Notification is signed with sha1_hash, repeated operation_id is ignored
+// @Tags adverts
+// @Accept application/x-www-form-urlencoded
+// @Produce application/json
+// @Success 200 {object} models.HttpBodyInterface
+// @failure default {object} models.HttpError
+// @Router /promotion [post]
+func (ph *PromotionHandler) NotificationHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+
+	err := r.ParseForm()
+	if err != nil {
+		logger.Warnf("invalid form: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	err = ph.promotionUsecase.HandleNotification(r.PostForm)
+	if err != nil {
+		logger.Warnf("cannot handle promotion notification: %s", err.Error())
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		// провайдер повторяет уведомление только при ответе не 200, это нужно лишь при внутренних ошибках
+		if metaCode >= http.StatusInternalServerError {
+			w.WriteHeader(http.StatusInternalServerError)
+		} else {
+			w.WriteHeader(http.StatusOK)
+		}
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(models.ToBytes(http.StatusOK, "notification accepted", nil))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
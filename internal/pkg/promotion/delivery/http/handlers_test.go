@@ -0,0 +1,132 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"yula/internal/models"
+	"yula/internal/pkg/middleware"
+
+	promoMock "yula/internal/pkg/promotion/mocks"
+
+	myerr "yula/internal/error"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestRouter(ph *PromotionHandler, userId int64) *mux.Router {
+	router := mux.NewRouter()
+	router.HandleFunc("/adverts/{id:[0-9]+}/promotion", ph.CheckoutHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.HandleFunc("/promotion", ph.NotificationHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+	router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), middleware.ContextUserId, userId)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	})
+	return router
+}
+
+func TestCheckoutSuccess(t *testing.T) {
+	pu := promoMock.PromotionUsecase{}
+	ph := NewPromotionHandler(&pu)
+
+	srv := httptest.NewServer(newTestRouter(ph, 1))
+	defer srv.Close()
+
+	checkout := &models.PromotionCheckout{AdvertId: 2, Package: "top_3", Price: 199, Label: "1__2__top_3",
+		CheckoutUrl: "fake://promotion"}
+	pu.On("Checkout", int64(1), int64(2), "top_3").Return(checkout, nil)
+
+	res, err := http.Post(fmt.Sprintf("%s/adverts/2/promotion", srv.URL), "application/json",
+		bytes.NewBufferString(`{"package": "top_3"}`))
+	assert.Nil(t, err)
+
+	var answer struct {
+		Code int                      `json:"code"`
+		Body models.PromotionCheckout `json:"body"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, answer.Code)
+	assert.Equal(t, *checkout, answer.Body)
+}
+
+func TestCheckoutInvalidBody(t *testing.T) {
+	pu := promoMock.PromotionUsecase{}
+	ph := NewPromotionHandler(&pu)
+
+	srv := httptest.NewServer(newTestRouter(ph, 1))
+	defer srv.Close()
+
+	res, err := http.Post(fmt.Sprintf("%s/adverts/2/promotion", srv.URL), "application/json",
+		bytes.NewBufferString(`{"package": ""}`))
+	assert.Nil(t, err)
+
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, answer.Code)
+	pu.AssertNotCalled(t, "Checkout", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestNotificationSuccess(t *testing.T) {
+	pu := promoMock.PromotionUsecase{}
+	ph := NewPromotionHandler(&pu)
+
+	srv := httptest.NewServer(newTestRouter(ph, 0))
+	defer srv.Close()
+
+	form := url.Values{"operation_id": {"1234567"}, "label": {"1__2__top_3"}}
+	pu.On("HandleNotification", form).Return(nil)
+
+	res, err := http.PostForm(fmt.Sprintf("%s/promotion", srv.URL), form)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, answer.Code)
+}
+
+func TestNotificationInvalidSignature(t *testing.T) {
+	pu := promoMock.PromotionUsecase{}
+	ph := NewPromotionHandler(&pu)
+
+	srv := httptest.NewServer(newTestRouter(ph, 0))
+	defer srv.Close()
+
+	pu.On("HandleNotification", mock.Anything).Return(myerr.InvalidSignature)
+
+	res, err := http.PostForm(fmt.Sprintf("%s/promotion", srv.URL), url.Values{"operation_id": {"1"}})
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusForbidden, answer.Code)
+}
+
+func TestNotificationInternalErrorIsRetried(t *testing.T) {
+	pu := promoMock.PromotionUsecase{}
+	ph := NewPromotionHandler(&pu)
+
+	srv := httptest.NewServer(newTestRouter(ph, 0))
+	defer srv.Close()
+
+	pu.On("HandleNotification", mock.Anything).Return(myerr.DatabaseError)
+
+	res, err := http.PostForm(fmt.Sprintf("%s/promotion", srv.URL), url.Values{"operation_id": {"1"}})
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+}
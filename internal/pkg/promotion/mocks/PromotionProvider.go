@@ -0,0 +1,67 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+
+	url "net/url"
+)
+
+// PromotionProvider is an autogenerated mock type for the PromotionProvider type
+type PromotionProvider struct {
+	mock.Mock
+}
+
+// CheckoutUrl provides a mock function with given fields: checkout
+func (_m *PromotionProvider) CheckoutUrl(checkout *models.PromotionCheckout) string {
+	ret := _m.Called(checkout)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*models.PromotionCheckout) string); ok {
+		r0 = rf(checkout)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// NetAmount provides a mock function with given fields: price
+func (_m *PromotionProvider) NetAmount(price int64) int64 {
+	ret := _m.Called(price)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(int64) int64); ok {
+		r0 = rf(price)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
+// ParseNotification provides a mock function with given fields: form
+func (_m *PromotionProvider) ParseNotification(form url.Values) (*models.PromotionNotification, error) {
+	ret := _m.Called(form)
+
+	var r0 *models.PromotionNotification
+	if rf, ok := ret.Get(0).(func(url.Values) *models.PromotionNotification); ok {
+		r0 = rf(form)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PromotionNotification)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(url.Values) error); ok {
+		r1 = rf(form)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,102 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PromotionRepository is an autogenerated mock type for the PromotionRepository type
+type PromotionRepository struct {
+	mock.Mock
+}
+
+// Apply provides a mock function with given fields: payment, promo
+func (_m *PromotionRepository) Apply(payment *models.PromotionPayment, promo *models.Promotion) error {
+	ret := _m.Called(payment, promo)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.PromotionPayment, *models.Promotion) error); ok {
+		r0 = rf(payment, promo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Claim provides a mock function with given fields: operationId
+func (_m *PromotionRepository) Claim(operationId string) (*models.PromotionPayment, error) {
+	ret := _m.Called(operationId)
+
+	var r0 *models.PromotionPayment
+	if rf, ok := ret.Get(0).(func(string) *models.PromotionPayment); ok {
+		r0 = rf(operationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PromotionPayment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(operationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: payment
+func (_m *PromotionRepository) Insert(payment *models.PromotionPayment) error {
+	ret := _m.Called(payment)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.PromotionPayment) error); ok {
+		r0 = rf(payment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SelectByOperationId provides a mock function with given fields: operationId
+func (_m *PromotionRepository) SelectByOperationId(operationId string) (*models.PromotionPayment, error) {
+	ret := _m.Called(operationId)
+
+	var r0 *models.PromotionPayment
+	if rf, ok := ret.Get(0).(func(string) *models.PromotionPayment); ok {
+		r0 = rf(operationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PromotionPayment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(operationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateStatus provides a mock function with given fields: payment
+func (_m *PromotionRepository) UpdateStatus(payment *models.PromotionPayment) error {
+	ret := _m.Called(payment)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.PromotionPayment) error); ok {
+		r0 = rf(payment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,53 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+
+	url "net/url"
+)
+
+// PromotionUsecase is an autogenerated mock type for the PromotionUsecase type
+type PromotionUsecase struct {
+	mock.Mock
+}
+
+// Checkout provides a mock function with given fields: userId, advertId, packageId
+func (_m *PromotionUsecase) Checkout(userId int64, advertId int64, packageId string) (*models.PromotionCheckout, error) {
+	ret := _m.Called(userId, advertId, packageId)
+
+	var r0 *models.PromotionCheckout
+	if rf, ok := ret.Get(0).(func(int64, int64, string) *models.PromotionCheckout); ok {
+		r0 = rf(userId, advertId, packageId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PromotionCheckout)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64, string) error); ok {
+		r1 = rf(userId, advertId, packageId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HandleNotification provides a mock function with given fields: form
+func (_m *PromotionUsecase) HandleNotification(form url.Values) error {
+	ret := _m.Called(form)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(url.Values) error); ok {
+		r0 = rf(form)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
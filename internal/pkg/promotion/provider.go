@@ -0,0 +1,16 @@
+package promotion
+
+import (
+	"net/url"
+	"yula/internal/models"
+)
+
+//go:generate mockery -name=PromotionProvider
+
+// провайдер оплаты продвижения: строит ссылку на оплату и проверяет уведомления о зачислении
+type PromotionProvider interface {
+	CheckoutUrl(checkout *models.PromotionCheckout) string
+	ParseNotification(form url.Values) (*models.PromotionNotification, error)
+	// NetAmount - сколько копеек дойдет до получателя при оплате пакета ценой price рублей
+	NetAmount(price int64) int64
+}
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"net/url"
+	"strconv"
+	"yula/internal/models"
+	"yula/internal/pkg/promotion"
+)
+
+// FakeProvider для локального запуска: оплата имитируется формой на /promotion
+// с теми же полями, что у ЮMoney, подпись не проверяется
+type FakeProvider struct{}
+
+func NewFakeProvider() promotion.PromotionProvider {
+	return &FakeProvider{}
+}
+
+func (fp *FakeProvider) CheckoutUrl(checkout *models.PromotionCheckout) string {
+	query := url.Values{}
+	query.Set("sum", strconv.FormatInt(checkout.Price, 10))
+	query.Set("label", checkout.Label)
+	return "fake://promotion?" + query.Encode()
+}
+
+func (fp *FakeProvider) ParseNotification(form url.Values) (*models.PromotionNotification, error) {
+	return parseNotification(form)
+}
+
+func (fp *FakeProvider) NetAmount(price int64) int64 {
+	return price * 100
+}
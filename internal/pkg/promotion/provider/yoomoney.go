@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/promotion"
+)
+
+const (
+	yooMoneyCheckoutUrl = "https://yoomoney.ru/quickpay/confirm.xml"
+	// код рубля по ISO 4217
+	yooMoneyCurrencyRUB = "643"
+	// комиссия ЮMoney за перевод с карты в процентах, ее удерживают из суммы перевода
+	yooMoneyCardFeePercent = 3
+)
+
+// поля уведомления в том порядке, в котором они входят в подпись, секрет стоит перед label
+var yooMoneySignedFields = []string{
+	"notification_type", "operation_id", "amount", "currency", "datetime", "sender", "codepro",
+}
+
+// YooMoneyProvider принимает оплату продвижения переводом на кошелек ЮMoney,
+// уведомления подписаны SHA-1 от полей уведомления и секрета кошелька
+type YooMoneyProvider struct {
+	wallet string
+	secret string
+}
+
+func NewYooMoneyProvider(wallet string, secret string) promotion.PromotionProvider {
+	return &YooMoneyProvider{
+		wallet: wallet,
+		secret: secret,
+	}
+}
+
+func (yp *YooMoneyProvider) CheckoutUrl(checkout *models.PromotionCheckout) string {
+	query := url.Values{}
+	query.Set("receiver", yp.wallet)
+	query.Set("quickpay-form", "shop")
+	query.Set("targets", fmt.Sprintf("Продвижение объявления %d", checkout.AdvertId))
+	query.Set("paymentType", "AC")
+	query.Set("sum", strconv.FormatInt(checkout.Price, 10))
+	query.Set("label", checkout.Label)
+	return yooMoneyCheckoutUrl + "?" + query.Encode()
+}
+
+func (yp *YooMoneyProvider) NetAmount(price int64) int64 {
+	return price * (100 - yooMoneyCardFeePercent)
+}
+
+func (yp *YooMoneyProvider) sign(form url.Values) string {
+	values := make([]string, 0, len(yooMoneySignedFields)+2)
+	for _, field := range yooMoneySignedFields {
+		values = append(values, form.Get(field))
+	}
+	values = append(values, yp.secret, form.Get("label"))
+
+	hash := sha1.Sum([]byte(strings.Join(values, "&")))
+	return hex.EncodeToString(hash[:])
+}
+
+func (yp *YooMoneyProvider) ParseNotification(form url.Values) (*models.PromotionNotification, error) {
+	expected := yp.sign(form)
+	got := strings.ToLower(form.Get("sha1_hash"))
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(got)) != 1 {
+		return nil, internalError.InvalidSignature
+	}
+
+	if form.Get("currency") != yooMoneyCurrencyRUB {
+		return nil, internalError.BadRequest
+	}
+	return parseNotification(form)
+}
+
+// parseNotification разбирает поля уведомления, подпись к этому моменту уже проверена
+func parseNotification(form url.Values) (*models.PromotionNotification, error) {
+	// берем amount - сколько дошло до кошелька за вычетом комиссии, только оно входит в подпись,
+	// withdraw_amount не подписан и его можно подменить
+	kopecks, err := parseKopecks(form.Get("amount"))
+	if err != nil {
+		return nil, internalError.BadRequest
+	}
+
+	notification := &models.PromotionNotification{
+		OperationId: form.Get("operation_id"),
+		Label:       form.Get("label"),
+		Amount:      kopecks,
+		Unaccepted:  form.Get("codepro") == "true" || form.Get("unaccepted") == "true",
+	}
+	if notification.OperationId == "" || len(notification.OperationId) > 64 {
+		return nil, internalError.BadRequest
+	}
+	return notification, nil
+}
+
+// parseKopecks переводит сумму вида "199.00" в копейки без потерь на float
+func parseKopecks(amount string) (int64, error) {
+	rubles, fraction := amount, ""
+	if dot := strings.IndexByte(amount, '.'); dot >= 0 {
+		rubles, fraction = amount[:dot], amount[dot+1:]
+	}
+	if rubles == "" || len(fraction) > 2 {
+		return 0, fmt.Errorf("invalid amount %q", amount)
+	}
+	fraction += strings.Repeat("0", 2-len(fraction))
+
+	whole, err := strconv.ParseUint(rubles, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	part, err := strconv.ParseUint(fraction, 10, 8)
+	if err != nil {
+		return 0, err
+	}
+	return int64(whole)*100 + int64(part), nil
+}
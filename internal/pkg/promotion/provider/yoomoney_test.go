@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"net/url"
+	"testing"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func notificationForm(secret string) url.Values {
+	form := url.Values{}
+	form.Set("notification_type", "card-incoming")
+	form.Set("operation_id", "1234567")
+	form.Set("amount", "193.03")
+	form.Set("withdraw_amount", "199.00")
+	form.Set("currency", "643")
+	form.Set("datetime", "2021-12-10T12:00:00Z")
+	form.Set("sender", "")
+	form.Set("codepro", "false")
+	form.Set("label", "1__2__top_3")
+
+	hash := sha1.Sum([]byte("card-incoming&1234567&193.03&643&2021-12-10T12:00:00Z&&false&" + secret + "&1__2__top_3"))
+	form.Set("sha1_hash", hex.EncodeToString(hash[:]))
+	return form
+}
+
+func TestYooMoneyParseNotification(t *testing.T) {
+	yp := NewYooMoneyProvider("4100", "secret")
+
+	n, err := yp.ParseNotification(notificationForm("secret"))
+	assert.NoError(t, err)
+	assert.Equal(t, "1234567", n.OperationId)
+	assert.Equal(t, "1__2__top_3", n.Label)
+	assert.Equal(t, int64(19303), n.Amount)
+	assert.False(t, n.Unaccepted)
+	assert.GreaterOrEqual(t, n.Amount, yp.NetAmount(199))
+}
+
+func TestYooMoneyIgnoresWithdrawAmount(t *testing.T) {
+	yp := NewYooMoneyProvider("4100", "secret")
+
+	// withdraw_amount не входит в подпись, его подмена не должна влиять на сумму
+	form := notificationForm("secret")
+	form.Set("withdraw_amount", "100000.00")
+	n, err := yp.ParseNotification(form)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(19303), n.Amount)
+}
+
+func TestYooMoneyInvalidSignature(t *testing.T) {
+	yp := NewYooMoneyProvider("4100", "secret")
+
+	_, err := yp.ParseNotification(notificationForm("other"))
+	assert.Equal(t, internalError.InvalidSignature, err)
+
+	// подменили метку после подписи
+	form := notificationForm("secret")
+	form.Set("label", "3__2__top_3")
+	_, err = yp.ParseNotification(form)
+	assert.Equal(t, internalError.InvalidSignature, err)
+}
+
+func TestYooMoneyUnaccepted(t *testing.T) {
+	yp := NewYooMoneyProvider("4100", "secret")
+
+	form := notificationForm("secret")
+	form.Set("unaccepted", "true")
+	n, err := yp.ParseNotification(form)
+	assert.NoError(t, err)
+	assert.True(t, n.Unaccepted)
+}
+
+func TestYooMoneyCheckoutUrl(t *testing.T) {
+	yp := NewYooMoneyProvider("4100", "secret")
+
+	link := yp.CheckoutUrl(&models.PromotionCheckout{AdvertId: 2, Price: 199, Label: "1__2__top_3"})
+	u, err := url.Parse(link)
+	assert.NoError(t, err)
+	assert.Equal(t, "yoomoney.ru", u.Host)
+	assert.Equal(t, "4100", u.Query().Get("receiver"))
+	assert.Equal(t, "199", u.Query().Get("sum"))
+	assert.Equal(t, "1__2__top_3", u.Query().Get("label"))
+}
+
+func TestParseKopecks(t *testing.T) {
+	cases := map[string]int64{"199": 19900, "199.5": 19950, "199.05": 19905, "0.99": 99}
+	for amount, expected := range cases {
+		kopecks, err := parseKopecks(amount)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, kopecks, amount)
+	}
+
+	for _, amount := range []string{"", ".5", "1.999", "-1", "abc"} {
+		_, err := parseKopecks(amount)
+		assert.Error(t, err, amount)
+	}
+}
+
+func TestFakeParseNotification(t *testing.T) {
+	fp := NewFakeProvider()
+
+	form := url.Values{}
+	form.Set("operation_id", "fake-1")
+	form.Set("amount", "199")
+	form.Set("label", "1__2__top_3")
+	n, err := fp.ParseNotification(form)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(19900), n.Amount)
+
+	form.Del("operation_id")
+	_, err = fp.ParseNotification(form)
+	assert.Equal(t, internalError.BadRequest, err)
+}
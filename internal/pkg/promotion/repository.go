@@ -0,0 +1,13 @@
+package promotion
+
+import "yula/internal/models"
+
+//go:generate mockery -name=PromotionRepository
+
+type PromotionRepository interface {
+	Insert(payment *models.PromotionPayment) error
+	SelectByOperationId(operationId string) (*models.PromotionPayment, error)
+	UpdateStatus(payment *models.PromotionPayment) error
+	Claim(operationId string) (*models.PromotionPayment, error)
+	Apply(payment *models.PromotionPayment, promo *models.Promotion) error
+}
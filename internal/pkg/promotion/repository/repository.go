@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/promotion"
+)
+
+type PromotionRepository struct {
+	DB *sql.DB
+}
+
+func NewPromotionRepository(DB *sql.DB) promotion.PromotionRepository {
+	return &PromotionRepository{
+		DB: DB,
+	}
+}
+
+// Insert заводит платеж, повторное уведомление с тем же operation_id не вставляется
+func (pr *PromotionRepository) Insert(p *models.PromotionPayment) error {
+	queryStr := `INSERT INTO payments (operation_id, user_id, advert_id, package, amount, status)
+				VALUES ($1, $2, $3, $4, $5, $6)
+				ON CONFLICT (operation_id) DO NOTHING RETURNING id, created_at, updated_at;`
+	query := pr.DB.QueryRowContext(context.Background(), queryStr,
+		p.OperationId, p.UserId, p.AdvertId, p.Package, p.Amount, p.Status)
+
+	err := query.Scan(&p.Id, &p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return internalError.PromotionPaymentDuplicate
+		}
+		return internalError.GenInternalError(err)
+	}
+
+	return nil
+}
+
+func (pr *PromotionRepository) SelectByOperationId(operationId string) (*models.PromotionPayment, error) {
+	query := pr.DB.QueryRowContext(context.Background(),
+		`SELECT id, operation_id, user_id, advert_id, package, amount, status, created_at, updated_at
+		FROM payments WHERE operation_id = $1;`, operationId)
+
+	var p models.PromotionPayment
+	err := query.Scan(&p.Id, &p.OperationId, &p.UserId, &p.AdvertId, &p.Package, &p.Amount, &p.Status,
+		&p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return nil, internalError.EmptyQuery
+		}
+		return nil, internalError.GenInternalError(err)
+	}
+
+	return &p, nil
+}
+
+func (pr *PromotionRepository) UpdateStatus(p *models.PromotionPayment) error {
+	query := pr.DB.QueryRowContext(context.Background(),
+		`UPDATE payments SET status = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 RETURNING updated_at;`,
+		p.Id, p.Status)
+
+	err := query.Scan(&p.UpdatedAt)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return internalError.EmptyQuery
+		}
+		return internalError.GenInternalError(err)
+	}
+
+	return nil
+}
+
+// Claim забирает в обработку платеж, уведомление по которому еще не применено или упало с внутренней ошибкой.
+// Из параллельных повторов платеж получает только один, остальным возвращается EmptyQuery
+func (pr *PromotionRepository) Claim(operationId string) (*models.PromotionPayment, error) {
+	query := pr.DB.QueryRowContext(context.Background(),
+		`UPDATE payments SET status = 'processing', updated_at = CURRENT_TIMESTAMP
+		WHERE operation_id = $1 AND status IN ('pending', 'failed')
+		RETURNING id, operation_id, user_id, advert_id, package, amount, status, created_at, updated_at;`, operationId)
+
+	var p models.PromotionPayment
+	err := query.Scan(&p.Id, &p.OperationId, &p.UserId, &p.AdvertId, &p.Package, &p.Amount, &p.Status,
+		&p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return nil, internalError.EmptyQuery
+		}
+		return nil, internalError.GenInternalError(err)
+	}
+
+	return &p, nil
+}
+
+// Apply продлевает продвижение и отмечает платеж примененным одной транзакцией
+func (pr *PromotionRepository) Apply(p *models.PromotionPayment, promo *models.Promotion) error {
+	tx, err := pr.DB.BeginTx(context.Background(), nil)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	_, err = tx.ExecContext(context.Background(),
+		"UPDATE promotion SET promo_level = $2, promo_start = $3, promo_until = $4 WHERE advert_id = $1;",
+		promo.AdvertId, promo.PromoLevel, promo.UpdateTime, promo.PromoUntil)
+	if err == nil {
+		query := tx.QueryRowContext(context.Background(),
+			`UPDATE payments SET status = 'applied', updated_at = CURRENT_TIMESTAMP
+			WHERE id = $1 AND status = 'processing' RETURNING updated_at;`, p.Id)
+		err = query.Scan(&p.UpdatedAt)
+		if err != nil {
+			res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+			if res {
+				err = internalError.EmptyQuery
+			}
+		}
+	}
+	if err != nil {
+		if rlbckEr := tx.Rollback(); rlbckEr != nil {
+			return internalError.RollbackError
+		}
+		if err == internalError.EmptyQuery {
+			return err
+		}
+		return internalError.GenInternalError(err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return internalError.NotCommited
+	}
+
+	p.Status = models.PromotionPaymentApplied
+	return nil
+}
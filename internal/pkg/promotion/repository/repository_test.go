@@ -0,0 +1,254 @@
+package repository
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	sqlmock "gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+var testColumns = []string{"id", "operation_id", "user_id", "advert_id", "package", "amount", "status",
+	"created_at", "updated_at"}
+
+func testPayment() *models.PromotionPayment {
+	return &models.PromotionPayment{OperationId: "1234567", UserId: 1, AdvertId: 2, Package: "top_3",
+		Amount: 19900, Status: models.PromotionPaymentPending}
+}
+
+func TestInsertOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewPromotionRepository(db)
+	p := testPayment()
+
+	mock.ExpectQuery("INSERT INTO payments").
+		WithArgs(p.OperationId, p.UserId, p.AdvertId, p.Package, p.Amount, p.Status).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(1, time.Now(), time.Now()))
+
+	err = repo.Insert(p)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), p.Id)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestInsertDuplicate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewPromotionRepository(db)
+	p := testPayment()
+
+	mock.ExpectQuery("INSERT INTO payments").
+		WithArgs(p.OperationId, p.UserId, p.AdvertId, p.Package, p.Amount, p.Status).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}))
+
+	err = repo.Insert(p)
+	assert.Equal(t, internalError.PromotionPaymentDuplicate, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestInsertError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewPromotionRepository(db)
+	p := testPayment()
+
+	mock.ExpectQuery("INSERT INTO payments").
+		WithArgs(p.OperationId, p.UserId, p.AdvertId, p.Package, p.Amount, p.Status).
+		WillReturnError(sql.ErrConnDone)
+
+	err = repo.Insert(p)
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectByOperationIdOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewPromotionRepository(db)
+
+	mock.ExpectQuery("SELECT .* FROM payments WHERE operation_id").
+		WithArgs("1234567").
+		WillReturnRows(sqlmock.NewRows(testColumns).AddRow(1, "1234567", 1, 2, "top_3", 19900,
+			models.PromotionPaymentApplied, time.Now(), time.Now()))
+
+	p, err := repo.SelectByOperationId("1234567")
+	assert.NoError(t, err)
+	assert.Equal(t, models.PromotionPaymentApplied, p.Status)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectByOperationIdEmpty(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewPromotionRepository(db)
+
+	mock.ExpectQuery("SELECT .* FROM payments WHERE operation_id").
+		WithArgs("1234567").
+		WillReturnRows(sqlmock.NewRows(testColumns))
+
+	_, err = repo.SelectByOperationId("1234567")
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateStatusOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewPromotionRepository(db)
+	p := testPayment()
+	p.Id = 1
+	p.Status = models.PromotionPaymentApplied
+
+	mock.ExpectQuery("UPDATE payments SET status").
+		WithArgs(p.Id, p.Status).
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(time.Now()))
+
+	err = repo.UpdateStatus(p)
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateStatusError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewPromotionRepository(db)
+	p := testPayment()
+	p.Id = 1
+
+	mock.ExpectQuery("UPDATE payments SET status").
+		WithArgs(p.Id, p.Status).
+		WillReturnError(sql.ErrConnDone)
+
+	err = repo.UpdateStatus(p)
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestClaimOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewPromotionRepository(db)
+
+	mock.ExpectQuery("UPDATE payments SET status = 'processing'").WithArgs("1234567").
+		WillReturnRows(sqlmock.NewRows(testColumns).AddRow(1, "1234567", 1, 2, "top_3", 19900,
+			models.PromotionPaymentProcessing, time.Now(), time.Now()))
+
+	p, err := repo.Claim("1234567")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), p.Id)
+	assert.Equal(t, models.PromotionPaymentProcessing, p.Status)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestClaimAlreadyTaken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewPromotionRepository(db)
+
+	mock.ExpectQuery("UPDATE payments SET status = 'processing'").WithArgs("1234567").
+		WillReturnRows(sqlmock.NewRows(testColumns))
+
+	_, err = repo.Claim("1234567")
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestApplyOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewPromotionRepository(db)
+	p := testPayment()
+	p.Id, p.Status = 1, models.PromotionPaymentProcessing
+	promo := &models.Promotion{AdvertId: 2, Package: "top_3", PromoLevel: 3, UpdateTime: time.Now(), PromoUntil: time.Now()}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE promotion SET promo_level").
+		WithArgs(promo.AdvertId, promo.PromoLevel, promo.UpdateTime, promo.PromoUntil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("UPDATE payments SET status = 'applied'").WithArgs(p.Id).
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(time.Now()))
+	mock.ExpectCommit()
+
+	err = repo.Apply(p, promo)
+	assert.NoError(t, err)
+	assert.Equal(t, models.PromotionPaymentApplied, p.Status)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestApplyNotProcessing(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewPromotionRepository(db)
+	p := testPayment()
+	p.Id = 1
+	promo := &models.Promotion{AdvertId: 2, Package: "top_3", PromoLevel: 3, UpdateTime: time.Now(), PromoUntil: time.Now()}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE promotion SET promo_level").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("UPDATE payments SET status = 'applied'").WithArgs(p.Id).
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}))
+	mock.ExpectRollback()
+
+	err = repo.Apply(p, promo)
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
@@ -0,0 +1,13 @@
+package promotion
+
+import (
+	"net/url"
+	"yula/internal/models"
+)
+
+//go:generate mockery -name=PromotionUsecase
+
+type PromotionUsecase interface {
+	Checkout(userId int64, advertId int64, packageId string) (*models.PromotionCheckout, error)
+	HandleNotification(form url.Values) error
+}
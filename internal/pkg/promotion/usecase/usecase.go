@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"net/http"
+	"net/url"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/advt"
+	"yula/internal/pkg/logging"
+	"yula/internal/pkg/promotion"
+)
+
+var logger logging.Logger = logging.GetLogger()
+
+type PromotionUsecase struct {
+	promotionRepository promotion.PromotionRepository
+	advtRepository      advt.AdvtRepository
+	advtUsecase         advt.AdvtUsecase
+	provider            promotion.PromotionProvider
+}
+
+func NewPromotionUsecase(promotionRepository promotion.PromotionRepository, advtRepository advt.AdvtRepository,
+	advtUsecase advt.AdvtUsecase, provider promotion.PromotionProvider) promotion.PromotionUsecase {
+	return &PromotionUsecase{
+		promotionRepository: promotionRepository,
+		advtRepository:      advtRepository,
+		advtUsecase:         advtUsecase,
+		provider:            provider,
+	}
+}
+
+// Checkout выдает ссылку на оплату пакета, продвигать можно только свое объявление
+func (pu *PromotionUsecase) Checkout(userId int64, advertId int64, packageId string) (*models.PromotionCheckout, error) {
+	pkg := models.GetPromotionPackage(packageId)
+	if pkg == nil {
+		return nil, internalError.BadRequest
+	}
+
+	advert, err := pu.advtRepository.SelectById(advertId)
+	if err == internalError.EmptyQuery {
+		return nil, internalError.NotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	if advert.PublisherId != userId {
+		return nil, internalError.Conflict
+	}
+
+	checkout := &models.PromotionCheckout{
+		AdvertId: advertId,
+		Package:  pkg.Id,
+		Price:    pkg.Price,
+		Label:    models.PromotionLabel(userId, advertId, pkg.Id),
+	}
+	checkout.CheckoutUrl = pu.provider.CheckoutUrl(checkout)
+	return checkout, nil
+}
+
+// HandleNotification применяет оплаченное продвижение. Каждое уведомление записывается в payments,
+// повторное уведомление с тем же operation_id применяется, только если прошлое не упало с внутренней ошибкой
+// и не пришло по еще не зачисленному переводу. Платеж применяет тот, кто забрал его в processing
+func (pu *PromotionUsecase) HandleNotification(form url.Values) error {
+	notification, err := pu.provider.ParseNotification(form)
+	if err != nil {
+		return err
+	}
+
+	userId, advertId, packageId, labelErr := models.ParsePromotionLabel(notification.Label)
+	unaccepted := labelErr == nil && notification.Unaccepted
+
+	p := &models.PromotionPayment{
+		OperationId: notification.OperationId,
+		Amount:      notification.Amount,
+		Status:      models.PromotionPaymentProcessing,
+	}
+	// pending остается у еще не зачисленного перевода, уведомление о зачислении придет с тем же operation_id
+	if unaccepted {
+		p.Status = models.PromotionPaymentPending
+	}
+	if labelErr == nil {
+		p.UserId, p.AdvertId, p.Package = userId, advertId, packageId
+	}
+
+	err = pu.promotionRepository.Insert(p)
+	if unaccepted && (err == nil || err == internalError.PromotionPaymentDuplicate) {
+		logger.Infof("promotion payment %s is not accepted yet", notification.OperationId)
+		return nil
+	}
+	if err == internalError.PromotionPaymentDuplicate {
+		p, err = pu.promotionRepository.Claim(notification.OperationId)
+		if err == internalError.EmptyQuery {
+			logger.Infof("promotion payment %s already processed or is being processed", notification.OperationId)
+			return nil
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	var applyErr error
+	if labelErr != nil {
+		logger.Warnf("promotion payment %s: %s", p.OperationId, labelErr.Error())
+		applyErr = internalError.BadRequest
+	} else {
+		applyErr = pu.apply(p, notification)
+	}
+	if applyErr == nil {
+		return nil
+	}
+
+	// внутренние ошибки можно повторить следующим уведомлением, остальные платежи отклоняются насовсем
+	p.Status = models.PromotionPaymentRejected
+	if code, _ := internalError.ToMetaStatus(applyErr); code >= http.StatusInternalServerError {
+		p.Status = models.PromotionPaymentFailed
+	}
+
+	err = pu.promotionRepository.UpdateStatus(p)
+	if err != nil {
+		return err
+	}
+	return applyErr
+}
+
+func (pu *PromotionUsecase) apply(p *models.PromotionPayment, notification *models.PromotionNotification) error {
+	pkg := models.GetPromotionPackage(p.Package)
+	if pkg == nil {
+		return internalError.BadRequest
+	}
+
+	// зачисленную сумму сверяем с ценой пакета за вычетом комиссии
+	if notification.Amount < pu.provider.NetAmount(pkg.Price) {
+		return internalError.PromotionUnderpaid
+	}
+
+	promo := &models.Promotion{
+		AdvertId: p.AdvertId,
+		Package:  p.Package,
+	}
+	err := pu.advtUsecase.PreparePromotion(p.UserId, promo)
+	if err != nil {
+		return err
+	}
+	return pu.promotionRepository.Apply(p, promo)
+}
@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"net/url"
+	"testing"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+
+	advtMock "yula/internal/pkg/advt/mocks"
+	promoMock "yula/internal/pkg/promotion/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type testDeps struct {
+	pr promoMock.PromotionRepository
+	ar advtMock.AdvtRepository
+	au advtMock.AdvtUsecase
+	pp promoMock.PromotionProvider
+}
+
+func newTestUsecase(d *testDeps) *PromotionUsecase {
+	// top_3 стоит 199 рублей, до кошелька доходит за вычетом комиссии
+	d.pp.On("NetAmount", int64(199)).Return(int64(19303))
+	return NewPromotionUsecase(&d.pr, &d.ar, &d.au, &d.pp).(*PromotionUsecase)
+}
+
+var testForm = url.Values{"operation_id": {"1234567"}}
+
+func notification(amount int64) *models.PromotionNotification {
+	return &models.PromotionNotification{OperationId: "1234567", Label: "1__2__top_3", Amount: amount}
+}
+
+func TestCheckoutSuccess(t *testing.T) {
+	d := &testDeps{}
+	pu := newTestUsecase(d)
+
+	d.ar.On("SelectById", int64(2)).Return(&models.Advert{Id: 2, PublisherId: 1}, nil)
+	d.pp.On("CheckoutUrl", mock.Anything).Return("fake://promotion")
+
+	checkout, err := pu.Checkout(1, 2, "top_3")
+	assert.NoError(t, err)
+	assert.Equal(t, "1__2__top_3", checkout.Label)
+	assert.Equal(t, int64(199), checkout.Price)
+	assert.Equal(t, "fake://promotion", checkout.CheckoutUrl)
+}
+
+func TestCheckoutErrors(t *testing.T) {
+	d := &testDeps{}
+	pu := newTestUsecase(d)
+
+	_, err := pu.Checkout(1, 2, "top_100")
+	assert.Equal(t, internalError.BadRequest, err)
+
+	d.ar.On("SelectById", int64(2)).Return(&models.Advert{Id: 2, PublisherId: 3}, nil)
+	_, err = pu.Checkout(1, 2, "top_3")
+	assert.Equal(t, internalError.Conflict, err)
+
+	d.ar.On("SelectById", int64(4)).Return(nil, internalError.EmptyQuery)
+	_, err = pu.Checkout(1, 4, "top_3")
+	assert.Equal(t, internalError.NotExist, err)
+	d.pp.AssertNotCalled(t, "CheckoutUrl", mock.Anything)
+}
+
+func TestHandleNotificationApplied(t *testing.T) {
+	d := &testDeps{}
+	pu := newTestUsecase(d)
+
+	d.pp.On("ParseNotification", testForm).Return(notification(19900), nil)
+	d.pr.On("Insert", mock.MatchedBy(func(p *models.PromotionPayment) bool {
+		return p.UserId == 1 && p.AdvertId == 2 && p.Package == "top_3" && p.Amount == 19900
+	})).Return(nil)
+	d.au.On("PreparePromotion", int64(1), &models.Promotion{AdvertId: 2, Package: "top_3"}).Return(nil)
+	d.pr.On("Apply", mock.MatchedBy(func(p *models.PromotionPayment) bool {
+		return p.Status == models.PromotionPaymentProcessing
+	}), &models.Promotion{AdvertId: 2, Package: "top_3"}).Return(nil)
+
+	err := pu.HandleNotification(testForm)
+	assert.NoError(t, err)
+	d.pr.AssertExpectations(t)
+	d.au.AssertExpectations(t)
+	d.pr.AssertNotCalled(t, "UpdateStatus", mock.Anything)
+}
+
+func TestHandleNotificationDuplicate(t *testing.T) {
+	d := &testDeps{}
+	pu := newTestUsecase(d)
+
+	d.pp.On("ParseNotification", testForm).Return(notification(19900), nil)
+	d.pr.On("Insert", mock.Anything).Return(internalError.PromotionPaymentDuplicate)
+	// платеж уже применен или его сейчас применяет другое уведомление
+	d.pr.On("Claim", "1234567").Return(nil, internalError.EmptyQuery)
+
+	err := pu.HandleNotification(testForm)
+	assert.NoError(t, err)
+	d.au.AssertNotCalled(t, "PreparePromotion", mock.Anything, mock.Anything)
+	d.pr.AssertNotCalled(t, "Apply", mock.Anything, mock.Anything)
+	d.pr.AssertNotCalled(t, "UpdateStatus", mock.Anything)
+}
+
+func TestHandleNotificationRetryFailed(t *testing.T) {
+	d := &testDeps{}
+	pu := newTestUsecase(d)
+
+	d.pp.On("ParseNotification", testForm).Return(notification(19900), nil)
+	d.pr.On("Insert", mock.Anything).Return(internalError.PromotionPaymentDuplicate)
+	d.pr.On("Claim", "1234567").Return(&models.PromotionPayment{Id: 1, OperationId: "1234567",
+		UserId: 1, AdvertId: 2, Package: "top_3", Amount: 19900, Status: models.PromotionPaymentProcessing}, nil)
+	d.au.On("PreparePromotion", int64(1), mock.Anything).Return(nil)
+	d.pr.On("Apply", mock.Anything, mock.Anything).Return(nil)
+
+	err := pu.HandleNotification(testForm)
+	assert.NoError(t, err)
+	d.au.AssertExpectations(t)
+	d.pr.AssertExpectations(t)
+}
+
+func TestHandleNotificationUnaccepted(t *testing.T) {
+	d := &testDeps{}
+	pu := newTestUsecase(d)
+
+	n := notification(19900)
+	n.Unaccepted = true
+	d.pp.On("ParseNotification", testForm).Return(n, nil)
+	d.pr.On("Insert", mock.MatchedBy(func(p *models.PromotionPayment) bool {
+		return p.Status == models.PromotionPaymentPending
+	})).Return(nil)
+
+	err := pu.HandleNotification(testForm)
+	assert.NoError(t, err)
+	d.au.AssertNotCalled(t, "PreparePromotion", mock.Anything, mock.Anything)
+	d.pr.AssertNotCalled(t, "UpdateStatus", mock.Anything)
+}
+
+func TestHandleNotificationAcceptedAfterPending(t *testing.T) {
+	d := &testDeps{}
+	pu := newTestUsecase(d)
+
+	d.pp.On("ParseNotification", testForm).Return(notification(19900), nil)
+	d.pr.On("Insert", mock.Anything).Return(internalError.PromotionPaymentDuplicate)
+	d.pr.On("Claim", "1234567").Return(&models.PromotionPayment{Id: 1, OperationId: "1234567",
+		UserId: 1, AdvertId: 2, Package: "top_3", Amount: 19900, Status: models.PromotionPaymentProcessing}, nil)
+	d.au.On("PreparePromotion", int64(1), &models.Promotion{AdvertId: 2, Package: "top_3"}).Return(nil)
+	d.pr.On("Apply", mock.MatchedBy(func(p *models.PromotionPayment) bool {
+		return p.Id == 1
+	}), mock.Anything).Return(nil)
+
+	err := pu.HandleNotification(testForm)
+	assert.NoError(t, err)
+	d.au.AssertExpectations(t)
+	d.pr.AssertExpectations(t)
+}
+
+func TestHandleNotificationUnderpaid(t *testing.T) {
+	d := &testDeps{}
+	pu := newTestUsecase(d)
+
+	d.pp.On("ParseNotification", testForm).Return(notification(19302), nil)
+	d.pr.On("Insert", mock.Anything).Return(nil)
+	d.pr.On("UpdateStatus", mock.MatchedBy(func(p *models.PromotionPayment) bool {
+		return p.Status == models.PromotionPaymentRejected
+	})).Return(nil)
+
+	err := pu.HandleNotification(testForm)
+	assert.Equal(t, internalError.PromotionUnderpaid, err)
+	d.au.AssertNotCalled(t, "PreparePromotion", mock.Anything, mock.Anything)
+	d.pr.AssertExpectations(t)
+}
+
+func TestHandleNotificationNotOwner(t *testing.T) {
+	d := &testDeps{}
+	pu := newTestUsecase(d)
+
+	d.pp.On("ParseNotification", testForm).Return(notification(19900), nil)
+	d.pr.On("Insert", mock.Anything).Return(nil)
+	d.au.On("PreparePromotion", int64(1), mock.Anything).Return(internalError.Conflict)
+	d.pr.On("UpdateStatus", mock.MatchedBy(func(p *models.PromotionPayment) bool {
+		return p.Status == models.PromotionPaymentRejected
+	})).Return(nil)
+
+	err := pu.HandleNotification(testForm)
+	assert.Equal(t, internalError.Conflict, err)
+	d.pr.AssertExpectations(t)
+}
+
+func TestHandleNotificationFailed(t *testing.T) {
+	d := &testDeps{}
+	pu := newTestUsecase(d)
+
+	d.pp.On("ParseNotification", testForm).Return(notification(19900), nil)
+	d.pr.On("Insert", mock.Anything).Return(nil)
+	d.au.On("PreparePromotion", int64(1), mock.Anything).Return(nil)
+	d.pr.On("Apply", mock.Anything, mock.Anything).Return(internalError.DatabaseError)
+	d.pr.On("UpdateStatus", mock.MatchedBy(func(p *models.PromotionPayment) bool {
+		return p.Status == models.PromotionPaymentFailed
+	})).Return(nil)
+
+	err := pu.HandleNotification(testForm)
+	assert.Equal(t, internalError.DatabaseError, err)
+	d.pr.AssertExpectations(t)
+}
+
+func TestHandleNotificationInvalidSignature(t *testing.T) {
+	d := &testDeps{}
+	pu := newTestUsecase(d)
+
+	d.pp.On("ParseNotification", testForm).Return(nil, internalError.InvalidSignature)
+
+	err := pu.HandleNotification(testForm)
+	assert.Equal(t, internalError.InvalidSignature, err)
+	d.pr.AssertNotCalled(t, "Insert", mock.Anything)
+}
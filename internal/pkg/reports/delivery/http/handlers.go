@@ -0,0 +1,270 @@
+package delivery
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/logging"
+	"yula/internal/pkg/middleware"
+	"yula/internal/pkg/reports"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	logger logging.Logger = logging.GetLogger()
+)
+
+type ReportHandler struct {
+	reportUsecase reports.ReportUsecase
+}
+
+func NewReportHandler(reportUsecase reports.ReportUsecase) *ReportHandler {
+	return &ReportHandler{
+		reportUsecase: reportUsecase,
+	}
+}
+
+func (rh *ReportHandler) Routing(r *mux.Router, sm *middleware.SessionMiddleware) {
+	r.Handle("/reports", sm.CheckAuthorized(http.HandlerFunc(rh.CreateReportHandler))).Methods(http.MethodPost, http.MethodOptions)
+
+	r.Handle("/admin/reports", middleware.SetSCRFToken(sm.CheckAuthorized(middleware.RequireModerator(http.HandlerFunc(rh.ReportQueueHandler))))).Methods(http.MethodGet, http.MethodOptions)
+	r.Handle("/admin/reports/{type:advert|user|message}/{id:[0-9]+}", middleware.SetSCRFToken(sm.CheckAuthorized(middleware.RequireModerator(http.HandlerFunc(rh.TargetReportsHandler))))).Methods(http.MethodGet, http.MethodOptions)
+	r.Handle("/admin/reports/{type:advert|user|message}/{id:[0-9]+}/resolve", sm.CheckAuthorized(middleware.RequireModerator(http.HandlerFunc(rh.ResolveHandler)))).Methods(http.MethodPost, http.MethodOptions)
+}
+
+// CreateReportHandler godoc
+// @Summary Report abuse
+// @Description Report advert, user or received chat message, one report per target
+// @Tags reports
+// @Accept application/json
+// @Produce application/json
+// @Param body body models.ReportInput true "Report"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyReport}
+// @failure default {object} models.HttpError
+// @Router /reports [post]
+func (rh *ReportHandler) CreateReportHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	defer r.Body.Close()
+	input := &models.ReportInput{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = easyjson.Unmarshal(buf, input)
+	}
+	if err == nil {
+		_, err = govalidator.ValidateStruct(input)
+	}
+	if err != nil {
+		logger.Warnf("invalid data: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	sanitizer := bluemonday.UGCPolicy()
+	input.Comment = sanitizer.Sanitize(input.Comment)
+
+	report, err := rh.reportUsecase.CreateReport(userId, input)
+	if err != nil {
+		logger.Warnf("can not create report: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyReport{Report: *report}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "report sent", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// ReportQueueHandler godoc
+// @Summary Reports queue
+// @Description Reported targets with open reports, most reported first
+// @Tags reports
+// @Produce application/json
+// @Param page query string false "Page"
+// @Param count query string false "Count"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyReportTargets}
+// @failure default {object} models.HttpError
+// @Router /admin/reports [get]
+func (rh *ReportHandler) ReportQueueHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+
+	u, err := url.Parse(r.URL.RequestURI())
+	if err != nil {
+		logger.Warnf("can not parse path: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	query := u.Query()
+	page, err := models.NewPage(query.Get("page"), query.Get("count"))
+	if err != nil {
+		logger.Warnf("can not create page: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	targets, err := rh.reportUsecase.GetQueue(page)
+	if err != nil {
+		logger.Warnf("unable to get reports queue: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyReportTargets{Targets: targets}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "reports queue got successfully", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// TargetReportsHandler godoc
+// @Summary Target reports
+// @Description All reports on one advert, user or message
+// @Tags reports
+// @Produce application/json
+// @Param type path string true "Target type" Enums(advert, user, message)
+// @Param id path integer true "Target id"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyReports}
+// @failure default {object} models.HttpError
+// @Router /admin/reports/{type}/{id} [get]
+func (rh *ReportHandler) TargetReportsHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+
+	vars := mux.Vars(r)
+	targetId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse target id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	targetReports, err := rh.reportUsecase.GetTargetReports(vars["type"], targetId)
+	if err != nil {
+		logger.Warnf("can not get reports on %s %d: %s", vars["type"], targetId, err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyReports{Reports: targetReports}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "reports got successfully", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// ResolveHandler godoc
+// @Summary Resolve reports
+// @Description Dismiss restores hidden target, confirm rejects advert, bans user or keeps message hidden
+// @Tags reports
+// @Accept application/json
+// @Produce application/json
+// @Param type path string true "Target type" Enums(advert, user, message)
+// @Param id path integer true "Target id"
+// @Param body body models.ReportResolution true "Decision"
+// @Success 200 {object} models.HttpBodyInterface
+// @failure default {object} models.HttpError
+// @Router /admin/reports/{type}/{id}/resolve [post]
+func (rh *ReportHandler) ResolveHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+
+	vars := mux.Vars(r)
+	targetId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse target id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	defer r.Body.Close()
+	resolution := &models.ReportResolution{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = easyjson.Unmarshal(buf, resolution)
+	}
+	if err == nil {
+		_, err = govalidator.ValidateStruct(resolution)
+	}
+	if err != nil {
+		logger.Warnf("invalid data: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	err = rh.reportUsecase.Resolve(vars["type"], targetId, resolution.Action)
+	if err != nil {
+		logger.Warnf("can not resolve reports on %s %d: %s", vars["type"], targetId, err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(models.ToBytes(http.StatusOK, "reports resolved", nil))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
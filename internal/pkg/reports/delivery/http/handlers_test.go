@@ -0,0 +1,124 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"yula/internal/models"
+	"yula/internal/pkg/middleware"
+
+	reportMock "yula/internal/pkg/reports/mocks"
+
+	myerr "yula/internal/error"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func withUser(userId int64) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), middleware.ContextUserId, userId)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func newTestRouter(rh *ReportHandler, userId int64) *mux.Router {
+	router := mux.NewRouter()
+	router.HandleFunc("/reports", rh.CreateReportHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.HandleFunc("/admin/reports", rh.ReportQueueHandler).Methods(http.MethodGet, http.MethodOptions)
+	router.HandleFunc("/admin/reports/{type:advert|user|message}/{id:[0-9]+}/resolve", rh.ResolveHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+	router.Use(withUser(userId))
+	return router
+}
+
+func TestCreateReportSuccess(t *testing.T) {
+	ru := reportMock.ReportUsecase{}
+	rh := NewReportHandler(&ru)
+
+	srv := httptest.NewServer(newTestRouter(rh, 2))
+	defer srv.Close()
+
+	ru.On("CreateReport", int64(2), mock.MatchedBy(func(input *models.ReportInput) bool {
+		return input.TargetType == models.ReportTargetMessage && input.TargetId == 3
+	})).Return(&models.Report{Id: 7, ReporterId: 2, TargetType: models.ReportTargetMessage, TargetId: 3}, nil)
+
+	reqBody := `{"target_type": "message", "target_id": 3, "reason": "spam"}`
+	res, err := http.Post(fmt.Sprintf("%s/reports", srv.URL), "application/json", bytes.NewBufferString(reqBody))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "report sent", Answer.Message)
+}
+
+func TestCreateReportUnknownReason(t *testing.T) {
+	ru := reportMock.ReportUsecase{}
+	rh := NewReportHandler(&ru)
+
+	srv := httptest.NewServer(newTestRouter(rh, 2))
+	defer srv.Close()
+
+	reqBody := `{"target_type": "advert", "target_id": 3, "reason": "boring"}`
+	res, err := http.Post(fmt.Sprintf("%s/reports", srv.URL), "application/json", bytes.NewBufferString(reqBody))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, Answer.Code)
+	ru.AssertNotCalled(t, "CreateReport", mock.Anything, mock.Anything)
+}
+
+func TestReportQueueSuccess(t *testing.T) {
+	ru := reportMock.ReportUsecase{}
+	rh := NewReportHandler(&ru)
+
+	srv := httptest.NewServer(newTestRouter(rh, 1))
+	defer srv.Close()
+
+	ru.On("GetQueue", &models.Page{PageNum: 0, Count: 50}).Return([]*models.ReportTarget{
+		{TargetType: models.ReportTargetAdvert, TargetId: 10, ReportsCount: 4, Reasons: []string{"fraud"}},
+	}, nil)
+
+	res, err := http.Get(fmt.Sprintf("%s/admin/reports", srv.URL))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "reports queue got successfully", Answer.Message)
+}
+
+func TestResolveNotExist(t *testing.T) {
+	ru := reportMock.ReportUsecase{}
+	rh := NewReportHandler(&ru)
+
+	srv := httptest.NewServer(newTestRouter(rh, 1))
+	defer srv.Close()
+
+	ru.On("Resolve", models.ReportTargetUser, int64(5), models.ReportActionConfirm).Return(myerr.NotExist)
+
+	reqBody := `{"action": "confirm"}`
+	res, err := http.Post(fmt.Sprintf("%s/admin/reports/user/5/resolve", srv.URL), "application/json", bytes.NewBufferString(reqBody))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusNotFound, Answer.Code)
+}
@@ -0,0 +1,109 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReportRepository is an autogenerated mock type for the ReportRepository type
+type ReportRepository struct {
+	mock.Mock
+}
+
+// Insert provides a mock function with given fields: report, hideThreshold
+func (_m *ReportRepository) Insert(report *models.Report, hideThreshold int64) error {
+	ret := _m.Called(report, hideThreshold)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Report, int64) error); ok {
+		r0 = rf(report, hideThreshold)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Resolve provides a mock function with given fields: targetType, targetId, action
+func (_m *ReportRepository) Resolve(targetType string, targetId int64, action string) error {
+	ret := _m.Called(targetType, targetId, action)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64, string) error); ok {
+		r0 = rf(targetType, targetId, action)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SelectByTarget provides a mock function with given fields: targetType, targetId
+func (_m *ReportRepository) SelectByTarget(targetType string, targetId int64) ([]*models.Report, error) {
+	ret := _m.Called(targetType, targetId)
+
+	var r0 []*models.Report
+	if rf, ok := ret.Get(0).(func(string, int64) []*models.Report); ok {
+		r0 = rf(targetType, targetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Report)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(targetType, targetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SelectQueue provides a mock function with given fields: from, count
+func (_m *ReportRepository) SelectQueue(from int64, count int64) ([]*models.ReportTarget, error) {
+	ret := _m.Called(from, count)
+
+	var r0 []*models.ReportTarget
+	if rf, ok := ret.Get(0).(func(int64, int64) []*models.ReportTarget); ok {
+		r0 = rf(from, count)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ReportTarget)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(from, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SelectTargetOwner provides a mock function with given fields: report
+func (_m *ReportRepository) SelectTargetOwner(report *models.Report) (int64, error) {
+	ret := _m.Called(report)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(*models.Report) int64); ok {
+		r0 = rf(report)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.Report) error); ok {
+		r1 = rf(report)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReportUsecase is an autogenerated mock type for the ReportUsecase type
+type ReportUsecase struct {
+	mock.Mock
+}
+
+// CreateReport provides a mock function with given fields: reporterId, input
+func (_m *ReportUsecase) CreateReport(reporterId int64, input *models.ReportInput) (*models.Report, error) {
+	ret := _m.Called(reporterId, input)
+
+	var r0 *models.Report
+	if rf, ok := ret.Get(0).(func(int64, *models.ReportInput) *models.Report); ok {
+		r0 = rf(reporterId, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Report)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, *models.ReportInput) error); ok {
+		r1 = rf(reporterId, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetQueue provides a mock function with given fields: page
+func (_m *ReportUsecase) GetQueue(page *models.Page) ([]*models.ReportTarget, error) {
+	ret := _m.Called(page)
+
+	var r0 []*models.ReportTarget
+	if rf, ok := ret.Get(0).(func(*models.Page) []*models.ReportTarget); ok {
+		r0 = rf(page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ReportTarget)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.Page) error); ok {
+		r1 = rf(page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTargetReports provides a mock function with given fields: targetType, targetId
+func (_m *ReportUsecase) GetTargetReports(targetType string, targetId int64) ([]*models.Report, error) {
+	ret := _m.Called(targetType, targetId)
+
+	var r0 []*models.Report
+	if rf, ok := ret.Get(0).(func(string, int64) []*models.Report); ok {
+		r0 = rf(targetType, targetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Report)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(targetType, targetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Resolve provides a mock function with given fields: targetType, targetId, action
+func (_m *ReportUsecase) Resolve(targetType string, targetId int64, action string) error {
+	ret := _m.Called(targetType, targetId, action)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64, string) error); ok {
+		r0 = rf(targetType, targetId, action)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,13 @@
+package reports
+
+import "yula/internal/models"
+
+//go:generate mockery -name=ReportRepository
+
+type ReportRepository interface {
+	SelectTargetOwner(report *models.Report) (int64, error)
+	Insert(report *models.Report, hideThreshold int64) error
+	SelectQueue(from, count int64) ([]*models.ReportTarget, error)
+	SelectByTarget(targetType string, targetId int64) ([]*models.Report, error)
+	Resolve(targetType string, targetId int64, action string) error
+}
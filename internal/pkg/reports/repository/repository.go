@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"strings"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/reports"
+)
+
+// запросы, скрывающие цель жалоб до проверки модератором;
+// объявление возвращается в очередь модерации, пользователь скрывается из выдачи
+var hideQueries = map[string]string{
+	models.ReportTargetAdvert:  "UPDATE advert SET status = 'pending_review' WHERE id = $1 AND status = 'published';",
+	models.ReportTargetUser:    "UPDATE users SET is_hidden = TRUE WHERE id = $1;",
+	models.ReportTargetMessage: "UPDATE messages SET is_hidden = TRUE WHERE id = $1;",
+}
+
+var resolveQueries = map[string]map[string]string{
+	models.ReportActionDismiss: {
+		models.ReportTargetAdvert: `UPDATE advert SET status = 'published', moderation_reason = ''
+									WHERE id = $1 AND status = 'pending_review';`,
+		models.ReportTargetUser:    "UPDATE users SET is_hidden = FALSE WHERE id = $1;",
+		models.ReportTargetMessage: "UPDATE messages SET is_hidden = FALSE WHERE id = $1;",
+	},
+	models.ReportActionConfirm: {
+		models.ReportTargetAdvert: `UPDATE advert SET status = 'rejected', moderation_reason = 'rejected after user reports'
+									WHERE id = $1 AND status IN ('published', 'pending_review');`,
+		models.ReportTargetUser:    "UPDATE users SET is_banned = TRUE, is_hidden = TRUE WHERE id = $1 AND role <> 'admin';",
+		models.ReportTargetMessage: "UPDATE messages SET is_hidden = TRUE WHERE id = $1;",
+	},
+}
+
+var resolveStatuses = map[string]string{
+	models.ReportActionDismiss: models.ReportStatusDismissed,
+	models.ReportActionConfirm: models.ReportStatusConfirmed,
+}
+
+type ReportRepository struct {
+	DB *sql.DB
+}
+
+func NewReportRepository(DB *sql.DB) reports.ReportRepository {
+	return &ReportRepository{
+		DB: DB,
+	}
+}
+
+// SelectTargetOwner возвращает автора цели жалобы,
+// на сообщение может пожаловаться только его получатель
+func (rr *ReportRepository) SelectTargetOwner(report *models.Report) (int64, error) {
+	var query *sql.Row
+	switch report.TargetType {
+	case models.ReportTargetAdvert:
+		query = rr.DB.QueryRowContext(context.Background(),
+			"SELECT publisher_id FROM advert WHERE id = $1;", report.TargetId)
+	case models.ReportTargetUser:
+		query = rr.DB.QueryRowContext(context.Background(),
+			"SELECT id FROM users WHERE id = $1;", report.TargetId)
+	case models.ReportTargetMessage:
+		query = rr.DB.QueryRowContext(context.Background(),
+			"SELECT user_from FROM messages WHERE id = $1 AND user_to = $2;", report.TargetId, report.ReporterId)
+	default:
+		return 0, internalError.BadRequest
+	}
+
+	var ownerId int64
+	err := query.Scan(&ownerId)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return 0, internalError.EmptyQuery
+		}
+		return 0, internalError.GenInternalError(err)
+	}
+
+	return ownerId, nil
+}
+
+// Insert сохраняет жалобу и скрывает цель, если открытых жалоб на нее набралось hideThreshold
+func (rr *ReportRepository) Insert(report *models.Report, hideThreshold int64) error {
+	tx, err := rr.DB.BeginTx(context.Background(), nil)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	queryStr := `INSERT INTO report (reporter_id, target_type, target_id, reason, comment)
+				VALUES ($1, $2, $3, $4, $5)
+				ON CONFLICT (reporter_id, target_type, target_id) DO NOTHING RETURNING id, status, created_at;`
+	query := tx.QueryRowContext(context.Background(), queryStr, report.ReporterId, report.TargetType,
+		report.TargetId, report.Reason, report.Comment)
+
+	err = query.Scan(&report.Id, &report.Status, &report.CreatedAt)
+	if err != nil {
+		if rlbckEr := tx.Rollback(); rlbckEr != nil {
+			return internalError.RollbackError
+		}
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return internalError.ReportDuplicate
+		}
+		return internalError.GenInternalError(err)
+	}
+
+	var reportsCount int64
+	query = tx.QueryRowContext(context.Background(),
+		"SELECT count(*) FROM report WHERE target_type = $1 AND target_id = $2 AND status = 'open';",
+		report.TargetType, report.TargetId)
+	err = query.Scan(&reportsCount)
+	if err == nil && reportsCount >= hideThreshold {
+		_, err = tx.ExecContext(context.Background(), hideQueries[report.TargetType], report.TargetId)
+	}
+	if err != nil {
+		if rlbckEr := tx.Rollback(); rlbckEr != nil {
+			return internalError.RollbackError
+		}
+		return internalError.GenInternalError(err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return internalError.NotCommited
+	}
+	return nil
+}
+
+func (rr *ReportRepository) SelectQueue(from, count int64) ([]*models.ReportTarget, error) {
+	queryStr := `SELECT target_type, target_id, count(*) AS reports_count,
+				string_agg(DISTINCT reason, ','), max(created_at)
+				FROM report WHERE status = 'open'
+				GROUP BY target_type, target_id
+				ORDER BY reports_count DESC, max(created_at) ASC
+				LIMIT $1 OFFSET $2;`
+	query, err := rr.DB.QueryContext(context.Background(), queryStr, count, from*count)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+	defer query.Close()
+
+	targets := make([]*models.ReportTarget, 0)
+	for query.Next() {
+		target := &models.ReportTarget{}
+		var reasons string
+		err = query.Scan(&target.TargetType, &target.TargetId, &target.ReportsCount, &reasons, &target.LastReportAt)
+		if err != nil {
+			return nil, internalError.GenInternalError(err)
+		}
+
+		target.Reasons = strings.Split(reasons, ",")
+		targets = append(targets, target)
+	}
+
+	return targets, nil
+}
+
+func (rr *ReportRepository) SelectByTarget(targetType string, targetId int64) ([]*models.Report, error) {
+	queryStr := `SELECT id, reporter_id, target_type, target_id, reason, comment, status, created_at
+				FROM report WHERE target_type = $1 AND target_id = $2
+				ORDER BY created_at DESC;`
+	query, err := rr.DB.QueryContext(context.Background(), queryStr, targetType, targetId)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+	defer query.Close()
+
+	targetReports := make([]*models.Report, 0)
+	for query.Next() {
+		report := &models.Report{}
+		err = query.Scan(&report.Id, &report.ReporterId, &report.TargetType, &report.TargetId,
+			&report.Reason, &report.Comment, &report.Status, &report.CreatedAt)
+		if err != nil {
+			return nil, internalError.GenInternalError(err)
+		}
+
+		targetReports = append(targetReports, report)
+	}
+
+	return targetReports, nil
+}
+
+// Resolve закрывает все открытые жалобы на цель и применяет решение модератора к самой цели
+func (rr *ReportRepository) Resolve(targetType string, targetId int64, action string) error {
+	tx, err := rr.DB.BeginTx(context.Background(), nil)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	result, err := tx.ExecContext(context.Background(),
+		"UPDATE report SET status = $3 WHERE target_type = $1 AND target_id = $2 AND status = 'open';",
+		targetType, targetId, resolveStatuses[action])
+	if err == nil {
+		if affected, _ := result.RowsAffected(); affected == 0 {
+			err = internalError.EmptyQuery
+		}
+	}
+	if err == nil {
+		result, err = tx.ExecContext(context.Background(), resolveQueries[action][targetType], targetId)
+	}
+	// запрос бана пропускает администраторов, жалобу на администратора подтвердить нельзя
+	if err == nil && action == models.ReportActionConfirm && targetType == models.ReportTargetUser {
+		if affected, _ := result.RowsAffected(); affected == 0 {
+			err = internalError.AdminBanNotAllowed
+		}
+	}
+	if err != nil {
+		if rlbckEr := tx.Rollback(); rlbckEr != nil {
+			return internalError.RollbackError
+		}
+		if err == internalError.EmptyQuery || err == internalError.AdminBanNotAllowed {
+			return err
+		}
+		return internalError.GenInternalError(err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return internalError.NotCommited
+	}
+	return nil
+}
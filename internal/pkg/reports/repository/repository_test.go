@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"testing"
+	"time"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	sqlmock "gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func newTestReport() *models.Report {
+	return &models.Report{
+		ReporterId: 2,
+		TargetType: models.ReportTargetAdvert,
+		TargetId:   10,
+		Reason:     "fraud",
+		Comment:    "asks for prepayment",
+	}
+}
+
+func TestSelectTargetOwnerMessage(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewReportRepository(db)
+	report := newTestReport()
+	report.TargetType = models.ReportTargetMessage
+
+	mock.ExpectQuery("SELECT user_from FROM messages").WithArgs(int64(10), int64(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"user_from"}).AddRow(5))
+
+	ownerId, err := repo.SelectTargetOwner(report)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), ownerId)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectTargetOwnerEmpty(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewReportRepository(db)
+
+	mock.ExpectQuery("SELECT publisher_id FROM advert").WithArgs(int64(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"publisher_id"}))
+
+	_, err = repo.SelectTargetOwner(newTestReport())
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestInsertBelowThreshold(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewReportRepository(db)
+	report := newTestReport()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO report").WithArgs(int64(2), models.ReportTargetAdvert, int64(10), "fraud", "asks for prepayment").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "status", "created_at"}).AddRow(7, models.ReportStatusOpen, time.Now()))
+	mock.ExpectQuery("SELECT count").WithArgs(models.ReportTargetAdvert, int64(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectCommit()
+
+	err = repo.Insert(report, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), report.Id)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestInsertHidesTarget(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewReportRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO report").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "status", "created_at"}).AddRow(7, models.ReportStatusOpen, time.Now()))
+	mock.ExpectQuery("SELECT count").WithArgs(models.ReportTargetAdvert, int64(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectExec("UPDATE advert SET status = 'pending_review'").WithArgs(int64(10)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = repo.Insert(newTestReport(), 3)
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestInsertDuplicate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewReportRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO report").WillReturnRows(sqlmock.NewRows([]string{"id", "status", "created_at"}))
+	mock.ExpectRollback()
+
+	err = repo.Insert(newTestReport(), 3)
+	assert.Equal(t, internalError.ReportDuplicate, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectQueueOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewReportRepository(db)
+
+	mock.ExpectQuery("SELECT target_type, target_id, count").WithArgs(int64(50), int64(0)).
+		WillReturnRows(sqlmock.NewRows([]string{"target_type", "target_id", "reports_count", "reasons", "max"}).
+			AddRow("advert", 10, 4, "fraud,spam", time.Now()).
+			AddRow("message", 3, 1, "offensive", time.Now()))
+
+	targets, err := repo.SelectQueue(0, 50)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(targets))
+	assert.Equal(t, []string{"fraud", "spam"}, targets[0].Reasons)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestResolveConfirmUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewReportRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE report SET status").WithArgs(models.ReportTargetUser, int64(5), models.ReportStatusConfirmed).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("UPDATE users SET is_banned = TRUE").WithArgs(int64(5)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = repo.Resolve(models.ReportTargetUser, 5, models.ReportActionConfirm)
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestResolveNoOpenReports(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewReportRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE report SET status").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err = repo.Resolve(models.ReportTargetAdvert, 10, models.ReportActionDismiss)
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestResolveConfirmAdmin(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewReportRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE report SET status").WithArgs(models.ReportTargetUser, int64(5), models.ReportStatusConfirmed).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE users SET is_banned = TRUE").WithArgs(int64(5)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err = repo.Resolve(models.ReportTargetUser, 5, models.ReportActionConfirm)
+	assert.Equal(t, internalError.AdminBanNotAllowed, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
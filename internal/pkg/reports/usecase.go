@@ -0,0 +1,12 @@
+package reports
+
+import "yula/internal/models"
+
+//go:generate mockery -name=ReportUsecase
+
+type ReportUsecase interface {
+	CreateReport(reporterId int64, input *models.ReportInput) (*models.Report, error)
+	GetQueue(page *models.Page) ([]*models.ReportTarget, error)
+	GetTargetReports(targetType string, targetId int64) ([]*models.Report, error)
+	Resolve(targetType string, targetId int64, action string) error
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/reports"
+)
+
+type ReportUsecase struct {
+	reportRepository reports.ReportRepository
+	hideThreshold    int64
+}
+
+func NewReportUsecase(reportRepository reports.ReportRepository, hideThreshold int64) reports.ReportUsecase {
+	return &ReportUsecase{
+		reportRepository: reportRepository,
+		hideThreshold:    hideThreshold,
+	}
+}
+
+func (ru *ReportUsecase) CreateReport(reporterId int64, input *models.ReportInput) (*models.Report, error) {
+	report := &models.Report{
+		ReporterId: reporterId,
+		TargetType: input.TargetType,
+		TargetId:   input.TargetId,
+		Reason:     input.Reason,
+		Comment:    input.Comment,
+	}
+
+	ownerId, err := ru.reportRepository.SelectTargetOwner(report)
+	switch {
+	case err == internalError.EmptyQuery:
+		return nil, internalError.NotExist
+	case err != nil:
+		return nil, err
+	}
+
+	// жаловаться на себя и свои объявления нельзя
+	if ownerId == reporterId {
+		return nil, internalError.Conflict
+	}
+
+	err = ru.reportRepository.Insert(report, ru.hideThreshold)
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (ru *ReportUsecase) GetQueue(page *models.Page) ([]*models.ReportTarget, error) {
+	return ru.reportRepository.SelectQueue(page.PageNum, page.Count)
+}
+
+func (ru *ReportUsecase) GetTargetReports(targetType string, targetId int64) ([]*models.Report, error) {
+	targetReports, err := ru.reportRepository.SelectByTarget(targetType, targetId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(targetReports) == 0 {
+		return nil, internalError.NotExist
+	}
+	return targetReports, nil
+}
+
+func (ru *ReportUsecase) Resolve(targetType string, targetId int64, action string) error {
+	err := ru.reportRepository.Resolve(targetType, targetId, action)
+	if err == internalError.EmptyQuery {
+		return internalError.NotExist
+	}
+	return err
+}
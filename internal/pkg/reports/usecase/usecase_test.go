@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"testing"
+	"yula/internal/models"
+	"yula/internal/pkg/reports/mocks"
+
+	myerr "yula/internal/error"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestInput() *models.ReportInput {
+	return &models.ReportInput{
+		TargetType: models.ReportTargetAdvert,
+		TargetId:   10,
+		Reason:     "fraud",
+	}
+}
+
+func TestCreateReportSuccess(t *testing.T) {
+	rr := mocks.ReportRepository{}
+	rr.On("SelectTargetOwner", mock.AnythingOfType("*models.Report")).Return(int64(5), nil)
+	rr.On("Insert", mock.MatchedBy(func(r *models.Report) bool {
+		return r.ReporterId == 2 && r.TargetId == 10 && r.Reason == "fraud"
+	}), int64(3)).Return(nil)
+
+	ru := NewReportUsecase(&rr, 3)
+	report, err := ru.CreateReport(2, newTestInput())
+	assert.Nil(t, err)
+	assert.Equal(t, models.ReportTargetAdvert, report.TargetType)
+}
+
+func TestCreateReportOwnTarget(t *testing.T) {
+	rr := mocks.ReportRepository{}
+	rr.On("SelectTargetOwner", mock.AnythingOfType("*models.Report")).Return(int64(2), nil)
+
+	ru := NewReportUsecase(&rr, 3)
+	_, err := ru.CreateReport(2, newTestInput())
+	assert.Equal(t, myerr.Conflict, err)
+	rr.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+}
+
+func TestCreateReportNoTarget(t *testing.T) {
+	rr := mocks.ReportRepository{}
+	rr.On("SelectTargetOwner", mock.AnythingOfType("*models.Report")).Return(int64(0), myerr.EmptyQuery)
+
+	ru := NewReportUsecase(&rr, 3)
+	_, err := ru.CreateReport(2, newTestInput())
+	assert.Equal(t, myerr.NotExist, err)
+}
+
+func TestCreateReportDuplicate(t *testing.T) {
+	rr := mocks.ReportRepository{}
+	rr.On("SelectTargetOwner", mock.AnythingOfType("*models.Report")).Return(int64(5), nil)
+	rr.On("Insert", mock.AnythingOfType("*models.Report"), int64(3)).Return(myerr.ReportDuplicate)
+
+	ru := NewReportUsecase(&rr, 3)
+	_, err := ru.CreateReport(2, newTestInput())
+	assert.Equal(t, myerr.ReportDuplicate, err)
+}
+
+func TestGetTargetReportsEmpty(t *testing.T) {
+	rr := mocks.ReportRepository{}
+	rr.On("SelectByTarget", models.ReportTargetUser, int64(5)).Return([]*models.Report{}, nil)
+
+	ru := NewReportUsecase(&rr, 3)
+	_, err := ru.GetTargetReports(models.ReportTargetUser, 5)
+	assert.Equal(t, myerr.NotExist, err)
+}
+
+func TestResolveNotExist(t *testing.T) {
+	rr := mocks.ReportRepository{}
+	rr.On("Resolve", models.ReportTargetAdvert, int64(10), models.ReportActionDismiss).Return(myerr.EmptyQuery)
+
+	ru := NewReportUsecase(&rr, 3)
+	err := ru.Resolve(models.ReportTargetAdvert, 10, models.ReportActionDismiss)
+	assert.Equal(t, myerr.NotExist, err)
+}
@@ -0,0 +1,245 @@
+package delivery
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/logging"
+	"yula/internal/pkg/middleware"
+	"yula/internal/pkg/reviews"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	logger logging.Logger = logging.GetLogger()
+)
+
+type ReviewHandler struct {
+	reviewUsecase reviews.ReviewUsecase
+}
+
+func NewReviewHandler(reviewUsecase reviews.ReviewUsecase) *ReviewHandler {
+	return &ReviewHandler{
+		reviewUsecase: reviewUsecase,
+	}
+}
+
+func (rh *ReviewHandler) Routing(r *mux.Router, sm *middleware.SessionMiddleware) {
+	r.Handle("/orders/{id:[0-9]+}/review", sm.CheckAuthorized(http.HandlerFunc(rh.LeaveReviewHandler))).Methods(http.MethodPost, http.MethodOptions)
+	r.Handle("/reviews/{id:[0-9]+}/reply", sm.CheckAuthorized(http.HandlerFunc(rh.ReplyHandler))).Methods(http.MethodPost, http.MethodOptions)
+	r.Handle("/users/{id:[0-9]+}/reviews", middleware.SetSCRFToken(http.HandlerFunc(rh.UserReviewsHandler))).Methods(http.MethodGet, http.MethodOptions)
+}
+
+// LeaveReviewHandler godoc
+// @Summary Leave review
+// @Description Review the other side of a completed order
+// @Tags reviews
+// @Accept application/json
+// @Produce application/json
+// @Param id path integer true "Order id"
+// @Param body body models.ReviewInput true "Review"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyReview}
+// @failure default {object} models.HttpError
+// @Router /orders/{id}/review [post]
+func (rh *ReviewHandler) LeaveReviewHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	orderId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse order id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	defer r.Body.Close()
+	input := &models.ReviewInput{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = easyjson.Unmarshal(buf, input)
+	}
+	if err == nil {
+		_, err = govalidator.ValidateStruct(input)
+	}
+	if err != nil {
+		logger.Warnf("invalid data: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	sanitizer := bluemonday.UGCPolicy()
+	input.Text = sanitizer.Sanitize(input.Text)
+
+	review, err := rh.reviewUsecase.LeaveReview(orderId, userId, input)
+	if err != nil {
+		logger.Warnf("can not leave review: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyReview{Review: *review}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "review left", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// ReplyHandler godoc
+// @Summary Reply to review
+// @Description Public reply of the reviewed user, only once
+// @Tags reviews
+// @Accept application/json
+// @Produce application/json
+// @Param id path integer true "Review id"
+// @Param body body models.ReviewReply true "Reply"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyReview}
+// @failure default {object} models.HttpError
+// @Router /reviews/{id}/reply [post]
+func (rh *ReviewHandler) ReplyHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	reviewId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse review id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	defer r.Body.Close()
+	reply := &models.ReviewReply{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = easyjson.Unmarshal(buf, reply)
+	}
+	if err == nil {
+		_, err = govalidator.ValidateStruct(reply)
+	}
+	if err != nil {
+		logger.Warnf("invalid data: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	sanitizer := bluemonday.UGCPolicy()
+	reply.Text = sanitizer.Sanitize(reply.Text)
+
+	review, err := rh.reviewUsecase.Reply(reviewId, userId, reply)
+	if err != nil {
+		logger.Warnf("can not reply to review: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyReview{Review: *review}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "reply left", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// UserReviewsHandler godoc
+// @Summary User's reviews
+// @Description Reviews about the user, newest first
+// @Tags reviews
+// @Accept application/json
+// @Produce application/json
+// @Param id path integer true "User id"
+// @Param page query string false "Page num"
+// @Param count query string false "Count"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyReviews}
+// @failure default {object} models.HttpError
+// @Router /users/{id}/reviews [get]
+func (rh *ReviewHandler) UserReviewsHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+
+	vars := mux.Vars(r)
+	targetId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse user id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	query := r.URL.Query()
+	page, err := models.NewPage(query.Get("page"), query.Get("count"))
+	if err != nil {
+		logger.Warnf("can not create page: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	userReviews, err := rh.reviewUsecase.GetReviews(targetId, page)
+	if err != nil {
+		logger.Warnf("can not get reviews: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyReviews{Reviews: userReviews}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "reviews got successfully", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
@@ -0,0 +1,210 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"yula/internal/models"
+	"yula/internal/pkg/middleware"
+
+	reviewMock "yula/internal/pkg/reviews/mocks"
+
+	myerr "yula/internal/error"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func withUser(userId int64) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), middleware.ContextUserId, userId)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func newTestRouter(rh *ReviewHandler, userId int64) *mux.Router {
+	router := mux.NewRouter()
+	router.HandleFunc("/orders/{id:[0-9]+}/review", rh.LeaveReviewHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.HandleFunc("/reviews/{id:[0-9]+}/reply", rh.ReplyHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.HandleFunc("/users/{id:[0-9]+}/reviews", rh.UserReviewsHandler).Methods(http.MethodGet, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+	router.Use(withUser(userId))
+	return router
+}
+
+var testReview = models.Review{
+	Id:       7,
+	OrderId:  5,
+	AuthorId: 1,
+	TargetId: 2,
+	Score:    5,
+	Text:     "good",
+}
+
+func TestLeaveReviewSuccess(t *testing.T) {
+	ru := reviewMock.ReviewUsecase{}
+	rh := NewReviewHandler(&ru)
+
+	srv := httptest.NewServer(newTestRouter(rh, 1))
+	defer srv.Close()
+
+	input := &models.ReviewInput{Score: 5, Text: "good"}
+	ru.On("LeaveReview", int64(5), int64(1), input).Return(&testReview, nil)
+
+	body, _ := json.Marshal(input)
+	res, err := http.Post(fmt.Sprintf("%s/orders/5/review", srv.URL), "application/json", bytes.NewReader(body))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "review left", Answer.Message)
+}
+
+func TestLeaveReviewInvalidScore(t *testing.T) {
+	ru := reviewMock.ReviewUsecase{}
+	rh := NewReviewHandler(&ru)
+
+	srv := httptest.NewServer(newTestRouter(rh, 1))
+	defer srv.Close()
+
+	body, _ := json.Marshal(&models.ReviewInput{Score: 6})
+	res, err := http.Post(fmt.Sprintf("%s/orders/5/review", srv.URL), "application/json", bytes.NewReader(body))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, Answer.Code)
+	assert.Equal(t, "invalid data", Answer.Message)
+}
+
+func TestLeaveReviewNotCompleted(t *testing.T) {
+	ru := reviewMock.ReviewUsecase{}
+	rh := NewReviewHandler(&ru)
+
+	srv := httptest.NewServer(newTestRouter(rh, 1))
+	defer srv.Close()
+
+	input := &models.ReviewInput{Score: 3}
+	ru.On("LeaveReview", int64(5), int64(1), input).Return(nil, myerr.ReviewNotAllowed)
+
+	body, _ := json.Marshal(input)
+	res, err := http.Post(fmt.Sprintf("%s/orders/5/review", srv.URL), "application/json", bytes.NewReader(body))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusConflict, Answer.Code)
+}
+
+func TestReplySuccess(t *testing.T) {
+	ru := reviewMock.ReviewUsecase{}
+	rh := NewReviewHandler(&ru)
+
+	srv := httptest.NewServer(newTestRouter(rh, 2))
+	defer srv.Close()
+
+	reply := &models.ReviewReply{Text: "thanks"}
+	replied := testReview
+	replied.Reply = reply.Text
+	ru.On("Reply", int64(7), int64(2), reply).Return(&replied, nil)
+
+	body, _ := json.Marshal(reply)
+	res, err := http.Post(fmt.Sprintf("%s/reviews/7/reply", srv.URL), "application/json", bytes.NewReader(body))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "reply left", Answer.Message)
+}
+
+func TestReplyEmpty(t *testing.T) {
+	ru := reviewMock.ReviewUsecase{}
+	rh := NewReviewHandler(&ru)
+
+	srv := httptest.NewServer(newTestRouter(rh, 2))
+	defer srv.Close()
+
+	body, _ := json.Marshal(&models.ReviewReply{})
+	res, err := http.Post(fmt.Sprintf("%s/reviews/7/reply", srv.URL), "application/json", bytes.NewReader(body))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, Answer.Code)
+}
+
+func TestReplyTwice(t *testing.T) {
+	ru := reviewMock.ReviewUsecase{}
+	rh := NewReviewHandler(&ru)
+
+	srv := httptest.NewServer(newTestRouter(rh, 2))
+	defer srv.Close()
+
+	reply := &models.ReviewReply{Text: "thanks"}
+	ru.On("Reply", int64(7), int64(2), reply).Return(nil, myerr.AlreadyExist)
+
+	body, _ := json.Marshal(reply)
+	res, err := http.Post(fmt.Sprintf("%s/reviews/7/reply", srv.URL), "application/json", bytes.NewReader(body))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusForbidden, Answer.Code)
+}
+
+func TestUserReviewsSuccess(t *testing.T) {
+	ru := reviewMock.ReviewUsecase{}
+	rh := NewReviewHandler(&ru)
+
+	srv := httptest.NewServer(newTestRouter(rh, 0))
+	defer srv.Close()
+
+	ru.On("GetReviews", int64(2), &models.Page{PageNum: 1, Count: 10}).Return([]*models.Review{&testReview}, nil)
+
+	res, err := http.Get(fmt.Sprintf("%s/users/2/reviews?page=2&count=10", srv.URL))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, Answer.Code)
+	assert.Equal(t, "reviews got successfully", Answer.Message)
+}
+
+func TestUserReviewsFailPage(t *testing.T) {
+	ru := reviewMock.ReviewUsecase{}
+	rh := NewReviewHandler(&ru)
+
+	srv := httptest.NewServer(newTestRouter(rh, 0))
+	defer srv.Close()
+
+	res, err := http.Get(fmt.Sprintf("%s/users/2/reviews?page=abc", srv.URL))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, Answer.Code)
+}
@@ -0,0 +1,88 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReviewRepository is an autogenerated mock type for the ReviewRepository type
+type ReviewRepository struct {
+	mock.Mock
+}
+
+// Insert provides a mock function with given fields: review
+func (_m *ReviewRepository) Insert(review *models.Review) error {
+	ret := _m.Called(review)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Review) error); ok {
+		r0 = rf(review)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SelectById provides a mock function with given fields: reviewId
+func (_m *ReviewRepository) SelectById(reviewId int64) (*models.Review, error) {
+	ret := _m.Called(reviewId)
+
+	var r0 *models.Review
+	if rf, ok := ret.Get(0).(func(int64) *models.Review); ok {
+		r0 = rf(reviewId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Review)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(reviewId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SelectByTargetId provides a mock function with given fields: targetId, from, count
+func (_m *ReviewRepository) SelectByTargetId(targetId int64, from int64, count int64) ([]*models.Review, error) {
+	ret := _m.Called(targetId, from, count)
+
+	var r0 []*models.Review
+	if rf, ok := ret.Get(0).(func(int64, int64, int64) []*models.Review); ok {
+		r0 = rf(targetId, from, count)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Review)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64, int64) error); ok {
+		r1 = rf(targetId, from, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateReply provides a mock function with given fields: review
+func (_m *ReviewRepository) UpdateReply(review *models.Review) error {
+	ret := _m.Called(review)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Review) error); ok {
+		r0 = rf(review)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,83 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReviewUsecase is an autogenerated mock type for the ReviewUsecase type
+type ReviewUsecase struct {
+	mock.Mock
+}
+
+// GetReviews provides a mock function with given fields: targetId, page
+func (_m *ReviewUsecase) GetReviews(targetId int64, page *models.Page) ([]*models.Review, error) {
+	ret := _m.Called(targetId, page)
+
+	var r0 []*models.Review
+	if rf, ok := ret.Get(0).(func(int64, *models.Page) []*models.Review); ok {
+		r0 = rf(targetId, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Review)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, *models.Page) error); ok {
+		r1 = rf(targetId, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LeaveReview provides a mock function with given fields: orderId, authorId, input
+func (_m *ReviewUsecase) LeaveReview(orderId int64, authorId int64, input *models.ReviewInput) (*models.Review, error) {
+	ret := _m.Called(orderId, authorId, input)
+
+	var r0 *models.Review
+	if rf, ok := ret.Get(0).(func(int64, int64, *models.ReviewInput) *models.Review); ok {
+		r0 = rf(orderId, authorId, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Review)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64, *models.ReviewInput) error); ok {
+		r1 = rf(orderId, authorId, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reply provides a mock function with given fields: reviewId, userId, reply
+func (_m *ReviewUsecase) Reply(reviewId int64, userId int64, reply *models.ReviewReply) (*models.Review, error) {
+	ret := _m.Called(reviewId, userId, reply)
+
+	var r0 *models.Review
+	if rf, ok := ret.Get(0).(func(int64, int64, *models.ReviewReply) *models.Review); ok {
+		r0 = rf(reviewId, userId, reply)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Review)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64, *models.ReviewReply) error); ok {
+		r1 = rf(reviewId, userId, reply)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
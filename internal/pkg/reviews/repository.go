@@ -0,0 +1,12 @@
+package reviews
+
+import "yula/internal/models"
+
+//go:generate mockery -name=ReviewRepository
+
+type ReviewRepository interface {
+	Insert(review *models.Review) error
+	SelectById(reviewId int64) (*models.Review, error)
+	SelectByTargetId(targetId int64, from, count int64) ([]*models.Review, error)
+	UpdateReply(review *models.Review) error
+}
@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/reviews"
+)
+
+type ReviewRepository struct {
+	DB *sql.DB
+}
+
+func NewReviewRepository(DB *sql.DB) reviews.ReviewRepository {
+	return &ReviewRepository{
+		DB: DB,
+	}
+}
+
+func (rr *ReviewRepository) rollback(tx *sql.Tx, err error) error {
+	if rollbackErr := tx.Rollback(); rollbackErr != nil {
+		return internalError.RollbackError
+	}
+	return err
+}
+
+func (rr *ReviewRepository) Insert(review *models.Review) error {
+	tx, err := rr.DB.BeginTx(context.Background(), nil)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	// на один заказ каждая сторона оставляет не больше одного отзыва
+	queryStr := `INSERT INTO review (order_id, author_id, target_id, score, text) VALUES ($1, $2, $3, $4, $5)
+				ON CONFLICT (order_id, author_id) DO NOTHING RETURNING id, created_at;`
+	query := tx.QueryRowContext(context.Background(), queryStr,
+		review.OrderId, review.AuthorId, review.TargetId, review.Score, review.Text)
+	err = query.Scan(&review.Id, &review.CreatedAt)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return rr.rollback(tx, internalError.AlreadyExist)
+		}
+		return rr.rollback(tx, internalError.GenInternalError(err))
+	}
+
+	// статистика рейтинга всегда пересчитывается по отзывам целиком
+	_, err = tx.ExecContext(context.Background(), `
+		UPDATE rating_statistics SET
+			sum = (SELECT COALESCE(SUM(score), 0) FROM review WHERE target_id = $1),
+			count = (SELECT COUNT(*) FROM review WHERE target_id = $1)
+		WHERE user_id = $1;`, review.TargetId)
+	if err != nil {
+		return rr.rollback(tx, internalError.GenInternalError(err))
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return internalError.NotCommited
+	}
+
+	return nil
+}
+
+func (rr *ReviewRepository) SelectById(reviewId int64) (*models.Review, error) {
+	queryStr := `SELECT id, order_id, author_id, target_id, score, text, reply, created_at, replied_at
+				FROM review WHERE id = $1;`
+	query := rr.DB.QueryRowContext(context.Background(), queryStr, reviewId)
+
+	review, err := scanReview(query)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return nil, internalError.EmptyQuery
+		}
+		return nil, internalError.GenInternalError(err)
+	}
+
+	return review, nil
+}
+
+func (rr *ReviewRepository) SelectByTargetId(targetId int64, from, count int64) ([]*models.Review, error) {
+	queryStr := `SELECT id, order_id, author_id, target_id, score, text, reply, created_at, replied_at
+				FROM review WHERE target_id = $1
+				ORDER BY created_at DESC
+				LIMIT $2 OFFSET $3;`
+	rows, err := rr.DB.QueryContext(context.Background(), queryStr, targetId, count, from*count)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+
+	defer rows.Close()
+	userReviews := make([]*models.Review, 0)
+	for rows.Next() {
+		review, err := scanReview(rows)
+		if err != nil {
+			return nil, internalError.GenInternalError(err)
+		}
+
+		userReviews = append(userReviews, review)
+	}
+
+	return userReviews, nil
+}
+
+func (rr *ReviewRepository) UpdateReply(review *models.Review) error {
+	// ответить на отзыв можно только один раз
+	queryStr := `UPDATE review SET reply = $2, replied_at = CURRENT_TIMESTAMP
+				WHERE id = $1 AND reply IS NULL RETURNING replied_at;`
+	query := rr.DB.QueryRowContext(context.Background(), queryStr, review.Id, review.Reply)
+
+	var repliedAt sql.NullTime
+	err := query.Scan(&repliedAt)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return internalError.AlreadyExist
+		}
+		return internalError.GenInternalError(err)
+	}
+
+	review.RepliedAt = &repliedAt.Time
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanReview(row scanner) (*models.Review, error) {
+	var review models.Review
+	var reply sql.NullString
+	var repliedAt sql.NullTime
+
+	err := row.Scan(&review.Id, &review.OrderId, &review.AuthorId, &review.TargetId, &review.Score,
+		&review.Text, &reply, &review.CreatedAt, &repliedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	review.Reply = reply.String
+	if repliedAt.Valid {
+		review.RepliedAt = &repliedAt.Time
+	}
+	return &review, nil
+}
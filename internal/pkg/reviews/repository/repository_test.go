@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	sqlmock "gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func newTestReview() *models.Review {
+	return &models.Review{
+		OrderId:  5,
+		AuthorId: 1,
+		TargetId: 2,
+		Score:    5,
+		Text:     "good",
+	}
+}
+
+var reviewColumns = []string{"id", "order_id", "author_id", "target_id", "score", "text", "reply",
+	"created_at", "replied_at"}
+
+func TestInsertOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewReviewRepository(db)
+	review := newTestReview()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO review").WithArgs(review.OrderId, review.AuthorId, review.TargetId,
+		review.Score, review.Text).WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(7, time.Now()))
+	mock.ExpectExec("UPDATE rating_statistics").WithArgs(review.TargetId).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = repo.Insert(review)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), review.Id)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestInsertTwice(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewReviewRepository(db)
+	review := newTestReview()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO review").WithArgs(review.OrderId, review.AuthorId, review.TargetId,
+		review.Score, review.Text).WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}))
+	mock.ExpectRollback()
+
+	err = repo.Insert(review)
+	assert.Equal(t, internalError.AlreadyExist, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestInsertStatError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewReviewRepository(db)
+	review := newTestReview()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO review").WithArgs(review.OrderId, review.AuthorId, review.TargetId,
+		review.Score, review.Text).WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(7, time.Now()))
+	mock.ExpectExec("UPDATE rating_statistics").WithArgs(review.TargetId).WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	err = repo.Insert(review)
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectByIdOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewReviewRepository(db)
+
+	mock.ExpectQuery("SELECT id, order_id").WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows(reviewColumns).AddRow(7, 5, 1, 2, 5, "good", nil, time.Now(), nil))
+
+	review, err := repo.SelectById(7)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), review.TargetId)
+	assert.Equal(t, "", review.Reply)
+	assert.Nil(t, review.RepliedAt)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectByIdEmpty(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewReviewRepository(db)
+
+	mock.ExpectQuery("SELECT id, order_id").WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows(reviewColumns))
+
+	_, err = repo.SelectById(7)
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectByTargetIdOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewReviewRepository(db)
+
+	mock.ExpectQuery("SELECT id, order_id").WithArgs(int64(2), int64(10), int64(10)).
+		WillReturnRows(sqlmock.NewRows(reviewColumns).
+			AddRow(7, 5, 1, 2, 5, "good", "thanks", time.Now(), time.Now()).
+			AddRow(8, 6, 3, 2, 4, "", nil, time.Now(), nil))
+
+	userReviews, err := repo.SelectByTargetId(2, 1, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(userReviews))
+	assert.Equal(t, "thanks", userReviews[0].Reply)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectByTargetIdError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewReviewRepository(db)
+
+	mock.ExpectQuery("SELECT id, order_id").WithArgs(int64(2), int64(10), int64(0)).
+		WillReturnError(sql.ErrConnDone)
+
+	_, err = repo.SelectByTargetId(2, 0, 10)
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateReplyOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewReviewRepository(db)
+	review := &models.Review{Id: 7, Reply: "thanks"}
+
+	mock.ExpectQuery("UPDATE review").WithArgs(review.Id, review.Reply).
+		WillReturnRows(sqlmock.NewRows([]string{"replied_at"}).AddRow(time.Now()))
+
+	err = repo.UpdateReply(review)
+	assert.NoError(t, err)
+	assert.NotNil(t, review.RepliedAt)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateReplyTwice(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewReviewRepository(db)
+	review := &models.Review{Id: 7, Reply: "thanks"}
+
+	mock.ExpectQuery("UPDATE review").WithArgs(review.Id, review.Reply).
+		WillReturnRows(sqlmock.NewRows([]string{"replied_at"}))
+
+	err = repo.UpdateReply(review)
+	assert.Equal(t, internalError.AlreadyExist, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
@@ -0,0 +1,11 @@
+package reviews
+
+import "yula/internal/models"
+
+//go:generate mockery -name=ReviewUsecase
+
+type ReviewUsecase interface {
+	LeaveReview(orderId int64, authorId int64, input *models.ReviewInput) (*models.Review, error)
+	Reply(reviewId int64, userId int64, reply *models.ReviewReply) (*models.Review, error)
+	GetReviews(targetId int64, page *models.Page) ([]*models.Review, error)
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/orders"
+	"yula/internal/pkg/reviews"
+)
+
+type ReviewUsecase struct {
+	reviewRepository reviews.ReviewRepository
+	orderRepository  orders.OrderRepository
+}
+
+func NewReviewUsecase(reviewRepository reviews.ReviewRepository, orderRepository orders.OrderRepository) reviews.ReviewUsecase {
+	return &ReviewUsecase{
+		reviewRepository: reviewRepository,
+		orderRepository:  orderRepository,
+	}
+}
+
+func (ru *ReviewUsecase) LeaveReview(orderId int64, authorId int64, input *models.ReviewInput) (*models.Review, error) {
+	order, err := ru.orderRepository.SelectById(orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// покупатель оценивает продавца, продавец - покупателя
+	var targetId int64
+	switch authorId {
+	case order.BuyerId:
+		targetId = order.SalesmanId
+	case order.SalesmanId:
+		targetId = order.BuyerId
+	default:
+		return nil, internalError.Conflict
+	}
+
+	if order.Status != models.OrderStatusDelivered && order.Status != models.OrderStatusReleased {
+		return nil, internalError.ReviewNotAllowed
+	}
+
+	review := &models.Review{
+		OrderId:  orderId,
+		AuthorId: authorId,
+		TargetId: targetId,
+		Score:    input.Score,
+		Text:     input.Text,
+	}
+	err = ru.reviewRepository.Insert(review)
+	if err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
+
+func (ru *ReviewUsecase) Reply(reviewId int64, userId int64, reply *models.ReviewReply) (*models.Review, error) {
+	review, err := ru.reviewRepository.SelectById(reviewId)
+	if err != nil {
+		return nil, err
+	}
+
+	// отвечает только тот, о ком отзыв
+	if review.TargetId != userId {
+		return nil, internalError.Conflict
+	}
+
+	if review.Reply != "" {
+		return nil, internalError.AlreadyExist
+	}
+
+	review.Reply = reply.Text
+	err = ru.reviewRepository.UpdateReply(review)
+	if err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
+
+func (ru *ReviewUsecase) GetReviews(targetId int64, page *models.Page) ([]*models.Review, error) {
+	return ru.reviewRepository.SelectByTargetId(targetId, page.PageNum, page.Count)
+}
@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"testing"
+	"yula/internal/models"
+	orderMocks "yula/internal/pkg/orders/mocks"
+	"yula/internal/pkg/reviews/mocks"
+
+	myerr "yula/internal/error"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestOrder(status string) *models.Order {
+	return &models.Order{
+		Id:         5,
+		BuyerId:    1,
+		SalesmanId: 2,
+		Status:     status,
+	}
+}
+
+func TestLeaveReviewByBuyer(t *testing.T) {
+	rr := mocks.ReviewRepository{}
+	or := orderMocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusDelivered), nil)
+	rr.On("Insert", mock.AnythingOfType("*models.Review")).Return(nil)
+
+	ru := NewReviewUsecase(&rr, &or)
+	review, err := ru.LeaveReview(5, 1, &models.ReviewInput{Score: 5, Text: "good"})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), review.TargetId)
+	assert.Equal(t, 5, review.Score)
+}
+
+func TestLeaveReviewBySalesman(t *testing.T) {
+	rr := mocks.ReviewRepository{}
+	or := orderMocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusDelivered), nil)
+	rr.On("Insert", mock.AnythingOfType("*models.Review")).Return(nil)
+
+	ru := NewReviewUsecase(&rr, &or)
+	review, err := ru.LeaveReview(5, 2, &models.ReviewInput{Score: 4})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), review.TargetId)
+}
+
+func TestLeaveReviewReleased(t *testing.T) {
+	rr := mocks.ReviewRepository{}
+	or := orderMocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusReleased), nil)
+	rr.On("Insert", mock.AnythingOfType("*models.Review")).Return(nil)
+
+	ru := NewReviewUsecase(&rr, &or)
+	_, err := ru.LeaveReview(5, 1, &models.ReviewInput{Score: 5})
+	assert.Nil(t, err)
+}
+
+func TestLeaveReviewStranger(t *testing.T) {
+	rr := mocks.ReviewRepository{}
+	or := orderMocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusDelivered), nil)
+
+	ru := NewReviewUsecase(&rr, &or)
+	review, err := ru.LeaveReview(5, 10, &models.ReviewInput{Score: 4})
+	assert.Equal(t, myerr.Conflict, err)
+	assert.Nil(t, review)
+}
+
+func TestLeaveReviewNotCompleted(t *testing.T) {
+	rr := mocks.ReviewRepository{}
+	or := orderMocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusShipped), nil)
+
+	ru := NewReviewUsecase(&rr, &or)
+	review, err := ru.LeaveReview(5, 1, &models.ReviewInput{Score: 4})
+	assert.Equal(t, myerr.ReviewNotAllowed, err)
+	assert.Nil(t, review)
+}
+
+func TestLeaveReviewTwice(t *testing.T) {
+	rr := mocks.ReviewRepository{}
+	or := orderMocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(newTestOrder(models.OrderStatusDelivered), nil)
+	rr.On("Insert", mock.AnythingOfType("*models.Review")).Return(myerr.AlreadyExist)
+
+	ru := NewReviewUsecase(&rr, &or)
+	review, err := ru.LeaveReview(5, 1, &models.ReviewInput{Score: 4})
+	assert.Equal(t, myerr.AlreadyExist, err)
+	assert.Nil(t, review)
+}
+
+func TestLeaveReviewNoOrder(t *testing.T) {
+	rr := mocks.ReviewRepository{}
+	or := orderMocks.OrderRepository{}
+	or.On("SelectById", int64(5)).Return(nil, myerr.EmptyQuery)
+
+	ru := NewReviewUsecase(&rr, &or)
+	_, err := ru.LeaveReview(5, 1, &models.ReviewInput{Score: 4})
+	assert.Equal(t, myerr.EmptyQuery, err)
+}
+
+func TestReplySuccess(t *testing.T) {
+	rr := mocks.ReviewRepository{}
+	or := orderMocks.OrderRepository{}
+	rr.On("SelectById", int64(3)).Return(&models.Review{Id: 3, AuthorId: 1, TargetId: 2}, nil)
+	rr.On("UpdateReply", mock.AnythingOfType("*models.Review")).Return(nil)
+
+	ru := NewReviewUsecase(&rr, &or)
+	review, err := ru.Reply(3, 2, &models.ReviewReply{Text: "thanks"})
+	assert.Nil(t, err)
+	assert.Equal(t, "thanks", review.Reply)
+}
+
+func TestReplyNotTarget(t *testing.T) {
+	rr := mocks.ReviewRepository{}
+	or := orderMocks.OrderRepository{}
+	rr.On("SelectById", int64(3)).Return(&models.Review{Id: 3, AuthorId: 1, TargetId: 2}, nil)
+
+	ru := NewReviewUsecase(&rr, &or)
+	_, err := ru.Reply(3, 1, &models.ReviewReply{Text: "thanks"})
+	assert.Equal(t, myerr.Conflict, err)
+}
+
+func TestReplyTwice(t *testing.T) {
+	rr := mocks.ReviewRepository{}
+	or := orderMocks.OrderRepository{}
+	rr.On("SelectById", int64(3)).Return(&models.Review{Id: 3, AuthorId: 1, TargetId: 2, Reply: "first"}, nil)
+
+	ru := NewReviewUsecase(&rr, &or)
+	_, err := ru.Reply(3, 2, &models.ReviewReply{Text: "second"})
+	assert.Equal(t, myerr.AlreadyExist, err)
+}
+
+func TestGetReviews(t *testing.T) {
+	userReviews := []*models.Review{{Id: 3, TargetId: 2, Score: 5}}
+	rr := mocks.ReviewRepository{}
+	or := orderMocks.OrderRepository{}
+	rr.On("SelectByTargetId", int64(2), int64(0), int64(50)).Return(userReviews, nil)
+
+	ru := NewReviewUsecase(&rr, &or)
+	res, err := ru.GetReviews(2, &models.Page{PageNum: 0, Count: 50})
+	assert.Nil(t, err)
+	assert.Equal(t, userReviews, res)
+}
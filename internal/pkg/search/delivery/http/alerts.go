@@ -0,0 +1,59 @@
+package delivery
+
+import (
+	"fmt"
+	"strings"
+	"yula/internal/models"
+	"yula/internal/pkg/notifications"
+	"yula/internal/pkg/search"
+)
+
+// SavedSearchJob - фоновая задача: присылает пользователям новые объявления по их сохраненным поискам
+type SavedSearchJob struct {
+	searchUsecase search.SearchUsecase
+	notifier      notifications.Notifier
+}
+
+func NewSavedSearchJob(searchUsecase search.SearchUsecase, notifier notifications.Notifier) *SavedSearchJob {
+	return &SavedSearchJob{
+		searchUsecase: searchUsecase,
+		notifier:      notifier,
+	}
+}
+
+func (sj *SavedSearchJob) Run() {
+	alerts, err := sj.searchUsecase.CollectSavedSearchAlerts()
+	if err != nil {
+		logger.Warnf("can not collect saved search alerts: %s", err.Error())
+	}
+	for _, alert := range alerts {
+		sj.notify(alert)
+	}
+}
+
+func savedSearchAlertText(alert *models.SavedSearchAlert) string {
+	adverts := make([]string, 0, len(alert.Adverts))
+	for _, advert := range alert.Adverts {
+		adverts = append(adverts, fmt.Sprintf("\"%s\" for %d", advert.Name, advert.Price))
+	}
+
+	text := fmt.Sprintf("New adverts for saved search \"%s\": %s", alert.SavedSearch.Name, strings.Join(adverts, ", "))
+	if more := alert.Total - len(alert.Adverts); more > 0 {
+		text += fmt.Sprintf(" and %d more", more)
+	}
+	return text
+}
+
+// notify ссылается на самое свежее из найденных объявлений
+func (sj *SavedSearchJob) notify(alert *models.SavedSearchAlert) {
+	userId := alert.SavedSearch.UserId
+	err := sj.notifier.Notify(&models.Notification{
+		UserId:   userId,
+		Kind:     models.NotificationSavedSearch,
+		Text:     savedSearchAlertText(alert),
+		AdvertId: alert.Adverts[0].Id,
+	})
+	if err != nil {
+		logger.Warnf("can not notify user %d about saved search %d: %s", userId, alert.SavedSearch.Id, err.Error())
+	}
+}
@@ -29,8 +29,12 @@ var (
 	logger logging.Logger = logging.GetLogger()
 )
 
-func (sh *SearchHandler) Routing(r *mux.Router) {
+func (sh *SearchHandler) Routing(r *mux.Router, sm *middleware.SessionMiddleware) {
 	r.Handle("/search", middleware.SetSCRFToken(http.HandlerFunc(sh.SearchHandler))).Methods(http.MethodGet, http.MethodOptions)
+
+	r.Handle("/search/saved", middleware.SetSCRFToken(sm.CheckAuthorized(http.HandlerFunc(sh.SavedSearchListHandler)))).Methods(http.MethodGet, http.MethodOptions)
+	r.Handle("/search/saved", sm.CheckAuthorized(http.HandlerFunc(sh.SaveSearchHandler))).Methods(http.MethodPost, http.MethodOptions)
+	r.Handle("/search/saved/{id:[0-9]+}", sm.CheckAuthorized(http.HandlerFunc(sh.DeleteSavedSearchHandler))).Methods(http.MethodDelete, http.MethodOptions)
 }
 
 // AdvertListHandler godoc
@@ -47,6 +51,7 @@ func (sh *SearchHandler) Routing(r *mux.Router) {
 // @Param radius query string false "Radius"
 // @Param sorting_name query string false "Sort by name"
 // @Param sorting_date query string false "Sort by date"
+// @Param attr.{name} query string false "Attribute value, attr.{name}_from and attr.{name}_to bound numeric attributes"
 // @Param page query string false "Page num"
 // @Param count query string false "Count adverts per page"
 // @Success 200 {object} models.HttpBodyInterface{body=[]models.Advert}
@@ -146,3 +146,53 @@ func TestSearchHandlerError3(t *testing.T) {
 
 	assert.Equal(t, Answer.Code, 400)
 }
+
+func TestSearchHandlerAttributes(t *testing.T) {
+	su := mocks.SearchUsecase{}
+	sh := NewSearchHandler(&su)
+
+	router := mux.NewRouter().PathPrefix("/search").Subrouter()
+	router.Use(middleware.LoggerMiddleware)
+	router.Handle("", http.HandlerFunc(sh.SearchHandler)).Methods(http.MethodGet, http.MethodOptions)
+
+	sf := &models.SearchFilter{
+		Query: "query", Date: time.Time{}, TimeDuration: -1, Latitude: -80, Longitude: 80, Radius: -1,
+		Attributes: map[string]string{"size": "M"}, AttributesFrom: map[string]float64{"year": 2015},
+	}
+	page := &models.Page{PageNum: 0, Count: 50}
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	su.On("SearchWithFilter", sf, page).Return(make([]*models.Advert, 0), nil)
+
+	res, err := http.Get(fmt.Sprintf("%s/search?query=query&attr.size=M&attr.year_from=2015", srv.URL))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, 200, Answer.Code)
+}
+
+func TestSearchHandlerAttributeBoundInvalid(t *testing.T) {
+	su := mocks.SearchUsecase{}
+	sh := NewSearchHandler(&su)
+
+	router := mux.NewRouter().PathPrefix("/search").Subrouter()
+	router.Use(middleware.LoggerMiddleware)
+	router.Handle("", http.HandlerFunc(sh.SearchHandler)).Methods(http.MethodGet, http.MethodOptions)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	res, err := http.Get(fmt.Sprintf("%s/search?query=query&attr.year_from=abc", srv.URL))
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, Answer.Code)
+}
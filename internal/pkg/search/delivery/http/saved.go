@@ -0,0 +1,155 @@
+package delivery
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/middleware"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	"github.com/sirupsen/logrus"
+)
+
+// SaveSearchHandler godoc
+// @Summary Save search
+// @Description Save search under a name, params are query parameters of /search.
+// @Description New adverts matching saved search are sent to the user periodically
+// @Tags search
+// @Accept application/json
+// @Produce application/json
+// @Param body body models.SavedSearchInput true "Saved search"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodySavedSearch}
+// @failure default {object} models.HttpError
+// @Router /search/saved [post]
+func (sh *SearchHandler) SaveSearchHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	defer r.Body.Close()
+	input := &models.SavedSearchInput{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = easyjson.Unmarshal(buf, input)
+	}
+	if err == nil {
+		_, err = govalidator.ValidateStruct(input)
+	}
+	if err != nil {
+		logger.Warnf("invalid data: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	saved, err := sh.searchUsecase.SaveSearch(userId, input)
+	if err != nil {
+		logger.Warnf("can not save search: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodySavedSearch{SavedSearch: *saved}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "search saved", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// SavedSearchListHandler godoc
+// @Summary Saved searches
+// @Description Saved searches of current user
+// @Tags search
+// @Produce application/json
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodySavedSearches}
+// @failure default {object} models.HttpError
+// @Router /search/saved [get]
+func (sh *SearchHandler) SavedSearchListHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	searches, err := sh.searchUsecase.GetSavedSearches(userId)
+	if err != nil {
+		logger.Warnf("can not get saved searches: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodySavedSearches{SavedSearches: searches}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "saved searches got successfully", body))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// DeleteSavedSearchHandler godoc
+// @Summary Delete saved search
+// @Description Delete saved search of current user
+// @Tags search
+// @Produce application/json
+// @Param id path integer true "Saved search id"
+// @Success 200 {object} models.HttpBodyInterface
+// @failure default {object} models.HttpError
+// @Router /search/saved/{id} [delete]
+func (sh *SearchHandler) DeleteSavedSearchHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	searchId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("can not parse saved search id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	err = sh.searchUsecase.DeleteSavedSearch(userId, searchId)
+	if err != nil {
+		logger.Warnf("can not delete saved search %d: %s", searchId, err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(models.ToBytes(http.StatusOK, "saved search deleted", nil))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
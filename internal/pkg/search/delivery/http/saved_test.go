@@ -0,0 +1,130 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	myerror "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/middleware"
+	"yula/internal/pkg/search/mocks"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	notifMock "yula/internal/pkg/notifications/mocks"
+)
+
+func newSavedRouter(sh *SearchHandler, userId int64) *mux.Router {
+	router := mux.NewRouter()
+	router.HandleFunc("/search/saved", sh.SaveSearchHandler).Methods(http.MethodPost, http.MethodOptions)
+	router.HandleFunc("/search/saved", sh.SavedSearchListHandler).Methods(http.MethodGet, http.MethodOptions)
+	router.HandleFunc("/search/saved/{id:[0-9]+}", sh.DeleteSavedSearchHandler).Methods(http.MethodDelete, http.MethodOptions)
+	router.Use(middleware.LoggerMiddleware)
+	router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), middleware.ContextUserId, userId)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	})
+	return router
+}
+
+func doSavedRequest(t *testing.T, method, url string, body []byte) models.HttpBodyInterface {
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	assert.NoError(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+
+	var answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&answer)
+	assert.NoError(t, err)
+	return answer
+}
+
+func TestSaveSearchHandler(t *testing.T) {
+	su := mocks.SearchUsecase{}
+	sh := NewSearchHandler(&su)
+	srv := httptest.NewServer(newSavedRouter(sh, 1))
+	defer srv.Close()
+
+	input := &models.SavedSearchInput{Name: "худи", Params: "query=худи"}
+	su.On("SaveSearch", int64(1), input).Return(&models.SavedSearch{Id: 3, UserId: 1, Name: input.Name, Params: input.Params}, nil).Once()
+
+	body, _ := json.Marshal(input)
+	answer := doSavedRequest(t, http.MethodPost, fmt.Sprintf("%s/search/saved", srv.URL), body)
+	assert.Equal(t, http.StatusOK, answer.Code)
+
+	su.On("SaveSearch", int64(1), input).Return(nil, myerror.SavedSearchLimit)
+
+	answer = doSavedRequest(t, http.MethodPost, fmt.Sprintf("%s/search/saved", srv.URL), body)
+	metaCode, _ := myerror.ToMetaStatus(myerror.SavedSearchLimit)
+	assert.Equal(t, metaCode, answer.Code)
+}
+
+func TestSaveSearchHandlerInvalidData(t *testing.T) {
+	su := mocks.SearchUsecase{}
+	sh := NewSearchHandler(&su)
+	srv := httptest.NewServer(newSavedRouter(sh, 1))
+	defer srv.Close()
+
+	answer := doSavedRequest(t, http.MethodPost, fmt.Sprintf("%s/search/saved", srv.URL), []byte(`{"name":""}`))
+	assert.Equal(t, http.StatusBadRequest, answer.Code)
+	su.AssertNotCalled(t, "SaveSearch", mock.Anything, mock.Anything)
+}
+
+func TestSavedSearchListHandler(t *testing.T) {
+	su := mocks.SearchUsecase{}
+	sh := NewSearchHandler(&su)
+	srv := httptest.NewServer(newSavedRouter(sh, 1))
+	defer srv.Close()
+
+	su.On("GetSavedSearches", int64(1)).Return([]*models.SavedSearch{{Id: 3, Name: "худи"}}, nil)
+
+	answer := doSavedRequest(t, http.MethodGet, fmt.Sprintf("%s/search/saved", srv.URL), nil)
+	assert.Equal(t, http.StatusOK, answer.Code)
+}
+
+func TestDeleteSavedSearchHandler(t *testing.T) {
+	su := mocks.SearchUsecase{}
+	sh := NewSearchHandler(&su)
+	srv := httptest.NewServer(newSavedRouter(sh, 1))
+	defer srv.Close()
+
+	su.On("DeleteSavedSearch", int64(1), int64(3)).Return(nil)
+	su.On("DeleteSavedSearch", int64(1), int64(4)).Return(myerror.NotExist)
+
+	answer := doSavedRequest(t, http.MethodDelete, fmt.Sprintf("%s/search/saved/3", srv.URL), nil)
+	assert.Equal(t, http.StatusOK, answer.Code)
+
+	answer = doSavedRequest(t, http.MethodDelete, fmt.Sprintf("%s/search/saved/4", srv.URL), nil)
+	metaCode, _ := myerror.ToMetaStatus(myerror.NotExist)
+	assert.Equal(t, metaCode, answer.Code)
+}
+
+func TestSavedSearchJobRun(t *testing.T) {
+	su := mocks.SearchUsecase{}
+	nt := notifMock.Notifier{}
+	sj := NewSavedSearchJob(&su, &nt)
+
+	su.On("CollectSavedSearchAlerts").Return([]*models.SavedSearchAlert{{
+		SavedSearch: &models.SavedSearch{Id: 3, UserId: 1, Name: "худи"},
+		Total:       3,
+		Adverts:     []*models.AdvertShort{{Id: 7, Name: "aboba", Price: 100}, {Id: 5, Name: "abeba", Price: 200}},
+	}}, nil)
+	nt.On("Notify", mock.MatchedBy(func(n *models.Notification) bool {
+		return n.UserId == 1 && n.Kind == models.NotificationSavedSearch && n.AdvertId == 7 &&
+			strings.Contains(n.Text, `"aboba" for 100, "abeba" for 200 and 1 more`)
+	})).Return(nil).Once()
+
+	sj.Run()
+
+	nt.AssertExpectations(t)
+}
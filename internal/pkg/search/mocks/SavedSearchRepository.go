@@ -0,0 +1,102 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SavedSearchRepository is an autogenerated mock type for the SavedSearchRepository type
+type SavedSearchRepository struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: searchId, userId
+func (_m *SavedSearchRepository) Delete(searchId int64, userId int64) error {
+	ret := _m.Called(searchId, userId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(searchId, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Insert provides a mock function with given fields: _a0
+func (_m *SavedSearchRepository) Insert(_a0 *models.SavedSearch) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.SavedSearch) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SelectAll provides a mock function with given fields:
+func (_m *SavedSearchRepository) SelectAll() ([]*models.SavedSearch, error) {
+	ret := _m.Called()
+
+	var r0 []*models.SavedSearch
+	if rf, ok := ret.Get(0).(func() []*models.SavedSearch); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SavedSearch)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SelectByUser provides a mock function with given fields: userId
+func (_m *SavedSearchRepository) SelectByUser(userId int64) ([]*models.SavedSearch, error) {
+	ret := _m.Called(userId)
+
+	var r0 []*models.SavedSearch
+	if rf, ok := ret.Get(0).(func(int64) []*models.SavedSearch); ok {
+		r0 = rf(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SavedSearch)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateLastRun provides a mock function with given fields: _a0
+func (_m *SavedSearchRepository) UpdateLastRun(_a0 *models.SavedSearch) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.SavedSearch) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -13,6 +13,89 @@ type SearchUsecase struct {
 	mock.Mock
 }
 
+// CollectSavedSearchAlerts provides a mock function with given fields:
+func (_m *SearchUsecase) CollectSavedSearchAlerts() ([]*models.SavedSearchAlert, error) {
+	ret := _m.Called()
+
+	var r0 []*models.SavedSearchAlert
+	if rf, ok := ret.Get(0).(func() []*models.SavedSearchAlert); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SavedSearchAlert)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteSavedSearch provides a mock function with given fields: userId, searchId
+func (_m *SearchUsecase) DeleteSavedSearch(userId int64, searchId int64) error {
+	ret := _m.Called(userId, searchId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(userId, searchId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetSavedSearches provides a mock function with given fields: userId
+func (_m *SearchUsecase) GetSavedSearches(userId int64) ([]*models.SavedSearch, error) {
+	ret := _m.Called(userId)
+
+	var r0 []*models.SavedSearch
+	if rf, ok := ret.Get(0).(func(int64) []*models.SavedSearch); ok {
+		r0 = rf(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SavedSearch)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveSearch provides a mock function with given fields: userId, input
+func (_m *SearchUsecase) SaveSearch(userId int64, input *models.SavedSearchInput) (*models.SavedSearch, error) {
+	ret := _m.Called(userId, input)
+
+	var r0 *models.SavedSearch
+	if rf, ok := ret.Get(0).(func(int64, *models.SavedSearchInput) *models.SavedSearch); ok {
+		r0 = rf(userId, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SavedSearch)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, *models.SavedSearchInput) error); ok {
+		r1 = rf(userId, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SearchWithFilter provides a mock function with given fields: query, page
 func (_m *SearchUsecase) SearchWithFilter(query *models.SearchFilter, page *models.Page) ([]*models.Advert, error) {
 	ret := _m.Called(query, page)
@@ -5,3 +5,11 @@ import "yula/internal/models"
 type SearchRepository interface {
 	SelectWithFilter(search *models.SearchFilter, from, count int64) ([]*models.Advert, error)
 }
+
+type SavedSearchRepository interface {
+	Insert(search *models.SavedSearch) error
+	SelectByUser(userId int64) ([]*models.SavedSearch, error)
+	SelectAll() ([]*models.SavedSearch, error)
+	Delete(searchId int64, userId int64) error
+	UpdateLastRun(search *models.SavedSearch) error
+}
@@ -9,6 +9,8 @@ import (
 	"yula/internal/models"
 	imageloader "yula/internal/pkg/image_loader"
 	"yula/internal/pkg/search"
+
+	"github.com/mailru/easyjson"
 )
 
 type SearchRepository struct {
@@ -27,37 +29,71 @@ func (sr *SearchRepository) SelectWithFilter(search *models.SearchFilter, from,
 	queryStr := `
 		SELECT * FROM (
 			SELECT a.id "id", a.name "name_", a.description, a.price, a.location, a.latitude, a.longitude, a.published_at, 
-				a.date_close, a.is_active, a.views, a.publisher_id, c.name "category", array_agg(ai.img_path), a.amount, a.is_new FROM advert a
+				a.date_close, a.is_active, a.views, a.publisher_id, c.name "category", array_agg(ai.img_path), a.amount, a.is_new, 
+				a.status, a.attributes, p.promo_level FROM advert a
 			JOIN category c ON a.category_id = c.Id
+			JOIN promotion as p ON a.id = p.advert_id
 			LEFT JOIN advert_image ai ON a.id = ai.advert_id 
 			GROUP BY a.id, a.name, a.Description,  a.price, a.location, a.latitude, a.longitude, a.published_at, 
-				a.date_close, a.is_active, a.views, a.publisher_id, c.name
+				a.date_close, a.is_active, a.views, a.publisher_id, c.name, p.promo_level
 		) as t
-		WHERE t.is_active AND plainto_tsquery($%d) @@ (to_tsvector(t.name_) || to_tsvector(t.description)) 
+		WHERE t.status = 'published' AND t.publisher_id NOT IN (SELECT id FROM users WHERE is_hidden)
+			AND plainto_tsquery($%d) @@ (to_tsvector(t.name_) || to_tsvector(t.description)) 
 	`
 	nums = append(nums, 1+len(nums))
 	vars = append(vars, search.Query)
 
+	// категория ищется вместе со всеми подкатегориями, имена категорий уникальны
 	if search.Category != "" {
-		queryStr += " AND t.category LIKE $%d"
-		nums = append(nums, 1+len(nums))
+		queryStr += ` AND t.category IN (
+			WITH RECURSIVE subtree AS (
+				SELECT id, name FROM category WHERE lower(name) = lower($%d) OR slug = $%d
+				UNION
+				SELECT c.id, c.name FROM category c JOIN subtree s ON c.parent_id = s.id
+			)
+			SELECT name FROM subtree
+		)`
+		// имя категории подставляется дважды, поэтому дальше номера считаем по аргументам, а не по плейсхолдерам
+		category := 1 + len(vars)
+		nums = append(nums, category, category)
 		vars = append(vars, search.Category)
 	}
 
 	if search.TimeDuration != models.TimeDurationNone {
 		queryStr += " AND (SELECT EXTRACT(DAY FROM ($%d - t.published_at))) < $%d"
-		nums = append(nums, 1+len(nums), 2+len(nums))
+		nums = append(nums, 1+len(vars), 2+len(vars))
 		vars = append(vars, search.Date, search.TimeDuration)
 	}
 
+	if !search.PublishedFrom.IsZero() {
+		queryStr += " AND t.published_at > $%d"
+		nums = append(nums, 1+len(vars))
+		vars = append(vars, search.PublishedFrom)
+	}
+
 	if search.Radius != models.RadiusNone && search.Latitude != models.LatitudeNone && search.Longitude != models.LongitudeNone {
 		queryStr += ` AND ST_DWithin(Geography(ST_SetSRID(ST_POINT(longitude, latitude), 4326)),
 									 Geography(ST_SetSRID(ST_POINT($%d, $%d), 4326)),
 									 $%d)`
-		nums = append(nums, 1+len(nums), 2+len(nums), 3+len(nums))
+		nums = append(nums, 1+len(vars), 2+len(vars), 3+len(vars))
 		vars = append(vars, search.Longitude, search.Latitude, search.Radius)
 	}
 
+	// ключи обходим в отсортированном порядке, чтобы порядок аргументов запроса был стабильным
+	names := make([]string, 0, len(search.Attributes))
+	for name := range search.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		queryStr += " AND t.attributes->>$%d = $%d"
+		nums = append(nums, 1+len(vars), 2+len(vars))
+		vars = append(vars, name, search.Attributes[name])
+	}
+
+	queryStr += sr.attributeBounds(search.AttributesFrom, ">=", &nums, &vars)
+	queryStr += sr.attributeBounds(search.AttributesTo, "<=", &nums, &vars)
+
 	if search.SortingName {
 		queryStr += " ORDER BY t.name_"
 	}
@@ -81,15 +117,22 @@ func (sr *SearchRepository) SelectWithFilter(search *models.SearchFilter, from,
 	for query.Next() {
 		var advert models.Advert
 		var images string
+		var attributes []byte
 
 		err = query.Scan(&advert.Id, &advert.Name, &advert.Description, &advert.Price, &advert.Location, &advert.Latitude,
 			&advert.Longitude, &advert.PublishedAt, &advert.DateClose, &advert.IsActive, &advert.Views,
-			&advert.PublisherId, &advert.Category, &images, &advert.Amount, &advert.IsNew)
+			&advert.PublisherId, &advert.Category, &images, &advert.Amount, &advert.IsNew, &advert.Status, &attributes,
+			&advert.PromoLevel)
 
 		if err != nil {
 			return nil, internalError.GenInternalError(err)
 		}
 
+		err = easyjson.Unmarshal(attributes, &advert.Attributes)
+		if err != nil {
+			return nil, internalError.GenInternalError(err)
+		}
+
 		advert.Images = make([]string, 0)
 		if images[1:len(images)-1] != "NULL" {
 			advert.Images = strings.Split(images[1:len(images)-1], ",")
@@ -104,3 +147,24 @@ func (sr *SearchRepository) SelectWithFilter(search *models.SearchFilter, from,
 	}
 	return adverts, nil
 }
+
+// attributeBounds добавляет к запросу границы числовых атрибутов,
+// у объявлений других категорий атрибут с тем же именем может быть не числом, такие не подходят
+func (sr *SearchRepository) attributeBounds(bounds map[string]float64, operator string, nums *[]interface{}, vars *[]interface{}) string {
+	names := make([]string, 0, len(bounds))
+	for name := range bounds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	queryStr := ""
+	for _, name := range names {
+		queryStr += ` AND CASE WHEN t.attributes->>$%d ~ '^-?[0-9]+(\.[0-9]+)?$' THEN (t.attributes->>$%d)::numeric END ` +
+			operator + " $%d"
+		// имя атрибута подставляется дважды, поэтому номера считаем по аргументам, а не по плейсхолдерам
+		key, bound := 1+len(*vars), 2+len(*vars)
+		*nums = append(*nums, key, key, bound)
+		*vars = append(*vars, name, bounds[name])
+	}
+	return queryStr
+}
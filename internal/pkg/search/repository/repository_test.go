@@ -59,13 +59,14 @@ func TestSelectWithFilter(t *testing.T) {
 	repo := NewSearchRepository(db)
 
 	rows := sqlmock.NewRows([]string{"id", "name", "description", "price", "location", "latitude", "longitude", "published_at",
-		"date_close", "is_active", "views", "publisher_id", "c.name", "array_agg(ai.img_path)", "amount", "a.is_new"},
+		"date_close", "is_active", "views", "publisher_id", "c.name", "array_agg(ai.img_path)", "amount", "a.is_new", "a.status",
+		"a.attributes", "p.promo_level"},
 	)
 	rows.AddRow(testadvert.Id, testadvert.Name, testadvert.Description, testadvert.Price, testadvert.Location, testadvert.Latitude,
 		testadvert.Longitude, testadvert.PublishedAt, testadvert.DateClose, testadvert.IsActive, testadvert.Views, testadvert.PublisherId,
-		testadvert.Category, testimages, testadvert.Amount, testadvert.IsNew,
+		testadvert.Category, testimages, testadvert.Amount, testadvert.IsNew, models.AdvertStatusPublished, []byte("{}"), int64(0),
 	)
-	mock.ExpectQuery("SELECT").WithArgs(sf.Query, sf.Category, sf.Date, sf.TimeDuration, sf.Longitude, sf.Latitude, sf.Radius).
+	mock.ExpectQuery(`t.category IN \(\s*WITH RECURSIVE subtree`).WithArgs(sf.Query, sf.Category, sf.Date, sf.TimeDuration, sf.Longitude, sf.Latitude, sf.Radius).
 		WillReturnRows(rows)
 
 	ads, err := repo.SelectWithFilter(sf, testpage.PageNum, testpage.Count)
@@ -74,6 +75,61 @@ func TestSelectWithFilter(t *testing.T) {
 	assert.NotNil(t, ads)
 }
 
+func TestSelectWithFilterPlaceholders(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	sf := &models.SearchFilter{
+		Query: "aboba", Category: "boba", Date: ParseTime(), TimeDuration: 30, Latitude: 30.0, Longitude: 30.0,
+		Radius: 500, Attributes: map[string]string{"size": "M"}, AttributesFrom: map[string]float64{"year": 2015},
+	}
+
+	repo := NewSearchRepository(db)
+
+	// категория занимает один аргумент, но два плейсхолдера, следующие номера идут по аргументам
+	mock.ExpectQuery(`lower\(name\) = lower\(\$2\) OR slug = \$2`+
+		`[\s\S]*EXTRACT\(DAY FROM \(\$3 - t.published_at\)\)\) < \$4`+
+		`[\s\S]*ST_POINT\(\$5, \$6\), 4326\)\),\s*\$7\)`+
+		` AND t.attributes->>\$8 = \$9`+
+		` AND CASE WHEN t.attributes->>\$10 ~ [\s\S]* THEN \(t.attributes->>\$10\)::numeric END >= \$11`).
+		WithArgs(sf.Query, sf.Category, sf.Date, sf.TimeDuration, sf.Longitude, sf.Latitude, sf.Radius, "size", "M", "year", 2015.0).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err = repo.SelectWithFilter(sf, testpage.PageNum, testpage.Count)
+
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectWithFilterPublishedFrom(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	sf := &models.SearchFilter{
+		Query: "aboba", Category: "boba", Date: ParseTime(), TimeDuration: 30, Latitude: models.LatitudeNone,
+		Longitude: models.LongitudeNone, Radius: models.RadiusNone, PublishedFrom: ParseTime(),
+	}
+
+	repo := NewSearchRepository(db)
+
+	mock.ExpectQuery(`EXTRACT\(DAY FROM \(\$3 - t.published_at\)\)\) < \$4 AND t.published_at > \$5`).
+		WithArgs(sf.Query, sf.Category, sf.Date, sf.TimeDuration, sf.PublishedFrom).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err = repo.SelectWithFilter(sf, testpage.PageNum, testpage.Count)
+
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
 func TestSelectWithFilterError(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -89,11 +145,12 @@ func TestSelectWithFilterError(t *testing.T) {
 	repo := NewSearchRepository(db)
 
 	rows := sqlmock.NewRows([]string{"id", "name", "description", "price", "location", "latitude", "longitude", "published_at",
-		"date_close", "is_active", "views", "publisher_id", "c.name", "array_agg(ai.img_path)", "amount", "a.is_new"},
+		"date_close", "is_active", "views", "publisher_id", "c.name", "array_agg(ai.img_path)", "amount", "a.is_new", "a.status",
+		"a.attributes", "p.promo_level"},
 	)
 	rows.AddRow(testadvert.Id, testadvert.Name, testadvert.Description, testadvert.Price, testadvert.Location, testadvert.Latitude,
 		testadvert.Longitude, testadvert.PublishedAt, testadvert.DateClose, testadvert.IsActive, testadvert.Views, testadvert.PublisherId,
-		testadvert.Category, testimages, testadvert.Amount, testadvert.IsNew,
+		testadvert.Category, testimages, testadvert.Amount, testadvert.IsNew, models.AdvertStatusPublished, []byte("{}"), int64(0),
 	)
 	mock.ExpectQuery("SELECT").WithArgs(sf.Query, sf.Category, sf.Date, sf.TimeDuration, sf.Longitude, sf.Latitude, sf.Radius)
 
@@ -103,3 +160,39 @@ func TestSelectWithFilterError(t *testing.T) {
 	err = mock.ExpectationsWereMet()
 	assert.Nil(t, err)
 }
+
+func TestSelectWithAttributeFilter(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	sf := &models.SearchFilter{
+		Query: "aboba", TimeDuration: models.TimeDurationNone, Latitude: models.LatitudeNone, Longitude: models.LongitudeNone,
+		Radius: models.RadiusNone, Attributes: map[string]string{"size": "M", "color": "red"},
+		AttributesFrom: map[string]float64{"year": 2015}, AttributesTo: map[string]float64{"year": 2020},
+	}
+
+	repo := NewSearchRepository(db)
+
+	rows := sqlmock.NewRows([]string{"id", "name", "description", "price", "location", "latitude", "longitude", "published_at",
+		"date_close", "is_active", "views", "publisher_id", "c.name", "array_agg(ai.img_path)", "amount", "a.is_new", "a.status",
+		"a.attributes", "p.promo_level"},
+	)
+	rows.AddRow(testadvert.Id, testadvert.Name, testadvert.Description, testadvert.Price, testadvert.Location, testadvert.Latitude,
+		testadvert.Longitude, testadvert.PublishedAt, testadvert.DateClose, testadvert.IsActive, testadvert.Views, testadvert.PublisherId,
+		testadvert.Category, testimages, testadvert.Amount, testadvert.IsNew, models.AdvertStatusPublished,
+		[]byte(`{"size":"M","color":"red","year":"2017"}`), int64(0),
+	)
+	mock.ExpectQuery(`t.attributes->>\$2 = \$3 AND t.attributes->>\$4 = \$5 AND .*\$6.*\$6.* >= \$7 AND .*\$8.*\$8.* <= \$9`).
+		WithArgs(sf.Query, "color", "red", "size", "M", "year", float64(2015), "year", float64(2020)).
+		WillReturnRows(rows)
+
+	ads, err := repo.SelectWithFilter(sf, testpage.PageNum, testpage.Count)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2017", ads[0].Attributes["year"])
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/search"
+)
+
+type SavedSearchRepository struct {
+	db *sql.DB
+}
+
+func NewSavedSearchRepository(db *sql.DB) search.SavedSearchRepository {
+	return &SavedSearchRepository{
+		db: db,
+	}
+}
+
+const savedSearchColumns string = "id, user_id, name, params, last_run_at, created_at"
+
+func (sr *SavedSearchRepository) Insert(saved *models.SavedSearch) error {
+	query := sr.db.QueryRowContext(context.Background(),
+		`INSERT INTO saved_search (user_id, name, params) VALUES ($1, $2, $3) RETURNING id, last_run_at, created_at;`,
+		saved.UserId, saved.Name, saved.Params)
+
+	err := query.Scan(&saved.Id, &saved.LastRunAt, &saved.CreatedAt)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+	return nil
+}
+
+func (sr *SavedSearchRepository) selectMany(queryStr string, args ...interface{}) ([]*models.SavedSearch, error) {
+	rows, err := sr.db.QueryContext(context.Background(), queryStr, args...)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+
+	defer rows.Close()
+	searches := make([]*models.SavedSearch, 0)
+	for rows.Next() {
+		var saved models.SavedSearch
+		err = rows.Scan(&saved.Id, &saved.UserId, &saved.Name, &saved.Params, &saved.LastRunAt, &saved.CreatedAt)
+		if err != nil {
+			return nil, internalError.GenInternalError(err)
+		}
+
+		searches = append(searches, &saved)
+	}
+	return searches, nil
+}
+
+func (sr *SavedSearchRepository) SelectByUser(userId int64) ([]*models.SavedSearch, error) {
+	return sr.selectMany("SELECT "+savedSearchColumns+" FROM saved_search WHERE user_id = $1 ORDER BY id;", userId)
+}
+
+func (sr *SavedSearchRepository) SelectAll() ([]*models.SavedSearch, error) {
+	return sr.selectMany("SELECT " + savedSearchColumns + " FROM saved_search ORDER BY id;")
+}
+
+func (sr *SavedSearchRepository) Delete(searchId int64, userId int64) error {
+	result, err := sr.db.ExecContext(context.Background(),
+		"DELETE FROM saved_search WHERE id = $1 AND user_id = $2;", searchId, userId)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+	if deleted == 0 {
+		return internalError.EmptyQuery
+	}
+	return nil
+}
+
+func (sr *SavedSearchRepository) UpdateLastRun(saved *models.SavedSearch) error {
+	_, err := sr.db.ExecContext(context.Background(),
+		"UPDATE saved_search SET last_run_at = $2 WHERE id = $1;", saved.Id, saved.LastRunAt)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"testing"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	sqlmock "gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestSavedSearchInsert(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewSavedSearchRepository(db)
+	saved := &models.SavedSearch{UserId: 1, Name: "худи", Params: "category=одежда&query=худи"}
+
+	mock.ExpectQuery("INSERT INTO saved_search").
+		WithArgs(saved.UserId, saved.Name, saved.Params).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "last_run_at", "created_at"}).AddRow(3, ParseTime(), ParseTime()))
+
+	err = repo.Insert(saved)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), saved.Id)
+	assert.Equal(t, ParseTime(), saved.LastRunAt)
+
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSavedSearchSelectByUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewSavedSearchRepository(db)
+
+	rows := sqlmock.NewRows([]string{"id", "user_id", "name", "params", "last_run_at", "created_at"}).
+		AddRow(1, 1, "худи", "query=худи", ParseTime(), ParseTime()).
+		AddRow(2, 1, "кроссовки", "query=кроссовки", ParseTime(), ParseTime())
+	mock.ExpectQuery("FROM saved_search WHERE user_id").WithArgs(1).WillReturnRows(rows)
+
+	searches, err := repo.SelectByUser(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(searches))
+	assert.Equal(t, "кроссовки", searches[1].Name)
+
+	mock.ExpectQuery("FROM saved_search ORDER BY id").WillReturnError(internalError.InternalError)
+
+	_, err = repo.SelectAll()
+	assert.Error(t, err)
+
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSavedSearchDelete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewSavedSearchRepository(db)
+
+	mock.ExpectExec("DELETE FROM saved_search").WithArgs(3, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	err = repo.Delete(3, 1)
+	assert.NoError(t, err)
+
+	// чужой или уже удаленный поиск
+	mock.ExpectExec("DELETE FROM saved_search").WithArgs(3, 2).WillReturnResult(sqlmock.NewResult(0, 0))
+	err = repo.Delete(3, 2)
+	assert.Equal(t, internalError.EmptyQuery, err)
+
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSavedSearchUpdateLastRun(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewSavedSearchRepository(db)
+	saved := &models.SavedSearch{Id: 3, LastRunAt: ParseTime()}
+
+	mock.ExpectExec("UPDATE saved_search SET last_run_at").WithArgs(saved.Id, saved.LastRunAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.UpdateLastRun(saved)
+	assert.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
@@ -4,4 +4,9 @@ import "yula/internal/models"
 
 type SearchUsecase interface {
 	SearchWithFilter(query *models.SearchFilter, page *models.Page) ([]*models.Advert, error)
+
+	SaveSearch(userId int64, input *models.SavedSearchInput) (*models.SavedSearch, error)
+	GetSavedSearches(userId int64) ([]*models.SavedSearch, error)
+	DeleteSavedSearch(userId int64, searchId int64) error
+	CollectSavedSearchAlerts() ([]*models.SavedSearchAlert, error)
 }
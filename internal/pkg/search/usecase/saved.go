@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"net/url"
+	"sort"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/logging"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/microcosm-cc/bluemonday"
+)
+
+var logger logging.Logger = logging.GetLogger()
+
+// parseSavedParams разбирает параметры сохраненного поиска так же, как GET /search,
+// и возвращает их без пагинации для хранения
+func parseSavedParams(params string) (*models.SearchFilter, string, error) {
+	values, err := url.ParseQuery(params)
+	if err != nil {
+		return nil, "", internalError.BadRequest
+	}
+
+	filter, err := models.NewSearchFilter(&values)
+	if err != nil {
+		return nil, "", err
+	}
+	_, err = govalidator.ValidateStruct(filter)
+	if err != nil {
+		return nil, "", internalError.BadRequest
+	}
+
+	sanitize := bluemonday.UGCPolicy()
+	filter.Query = sanitize.Sanitize(filter.Query)
+	filter.Category = sanitize.Sanitize(filter.Category)
+
+	values.Del("page")
+	values.Del("count")
+	return filter, values.Encode(), nil
+}
+
+func (su *SearchUsecase) SaveSearch(userId int64, input *models.SavedSearchInput) (*models.SavedSearch, error) {
+	_, params, err := parseSavedParams(input.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	searches, err := su.savedSearchRepository.SelectByUser(userId)
+	if err != nil {
+		return nil, err
+	}
+	if len(searches) >= models.SavedSearchLimit {
+		return nil, internalError.SavedSearchLimit
+	}
+
+	saved := &models.SavedSearch{
+		UserId: userId,
+		Name:   bluemonday.UGCPolicy().Sanitize(input.Name),
+		Params: params,
+	}
+	err = su.savedSearchRepository.Insert(saved)
+	if err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+func (su *SearchUsecase) GetSavedSearches(userId int64) ([]*models.SavedSearch, error) {
+	return su.savedSearchRepository.SelectByUser(userId)
+}
+
+func (su *SearchUsecase) DeleteSavedSearch(userId int64, searchId int64) error {
+	err := su.savedSearchRepository.Delete(searchId, userId)
+	if err == internalError.EmptyQuery {
+		return internalError.NotExist
+	}
+	return err
+}
+
+// CollectSavedSearchAlerts ищет по каждому сохраненному поиску объявления, опубликованные с прошлой проверки,
+// свои объявления пользователю не показываются
+func (su *SearchUsecase) CollectSavedSearchAlerts() ([]*models.SavedSearchAlert, error) {
+	searches, err := su.savedSearchRepository.SelectAll()
+	if err != nil {
+		return nil, err
+	}
+
+	alerts := make([]*models.SavedSearchAlert, 0)
+	for _, saved := range searches {
+		filter, _, err := parseSavedParams(saved.Params)
+		if err != nil {
+			logger.Warnf("saved search %d has invalid params: %s", saved.Id, err.Error())
+			continue
+		}
+
+		filter.PublishedFrom = saved.LastRunAt
+		adverts, err := su.searchRepository.SelectWithFilter(filter, 0, 0)
+		if err != nil && err != internalError.EmptyQuery {
+			return alerts, err
+		}
+
+		// отметку двигаем по published_at найденных объявлений, а не по часам сервера:
+		// время публикации ставит база, и сравнение идет с ее же значениями
+		watermark := saved.LastRunAt
+		for _, advert := range adverts {
+			if advert.PublishedAt.After(watermark) {
+				watermark = advert.PublishedAt
+			}
+		}
+		if watermark.After(saved.LastRunAt) {
+			saved.LastRunAt = watermark
+			err = su.savedSearchRepository.UpdateLastRun(saved)
+			if err != nil {
+				return alerts, err
+			}
+		}
+
+		fresh := make([]*models.Advert, 0, len(adverts))
+		for _, advert := range adverts {
+			if advert.PublisherId != saved.UserId {
+				fresh = append(fresh, advert)
+			}
+		}
+		if len(fresh) == 0 {
+			continue
+		}
+
+		sort.SliceStable(fresh, func(i, j int) bool {
+			return fresh[i].PublishedAt.After(fresh[j].PublishedAt)
+		})
+		alert := &models.SavedSearchAlert{
+			SavedSearch: saved,
+			Total:       len(fresh),
+			Adverts:     make([]*models.AdvertShort, 0, models.SavedSearchAlertAdverts),
+		}
+		for i := 0; i < len(fresh) && i < models.SavedSearchAlertAdverts; i++ {
+			alert.Adverts = append(alert.Adverts, fresh[i].ToShort())
+		}
+		alerts = append(alerts, alert)
+	}
+	return alerts, nil
+}
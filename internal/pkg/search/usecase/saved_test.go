@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+	myerror "yula/internal/error"
+	"yula/internal/models"
+
+	mockAdvt "yula/internal/pkg/advt/mocks"
+	mockSrch "yula/internal/pkg/search/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSaveSearch(t *testing.T) {
+	sr := &mockSrch.SearchRepository{}
+	ssr := &mockSrch.SavedSearchRepository{}
+	su := NewSearchUsecase(sr, &mockAdvt.AdvtRepository{}, ssr)
+
+	ssr.On("SelectByUser", int64(1)).Return([]*models.SavedSearch{}, nil)
+	ssr.On("Insert", mock.MatchedBy(func(saved *models.SavedSearch) bool {
+		// пагинация в сохраненный поиск не попадает
+		return saved.UserId == 1 && saved.Name == "худи" && saved.Params == "category=%D0%BE%D0%B4%D0%B5%D0%B6%D0%B4%D0%B0&query=%D1%85%D1%83%D0%B4%D0%B8"
+	})).Return(nil)
+
+	saved, err := su.SaveSearch(1, &models.SavedSearchInput{Name: "худи", Params: "query=худи&category=одежда&page=2&count=10"})
+	assert.NoError(t, err)
+	assert.Equal(t, "худи", saved.Name)
+	ssr.AssertExpectations(t)
+}
+
+func TestSaveSearchLimit(t *testing.T) {
+	ssr := &mockSrch.SavedSearchRepository{}
+	su := NewSearchUsecase(&mockSrch.SearchRepository{}, &mockAdvt.AdvtRepository{}, ssr)
+
+	searches := make([]*models.SavedSearch, models.SavedSearchLimit)
+	ssr.On("SelectByUser", int64(1)).Return(searches, nil)
+
+	_, err := su.SaveSearch(1, &models.SavedSearchInput{Name: "худи", Params: "query=худи"})
+	assert.Equal(t, myerror.SavedSearchLimit, err)
+	ssr.AssertNotCalled(t, "Insert", mock.Anything)
+}
+
+func TestSaveSearchInvalidParams(t *testing.T) {
+	ssr := &mockSrch.SavedSearchRepository{}
+	su := NewSearchUsecase(&mockSrch.SearchRepository{}, &mockAdvt.AdvtRepository{}, ssr)
+
+	_, err := su.SaveSearch(1, &models.SavedSearchInput{Name: "худи", Params: "radius=abc"})
+	assert.Error(t, err)
+	ssr.AssertNotCalled(t, "SelectByUser", mock.Anything)
+}
+
+func TestDeleteSavedSearch(t *testing.T) {
+	ssr := &mockSrch.SavedSearchRepository{}
+	su := NewSearchUsecase(&mockSrch.SearchRepository{}, &mockAdvt.AdvtRepository{}, ssr)
+
+	ssr.On("Delete", int64(3), int64(1)).Return(nil)
+	ssr.On("Delete", int64(3), int64(2)).Return(myerror.EmptyQuery)
+
+	err := su.DeleteSavedSearch(1, 3)
+	assert.NoError(t, err)
+
+	err = su.DeleteSavedSearch(2, 3)
+	assert.Equal(t, myerror.NotExist, err)
+}
+
+func TestCollectSavedSearchAlerts(t *testing.T) {
+	sr := &mockSrch.SearchRepository{}
+	ssr := &mockSrch.SavedSearchRepository{}
+	su := NewSearchUsecase(sr, &mockAdvt.AdvtRepository{}, ssr)
+
+	lastRun := time.Now().Add(-time.Hour)
+	saved := &models.SavedSearch{Id: 3, UserId: 1, Name: "худи", Params: "query=худи", LastRunAt: lastRun}
+	ssr.On("SelectAll").Return([]*models.SavedSearch{saved}, nil)
+
+	adverts := []*models.Advert{{Id: 1, PublisherId: 1, PublishedAt: lastRun.Add(time.Minute)}}
+	for i := 0; i < models.SavedSearchAlertAdverts+2; i++ {
+		adverts = append(adverts, &models.Advert{Id: int64(i + 2), PublisherId: 2, PublishedAt: lastRun.Add(time.Duration(i) * time.Minute)})
+	}
+	sr.On("SelectWithFilter", mock.MatchedBy(func(filter *models.SearchFilter) bool {
+		return filter.Query == "худи" && filter.PublishedFrom.Equal(lastRun)
+	}), int64(0), int64(0)).Return(adverts, nil)
+	// отметка - самое позднее время публикации среди найденных, включая свои объявления
+	ssr.On("UpdateLastRun", mock.MatchedBy(func(updated *models.SavedSearch) bool {
+		return updated.Id == 3 && updated.LastRunAt.Equal(lastRun.Add(time.Duration(models.SavedSearchAlertAdverts+1)*time.Minute))
+	})).Return(nil)
+
+	alerts, err := su.CollectSavedSearchAlerts()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(alerts))
+
+	// свое объявление отброшено, в сообщение попадают самые свежие
+	alert := alerts[0]
+	assert.Equal(t, models.SavedSearchAlertAdverts+2, alert.Total)
+	assert.Equal(t, models.SavedSearchAlertAdverts, len(alert.Adverts))
+	assert.Equal(t, int64(models.SavedSearchAlertAdverts+3), alert.Adverts[0].Id)
+	ssr.AssertExpectations(t)
+}
+
+func TestCollectSavedSearchAlertsNothingNew(t *testing.T) {
+	sr := &mockSrch.SearchRepository{}
+	ssr := &mockSrch.SavedSearchRepository{}
+	su := NewSearchUsecase(sr, &mockAdvt.AdvtRepository{}, ssr)
+
+	saved := &models.SavedSearch{Id: 3, UserId: 1, Name: "худи", Params: "query=худи"}
+	ssr.On("SelectAll").Return([]*models.SavedSearch{saved}, nil)
+	sr.On("SelectWithFilter", mock.Anything, int64(0), int64(0)).Return(nil, myerror.EmptyQuery)
+
+	alerts, err := su.CollectSavedSearchAlerts()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(alerts))
+	ssr.AssertNotCalled(t, "UpdateLastRun", mock.Anything)
+	ssr.AssertExpectations(t)
+}
@@ -8,14 +8,17 @@ import (
 )
 
 type SearchUsecase struct {
-	searchRepository search.SearchRepository
-	advertRepository advt.AdvtRepository
+	searchRepository      search.SearchRepository
+	advertRepository      advt.AdvtRepository
+	savedSearchRepository search.SavedSearchRepository
 }
 
-func NewSearchUsecase(searchRepository search.SearchRepository, advertRepository advt.AdvtRepository) search.SearchUsecase {
+func NewSearchUsecase(searchRepository search.SearchRepository, advertRepository advt.AdvtRepository,
+	savedSearchRepository search.SavedSearchRepository) search.SearchUsecase {
 	return &SearchUsecase{
-		searchRepository: searchRepository,
-		advertRepository: advertRepository,
+		searchRepository:      searchRepository,
+		advertRepository:      advertRepository,
+		savedSearchRepository: savedSearchRepository,
 	}
 }
 
@@ -23,7 +26,8 @@ func (su *SearchUsecase) SearchWithFilter(filter *models.SearchFilter, page *mod
 	adverts, err := su.searchRepository.SelectWithFilter(filter, page.PageNum, page.Count)
 	switch err {
 	case nil, internalError.EmptyQuery:
-		return adverts, nil
+		// выдача поиска приходит целиком, места под объявления в топе расставляются здесь
+		return models.ReservePromotedSlots(adverts, page.Count), nil
 	}
 	return nil, err
 }
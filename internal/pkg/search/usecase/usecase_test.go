@@ -14,7 +14,7 @@ import (
 func TestSearchWithFilter(t *testing.T) {
 	sr := &mockSrch.SearchRepository{}
 	ar := &mockAdvt.AdvtRepository{}
-	su := NewSearchUsecase(sr, ar)
+	su := NewSearchUsecase(sr, ar, &mockSrch.SavedSearchRepository{})
 
 	filter := &models.SearchFilter{}
 	page := &models.Page{}
@@ -29,7 +29,7 @@ func TestSearchWithFilter(t *testing.T) {
 func TestSearchWithFilterError(t *testing.T) {
 	sr := &mockSrch.SearchRepository{}
 	ar := &mockAdvt.AdvtRepository{}
-	su := NewSearchUsecase(sr, ar)
+	su := NewSearchUsecase(sr, ar, &mockSrch.SavedSearchRepository{})
 
 	filter := &models.SearchFilter{}
 	page := &models.Page{}
@@ -40,3 +40,27 @@ func TestSearchWithFilterError(t *testing.T) {
 
 	assert.Error(t, err)
 }
+
+func TestSearchWithFilterPromotedSlots(t *testing.T) {
+	sr := &mockSrch.SearchRepository{}
+	ar := &mockAdvt.AdvtRepository{}
+	su := NewSearchUsecase(sr, ar, &mockSrch.SavedSearchRepository{})
+
+	filter := &models.SearchFilter{}
+	page := &models.Page{PageNum: 0, Count: 4}
+
+	adverts := []*models.Advert{{Id: 1}, {Id: 2}, {Id: 3}, {Id: 4, PromoLevel: models.PromotionTopLevel},
+		{Id: 5}, {Id: 6, PromoLevel: models.PromotionHighlightLevel}, {Id: 7, PromoLevel: models.PromotionTopLevel},
+		{Id: 8, PromoLevel: models.PromotionTopLevel}}
+	sr.On("SelectWithFilter", filter, page.PageNum, page.Count).Return(adverts, nil)
+
+	result, err := su.SearchWithFilter(filter, page)
+	assert.NoError(t, err)
+
+	// на странице из 4 объявлений два места отдаются топу, выделение мест не получает
+	ids := make([]int64, 0, len(result))
+	for _, advert := range result {
+		ids = append(ids, advert.Id)
+	}
+	assert.Equal(t, []int64{4, 7, 1, 2, 8, 3, 5, 6}, ids)
+}
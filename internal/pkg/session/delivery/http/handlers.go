@@ -9,6 +9,7 @@ import (
 	auth "yula/proto/generated/auth"
 
 	"yula/internal/models"
+	"yula/internal/pkg/cart"
 	"yula/internal/pkg/logging"
 	"yula/internal/pkg/middleware"
 	"yula/internal/pkg/user"
@@ -23,11 +24,12 @@ import (
 type SessionHandler struct {
 	sessionUsecase auth.AuthClient
 	userUsecase    user.UserUsecase
+	cartUsecase    cart.CartUsecase
 }
 
-func NewSessionHandler(sessionUsecase auth.AuthClient, userUsecase user.UserUsecase) *SessionHandler {
+func NewSessionHandler(sessionUsecase auth.AuthClient, userUsecase user.UserUsecase, cartUsecase cart.CartUsecase) *SessionHandler {
 	return &SessionHandler{
-		sessionUsecase: sessionUsecase, userUsecase: userUsecase,
+		sessionUsecase: sessionUsecase, userUsecase: userUsecase, cartUsecase: cartUsecase,
 	}
 }
 
@@ -145,6 +147,16 @@ func (sh *SessionHandler) SignInHandler(w http.ResponseWriter, r *http.Request)
 		Secure:   true,
 	})
 
+	// переносим гостевую корзину в корзину пользователя
+	if token, ok := middleware.GuestToken(r); ok {
+		err = sh.cartUsecase.MergeGuestCart(token, user.Id)
+		if err != nil {
+			logger.Warnf("can not merge guest cart: %s", err.Error())
+		} else {
+			middleware.ClearGuestToken(w)
+		}
+	}
+
 	w.WriteHeader(http.StatusOK)
 	_, err = w.Write(models.ToBytes(http.StatusOK, "signin successfully", nil))
 	if err != nil {
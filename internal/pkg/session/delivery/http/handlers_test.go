@@ -16,6 +16,8 @@ import (
 
 	userMock "yula/internal/pkg/user/mocks"
 
+	cartMock "yula/internal/pkg/cart/mocks"
+
 	sessMock "yula/internal/services/auth/mocks"
 
 	imageloader "yula/internal/pkg/image_loader"
@@ -30,7 +32,8 @@ import (
 func TestSession_SignInHandler_Success(t *testing.T) {
 	ac := sessMock.AuthClient{}
 	uu := userMock.UserUsecase{}
-	sh := NewSessionHandler(&ac, &uu)
+	cu := cartMock.CartUsecase{}
+	sh := NewSessionHandler(&ac, &uu, &cu)
 
 	router := mux.NewRouter().PathPrefix("/").Subrouter()
 	router.Use(middleware.LoggerMiddleware)
@@ -90,7 +93,8 @@ func TestSession_SignInHandler_Success(t *testing.T) {
 func TestSession_SignInHandler_InvalidEmail(t *testing.T) {
 	su := sessMock.AuthClient{}
 	uu := userMock.UserUsecase{}
-	sh := NewSessionHandler(&su, &uu)
+	cu := cartMock.CartUsecase{}
+	sh := NewSessionHandler(&su, &uu, &cu)
 
 	router := mux.NewRouter().PathPrefix("/").Subrouter()
 	router.Use(middleware.LoggerMiddleware)
@@ -129,7 +133,8 @@ func TestSession_SignInHandler_InvalidEmail(t *testing.T) {
 func TestSession_SignInHandler_InvalidPassword(t *testing.T) {
 	su := sessMock.AuthClient{}
 	uu := userMock.UserUsecase{}
-	sh := NewSessionHandler(&su, &uu)
+	cu := cartMock.CartUsecase{}
+	sh := NewSessionHandler(&su, &uu, &cu)
 
 	router := mux.NewRouter().PathPrefix("/").Subrouter()
 	router.Use(middleware.LoggerMiddleware)
@@ -177,7 +182,8 @@ func TestSession_SignInHandler_InvalidPassword(t *testing.T) {
 func TestSession_SignInHandler_InvalidBody(t *testing.T) {
 	su := sessMock.AuthClient{}
 	uu := userMock.UserUsecase{}
-	sh := NewSessionHandler(&su, &uu)
+	cu := cartMock.CartUsecase{}
+	sh := NewSessionHandler(&su, &uu, &cu)
 
 	router := mux.NewRouter().PathPrefix("/").Subrouter()
 	router.Use(middleware.LoggerMiddleware)
@@ -203,7 +209,8 @@ func TestSession_SignInHandler_InvalidBody(t *testing.T) {
 func TestSession_LogOutHandler_Success(t *testing.T) {
 	su := sessMock.AuthClient{}
 	uu := userMock.UserUsecase{}
-	sh := NewSessionHandler(&su, &uu)
+	cu := cartMock.CartUsecase{}
+	sh := NewSessionHandler(&su, &uu, &cu)
 
 	router := mux.NewRouter().PathPrefix("/").Subrouter()
 	router.Use(middleware.LoggerMiddleware)
@@ -244,7 +251,8 @@ func TestSession_LogOutHandler_Success(t *testing.T) {
 func TestSession_LogOutHandler_InvalidName(t *testing.T) {
 	su := sessMock.AuthClient{}
 	uu := userMock.UserUsecase{}
-	sh := NewSessionHandler(&su, &uu)
+	cu := cartMock.CartUsecase{}
+	sh := NewSessionHandler(&su, &uu, &cu)
 
 	router := mux.NewRouter().PathPrefix("/").Subrouter()
 	router.Use(middleware.LoggerMiddleware)
@@ -283,7 +291,8 @@ func TestSession_LogOutHandler_InvalidName(t *testing.T) {
 func TestSession_LogOutHandler_InvalidValue(t *testing.T) {
 	su := sessMock.AuthClient{}
 	uu := userMock.UserUsecase{}
-	sh := NewSessionHandler(&su, &uu)
+	cu := cartMock.CartUsecase{}
+	sh := NewSessionHandler(&su, &uu, &cu)
 
 	router := mux.NewRouter().PathPrefix("/").Subrouter()
 	router.Use(middleware.LoggerMiddleware)
@@ -320,3 +329,80 @@ func TestSession_LogOutHandler_InvalidValue(t *testing.T) {
 	assert.Equal(t, Answer.Code, 404)
 	assert.Equal(t, Answer.Message, "not exist")
 }
+
+func TestSession_SignInHandler_MergeGuestCart(t *testing.T) {
+	ac := sessMock.AuthClient{}
+	uu := userMock.UserUsecase{}
+	cu := cartMock.CartUsecase{}
+	sh := NewSessionHandler(&ac, &uu, &cu)
+
+	router := mux.NewRouter().PathPrefix("/").Subrouter()
+	router.Use(middleware.LoggerMiddleware)
+	sh.Routing(router)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	reqUser := models.UserSignIn{
+		Password: "password",
+		Email:    "superchel@shibanov.jp",
+	}
+
+	user := models.UserData{
+		Id:        258,
+		Email:     reqUser.Email,
+		Password:  "aboba",
+		CreatedAt: time.Now(),
+		Image:     imageloader.DefaultAdvertImage,
+	}
+
+	sessionCreated := models.Session{
+		Value:     uuid.NewString(),
+		UserId:    user.Id,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	// получаем подписанную гостевую куку так же, как ее получает браузер
+	var guestToken string
+	guestRecorder := httptest.NewRecorder()
+	middleware.GuestCart(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		guestToken = r.Context().Value(middleware.ContextGuestToken).(string)
+	})).ServeHTTP(guestRecorder, httptest.NewRequest("GET", "/cart", nil))
+	guestCookie := guestRecorder.Result().Cookies()[0]
+
+	uu.On("GetByEmail", reqUser.Email).Return(&user, nil)
+	uu.On("CheckPassword", &user, reqUser.Password).Return(nil)
+	cu.On("MergeGuestCart", guestToken, user.Id).Return(nil)
+
+	ac.On("Create", mock.Anything, &auth.UserID{ID: user.Id}).Return(&auth.Result{
+		UserID:    sessionCreated.UserId,
+		SessionID: sessionCreated.Value,
+		ExpireAt:  timestamppb.New(sessionCreated.ExpiresAt),
+	}, nil)
+
+	reqBodyBuffer := new(bytes.Buffer)
+	err := json.NewEncoder(reqBodyBuffer).Encode(reqUser)
+	assert.Nil(t, err)
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/signin", srv.URL), reqBodyBuffer)
+	assert.Nil(t, err)
+	req.AddCookie(guestCookie)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, Answer.Code, 200)
+	cu.AssertCalled(t, "MergeGuestCart", guestToken, user.Id)
+
+	cleared := false
+	for _, cookie := range res.Cookies() {
+		if cookie.Name == middleware.GuestCartCookie && cookie.Value == "" {
+			cleared = true
+		}
+	}
+	assert.True(t, cleared)
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	internalError "yula/internal/error"
 	"yula/internal/models"
 	"yula/internal/pkg/logging"
@@ -41,7 +42,11 @@ func (uh *UserHandler) Routing(r *mux.Router, sm *middleware.SessionMiddleware)
 	s.Handle("/profile", sm.CheckAuthorized(http.HandlerFunc(uh.UpdateProfileHandler))).Methods(http.MethodPost, http.MethodOptions)
 	s.Handle("/profile/upload", sm.CheckAuthorized(http.HandlerFunc(uh.UploadProfileImageHandler))).Methods(http.MethodPost, http.MethodOptions)
 	s.Handle("/profile/password", sm.CheckAuthorized(http.HandlerFunc(uh.ChangePasswordHandler))).Methods(http.MethodPost, http.MethodOptions)
-	s.Handle("/profile/rating", sm.CheckAuthorized(http.HandlerFunc(uh.RatingHandler))).Methods(http.MethodPost, http.MethodOptions)
+
+	s.Handle("/profile/addresses", middleware.SetSCRFToken(sm.CheckAuthorized(http.HandlerFunc(uh.GetAddressesHandler)))).Methods(http.MethodGet, http.MethodOptions)
+	s.Handle("/profile/addresses", sm.CheckAuthorized(http.HandlerFunc(uh.CreateAddressHandler))).Methods(http.MethodPost, http.MethodOptions)
+	s.Handle("/profile/addresses/{id:[0-9]+}", sm.CheckAuthorized(http.HandlerFunc(uh.UpdateAddressHandler))).Methods(http.MethodPost, http.MethodOptions)
+	s.Handle("/profile/addresses/{id:[0-9]+}", sm.CheckAuthorized(http.HandlerFunc(uh.DeleteAddressHandler))).Methods(http.MethodDelete, http.MethodOptions)
 }
 
 var (
@@ -471,28 +476,25 @@ func (uh *UserHandler) ChangePasswordHandler(w http.ResponseWriter, r *http.Requ
 	logger.Debugf("user %d changed password successfully", userId)
 }
 
-// RatingHandler godoc
-// @Summary Rate users
-// @Description Rate users
+// GetAddressesHandler godoc
+// @Summary Get user's addresses
+// @Description Get user's delivery addresses
 // @Tags user
 // @Accept application/json
 // @Produce application/json
-// @Param body body models.Rating true "Rate user model"
-// @Success 200 {object} models.HttpBodyInterface
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyAddresses}
 // @failure default {object} models.HttpError
-// @Router /users/profile/rating [post]
-func (uh *UserHandler) RatingHandler(w http.ResponseWriter, r *http.Request) {
+// @Router /users/profile/addresses [get]
+func (uh *UserHandler) GetAddressesHandler(w http.ResponseWriter, r *http.Request) {
 	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
 	var userId int64
 	if r.Context().Value(middleware.ContextUserId) != nil {
 		userId = r.Context().Value(middleware.ContextUserId).(int64)
 	}
 
-	defer r.Body.Close()
-	inputRating := &models.Rating{}
-	buf, err := ioutil.ReadAll(r.Body)
+	addresses, err := uh.userUsecase.GetAddresses(userId)
 	if err != nil {
-		logger.Warnf("cannot convert body to bytes: %s", err.Error())
+		logger.Warnf("cannot get addresses: %s", err.Error())
 		w.WriteHeader(http.StatusOK)
 		metaCode, metaMessage := internalError.ToMetaStatus(err)
 		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
@@ -502,11 +504,46 @@ func (uh *UserHandler) RatingHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = easyjson.Unmarshal(buf, inputRating)
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyAddresses{Addresses: addresses}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "addresses provided", body))
 	if err != nil {
-		logger.Warnf("cannot unmarshal: %s", err.Error())
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// CreateAddressHandler godoc
+// @Summary Create address
+// @Description Add delivery address to user's address book
+// @Tags user
+// @Accept application/json
+// @Produce application/json
+// @Param body body models.Address true "Address"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyAddress}
+// @failure default {object} models.HttpError
+// @Router /users/profile/addresses [post]
+func (uh *UserHandler) CreateAddressHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	address, err := readAddress(r)
+	if err != nil {
+		logger.Warnf("invalid address: %s", err.Error())
 		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
 
+	err = uh.userUsecase.CreateAddress(userId, address)
+	if err != nil {
+		logger.Warnf("cannot create address: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
 		metaCode, metaMessage := internalError.ToMetaStatus(err)
 		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
 		if err != nil {
@@ -515,9 +552,48 @@ func (uh *UserHandler) RatingHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, err = govalidator.ValidateStruct(inputRating)
+	w.WriteHeader(http.StatusOK)
+	body := models.HttpBodyAddress{Address: *address}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "address created", body))
 	if err != nil {
-		logger.Warnf("invalid data: %s", err.Error())
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// UpdateAddressHandler godoc
+// @Summary Update address
+// @Description Update delivery address
+// @Tags user
+// @Accept application/json
+// @Produce application/json
+// @Param id path integer true "Address id"
+// @Param body body models.Address true "Address"
+// @Success 200 {object} models.HttpBodyInterface{body=models.HttpBodyAddress}
+// @failure default {object} models.HttpError
+// @Router /users/profile/addresses/{id} [post]
+func (uh *UserHandler) UpdateAddressHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	addressId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("cannot parse address id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	address, err := readAddress(r)
+	if err != nil {
+		logger.Warnf("invalid address: %s", err.Error())
 		w.WriteHeader(http.StatusOK)
 		_, err = w.Write(models.ToBytes(http.StatusBadRequest, "invalid data", nil))
 		if err != nil {
@@ -526,10 +602,9 @@ func (uh *UserHandler) RatingHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	inputRating.UserFrom = userId
-	err = uh.userUsecase.SetRating(inputRating)
+	err = uh.userUsecase.UpdateAddress(userId, addressId, address)
 	if err != nil {
-		logger.Warnf("cannot set rating: %s", err.Error())
+		logger.Warnf("cannot update address: %s", err.Error())
 		w.WriteHeader(http.StatusOK)
 		metaCode, metaMessage := internalError.ToMetaStatus(err)
 		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
@@ -540,8 +615,86 @@ func (uh *UserHandler) RatingHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.WriteHeader(http.StatusOK)
-	_, err = w.Write(models.ToBytes(http.StatusOK, "user appreciated", nil))
+	body := models.HttpBodyAddress{Address: *address}
+	_, err = w.Write(models.ToBytes(http.StatusOK, "address updated", body))
 	if err != nil {
 		logger.Warnf("cannot write answer to body %s", err.Error())
 	}
 }
+
+// DeleteAddressHandler godoc
+// @Summary Delete address
+// @Description Remove delivery address from user's address book
+// @Tags user
+// @Accept application/json
+// @Produce application/json
+// @Param id path integer true "Address id"
+// @Success 200 {object} models.HttpBodyInterface
+// @failure default {object} models.HttpError
+// @Router /users/profile/addresses/{id} [delete]
+func (uh *UserHandler) DeleteAddressHandler(w http.ResponseWriter, r *http.Request) {
+	logger = logger.GetLoggerWithFields((r.Context().Value(middleware.ContextLoggerField)).(logrus.Fields))
+	var userId int64
+	if r.Context().Value(middleware.ContextUserId) != nil {
+		userId = r.Context().Value(middleware.ContextUserId).(int64)
+	}
+
+	vars := mux.Vars(r)
+	addressId, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Warnf("cannot parse address id: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(internalError.BadRequest)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	err = uh.userUsecase.DeleteAddress(userId, addressId)
+	if err != nil {
+		logger.Warnf("cannot delete address: %s", err.Error())
+		w.WriteHeader(http.StatusOK)
+		metaCode, metaMessage := internalError.ToMetaStatus(err)
+		_, err = w.Write(models.ToBytes(metaCode, metaMessage, nil))
+		if err != nil {
+			logger.Warnf("cannot write answer to body %s", err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(models.ToBytes(http.StatusOK, "address deleted", nil))
+	if err != nil {
+		logger.Warnf("cannot write answer to body %s", err.Error())
+	}
+}
+
+// readAddress разбирает и валидирует адрес из тела запроса
+func readAddress(r *http.Request) (*models.Address, error) {
+	defer r.Body.Close()
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	address := &models.Address{}
+	err = easyjson.Unmarshal(buf, address)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = govalidator.ValidateStruct(address)
+	if err != nil {
+		return nil, err
+	}
+
+	sanitizer := bluemonday.UGCPolicy()
+	address.Title = sanitizer.Sanitize(address.Title)
+	address.Recipient = sanitizer.Sanitize(address.Recipient)
+	address.Phone = sanitizer.Sanitize(address.Phone)
+	address.City = sanitizer.Sanitize(address.City)
+	address.Street = sanitizer.Sanitize(address.Street)
+	return address, nil
+}
@@ -39,7 +39,7 @@ func TestSignUpHandlerValid(t *testing.T) {
 
 	r := mux.NewRouter()
 	r.Use(middleware.LoggerMiddleware)
-	sm := middleware.NewSessionMiddleware(&su)
+	sm := middleware.NewSessionMiddleware(&su, &uu)
 	uh.Routing(r, sm)
 
 	srv := httptest.NewServer(r)
@@ -101,7 +101,7 @@ func TestSignUpHandlerUserNotValid(t *testing.T) {
 
 	r := mux.NewRouter()
 	r.Use(middleware.LoggerMiddleware)
-	sm := middleware.NewSessionMiddleware(&su)
+	sm := middleware.NewSessionMiddleware(&su, &uu)
 	uh.Routing(r, sm)
 
 	srv := httptest.NewServer(r)
@@ -124,7 +124,7 @@ func TestSignUpHandlerSameEmail(t *testing.T) {
 
 	r := mux.NewRouter()
 	r.Use(middleware.LoggerMiddleware)
-	sm := middleware.NewSessionMiddleware(&su)
+	sm := middleware.NewSessionMiddleware(&su, &uu)
 	uh.Routing(r, sm)
 
 	srv := httptest.NewServer(r)
@@ -186,7 +186,7 @@ func TestSignUpHandlerFailCreateSession(t *testing.T) {
 
 	r := mux.NewRouter()
 	r.Use(middleware.LoggerMiddleware)
-	sm := middleware.NewSessionMiddleware(&su)
+	sm := middleware.NewSessionMiddleware(&su, &uu)
 	uh.Routing(r, sm)
 
 	srv := httptest.NewServer(r)
@@ -578,33 +578,23 @@ func TestChangePasswordFailUpdate(t *testing.T) {
 	assert.Equal(t, Answer.Message, "internal error")
 }
 
-func TestRatingHandlerSuccess(t *testing.T) {
+func TestGetAddressesHandlerSuccess(t *testing.T) {
 	su := sessMock.AuthClient{}
 	uu := userMock.UserUsecase{}
 	uh := NewUserHandler(&uu, &su)
 
 	router := mux.NewRouter()
 	router.Use(middleware.LoggerMiddleware)
-	router.Handle("/profile/rating", http.HandlerFunc(uh.RatingHandler)).Methods(http.MethodPost, http.MethodOptions)
+	router.Handle("/profile/addresses", http.HandlerFunc(uh.GetAddressesHandler)).Methods(http.MethodGet, http.MethodOptions)
 
 	srv := httptest.NewServer(router)
 	defer srv.Close()
 
-	rating := &models.Rating{
-		UserFrom: 0,
-		UserTo:   2,
-		Rating:   5,
-	}
-
-	reqBodyBuffer := new(bytes.Buffer)
-	err := json.NewEncoder(reqBodyBuffer).Encode(rating)
-	assert.Nil(t, err)
-	reader := bytes.NewReader(reqBodyBuffer.Bytes())
-
-	uu.On("SetRating", rating).Return(nil)
+	addresses := []*models.Address{{Id: 1, Recipient: "Ivan", Phone: "+79990000000", City: "Moscow", Street: "Tverskaya, 1"}}
+	uu.On("GetAddresses", int64(0)).Return(addresses, nil)
 
 	client := &http.Client{}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/profile/rating", srv.URL), reader)
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/profile/addresses", srv.URL), nil)
 	assert.Nil(t, err)
 
 	res, err := client.Do(req)
@@ -615,36 +605,31 @@ func TestRatingHandlerSuccess(t *testing.T) {
 	assert.Nil(t, err)
 
 	assert.Equal(t, Answer.Code, 200)
-	assert.Equal(t, Answer.Message, "user appreciated")
+	assert.Equal(t, Answer.Message, "addresses provided")
 }
 
-func TestRatingHandlerError1(t *testing.T) {
+func TestCreateAddressHandlerSuccess(t *testing.T) {
 	su := sessMock.AuthClient{}
 	uu := userMock.UserUsecase{}
 	uh := NewUserHandler(&uu, &su)
 
 	router := mux.NewRouter()
 	router.Use(middleware.LoggerMiddleware)
-	router.Handle("/profile/rating", http.HandlerFunc(uh.RatingHandler)).Methods(http.MethodPost, http.MethodOptions)
+	router.Handle("/profile/addresses", http.HandlerFunc(uh.CreateAddressHandler)).Methods(http.MethodPost, http.MethodOptions)
 
 	srv := httptest.NewServer(router)
 	defer srv.Close()
 
-	rating := &models.Rating{
-		UserFrom: 0,
-		UserTo:   2,
-		Rating:   5,
-	}
+	address := &models.Address{Recipient: "Ivan", Phone: "+79990000000", City: "Moscow", Street: "Tverskaya, 1", PostalCode: "125009"}
 
 	reqBodyBuffer := new(bytes.Buffer)
-	err := json.NewEncoder(reqBodyBuffer).Encode(rating)
+	err := json.NewEncoder(reqBodyBuffer).Encode(address)
 	assert.Nil(t, err)
-	reader := bytes.NewReader(reqBodyBuffer.Bytes())
 
-	uu.On("SetRating", rating).Return(myerr.InternalError)
+	uu.On("CreateAddress", int64(0), address).Return(nil)
 
 	client := &http.Client{}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/profile/rating", srv.URL), reader)
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/profile/addresses", srv.URL), reqBodyBuffer)
 	assert.Nil(t, err)
 
 	res, err := client.Do(req)
@@ -652,37 +637,32 @@ func TestRatingHandlerError1(t *testing.T) {
 
 	var Answer models.HttpBodyInterface
 	err = json.NewDecoder(res.Body).Decode(&Answer)
-	assert.NoError(t, err)
-	assert.Equal(t, 500, Answer.Code)
+	assert.Nil(t, err)
+
+	assert.Equal(t, Answer.Code, 200)
+	assert.Equal(t, Answer.Message, "address created")
 }
 
-func TestRatingHandlerError2(t *testing.T) {
+func TestCreateAddressHandlerInvalid(t *testing.T) {
 	su := sessMock.AuthClient{}
 	uu := userMock.UserUsecase{}
 	uh := NewUserHandler(&uu, &su)
 
 	router := mux.NewRouter()
 	router.Use(middleware.LoggerMiddleware)
-	router.Handle("/profile/rating", http.HandlerFunc(uh.RatingHandler)).Methods(http.MethodPost, http.MethodOptions)
+	router.Handle("/profile/addresses", http.HandlerFunc(uh.CreateAddressHandler)).Methods(http.MethodPost, http.MethodOptions)
 
 	srv := httptest.NewServer(router)
 	defer srv.Close()
 
-	rating := &models.Rating{
-		UserFrom: 0,
-		UserTo:   2,
-		Rating:   5423421423423423424,
-	}
+	address := &models.Address{Recipient: "Ivan", City: "Moscow", PostalCode: "12"}
 
 	reqBodyBuffer := new(bytes.Buffer)
-	err := json.NewEncoder(reqBodyBuffer).Encode(rating)
+	err := json.NewEncoder(reqBodyBuffer).Encode(address)
 	assert.Nil(t, err)
-	reader := bytes.NewReader(reqBodyBuffer.Bytes())
-
-	uu.On("SetRating", rating).Return(myerr.InternalError)
 
 	client := &http.Client{}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/profile/rating", srv.URL), reader)
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/profile/addresses", srv.URL), reqBodyBuffer)
 	assert.Nil(t, err)
 
 	res, err := client.Do(req)
@@ -690,36 +670,34 @@ func TestRatingHandlerError2(t *testing.T) {
 
 	var Answer models.HttpBodyInterface
 	err = json.NewDecoder(res.Body).Decode(&Answer)
-	assert.NoError(t, err)
-	assert.Equal(t, 400, Answer.Code)
+	assert.Nil(t, err)
+
+	assert.Equal(t, Answer.Code, http.StatusBadRequest)
+	assert.Equal(t, Answer.Message, "invalid data")
 }
 
-func TestRatingHandlerError3(t *testing.T) {
+func TestUpdateAddressHandlerNotFound(t *testing.T) {
 	su := sessMock.AuthClient{}
 	uu := userMock.UserUsecase{}
 	uh := NewUserHandler(&uu, &su)
 
 	router := mux.NewRouter()
 	router.Use(middleware.LoggerMiddleware)
-	router.Handle("/profile/rating", http.HandlerFunc(uh.RatingHandler)).Methods(http.MethodPost, http.MethodOptions)
+	router.Handle("/profile/addresses/{id:[0-9]+}", http.HandlerFunc(uh.UpdateAddressHandler)).Methods(http.MethodPost, http.MethodOptions)
 
 	srv := httptest.NewServer(router)
 	defer srv.Close()
 
-	rating := &models.Rating{
-		UserFrom: 0,
-		UserTo:   2,
-		Rating:   5,
-	}
+	address := &models.Address{Recipient: "Ivan", Phone: "+79990000000", City: "Moscow", Street: "Tverskaya, 1"}
 
 	reqBodyBuffer := new(bytes.Buffer)
-	err := json.NewEncoder(reqBodyBuffer).Encode(rating)
+	err := json.NewEncoder(reqBodyBuffer).Encode(address)
 	assert.Nil(t, err)
 
-	uu.On("SetRating", rating).Return(myerr.InternalError)
+	uu.On("UpdateAddress", int64(0), int64(3), address).Return(myerr.EmptyQuery)
 
 	client := &http.Client{}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/profile/rating", srv.URL), nil)
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/profile/addresses/3", srv.URL), reqBodyBuffer)
 	assert.Nil(t, err)
 
 	res, err := client.Do(req)
@@ -727,6 +705,36 @@ func TestRatingHandlerError3(t *testing.T) {
 
 	var Answer models.HttpBodyInterface
 	err = json.NewDecoder(res.Body).Decode(&Answer)
-	assert.NoError(t, err)
-	assert.Equal(t, 500, Answer.Code)
+	assert.Nil(t, err)
+
+	assert.Equal(t, Answer.Code, http.StatusNotFound)
+}
+
+func TestDeleteAddressHandlerSuccess(t *testing.T) {
+	su := sessMock.AuthClient{}
+	uu := userMock.UserUsecase{}
+	uh := NewUserHandler(&uu, &su)
+
+	router := mux.NewRouter()
+	router.Use(middleware.LoggerMiddleware)
+	router.Handle("/profile/addresses/{id:[0-9]+}", http.HandlerFunc(uh.DeleteAddressHandler)).Methods(http.MethodDelete, http.MethodOptions)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	uu.On("DeleteAddress", int64(0), int64(3)).Return(nil)
+
+	client := &http.Client{}
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/profile/addresses/3", srv.URL), nil)
+	assert.Nil(t, err)
+
+	res, err := client.Do(req)
+	assert.Nil(t, err)
+
+	var Answer models.HttpBodyInterface
+	err = json.NewDecoder(res.Body).Decode(&Answer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, Answer.Code, 200)
+	assert.Equal(t, Answer.Message, "address deleted")
 }
@@ -0,0 +1,102 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "yula/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AddressRepository is an autogenerated mock type for the AddressRepository type
+type AddressRepository struct {
+	mock.Mock
+}
+
+// DeleteAddress provides a mock function with given fields: userId, addressId
+func (_m *AddressRepository) DeleteAddress(userId int64, addressId int64) error {
+	ret := _m.Called(userId, addressId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(userId, addressId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InsertAddress provides a mock function with given fields: address
+func (_m *AddressRepository) InsertAddress(address *models.Address) error {
+	ret := _m.Called(address)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Address) error); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SelectAddress provides a mock function with given fields: userId, addressId
+func (_m *AddressRepository) SelectAddress(userId int64, addressId int64) (*models.Address, error) {
+	ret := _m.Called(userId, addressId)
+
+	var r0 *models.Address
+	if rf, ok := ret.Get(0).(func(int64, int64) *models.Address); ok {
+		r0 = rf(userId, addressId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Address)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(userId, addressId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SelectAddresses provides a mock function with given fields: userId
+func (_m *AddressRepository) SelectAddresses(userId int64) ([]*models.Address, error) {
+	ret := _m.Called(userId)
+
+	var r0 []*models.Address
+	if rf, ok := ret.Get(0).(func(int64) []*models.Address); ok {
+		r0 = rf(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Address)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateAddress provides a mock function with given fields: address
+func (_m *AddressRepository) UpdateAddress(address *models.Address) error {
+	ret := _m.Called(address)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Address) error); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
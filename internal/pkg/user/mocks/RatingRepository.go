@@ -13,34 +13,6 @@ type RatingRepository struct {
 	mock.Mock
 }
 
-// DeleteRating provides a mock function with given fields: rating
-func (_m *RatingRepository) DeleteRating(rating *models.Rating) error {
-	ret := _m.Called(rating)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*models.Rating) error); ok {
-		r0 = rf(rating)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// InsertRating provides a mock function with given fields: rating
-func (_m *RatingRepository) InsertRating(rating *models.Rating) error {
-	ret := _m.Called(rating)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*models.Rating) error); ok {
-		r0 = rf(rating)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
 // InsertStat provides a mock function with given fields: userId
 func (_m *RatingRepository) InsertStat(userId int64) error {
 	ret := _m.Called(userId)
@@ -105,31 +77,3 @@ func (_m *RatingRepository) SelectStat(userId int64) (int64, int64, error) {
 
 	return r0, r1, r2
 }
-
-// UpdateRating provides a mock function with given fields: rating
-func (_m *RatingRepository) UpdateRating(rating *models.Rating) error {
-	ret := _m.Called(rating)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*models.Rating) error); ok {
-		r0 = rf(rating)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// UpdateStat provides a mock function with given fields: userId, rate, count
-func (_m *RatingRepository) UpdateStat(userId int64, rate int, count int) error {
-	ret := _m.Called(userId, rate, count)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(int64, int, int) error); ok {
-		r0 = rf(userId, rate, count)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
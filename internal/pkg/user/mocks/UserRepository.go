@@ -86,3 +86,31 @@ func (_m *UserRepository) Update(_a0 *models.UserData) error {
 
 	return r0
 }
+
+// UpdateBanned provides a mock function with given fields: userId, isBanned
+func (_m *UserRepository) UpdateBanned(userId int64, isBanned bool) error {
+	ret := _m.Called(userId, isBanned)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, bool) error); ok {
+		r0 = rf(userId, isBanned)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateRole provides a mock function with given fields: userId, role
+func (_m *UserRepository) UpdateRole(userId int64, role string) error {
+	ret := _m.Called(userId, role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, string) error); ok {
+		r0 = rf(userId, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
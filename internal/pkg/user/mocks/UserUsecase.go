@@ -3,11 +3,10 @@
 package mocks
 
 import (
+	multipart "mime/multipart"
 	models "yula/internal/models"
 
 	mock "github.com/stretchr/testify/mock"
-
-	multipart "mime/multipart"
 )
 
 // UserUsecase is an autogenerated mock type for the UserUsecase type
@@ -15,6 +14,27 @@ type UserUsecase struct {
 	mock.Mock
 }
 
+// CheckAccess provides a mock function with given fields: userId
+func (_m *UserUsecase) CheckAccess(userId int64) (string, error) {
+	ret := _m.Called(userId)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(int64) string); ok {
+		r0 = rf(userId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CheckPassword provides a mock function with given fields: _a0, gettedPassword
 func (_m *UserUsecase) CheckPassword(_a0 *models.UserData, gettedPassword string) error {
 	ret := _m.Called(_a0, gettedPassword)
@@ -52,6 +72,80 @@ func (_m *UserUsecase) Create(_a0 *models.UserSignUp) (*models.UserData, error)
 	return r0, r1
 }
 
+// CreateAddress provides a mock function with given fields: userId, address
+func (_m *UserUsecase) CreateAddress(userId int64, address *models.Address) error {
+	ret := _m.Called(userId, address)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, *models.Address) error); ok {
+		r0 = rf(userId, address)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteAddress provides a mock function with given fields: userId, addressId
+func (_m *UserUsecase) DeleteAddress(userId int64, addressId int64) error {
+	ret := _m.Called(userId, addressId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(userId, addressId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAddress provides a mock function with given fields: userId, addressId
+func (_m *UserUsecase) GetAddress(userId int64, addressId int64) (*models.Address, error) {
+	ret := _m.Called(userId, addressId)
+
+	var r0 *models.Address
+	if rf, ok := ret.Get(0).(func(int64, int64) *models.Address); ok {
+		r0 = rf(userId, addressId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Address)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(userId, addressId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAddresses provides a mock function with given fields: userId
+func (_m *UserUsecase) GetAddresses(userId int64) ([]*models.Address, error) {
+	ret := _m.Called(userId)
+
+	var r0 []*models.Address
+	if rf, ok := ret.Get(0).(func(int64) []*models.Address); ok {
+		r0 = rf(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Address)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByEmail provides a mock function with given fields: email
 func (_m *UserUsecase) GetByEmail(email string) (*models.UserData, error) {
 	ret := _m.Called(email)
@@ -121,13 +215,13 @@ func (_m *UserUsecase) GetRating(userFrom int64, userTo int64) (*models.RatingSt
 	return r0, r1
 }
 
-// SetRating provides a mock function with given fields: rating
-func (_m *UserUsecase) SetRating(rating *models.Rating) error {
-	ret := _m.Called(rating)
+// UpdateAddress provides a mock function with given fields: userId, addressId, address
+func (_m *UserUsecase) UpdateAddress(userId int64, addressId int64, address *models.Address) error {
+	ret := _m.Called(userId, addressId, address)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*models.Rating) error); ok {
-		r0 = rf(rating)
+	if rf, ok := ret.Get(0).(func(int64, int64, *models.Address) error); ok {
+		r0 = rf(userId, addressId, address)
 	} else {
 		r0 = ret.Error(0)
 	}
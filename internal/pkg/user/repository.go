@@ -12,15 +12,24 @@ type UserRepository interface {
 	SelectByEmail(email string) (*models.UserData, error)
 	SelectById(userId int64) (*models.UserData, error)
 	Update(user *models.UserData) error
+
+	UpdateRole(userId int64, role string) error
+	UpdateBanned(userId int64, isBanned bool) error
 }
 
 type RatingRepository interface {
 	SelectRating(userFrom int64, userTo int64) (*models.Rating, error)
-	InsertRating(rating *models.Rating) error
-	UpdateRating(rating *models.Rating) error
-	DeleteRating(rating *models.Rating) error
 
 	SelectStat(userId int64) (int64, int64, error)
 	InsertStat(userId int64) error
-	UpdateStat(userId int64, rate int, count int) error
+}
+
+//go:generate mockery -name=AddressRepository
+
+type AddressRepository interface {
+	SelectAddresses(userId int64) ([]*models.Address, error)
+	SelectAddress(userId int64, addressId int64) (*models.Address, error)
+	InsertAddress(address *models.Address) error
+	UpdateAddress(address *models.Address) error
+	DeleteAddress(userId int64, addressId int64) error
 }
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+	"yula/internal/pkg/user"
+)
+
+type AddressRepository struct {
+	db *sql.DB
+}
+
+func NewAddressRepository(db *sql.DB) user.AddressRepository {
+	return &AddressRepository{
+		db: db,
+	}
+}
+
+func (ar *AddressRepository) SelectAddresses(userId int64) ([]*models.Address, error) {
+	queryStr := `SELECT id, user_id, title, recipient, phone, city, street, postal_code
+				FROM address WHERE user_id = $1 ORDER BY id;`
+	query, err := ar.db.QueryContext(context.Background(), queryStr, userId)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+
+	defer query.Close()
+	addresses := make([]*models.Address, 0)
+	for query.Next() {
+		var address models.Address
+
+		err = query.Scan(&address.Id, &address.UserId, &address.Title, &address.Recipient,
+			&address.Phone, &address.City, &address.Street, &address.PostalCode)
+		if err != nil {
+			return nil, internalError.GenInternalError(err)
+		}
+
+		addresses = append(addresses, &address)
+	}
+
+	return addresses, nil
+}
+
+func (ar *AddressRepository) SelectAddress(userId int64, addressId int64) (*models.Address, error) {
+	queryStr := `SELECT id, user_id, title, recipient, phone, city, street, postal_code
+				FROM address WHERE id = $1 AND user_id = $2;`
+	query := ar.db.QueryRowContext(context.Background(), queryStr, addressId, userId)
+
+	var address models.Address
+	err := query.Scan(&address.Id, &address.UserId, &address.Title, &address.Recipient,
+		&address.Phone, &address.City, &address.Street, &address.PostalCode)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return nil, internalError.EmptyQuery
+		}
+		return nil, internalError.GenInternalError(err)
+	}
+
+	return &address, nil
+}
+
+func (ar *AddressRepository) InsertAddress(address *models.Address) error {
+	queryStr := `INSERT INTO address (user_id, title, recipient, phone, city, street, postal_code)
+				VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id;`
+	query := ar.db.QueryRowContext(context.Background(), queryStr, address.UserId, address.Title,
+		address.Recipient, address.Phone, address.City, address.Street, address.PostalCode)
+
+	err := query.Scan(&address.Id)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	return nil
+}
+
+func (ar *AddressRepository) UpdateAddress(address *models.Address) error {
+	queryStr := `UPDATE address SET title = $3, recipient = $4, phone = $5, city = $6, street = $7, postal_code = $8
+				WHERE id = $1 AND user_id = $2;`
+	ct, err := ar.db.ExecContext(context.Background(), queryStr, address.Id, address.UserId, address.Title,
+		address.Recipient, address.Phone, address.City, address.Street, address.PostalCode)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	if ra, _ := ct.RowsAffected(); ra == 0 {
+		return internalError.EmptyQuery
+	}
+
+	return nil
+}
+
+func (ar *AddressRepository) DeleteAddress(userId int64, addressId int64) error {
+	ct, err := ar.db.ExecContext(context.Background(),
+		"DELETE FROM address WHERE id = $1 AND user_id = $2;", addressId, userId)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	if ra, _ := ct.RowsAffected(); ra == 0 {
+		return internalError.EmptyQuery
+	}
+
+	return nil
+}
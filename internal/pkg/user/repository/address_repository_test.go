@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"database/sql"
+	"testing"
+	internalError "yula/internal/error"
+	"yula/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	sqlmock "gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+var testaddress = &models.Address{
+	Id:         3,
+	UserId:     1,
+	Title:      "дом",
+	Recipient:  "Ваня Иванов",
+	Phone:      "89999999999",
+	City:       "Москва",
+	Street:     "Тверская, 1",
+	PostalCode: "125009",
+}
+
+var addressColumns = []string{"id", "user_id", "title", "recipient", "phone", "city", "street", "postal_code"}
+
+func TestSelectAddressesOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAddressRepository(db)
+
+	rows := sqlmock.NewRows(addressColumns).AddRow(testaddress.Id, testaddress.UserId, testaddress.Title,
+		testaddress.Recipient, testaddress.Phone, testaddress.City, testaddress.Street, testaddress.PostalCode)
+	mock.ExpectQuery("SELECT").WithArgs(testaddress.UserId).WillReturnRows(rows)
+
+	addresses, err := repo.SelectAddresses(testaddress.UserId)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.Address{testaddress}, addresses)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectAddressNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAddressRepository(db)
+
+	mock.ExpectQuery("SELECT").WithArgs(testaddress.Id, int64(2)).WillReturnRows(sqlmock.NewRows(addressColumns))
+
+	_, err = repo.SelectAddress(2, testaddress.Id)
+
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestInsertAddressOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAddressRepository(db)
+	address := *testaddress
+	address.Id = 0
+
+	mock.ExpectQuery("INSERT INTO address").WithArgs(address.UserId, address.Title, address.Recipient,
+		address.Phone, address.City, address.Street, address.PostalCode).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+
+	err = repo.InsertAddress(&address)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), address.Id)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateAddressNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAddressRepository(db)
+
+	mock.ExpectExec("UPDATE address").WithArgs(testaddress.Id, testaddress.UserId, testaddress.Title,
+		testaddress.Recipient, testaddress.Phone, testaddress.City, testaddress.Street, testaddress.PostalCode).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.UpdateAddress(testaddress)
+
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestDeleteAddressOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAddressRepository(db)
+
+	mock.ExpectExec("DELETE FROM address").WithArgs(testaddress.Id, testaddress.UserId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.DeleteAddress(testaddress.UserId, testaddress.Id)
+
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestDeleteAddressError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewAddressRepository(db)
+
+	mock.ExpectExec("DELETE FROM address").WithArgs(testaddress.Id, testaddress.UserId).WillReturnError(sql.ErrConnDone)
+
+	err = repo.DeleteAddress(testaddress.UserId, testaddress.Id)
+
+	assert.Error(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
@@ -20,7 +20,9 @@ func NewRatingRepository(db *sql.DB) user.RatingRepository {
 
 func (rr *RatingRepository) SelectRating(userFrom int64, userTo int64) (*models.Rating, error) {
 	rating := &models.Rating{}
-	query := rr.db.QueryRow("SELECT rate FROM rating WHERE user_from = $1 AND user_to = $2;", userFrom, userTo)
+	// личная оценка - последний отзыв, оставленный по заказам между пользователями
+	query := rr.db.QueryRow("SELECT score FROM review WHERE author_id = $1 AND target_id = $2 "+
+		"ORDER BY created_at DESC LIMIT 1;", userFrom, userTo)
 
 	err := query.Scan(&rating.Rating)
 	if err != nil {
@@ -39,78 +41,6 @@ func (rr *RatingRepository) SelectRating(userFrom int64, userTo int64) (*models.
 	return rating, nil
 }
 
-func (rr *RatingRepository) InsertRating(rating *models.Rating) error {
-	tx, err := rr.db.BeginTx(context.Background(), &sql.TxOptions{})
-	if err != nil {
-		return internalError.GenInternalError(err)
-	}
-
-	_, err = rr.db.Exec("INSERT INTO rating(user_from, user_to, rate) VALUES ($1, $2, $3);",
-		rating.UserFrom, rating.UserTo, rating.Rating)
-	if err != nil {
-		rollbackError := tx.Rollback()
-		if rollbackError != nil {
-			return rollbackError
-		}
-		return internalError.GenInternalError(err)
-	}
-
-	err = tx.Commit()
-	if err != nil {
-		return internalError.NotCommited
-	}
-
-	return nil
-}
-
-func (rr *RatingRepository) DeleteRating(rating *models.Rating) error {
-	tx, err := rr.db.BeginTx(context.Background(), &sql.TxOptions{})
-	if err != nil {
-		return internalError.GenInternalError(err)
-	}
-
-	_, err = rr.db.Exec("DELETE FROM rating WHERE user_from = $1 AND user_to = $2;",
-		rating.UserFrom, rating.UserTo)
-	if err != nil {
-		rollbackError := tx.Rollback()
-		if rollbackError != nil {
-			return rollbackError
-		}
-		return internalError.GenInternalError(err)
-	}
-
-	err = tx.Commit()
-	if err != nil {
-		return internalError.NotCommited
-	}
-
-	return nil
-}
-
-func (rr *RatingRepository) UpdateRating(rating *models.Rating) error {
-	tx, err := rr.db.BeginTx(context.Background(), &sql.TxOptions{})
-	if err != nil {
-		return internalError.GenInternalError(err)
-	}
-
-	_, err = rr.db.Exec("UPDATE rating SET rate = $3 WHERE user_from = $1 AND user_to = $2;",
-		rating.UserFrom, rating.UserTo, rating.Rating)
-	if err != nil {
-		rollbackError := tx.Rollback()
-		if rollbackError != nil {
-			return rollbackError
-		}
-		return internalError.GenInternalError(err)
-	}
-
-	err = tx.Commit()
-	if err != nil {
-		return internalError.NotCommited
-	}
-
-	return nil
-}
-
 func (rr *RatingRepository) SelectStat(userId int64) (int64, int64, error) {
 	var sum, count int64
 
@@ -145,27 +75,3 @@ func (rr *RatingRepository) InsertStat(userId int64) error {
 
 	return nil
 }
-
-func (rr *RatingRepository) UpdateStat(userId int64, rate int, count int) error {
-	tx, err := rr.db.BeginTx(context.Background(), &sql.TxOptions{})
-	if err != nil {
-		return internalError.GenInternalError(err)
-	}
-
-	_, err = rr.db.Exec("UPDATE rating_statistics SET sum = sum + $2, count = count + $3 WHERE user_id = $1;",
-		userId, rate, count)
-	if err != nil {
-		rollbackError := tx.Rollback()
-		if rollbackError != nil {
-			return rollbackError
-		}
-		return internalError.GenInternalError(err)
-	}
-
-	err = tx.Commit()
-	if err != nil {
-		return internalError.NotCommited
-	}
-
-	return nil
-}
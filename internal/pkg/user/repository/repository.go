@@ -61,12 +61,12 @@ func (ur *UserRepository) Insert(user *models.UserData) error {
 
 func (ur *UserRepository) SelectByEmail(email string) (*models.UserData, error) {
 	row := ur.DB.QueryRowContext(context.Background(),
-		"SELECT id, email, phone, password, created_at, name, surname, image FROM users WHERE email = $1",
+		"SELECT id, email, phone, password, created_at, name, surname, image, role, is_banned, is_hidden FROM users WHERE email = $1",
 		email)
 
 	user := models.UserData{}
 	if err := row.Scan(&user.Id, &user.Email, &user.Phone, &user.Password, &user.CreatedAt,
-		&user.Name, &user.Surname, &user.Image); err != nil {
+		&user.Name, &user.Surname, &user.Image, &user.Role, &user.IsBanned, &user.IsHidden); err != nil {
 		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
 		if res {
 			return nil, internalError.EmptyQuery
@@ -79,11 +79,11 @@ func (ur *UserRepository) SelectByEmail(email string) (*models.UserData, error)
 
 func (ur *UserRepository) SelectById(userId int64) (*models.UserData, error) {
 	row := ur.DB.QueryRowContext(context.Background(),
-		"SELECT id, email, phone, password, created_at, name, surname, image FROM users WHERE id = $1",
+		"SELECT id, email, phone, password, created_at, name, surname, image, role, is_banned, is_hidden FROM users WHERE id = $1",
 		userId)
 	user := models.UserData{}
 	if err := row.Scan(&user.Id, &user.Email, &user.Phone, &user.Password, &user.CreatedAt,
-		&user.Name, &user.Surname, &user.Image); err != nil {
+		&user.Name, &user.Surname, &user.Image, &user.Role, &user.IsBanned, &user.IsHidden); err != nil {
 		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
 		if res {
 			return nil, internalError.EmptyQuery
@@ -124,3 +124,29 @@ func (ur *UserRepository) Update(user *models.UserData) error {
 
 	return nil
 }
+
+func (ur *UserRepository) UpdateRole(userId int64, role string) error {
+	result, err := ur.DB.ExecContext(context.Background(),
+		"UPDATE users SET role = $2 WHERE id = $1;", userId, role)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return internalError.EmptyQuery
+	}
+	return nil
+}
+
+func (ur *UserRepository) UpdateBanned(userId int64, isBanned bool) error {
+	result, err := ur.DB.ExecContext(context.Background(),
+		"UPDATE users SET is_banned = $2 WHERE id = $1;", userId, isBanned)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return internalError.EmptyQuery
+	}
+	return nil
+}
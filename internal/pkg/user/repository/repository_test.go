@@ -4,6 +4,7 @@ import (
 	"database/sql/driver"
 	"testing"
 	"time"
+	internalError "yula/internal/error"
 	"yula/internal/models"
 
 	"github.com/stretchr/testify/assert"
@@ -29,6 +30,7 @@ var testuser = &models.UserData{
 	CreatedAt: ParseTime(),
 	Image:     "default_image",
 	Phone:     "89999999999",
+	Role:      models.RoleUser,
 }
 
 var testrating = &models.Rating{
@@ -124,9 +126,9 @@ func TestUserSelectByEmailOk(t *testing.T) {
 
 	repo := NewUserRepository(db)
 
-	rows := sqlmock.NewRows([]string{"id", "email", "phone", "password", "created_at", "name", "surname", "image"})
+	rows := sqlmock.NewRows([]string{"id", "email", "phone", "password", "created_at", "name", "surname", "image", "role", "is_banned", "is_hidden"})
 	rows.AddRow(testuser.Id, testuser.Email, testuser.Phone, testuser.Password, testuser.CreatedAt,
-		testuser.Name, testuser.Surname, testuser.Image,
+		testuser.Name, testuser.Surname, testuser.Image, testuser.Role, testuser.IsBanned, testuser.IsHidden,
 	)
 	mock.ExpectQuery("SELECT").WithArgs(testuser.Email).WillReturnRows(rows)
 
@@ -147,9 +149,9 @@ func TestUserSelectByEmailError(t *testing.T) {
 
 	repo := NewUserRepository(db)
 
-	rows := sqlmock.NewRows([]string{"id", "email", "phone", "password", "created_at", "name", "surname", "image"})
+	rows := sqlmock.NewRows([]string{"id", "email", "phone", "password", "created_at", "name", "surname", "image", "role", "is_banned", "is_hidden"})
 	rows.AddRow(testuser.Id, testuser.Email, testuser.Phone, testuser.Password, testime,
-		testuser.Name, testuser.Surname, testuser.Image,
+		testuser.Name, testuser.Surname, testuser.Image, testuser.Role, testuser.IsBanned, testuser.IsHidden,
 	)
 	mock.ExpectQuery("SELECT").WithArgs(testuser.Email).WillReturnRows(rows)
 
@@ -170,9 +172,9 @@ func TestUserSelectByIdOk(t *testing.T) {
 
 	repo := NewUserRepository(db)
 
-	rows := sqlmock.NewRows([]string{"id", "email", "phone", "password", "created_at", "name", "surname", "image"})
+	rows := sqlmock.NewRows([]string{"id", "email", "phone", "password", "created_at", "name", "surname", "image", "role", "is_banned", "is_hidden"})
 	rows.AddRow(testuser.Id, testuser.Email, testuser.Phone, testuser.Password, testuser.CreatedAt,
-		testuser.Name, testuser.Surname, testuser.Image,
+		testuser.Name, testuser.Surname, testuser.Image, testuser.Role, testuser.IsBanned, testuser.IsHidden,
 	)
 	mock.ExpectQuery("SELECT").WithArgs(testuser.Id).WillReturnRows(rows)
 
@@ -193,9 +195,9 @@ func TestUserSelectByIdError(t *testing.T) {
 
 	repo := NewUserRepository(db)
 
-	rows := sqlmock.NewRows([]string{"id", "email", "phone", "password", "created_at", "name", "surname", "image"})
+	rows := sqlmock.NewRows([]string{"id", "email", "phone", "password", "created_at", "name", "surname", "image", "role", "is_banned", "is_hidden"})
 	rows.AddRow(testuser.Id, testuser.Email, testuser.Phone, testuser.Password, testime,
-		testuser.Name, testuser.Surname, testuser.Image,
+		testuser.Name, testuser.Surname, testuser.Image, testuser.Role, testuser.IsBanned, testuser.IsHidden,
 	)
 	mock.ExpectQuery("SELECT").WithArgs(testuser.Id).WillReturnRows(rows)
 
@@ -258,7 +260,7 @@ func TestUserSelectRatingOk(t *testing.T) {
 
 	repo := NewRatingRepository(db)
 
-	rows := sqlmock.NewRows([]string{"rate"})
+	rows := sqlmock.NewRows([]string{"score"})
 	rows.AddRow(testrating.Rating)
 	mock.ExpectQuery("SELECT").WithArgs(testrating.UserFrom, testrating.UserTo).WillReturnRows(rows)
 
@@ -279,7 +281,7 @@ func TestUserSelectRatingError(t *testing.T) {
 
 	repo := NewRatingRepository(db)
 
-	rows := sqlmock.NewRows([]string{"rate"})
+	rows := sqlmock.NewRows([]string{"score"})
 	rows.AddRow(testrating.Rating)
 	mock.ExpectQuery("SELECT").WithArgs(testrating.UserFrom, testrating.UserTo)
 
@@ -290,126 +292,6 @@ func TestUserSelectRatingError(t *testing.T) {
 	assert.Nil(t, err)
 }
 
-func TestUserInsertRatingOk(t *testing.T) {
-	db, mock, err := sqlmock.New()
-	if err != nil {
-		t.Fatalf("cant create mock: %s", err)
-	}
-	defer db.Close()
-
-	repo := NewRatingRepository(db)
-
-	mock.ExpectBegin()
-	mock.ExpectExec("INSERT").WithArgs(testrating.UserFrom, testrating.UserTo, testrating.Rating).WillReturnResult(driver.ResultNoRows)
-	mock.ExpectCommit()
-
-	err = repo.InsertRating(testrating)
-	assert.NoError(t, err)
-
-	err = mock.ExpectationsWereMet()
-	assert.Nil(t, err)
-}
-
-func TestUserInsertRatingError(t *testing.T) {
-	db, mock, err := sqlmock.New()
-	if err != nil {
-		t.Fatalf("cant create mock: %s", err)
-	}
-	defer db.Close()
-
-	repo := NewRatingRepository(db)
-
-	mock.ExpectBegin()
-	mock.ExpectExec("INSERT").WithArgs(testrating.UserFrom, testrating.UserTo, testrating.Rating)
-	mock.ExpectRollback()
-
-	err = repo.InsertRating(testrating)
-	assert.Error(t, err)
-
-	err = mock.ExpectationsWereMet()
-	assert.Nil(t, err)
-}
-
-func TestUserDeleteRatingOk(t *testing.T) {
-	db, mock, err := sqlmock.New()
-	if err != nil {
-		t.Fatalf("cant create mock: %s", err)
-	}
-	defer db.Close()
-
-	repo := NewRatingRepository(db)
-
-	mock.ExpectBegin()
-	mock.ExpectExec("DELETE").WithArgs(testrating.UserFrom, testrating.UserTo).WillReturnResult(driver.ResultNoRows)
-	mock.ExpectCommit()
-
-	err = repo.DeleteRating(testrating)
-
-	assert.NoError(t, err)
-	err = mock.ExpectationsWereMet()
-	assert.Nil(t, err)
-}
-
-func TestUserDeleteRatingError(t *testing.T) {
-	db, mock, err := sqlmock.New()
-	if err != nil {
-		t.Fatalf("cant create mock: %s", err)
-	}
-	defer db.Close()
-
-	repo := NewRatingRepository(db)
-
-	mock.ExpectBegin()
-	mock.ExpectExec("DELETE").WithArgs(testrating.UserFrom, testrating.UserTo)
-	mock.ExpectRollback()
-
-	err = repo.DeleteRating(testrating)
-
-	assert.Error(t, err)
-	err = mock.ExpectationsWereMet()
-	assert.Nil(t, err)
-}
-
-func TestUserUpdateRatingOk(t *testing.T) {
-	db, mock, err := sqlmock.New()
-	if err != nil {
-		t.Fatalf("cant create mock: %s", err)
-	}
-	defer db.Close()
-
-	repo := NewRatingRepository(db)
-
-	mock.ExpectBegin()
-	mock.ExpectExec("UPDATE").WithArgs(testrating.UserFrom, testrating.UserTo, testrating.Rating).WillReturnResult(driver.ResultNoRows)
-	mock.ExpectCommit()
-
-	err = repo.UpdateRating(testrating)
-
-	assert.NoError(t, err)
-	err = mock.ExpectationsWereMet()
-	assert.Nil(t, err)
-}
-
-func TestUserUpdateRatingError(t *testing.T) {
-	db, mock, err := sqlmock.New()
-	if err != nil {
-		t.Fatalf("cant create mock: %s", err)
-	}
-	defer db.Close()
-
-	repo := NewRatingRepository(db)
-
-	mock.ExpectBegin()
-	mock.ExpectExec("UPDATE").WithArgs(testrating.UserFrom, testrating.UserTo, testrating.Rating)
-	mock.ExpectRollback()
-
-	err = repo.UpdateRating(testrating)
-
-	assert.Error(t, err)
-	err = mock.ExpectationsWereMet()
-	assert.Nil(t, err)
-}
-
 func TestSelectStatOk(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -490,42 +372,38 @@ func TestInsertStatError(t *testing.T) {
 	assert.Nil(t, err)
 }
 
-func TestUpdateStatOk(t *testing.T) {
+func TestUserUpdateRoleOk(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		t.Fatalf("cant create mock: %s", err)
 	}
 	defer db.Close()
 
-	repo := NewRatingRepository(db)
-
-	mock.ExpectBegin()
-	mock.ExpectExec("UPDATE").WithArgs(testuser.Id, teststat.PersonalRate, 1).WillReturnResult(driver.ResultNoRows)
-	mock.ExpectCommit()
+	repo := NewUserRepository(db)
 
-	err = repo.UpdateStat(testuser.Id, teststat.PersonalRate, 1)
+	mock.ExpectExec("UPDATE users SET role").WithArgs(int64(3), models.RoleModerator).
+		WillReturnResult(sqlmock.NewResult(0, 1))
 
+	err = repo.UpdateRole(3, models.RoleModerator)
 	assert.NoError(t, err)
 	err = mock.ExpectationsWereMet()
 	assert.Nil(t, err)
 }
 
-func TestUpdateStatError(t *testing.T) {
+func TestUserUpdateBannedNotFound(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		t.Fatalf("cant create mock: %s", err)
 	}
 	defer db.Close()
 
-	repo := NewRatingRepository(db)
-
-	mock.ExpectBegin()
-	mock.ExpectExec("UPDATE").WithArgs(testuser.Id, teststat.PersonalRate, 1)
-	mock.ExpectRollback()
+	repo := NewUserRepository(db)
 
-	err = repo.UpdateStat(testuser.Id, teststat.PersonalRate, 1)
+	mock.ExpectExec("UPDATE users SET is_banned").WithArgs(int64(3), true).
+		WillReturnResult(sqlmock.NewResult(0, 0))
 
-	assert.Error(t, err)
+	err = repo.UpdateBanned(3, true)
+	assert.Equal(t, internalError.EmptyQuery, err)
 	err = mock.ExpectationsWereMet()
 	assert.Nil(t, err)
 }
@@ -13,12 +13,18 @@ type UserUsecase interface {
 	GetByEmail(email string) (*models.UserData, error)
 
 	CheckPassword(user *models.UserData, gettedPassword string) error
+	CheckAccess(userId int64) (string, error)
 	UpdatePassword(userId int64, changePassword *models.ChangePassword) error
 
 	GetById(id int64) (*models.Profile, error)
 	UpdateProfile(userId int64, userNew *models.UserData) (*models.Profile, error)
 	UploadAvatar(file *multipart.FileHeader, userId int64) (*models.UserData, error)
 
-	SetRating(rating *models.Rating) error
 	GetRating(userFrom int64, userTo int64) (*models.RatingStat, error)
+
+	GetAddresses(userId int64) ([]*models.Address, error)
+	GetAddress(userId int64, addressId int64) (*models.Address, error)
+	CreateAddress(userId int64, address *models.Address) error
+	UpdateAddress(userId int64, addressId int64, address *models.Address) error
+	DeleteAddress(userId int64, addressId int64) error
 }
@@ -15,14 +15,16 @@ import (
 type UserUsecase struct {
 	userRepo             user.UserRepository
 	userRatingRepository user.RatingRepository
+	addressRepository    user.AddressRepository
 	imageLoaderUse       imageloader.ImageLoaderUsecase
 }
 
 func NewUserUsecase(repo user.UserRepository, userRatingRepository user.RatingRepository,
-	imageLoaderUse imageloader.ImageLoaderUsecase) user.UserUsecase {
+	addressRepository user.AddressRepository, imageLoaderUse imageloader.ImageLoaderUsecase) user.UserUsecase {
 	return &UserUsecase{
 		userRepo:             repo,
 		userRatingRepository: userRatingRepository,
+		addressRepository:    addressRepository,
 		imageLoaderUse:       imageLoaderUse,
 	}
 }
@@ -83,9 +85,30 @@ func (uu *UserUsecase) CheckPassword(user *models.UserData, gettedPassword strin
 	if err != nil {
 		return internalError.PasswordMismatch
 	}
+
+	if user.IsBanned {
+		return internalError.UserBanned
+	}
 	return nil
 }
 
+// CheckAccess возвращает роль пользователя для проверки сессии, заблокированным доступ закрыт
+func (uu *UserUsecase) CheckAccess(userId int64) (string, error) {
+	user, err := uu.userRepo.SelectById(userId)
+	if err != nil {
+		return "", err
+	}
+
+	if user.IsBanned {
+		return "", internalError.UserBanned
+	}
+
+	if user.Role == "" {
+		return models.RoleUser, nil
+	}
+	return user.Role, nil
+}
+
 func (uu *UserUsecase) GetById(user_id int64) (*models.Profile, error) {
 	user, err := uu.userRepo.SelectById(user_id)
 
@@ -185,47 +208,6 @@ func (uu *UserUsecase) UpdatePassword(userId int64, changePassword *models.Chang
 	return err
 }
 
-func (uu *UserUsecase) SetRating(rating *models.Rating) error {
-	lastRating, err := uu.userRatingRepository.SelectRating(rating.UserFrom, rating.UserTo)
-	var count int
-	var rate int
-
-	// если рейтинга нет и намерение удалить рейтинг
-	if err == internalError.EmptyQuery && rating.Rating == 0 {
-		// отвечаем, что все ок, удалено
-		return nil
-
-		// если рейтинга нет и намерение поставить оценку
-	} else if err == internalError.EmptyQuery && rating.Rating > 0 {
-		err = uu.userRatingRepository.InsertRating(rating)
-		rate = rating.Rating
-		count = 1
-
-		// если рейтинг уже есть и намерение удалить его
-	} else if err == nil && rating.Rating == 0 {
-		err = uu.userRatingRepository.DeleteRating(rating)
-		// поставим отрицательную оценку, чтобы бд корректно удалила
-		rate = -lastRating.Rating
-		count = -1
-
-		// если рейтинг уже есть и намерение обновить его
-	} else if err == nil && rating.Rating > 0 {
-		err = uu.userRatingRepository.UpdateRating(rating)
-		rate = rating.Rating - lastRating.Rating
-		count = 0
-	} else {
-		return err
-	}
-
-	if err != nil {
-		return err
-	}
-
-	// после обновления rating необходимо обновить статистику
-	err = uu.userRatingRepository.UpdateStat(rating.UserTo, rate, count)
-	return err
-}
-
 func (uu *UserUsecase) GetRating(userFrom int64, userTo int64) (*models.RatingStat, error) {
 	sum, count, err := uu.userRatingRepository.SelectStat(userTo)
 	if err != nil {
@@ -260,3 +242,26 @@ func (uu *UserUsecase) GetRating(userFrom int64, userTo int64) (*models.RatingSt
 	ratingStat.PersonalRate = int(rating.Rating)
 	return ratingStat, nil
 }
+
+func (uu *UserUsecase) GetAddresses(userId int64) ([]*models.Address, error) {
+	return uu.addressRepository.SelectAddresses(userId)
+}
+
+func (uu *UserUsecase) GetAddress(userId int64, addressId int64) (*models.Address, error) {
+	return uu.addressRepository.SelectAddress(userId, addressId)
+}
+
+func (uu *UserUsecase) CreateAddress(userId int64, address *models.Address) error {
+	address.UserId = userId
+	return uu.addressRepository.InsertAddress(address)
+}
+
+func (uu *UserUsecase) UpdateAddress(userId int64, addressId int64, address *models.Address) error {
+	address.Id = addressId
+	address.UserId = userId
+	return uu.addressRepository.UpdateAddress(address)
+}
+
+func (uu *UserUsecase) DeleteAddress(userId int64, addressId int64) error {
+	return uu.addressRepository.DeleteAddress(userId, addressId)
+}
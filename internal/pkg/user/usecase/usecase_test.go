@@ -28,7 +28,7 @@ func TestCreate(t *testing.T) {
 
 	ur := mocks.UserRepository{}
 	rr := mocks.RatingRepository{}
-	uu := NewUserUsecase(&ur, &rr, ilu)
+	uu := NewUserUsecase(&ur, &rr, &mocks.AddressRepository{}, ilu)
 
 	reqUser := models.UserSignUp{
 		Password: "password",
@@ -48,7 +48,7 @@ func TestCreate(t *testing.T) {
 func TestGetByEmail(t *testing.T) {
 	ur := mocks.UserRepository{}
 	rr := mocks.RatingRepository{}
-	uu := NewUserUsecase(&ur, &rr, ilu)
+	uu := NewUserUsecase(&ur, &rr, &mocks.AddressRepository{}, ilu)
 
 	reqUser := &models.UserSignUp{
 		Password: "password",
@@ -71,7 +71,7 @@ func TestGetByEmail(t *testing.T) {
 func TestTwiceCreate(t *testing.T) {
 	ur := mocks.UserRepository{}
 	rr := mocks.RatingRepository{}
-	uu := NewUserUsecase(&ur, &rr, ilu)
+	uu := NewUserUsecase(&ur, &rr, &mocks.AddressRepository{}, ilu)
 
 	reqUser := &models.UserSignUp{
 		Password: "password",
@@ -94,7 +94,7 @@ func TestTwiceCreate(t *testing.T) {
 func TestGetByEmailUserNotExist(t *testing.T) {
 	ur := mocks.UserRepository{}
 	rr := mocks.RatingRepository{}
-	uu := NewUserUsecase(&ur, &rr, ilu)
+	uu := NewUserUsecase(&ur, &rr, &mocks.AddressRepository{}, ilu)
 
 	reqUser := models.UserSignUp{
 		Password: "password",
@@ -110,7 +110,7 @@ func TestGetByEmailUserNotExist(t *testing.T) {
 func TestCheckPassword(t *testing.T) {
 	ur := mocks.UserRepository{}
 	rr := mocks.RatingRepository{}
-	uu := NewUserUsecase(&ur, &rr, ilu)
+	uu := NewUserUsecase(&ur, &rr, &mocks.AddressRepository{}, ilu)
 
 	reqUser := models.UserSignUp{
 		Password: "password",
@@ -130,7 +130,7 @@ func TestCheckPassword(t *testing.T) {
 func TestCheckPasswordInvalid(t *testing.T) {
 	ur := mocks.UserRepository{}
 	rr := mocks.RatingRepository{}
-	uu := NewUserUsecase(&ur, &rr, ilu)
+	uu := NewUserUsecase(&ur, &rr, &mocks.AddressRepository{}, ilu)
 
 	reqUser := models.UserSignUp{
 		Password: "password",
@@ -147,10 +147,41 @@ func TestCheckPasswordInvalid(t *testing.T) {
 	assert.Equal(t, error, myerr.PasswordMismatch)
 }
 
+func TestCheckPasswordBanned(t *testing.T) {
+	uu := NewUserUsecase(&mocks.UserRepository{}, &mocks.RatingRepository{}, &mocks.AddressRepository{}, ilu)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	assert.Nil(t, err)
+
+	err = uu.CheckPassword(&models.UserData{Password: string(hash), IsBanned: true}, "password")
+	assert.Equal(t, myerr.UserBanned, err)
+}
+
+func TestCheckAccess(t *testing.T) {
+	ur := mocks.UserRepository{}
+	uu := NewUserUsecase(&ur, &mocks.RatingRepository{}, &mocks.AddressRepository{}, ilu)
+
+	ur.On("SelectById", int64(3)).Return(&models.UserData{Id: 3, Role: models.RoleModerator}, nil)
+
+	role, err := uu.CheckAccess(3)
+	assert.Nil(t, err)
+	assert.Equal(t, models.RoleModerator, role)
+}
+
+func TestCheckAccessBanned(t *testing.T) {
+	ur := mocks.UserRepository{}
+	uu := NewUserUsecase(&ur, &mocks.RatingRepository{}, &mocks.AddressRepository{}, ilu)
+
+	ur.On("SelectById", int64(3)).Return(&models.UserData{Id: 3, Role: models.RoleUser, IsBanned: true}, nil)
+
+	_, err := uu.CheckAccess(3)
+	assert.Equal(t, myerr.UserBanned, err)
+}
+
 func TestGetById(t *testing.T) {
 	ur := mocks.UserRepository{}
 	rr := mocks.RatingRepository{}
-	uu := NewUserUsecase(&ur, &rr, ilu)
+	uu := NewUserUsecase(&ur, &rr, &mocks.AddressRepository{}, ilu)
 
 	reqUser := models.UserSignUp{
 		Password: "password",
@@ -173,7 +204,7 @@ func TestGetById(t *testing.T) {
 func TestGetByIdUserNotExist(t *testing.T) {
 	ur := mocks.UserRepository{}
 	rr := mocks.RatingRepository{}
-	uu := NewUserUsecase(&ur, &rr, ilu)
+	uu := NewUserUsecase(&ur, &rr, &mocks.AddressRepository{}, ilu)
 
 	ur.On("SelectById", mock.MatchedBy(func(userId int64) bool { return userId < 0 })).Return(nil, myerr.EmptyQuery)
 
@@ -184,7 +215,7 @@ func TestGetByIdUserNotExist(t *testing.T) {
 func TestUpdateUserProfile(t *testing.T) {
 	ur := mocks.UserRepository{}
 	rr := mocks.RatingRepository{}
-	uu := NewUserUsecase(&ur, &rr, ilu)
+	uu := NewUserUsecase(&ur, &rr, &mocks.AddressRepository{}, ilu)
 
 	reqUser := models.UserData{
 		Id:       0,
@@ -206,7 +237,7 @@ func TestUpdateUserProfile(t *testing.T) {
 func TestUpdateUserAlreadyExist(t *testing.T) {
 	ur := mocks.UserRepository{}
 	rr := mocks.RatingRepository{}
-	uu := NewUserUsecase(&ur, &rr, ilu)
+	uu := NewUserUsecase(&ur, &rr, &mocks.AddressRepository{}, ilu)
 
 	userActual := models.UserData{
 		Id:       0,
@@ -242,7 +273,7 @@ func TestUpdatePasswordSuccess(t *testing.T) {
 	ur := mocks.UserRepository{}
 	mockedILU := imageloaderMocks.ImageLoaderUsecase{}
 	rr := mocks.RatingRepository{}
-	uu := NewUserUsecase(&ur, &rr, &mockedILU)
+	uu := NewUserUsecase(&ur, &rr, &mocks.AddressRepository{}, &mockedILU)
 
 	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
 
@@ -269,147 +300,102 @@ func TestUpdatePasswordSuccess(t *testing.T) {
 	assert.Nil(t, error)
 }
 
-func TestSetRatingOk(t *testing.T) {
-	ur := mocks.UserRepository{}
-	mockedILU := imageloaderMocks.ImageLoaderUsecase{}
-	rr := mocks.RatingRepository{}
-	uu := NewUserUsecase(&ur, &rr, &mockedILU)
-
-	rating := &models.Rating{UserFrom: 0, UserTo: 1, Rating: 0}
-	selrat := &models.Rating{UserFrom: 0, UserTo: 1, Rating: 0}
-
-	rr.On("SelectRating", rating.UserFrom, rating.UserTo).Return(selrat, myerr.EmptyQuery)
-
-	err := uu.SetRating(rating)
-	assert.NoError(t, err)
-}
-
-func TestSetRatingOk2(t *testing.T) {
+func TestGetRatingOk(t *testing.T) {
 	ur := mocks.UserRepository{}
 	mockedILU := imageloaderMocks.ImageLoaderUsecase{}
 	rr := mocks.RatingRepository{}
-	uu := NewUserUsecase(&ur, &rr, &mockedILU)
+	uu := NewUserUsecase(&ur, &rr, &mocks.AddressRepository{}, &mockedILU)
 
 	rating := &models.Rating{UserFrom: 0, UserTo: 1, Rating: 3}
-	selrat := &models.Rating{UserFrom: 0, UserTo: 1, Rating: 0}
+	selrat := &models.RatingStat{RatingSum: 8, RatingCount: 2, RatingAvg: 4.0, PersonalRate: 3, IsRated: true}
 
-	rr.On("SelectRating", rating.UserFrom, rating.UserTo).Return(selrat, myerr.EmptyQuery)
-	rr.On("InsertRating", rating).Return(nil)
-	rr.On("UpdateStat", rating.UserTo, rating.Rating, 1).Return(nil)
+	rr.On("SelectStat", rating.UserTo).Return(selrat.RatingSum, selrat.RatingCount, nil)
+	rr.On("SelectRating", rating.UserFrom, rating.UserTo).Return(rating, myerr.EmptyQuery)
 
-	err := uu.SetRating(rating)
+	_, err := uu.GetRating(rating.UserFrom, rating.UserTo)
 	assert.NoError(t, err)
 }
 
-func TestSetRatingOk3(t *testing.T) {
+func TestGetRatingOk2(t *testing.T) {
 	ur := mocks.UserRepository{}
 	mockedILU := imageloaderMocks.ImageLoaderUsecase{}
 	rr := mocks.RatingRepository{}
-	uu := NewUserUsecase(&ur, &rr, &mockedILU)
+	uu := NewUserUsecase(&ur, &rr, &mocks.AddressRepository{}, &mockedILU)
 
-	rating := &models.Rating{UserFrom: 0, UserTo: 1, Rating: 0}
-	selrat := &models.Rating{UserFrom: 0, UserTo: 1, Rating: 0}
+	rating := &models.Rating{UserFrom: 1, UserTo: 2, Rating: 3}
+	selrat := &models.RatingStat{RatingSum: 8, RatingCount: 2, RatingAvg: 4.0, PersonalRate: 3, IsRated: true}
 
-	rr.On("SelectRating", rating.UserFrom, rating.UserTo).Return(selrat, nil)
-	rr.On("DeleteRating", rating).Return(nil)
-	rr.On("UpdateStat", rating.UserTo, rating.Rating, -1).Return(nil)
+	rr.On("SelectStat", rating.UserTo).Return(selrat.RatingSum, selrat.RatingCount, nil)
+	rr.On("SelectRating", rating.UserFrom, rating.UserTo).Return(rating, nil)
 
-	err := uu.SetRating(rating)
+	_, err := uu.GetRating(rating.UserFrom, rating.UserTo)
 	assert.NoError(t, err)
 }
 
-func TestSetRatingOk4(t *testing.T) {
+func TestGetRatingError(t *testing.T) {
 	ur := mocks.UserRepository{}
 	mockedILU := imageloaderMocks.ImageLoaderUsecase{}
 	rr := mocks.RatingRepository{}
-	uu := NewUserUsecase(&ur, &rr, &mockedILU)
+	uu := NewUserUsecase(&ur, &rr, &mocks.AddressRepository{}, &mockedILU)
 
 	rating := &models.Rating{UserFrom: 0, UserTo: 1, Rating: 3}
-	selrat := &models.Rating{UserFrom: 0, UserTo: 1, Rating: 0}
-
-	rr.On("SelectRating", rating.UserFrom, rating.UserTo).Return(selrat, nil)
-	rr.On("UpdateRating", rating).Return(nil)
-	rr.On("UpdateStat", rating.UserTo, rating.Rating, 0).Return(nil)
-
-	err := uu.SetRating(rating)
-	assert.NoError(t, err)
-}
-
-func TestSetRatingError(t *testing.T) {
-	ur := mocks.UserRepository{}
-	mockedILU := imageloaderMocks.ImageLoaderUsecase{}
-	rr := mocks.RatingRepository{}
-	uu := NewUserUsecase(&ur, &rr, &mockedILU)
-
-	rating := &models.Rating{UserFrom: 0, UserTo: 1, Rating: 0}
-	selrat := &models.Rating{UserFrom: 0, UserTo: 1, Rating: 0}
+	selrat := &models.RatingStat{RatingSum: 8, RatingCount: 2, RatingAvg: 4.0, PersonalRate: 3, IsRated: true}
 
-	rr.On("SelectRating", rating.UserFrom, rating.UserTo).Return(selrat, myerr.InternalError)
+	rr.On("SelectStat", rating.UserTo).Return(selrat.RatingSum, selrat.RatingCount, nil)
+	rr.On("SelectRating", rating.UserFrom, rating.UserTo).Return(rating, myerr.InternalError)
 
-	err := uu.SetRating(rating)
+	_, err := uu.GetRating(rating.UserFrom, rating.UserTo)
 	assert.Error(t, err)
 }
 
-func TestSetRatingError2(t *testing.T) {
+func TestCreateAddress(t *testing.T) {
 	ur := mocks.UserRepository{}
-	mockedILU := imageloaderMocks.ImageLoaderUsecase{}
 	rr := mocks.RatingRepository{}
-	uu := NewUserUsecase(&ur, &rr, &mockedILU)
+	adr := mocks.AddressRepository{}
+	uu := NewUserUsecase(&ur, &rr, &adr, ilu)
 
-	rating := &models.Rating{UserFrom: 0, UserTo: 1, Rating: 3}
-	selrat := &models.Rating{UserFrom: 0, UserTo: 1, Rating: 0}
-
-	rr.On("SelectRating", rating.UserFrom, rating.UserTo).Return(selrat, myerr.EmptyQuery)
-	rr.On("InsertRating", rating).Return(myerr.InternalError)
+	address := &models.Address{Recipient: "Ivan", Phone: "+79990000000", City: "Moscow", Street: "Tverskaya, 1"}
+	adr.On("InsertAddress", address).Return(nil)
 
-	err := uu.SetRating(rating)
-	assert.Error(t, err)
+	err := uu.CreateAddress(7, address)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), address.UserId)
 }
 
-func TestGetRatingOk(t *testing.T) {
+func TestUpdateAddress(t *testing.T) {
 	ur := mocks.UserRepository{}
-	mockedILU := imageloaderMocks.ImageLoaderUsecase{}
 	rr := mocks.RatingRepository{}
-	uu := NewUserUsecase(&ur, &rr, &mockedILU)
-
-	rating := &models.Rating{UserFrom: 0, UserTo: 1, Rating: 3}
-	selrat := &models.RatingStat{RatingSum: 8, RatingCount: 2, RatingAvg: 4.0, PersonalRate: 3, IsRated: true}
+	adr := mocks.AddressRepository{}
+	uu := NewUserUsecase(&ur, &rr, &adr, ilu)
 
-	rr.On("SelectStat", rating.UserTo).Return(selrat.RatingSum, selrat.RatingCount, nil)
-	rr.On("SelectRating", rating.UserFrom, rating.UserTo).Return(rating, myerr.EmptyQuery)
+	address := &models.Address{Recipient: "Ivan", Phone: "+79990000000", City: "Moscow", Street: "Tverskaya, 1"}
+	adr.On("UpdateAddress", address).Return(myerr.EmptyQuery)
 
-	_, err := uu.GetRating(rating.UserFrom, rating.UserTo)
-	assert.NoError(t, err)
+	err := uu.UpdateAddress(7, 3, address)
+	assert.Equal(t, myerr.EmptyQuery, err)
+	assert.Equal(t, int64(3), address.Id)
+	assert.Equal(t, int64(7), address.UserId)
 }
 
-func TestGetRatingOk2(t *testing.T) {
+func TestGetAddress(t *testing.T) {
 	ur := mocks.UserRepository{}
-	mockedILU := imageloaderMocks.ImageLoaderUsecase{}
 	rr := mocks.RatingRepository{}
-	uu := NewUserUsecase(&ur, &rr, &mockedILU)
-
-	rating := &models.Rating{UserFrom: 1, UserTo: 2, Rating: 3}
-	selrat := &models.RatingStat{RatingSum: 8, RatingCount: 2, RatingAvg: 4.0, PersonalRate: 3, IsRated: true}
-
-	rr.On("SelectStat", rating.UserTo).Return(selrat.RatingSum, selrat.RatingCount, nil)
-	rr.On("SelectRating", rating.UserFrom, rating.UserTo).Return(rating, nil)
+	adr := mocks.AddressRepository{}
+	uu := NewUserUsecase(&ur, &rr, &adr, ilu)
 
-	_, err := uu.GetRating(rating.UserFrom, rating.UserTo)
-	assert.NoError(t, err)
-}
-
-func TestGetRatingError(t *testing.T) {
-	ur := mocks.UserRepository{}
-	mockedILU := imageloaderMocks.ImageLoaderUsecase{}
-	rr := mocks.RatingRepository{}
-	uu := NewUserUsecase(&ur, &rr, &mockedILU)
+	address := &models.Address{Id: 3, UserId: 7}
+	adr.On("SelectAddress", int64(7), int64(3)).Return(address, nil)
+	adr.On("SelectAddresses", int64(7)).Return([]*models.Address{address}, nil)
+	adr.On("DeleteAddress", int64(7), int64(3)).Return(nil)
 
-	rating := &models.Rating{UserFrom: 0, UserTo: 1, Rating: 3}
-	selrat := &models.RatingStat{RatingSum: 8, RatingCount: 2, RatingAvg: 4.0, PersonalRate: 3, IsRated: true}
+	res, err := uu.GetAddress(7, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, address, res)
 
-	rr.On("SelectStat", rating.UserTo).Return(selrat.RatingSum, selrat.RatingCount, nil)
-	rr.On("SelectRating", rating.UserFrom, rating.UserTo).Return(rating, myerr.InternalError)
+	list, err := uu.GetAddresses(7)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(list))
 
-	_, err := uu.GetRating(rating.UserFrom, rating.UserTo)
-	assert.Error(t, err)
+	err = uu.DeleteAddress(7, 3)
+	assert.Nil(t, err)
 }
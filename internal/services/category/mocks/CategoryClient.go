@@ -6,9 +6,8 @@ import (
 	context "context"
 	category "yula/proto/generated/category"
 
-	grpc "google.golang.org/grpc"
-
 	mock "github.com/stretchr/testify/mock"
+	grpc "google.golang.org/grpc"
 )
 
 // CategoryClient is an autogenerated mock type for the CategoryClient type
@@ -16,6 +15,96 @@ type CategoryClient struct {
 	mock.Mock
 }
 
+// CreateCategory provides a mock function with given fields: ctx, in, opts
+func (_m *CategoryClient) CreateCategory(ctx context.Context, in *category.XCategory, opts ...grpc.CallOption) (*category.XCategory, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *category.XCategory
+	if rf, ok := ret.Get(0).(func(context.Context, *category.XCategory, ...grpc.CallOption) *category.XCategory); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*category.XCategory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *category.XCategory, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCategory provides a mock function with given fields: ctx, in, opts
+func (_m *CategoryClient) DeleteCategory(ctx context.Context, in *category.CategoryId, opts ...grpc.CallOption) (*category.Nothing, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *category.Nothing
+	if rf, ok := ret.Get(0).(func(context.Context, *category.CategoryId, ...grpc.CallOption) *category.Nothing); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*category.Nothing)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *category.CategoryId, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAttributes provides a mock function with given fields: ctx, in, opts
+func (_m *CategoryClient) GetAttributes(ctx context.Context, in *category.CategoryName, opts ...grpc.CallOption) (*category.CategoryAttributes, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *category.CategoryAttributes
+	if rf, ok := ret.Get(0).(func(context.Context, *category.CategoryName, ...grpc.CallOption) *category.CategoryAttributes); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*category.CategoryAttributes)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *category.CategoryName, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCategories provides a mock function with given fields: ctx, in, opts
 func (_m *CategoryClient) GetCategories(ctx context.Context, in *category.Nothing, opts ...grpc.CallOption) (*category.Categories, error) {
 	_va := make([]interface{}, len(opts))
@@ -45,3 +134,183 @@ func (_m *CategoryClient) GetCategories(ctx context.Context, in *category.Nothin
 
 	return r0, r1
 }
+
+// GetCategory provides a mock function with given fields: ctx, in, opts
+func (_m *CategoryClient) GetCategory(ctx context.Context, in *category.CategorySlug, opts ...grpc.CallOption) (*category.XCategory, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *category.XCategory
+	if rf, ok := ret.Get(0).(func(context.Context, *category.CategorySlug, ...grpc.CallOption) *category.XCategory); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*category.XCategory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *category.CategorySlug, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCategoryTree provides a mock function with given fields: ctx, in, opts
+func (_m *CategoryClient) GetCategoryTree(ctx context.Context, in *category.Nothing, opts ...grpc.CallOption) (*category.Categories, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *category.Categories
+	if rf, ok := ret.Get(0).(func(context.Context, *category.Nothing, ...grpc.CallOption) *category.Categories); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*category.Categories)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *category.Nothing, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrendingCategories provides a mock function with given fields: ctx, in, opts
+func (_m *CategoryClient) GetTrendingCategories(ctx context.Context, in *category.TrendingRequest, opts ...grpc.CallOption) (*category.Categories, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *category.Categories
+	if rf, ok := ret.Get(0).(func(context.Context, *category.TrendingRequest, ...grpc.CallOption) *category.Categories); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*category.Categories)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *category.TrendingRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MoveCategory provides a mock function with given fields: ctx, in, opts
+func (_m *CategoryClient) MoveCategory(ctx context.Context, in *category.CategoryMove, opts ...grpc.CallOption) (*category.Nothing, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *category.Nothing
+	if rf, ok := ret.Get(0).(func(context.Context, *category.CategoryMove, ...grpc.CallOption) *category.Nothing); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*category.Nothing)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *category.CategoryMove, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetAttributes provides a mock function with given fields: ctx, in, opts
+func (_m *CategoryClient) SetAttributes(ctx context.Context, in *category.CategoryAttributes, opts ...grpc.CallOption) (*category.Nothing, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *category.Nothing
+	if rf, ok := ret.Get(0).(func(context.Context, *category.CategoryAttributes, ...grpc.CallOption) *category.Nothing); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*category.Nothing)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *category.CategoryAttributes, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCategory provides a mock function with given fields: ctx, in, opts
+func (_m *CategoryClient) UpdateCategory(ctx context.Context, in *category.XCategory, opts ...grpc.CallOption) (*category.XCategory, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *category.XCategory
+	if rf, ok := ret.Get(0).(func(context.Context, *category.XCategory, ...grpc.CallOption) *category.XCategory); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*category.XCategory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *category.XCategory, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
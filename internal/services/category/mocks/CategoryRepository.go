@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	time "time"
 	models "yula/internal/models"
 
 	mock "github.com/stretchr/testify/mock"
@@ -13,6 +14,80 @@ type CategoryRepository struct {
 	mock.Mock
 }
 
+// Delete provides a mock function with given fields: categoryId
+func (_m *CategoryRepository) Delete(categoryId int64) error {
+	ret := _m.Called(categoryId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(categoryId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Insert provides a mock function with given fields: _a0
+func (_m *CategoryRepository) Insert(_a0 *models.Category) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Category) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SelectAdvertCounts provides a mock function with given fields:
+func (_m *CategoryRepository) SelectAdvertCounts() (map[int64]int64, error) {
+	ret := _m.Called()
+
+	var r0 map[int64]int64
+	if rf, ok := ret.Get(0).(func() map[int64]int64); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SelectAttributes provides a mock function with given fields: categoryName
+func (_m *CategoryRepository) SelectAttributes(categoryName string) (models.CategoryAttributeList, error) {
+	ret := _m.Called(categoryName)
+
+	var r0 models.CategoryAttributeList
+	if rf, ok := ret.Get(0).(func(string) models.CategoryAttributeList); ok {
+		r0 = rf(categoryName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(models.CategoryAttributeList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(categoryName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SelectCategories provides a mock function with given fields:
 func (_m *CategoryRepository) SelectCategories() ([]*models.Category, error) {
 	ret := _m.Called()
@@ -35,3 +110,68 @@ func (_m *CategoryRepository) SelectCategories() ([]*models.Category, error) {
 
 	return r0, r1
 }
+
+// SelectTrending provides a mock function with given fields: since, limit
+func (_m *CategoryRepository) SelectTrending(since time.Time, limit int64) ([]int64, error) {
+	ret := _m.Called(since, limit)
+
+	var r0 []int64
+	if rf, ok := ret.Get(0).(func(time.Time, int64) []int64); ok {
+		r0 = rf(since, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(time.Time, int64) error); ok {
+		r1 = rf(since, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: _a0
+func (_m *CategoryRepository) Update(_a0 *models.Category) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Category) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateAttributes provides a mock function with given fields: categoryName, attributes
+func (_m *CategoryRepository) UpdateAttributes(categoryName string, attributes models.CategoryAttributeList) error {
+	ret := _m.Called(categoryName, attributes)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, models.CategoryAttributeList) error); ok {
+		r0 = rf(categoryName, attributes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateParent provides a mock function with given fields: categoryId, parentId
+func (_m *CategoryRepository) UpdateParent(categoryId int64, parentId int64) error {
+	ret := _m.Called(categoryId, parentId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(categoryId, parentId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -13,6 +13,57 @@ type CategoryUsecase struct {
 	mock.Mock
 }
 
+// CreateCategory provides a mock function with given fields: _a0
+func (_m *CategoryUsecase) CreateCategory(_a0 *models.Category) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Category) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCategory provides a mock function with given fields: categoryId
+func (_m *CategoryUsecase) DeleteCategory(categoryId int64) error {
+	ret := _m.Called(categoryId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(categoryId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAttributes provides a mock function with given fields: categoryName
+func (_m *CategoryUsecase) GetAttributes(categoryName string) (models.CategoryAttributeList, error) {
+	ret := _m.Called(categoryName)
+
+	var r0 models.CategoryAttributeList
+	if rf, ok := ret.Get(0).(func(string) models.CategoryAttributeList); ok {
+		r0 = rf(categoryName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(models.CategoryAttributeList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(categoryName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCategories provides a mock function with given fields:
 func (_m *CategoryUsecase) GetCategories() ([]*models.Category, error) {
 	ret := _m.Called()
@@ -35,3 +86,114 @@ func (_m *CategoryUsecase) GetCategories() ([]*models.Category, error) {
 
 	return r0, r1
 }
+
+// GetCategory provides a mock function with given fields: slug
+func (_m *CategoryUsecase) GetCategory(slug string) (*models.Category, error) {
+	ret := _m.Called(slug)
+
+	var r0 *models.Category
+	if rf, ok := ret.Get(0).(func(string) *models.Category); ok {
+		r0 = rf(slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Category)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCategoryTree provides a mock function with given fields:
+func (_m *CategoryUsecase) GetCategoryTree() ([]*models.Category, error) {
+	ret := _m.Called()
+
+	var r0 []*models.Category
+	if rf, ok := ret.Get(0).(func() []*models.Category); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Category)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrendingCategories provides a mock function with given fields: limit
+func (_m *CategoryUsecase) GetTrendingCategories(limit int64) ([]*models.Category, error) {
+	ret := _m.Called(limit)
+
+	var r0 []*models.Category
+	if rf, ok := ret.Get(0).(func(int64) []*models.Category); ok {
+		r0 = rf(limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Category)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MoveCategory provides a mock function with given fields: categoryId, parentId
+func (_m *CategoryUsecase) MoveCategory(categoryId int64, parentId int64) error {
+	ret := _m.Called(categoryId, parentId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(categoryId, parentId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetAttributes provides a mock function with given fields: categoryName, attributes
+func (_m *CategoryUsecase) SetAttributes(categoryName string, attributes models.CategoryAttributeList) error {
+	ret := _m.Called(categoryName, attributes)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, models.CategoryAttributeList) error); ok {
+		r0 = rf(categoryName, attributes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCategory provides a mock function with given fields: _a0
+func (_m *CategoryUsecase) UpdateCategory(_a0 *models.Category) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Category) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
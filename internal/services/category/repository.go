@@ -1,9 +1,21 @@
 package category
 
-import "yula/internal/models"
+import (
+	"time"
+	"yula/internal/models"
+)
 
 //go:generate mockery -name=CategoryRepository
 
 type CategoryRepository interface {
 	SelectCategories() ([]*models.Category, error)
+	SelectAdvertCounts() (map[int64]int64, error)
+	SelectTrending(since time.Time, limit int64) ([]int64, error)
+	Insert(category *models.Category) error
+	Update(category *models.Category) error
+	UpdateParent(categoryId int64, parentId int64) error
+	Delete(categoryId int64) error
+
+	SelectAttributes(categoryName string) (models.CategoryAttributeList, error)
+	UpdateAttributes(categoryName string, attributes models.CategoryAttributeList) error
 }
@@ -1,10 +1,15 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
+	"regexp"
+	"time"
 	internalError "yula/internal/error"
 	"yula/internal/models"
 	"yula/internal/services/category"
+
+	"github.com/mailru/easyjson"
 )
 
 type CategoryRepository struct {
@@ -18,7 +23,8 @@ func NewCategoryRepository(DB *sql.DB) category.CategoryRepository {
 }
 
 func (cr *CategoryRepository) SelectCategories() ([]*models.Category, error) {
-	rows, err := cr.DB.Query("SELECT name FROM category")
+	rows, err := cr.DB.Query(`SELECT id, COALESCE(parent_id, 0), name, slug, icon, sort_order, lifetime_days 
+		FROM category ORDER BY sort_order, name`)
 	if err != nil {
 		return nil, internalError.GenInternalError(err)
 	}
@@ -28,7 +34,7 @@ func (cr *CategoryRepository) SelectCategories() ([]*models.Category, error) {
 	for rows.Next() {
 		var ctgry models.Category
 
-		err = rows.Scan(&ctgry.Name)
+		err = rows.Scan(&ctgry.Id, &ctgry.ParentId, &ctgry.Name, &ctgry.Slug, &ctgry.Icon, &ctgry.SortOrder, &ctgry.LifetimeDays)
 		if err != nil {
 			return nil, internalError.GenInternalError(err)
 		}
@@ -38,3 +44,241 @@ func (cr *CategoryRepository) SelectCategories() ([]*models.Category, error) {
 
 	return categories, nil
 }
+
+// SelectAdvertCounts возвращает число опубликованных объявлений непосредственно в каждой категории,
+// объявления скрытых пользователей не считаются, как и в выдаче категории
+func (cr *CategoryRepository) SelectAdvertCounts() (map[int64]int64, error) {
+	rows, err := cr.DB.Query(`SELECT category_id, count(*) FROM advert 
+		WHERE status = 'published' AND publisher_id NOT IN (SELECT id FROM users WHERE is_hidden)
+		GROUP BY category_id`)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+
+	defer rows.Close()
+	counts := make(map[int64]int64)
+	for rows.Next() {
+		var categoryId, count int64
+
+		err = rows.Scan(&categoryId, &count)
+		if err != nil {
+			return nil, internalError.GenInternalError(err)
+		}
+
+		counts[categoryId] = count
+	}
+
+	return counts, nil
+}
+
+// SelectTrending возвращает id самых популярных категорий: по объявлениям, опубликованным после since,
+// считаются публикации, просмотры и добавления в избранное
+func (cr *CategoryRepository) SelectTrending(since time.Time, limit int64) ([]int64, error) {
+	queryStr := `
+		SELECT a.category_id FROM advert a
+		LEFT JOIN (
+			SELECT advert_id, count(*) AS cnt FROM favorite GROUP BY advert_id
+		) f ON f.advert_id = a.id
+		WHERE a.status = 'published' AND a.published_at > $1
+		GROUP BY a.category_id
+		ORDER BY count(*) * 10 + sum(a.views) + sum(COALESCE(f.cnt, 0)) * 5 DESC, a.category_id
+		LIMIT $2`
+	rows, err := cr.DB.Query(queryStr, since, limit)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+
+	defer rows.Close()
+	categoryIds := make([]int64, 0)
+	for rows.Next() {
+		var categoryId int64
+
+		err = rows.Scan(&categoryId)
+		if err != nil {
+			return nil, internalError.GenInternalError(err)
+		}
+
+		categoryIds = append(categoryIds, categoryId)
+	}
+
+	return categoryIds, nil
+}
+
+func (cr *CategoryRepository) Insert(category *models.Category) error {
+	queryStr := `INSERT INTO category (parent_id, name, slug, icon, sort_order, lifetime_days) 
+		VALUES (NULLIF($1, 0), $2, $3, $4, $5, $6) ON CONFLICT DO NOTHING RETURNING id`
+	query := cr.DB.QueryRow(queryStr, category.ParentId, category.Name, category.Slug, category.Icon,
+		category.SortOrder, category.LifetimeDays)
+
+	err := query.Scan(&category.Id)
+	if err != nil {
+		if res, _ := regexp.Match(".*no rows.*", []byte(err.Error())); res {
+			return internalError.AlreadyExist
+		}
+		// родительской категории нет
+		if res, _ := regexp.Match(".*foreign key.*", []byte(err.Error())); res {
+			return internalError.EmptyQuery
+		}
+		return internalError.GenInternalError(err)
+	}
+
+	return nil
+}
+
+// Update меняет все поля, кроме родителя: перенос в другую ветку проверяется отдельно в UpdateParent
+func (cr *CategoryRepository) Update(category *models.Category) error {
+	result, err := cr.DB.Exec(`UPDATE category SET name = $2, slug = $3, icon = $4, sort_order = $5, 
+		lifetime_days = COALESCE(NULLIF($6, 0), lifetime_days) WHERE id = $1`,
+		category.Id, category.Name, category.Slug, category.Icon, category.SortOrder, category.LifetimeDays)
+	if err != nil {
+		if res, _ := regexp.Match(".*duplicate key.*", []byte(err.Error())); res {
+			return internalError.AlreadyExist
+		}
+		return internalError.GenInternalError(err)
+	}
+
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return internalError.EmptyQuery
+	}
+	return nil
+}
+
+// UpdateParent переносит категорию вместе с поддеревом, parentId = 0 делает ее корневой
+func (cr *CategoryRepository) UpdateParent(categoryId int64, parentId int64) error {
+	tx, err := cr.DB.BeginTx(context.Background(), nil)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	// нельзя сделать родителем саму категорию или ее потомка, иначе дерево замкнется
+	var cycle bool
+	query := tx.QueryRowContext(context.Background(), `
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM category WHERE id = $1
+			UNION ALL
+			SELECT c.id FROM category c JOIN subtree s ON c.parent_id = s.id
+		)
+		SELECT EXISTS (SELECT 1 FROM subtree WHERE id = $2)`, categoryId, parentId)
+	err = query.Scan(&cycle)
+	if err == nil && cycle {
+		err = internalError.CategoryCycle
+	}
+	if err != nil {
+		if rlbckEr := tx.Rollback(); rlbckEr != nil {
+			return internalError.RollbackError
+		}
+		if err == internalError.CategoryCycle {
+			return err
+		}
+		return internalError.GenInternalError(err)
+	}
+
+	result, err := tx.ExecContext(context.Background(),
+		"UPDATE category SET parent_id = NULLIF($2, 0) WHERE id = $1", categoryId, parentId)
+	if err != nil {
+		if rlbckEr := tx.Rollback(); rlbckEr != nil {
+			return internalError.RollbackError
+		}
+		if res, _ := regexp.Match(".*foreign key.*", []byte(err.Error())); res {
+			return internalError.EmptyQuery
+		}
+		return internalError.GenInternalError(err)
+	}
+
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		if rlbckEr := tx.Rollback(); rlbckEr != nil {
+			return internalError.RollbackError
+		}
+		return internalError.EmptyQuery
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return internalError.NotCommited
+	}
+	return nil
+}
+
+func (cr *CategoryRepository) Delete(categoryId int64) error {
+	tx, err := cr.DB.BeginTx(context.Background(), nil)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	// объявления удаляются вместе с категорией каскадом, поэтому непустую категорию не трогаем
+	var notEmpty bool
+	query := tx.QueryRowContext(context.Background(),
+		`SELECT EXISTS (SELECT 1 FROM advert WHERE category_id = $1) 
+			OR EXISTS (SELECT 1 FROM category WHERE parent_id = $1)`, categoryId)
+	err = query.Scan(&notEmpty)
+	if err == nil && notEmpty {
+		err = internalError.CategoryNotEmpty
+	}
+	if err != nil {
+		if rlbckEr := tx.Rollback(); rlbckEr != nil {
+			return internalError.RollbackError
+		}
+		if err == internalError.CategoryNotEmpty {
+			return err
+		}
+		return internalError.GenInternalError(err)
+	}
+
+	result, err := tx.ExecContext(context.Background(), "DELETE FROM category WHERE id = $1", categoryId)
+	if err != nil {
+		if rlbckEr := tx.Rollback(); rlbckEr != nil {
+			return internalError.RollbackError
+		}
+		return internalError.GenInternalError(err)
+	}
+
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		if rlbckEr := tx.Rollback(); rlbckEr != nil {
+			return internalError.RollbackError
+		}
+		return internalError.EmptyQuery
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return internalError.NotCommited
+	}
+	return nil
+}
+
+func (cr *CategoryRepository) SelectAttributes(categoryName string) (models.CategoryAttributeList, error) {
+	var raw []byte
+	err := cr.DB.QueryRow("SELECT attributes FROM category WHERE lower(name) = lower($1)", categoryName).Scan(&raw)
+	if err != nil {
+		res, _ := regexp.Match(".*no rows.*", []byte(err.Error()))
+		if res {
+			return nil, internalError.EmptyQuery
+		}
+		return nil, internalError.GenInternalError(err)
+	}
+
+	attributes := models.CategoryAttributeList{}
+	err = easyjson.Unmarshal(raw, &attributes)
+	if err != nil {
+		return nil, internalError.GenInternalError(err)
+	}
+
+	return attributes, nil
+}
+
+func (cr *CategoryRepository) UpdateAttributes(categoryName string, attributes models.CategoryAttributeList) error {
+	raw, err := easyjson.Marshal(attributes)
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	result, err := cr.DB.Exec("UPDATE category SET attributes = $2 WHERE lower(name) = lower($1)", categoryName, string(raw))
+	if err != nil {
+		return internalError.GenInternalError(err)
+	}
+
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return internalError.EmptyQuery
+	}
+	return nil
+}
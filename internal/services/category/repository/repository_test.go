@@ -1,7 +1,10 @@
 package repository
 
 import (
+	"errors"
 	"testing"
+	"time"
+	internalError "yula/internal/error"
 	"yula/internal/models"
 
 	"github.com/stretchr/testify/assert"
@@ -18,20 +21,314 @@ func TestSelectCategories(t *testing.T) {
 	repo := &CategoryRepository{
 		DB: db,
 	}
-	rows := sqlmock.NewRows([]string{"name"})
+	rows := sqlmock.NewRows([]string{"id", "parent_id", "name", "slug", "icon", "sort_order", "lifetime_days"})
 	expect := []*models.Category{
-		{Name: "aboba"},
+		{Id: 1, Name: "aboba", Slug: "aboba", LifetimeDays: 30},
+		{Id: 2, ParentId: 1, Name: "boba", Slug: "boba", LifetimeDays: 30},
 	}
 	for _, item := range expect {
-		rows = rows.AddRow(item.Name)
+		rows = rows.AddRow(item.Id, item.ParentId, item.Name, item.Slug, item.Icon, item.SortOrder, item.LifetimeDays)
 	}
 
 	mock.ExpectQuery("SELECT").WillReturnRows(rows)
 
-	_, err = repo.SelectCategories()
+	categories, err := repo.SelectCategories()
 	assert.Nil(t, err)
+	assert.Equal(t, expect, categories)
 
 	err = mock.ExpectationsWereMet()
 	assert.Nil(t, err)
 
 }
+
+func TestSelectAttributes(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := &CategoryRepository{
+		DB: db,
+	}
+	rows := sqlmock.NewRows([]string{"attributes"}).
+		AddRow([]byte(`[{"name":"size","type":"enum","required":true,"values":["S","M"]}]`))
+
+	mock.ExpectQuery("SELECT attributes FROM category").WithArgs("одежда").WillReturnRows(rows)
+
+	attributes, err := repo.SelectAttributes("одежда")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(attributes))
+	assert.Equal(t, []string{"S", "M"}, attributes[0].Values)
+
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectAttributesEmpty(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := &CategoryRepository{
+		DB: db,
+	}
+	mock.ExpectQuery("SELECT attributes FROM category").WithArgs("одежда").
+		WillReturnRows(sqlmock.NewRows([]string{"attributes"}))
+
+	_, err = repo.SelectAttributes("одежда")
+	assert.Equal(t, internalError.EmptyQuery, err)
+
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateAttributes(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := &CategoryRepository{
+		DB: db,
+	}
+	attributes := models.CategoryAttributeList{{Name: "year", Type: models.AttributeNumber}}
+
+	mock.ExpectExec("UPDATE category SET attributes").
+		WithArgs("авто", `[{"name":"year","type":"number","required":false}]`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.UpdateAttributes("авто", attributes)
+	assert.Nil(t, err)
+
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestInsertCategoryOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCategoryRepository(db)
+	category := &models.Category{ParentId: 1, Name: "кроссовки", Slug: "sneakers", LifetimeDays: 30}
+
+	mock.ExpectQuery("INSERT INTO category").WithArgs(int64(1), "кроссовки", "sneakers", "", int64(0), int64(30)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+
+	err = repo.Insert(category)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), category.Id)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestInsertCategoryDuplicate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCategoryRepository(db)
+
+	mock.ExpectQuery("INSERT INTO category").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	err = repo.Insert(&models.Category{Name: "одежда", Slug: "clothes", LifetimeDays: 30})
+	assert.Equal(t, internalError.AlreadyExist, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestInsertCategoryNoParent(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCategoryRepository(db)
+
+	mock.ExpectQuery("INSERT INTO category").
+		WillReturnError(errors.New("insert or update on table \"category\" violates foreign key constraint"))
+
+	err = repo.Insert(&models.Category{ParentId: 100, Name: "одежда", Slug: "clothes", LifetimeDays: 30})
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateCategoryDuplicate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCategoryRepository(db)
+
+	mock.ExpectExec("UPDATE category").WithArgs(int64(4), "обувь", "shoes", "", int64(0), int64(0)).
+		WillReturnError(errors.New("pq: duplicate key value violates unique constraint"))
+
+	err = repo.Update(&models.Category{Id: 4, Name: "обувь", Slug: "shoes"})
+	assert.Equal(t, internalError.AlreadyExist, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateCategoryNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCategoryRepository(db)
+
+	mock.ExpectExec("UPDATE category").WithArgs(int64(4), "обувь", "shoes", "", int64(0), int64(0)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.Update(&models.Category{Id: 4, Name: "обувь", Slug: "shoes"})
+	assert.Equal(t, internalError.EmptyQuery, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateParentOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCategoryRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("WITH RECURSIVE subtree").WithArgs(int64(4), int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectExec("UPDATE category SET parent_id").WithArgs(int64(4), int64(1)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = repo.UpdateParent(4, 1)
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestUpdateParentCycle(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCategoryRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("WITH RECURSIVE subtree").WithArgs(int64(1), int64(4)).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectRollback()
+
+	err = repo.UpdateParent(1, 4)
+	assert.Equal(t, internalError.CategoryCycle, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestDeleteCategoryOk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCategoryRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT EXISTS").WithArgs(int64(4)).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectExec("DELETE FROM category").WithArgs(int64(4)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = repo.Delete(4)
+	assert.NoError(t, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestDeleteCategoryNotEmpty(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCategoryRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT EXISTS").WithArgs(int64(4)).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectRollback()
+
+	err = repo.Delete(4)
+	assert.Equal(t, internalError.CategoryNotEmpty, err)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectAdvertCounts(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCategoryRepository(db)
+
+	rows := sqlmock.NewRows([]string{"category_id", "count"}).AddRow(1, 1243).AddRow(2, 5)
+	mock.ExpectQuery("SELECT category_id, count").WillReturnRows(rows)
+
+	counts, err := repo.SelectAdvertCounts()
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]int64{1: 1243, 2: 5}, counts)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectTrending(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCategoryRepository(db)
+	since := time.Now()
+
+	rows := sqlmock.NewRows([]string{"category_id"}).AddRow(3).AddRow(1)
+	mock.ExpectQuery("SELECT a.category_id FROM advert").WithArgs(since, int64(10)).WillReturnRows(rows)
+
+	categoryIds, err := repo.SelectTrending(since, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{3, 1}, categoryIds)
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
+
+func TestSelectTrendingError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	repo := NewCategoryRepository(db)
+
+	mock.ExpectQuery("SELECT a.category_id FROM advert").WillReturnError(errors.New("connection refused"))
+
+	_, err = repo.SelectTrending(time.Now(), 10)
+	assert.Error(t, err)
+}
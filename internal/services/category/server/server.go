@@ -3,6 +3,8 @@ package http
 import (
 	"context"
 	"net"
+	internalError "yula/internal/error"
+	"yula/internal/models"
 	"yula/internal/services/category"
 
 	proto "yula/proto/generated/category"
@@ -44,13 +46,157 @@ func (s *CategoryServer) GetCategories(ctx context.Context, dummy *proto.Nothing
 	res, err := s.cu.GetCategories()
 	if err != nil {
 		s.logger.Errorf("can not get categories")
-		return nil, err
+		return nil, internalError.ToGRPCStatus(err)
 	}
 	var categories *proto.Categories = &proto.Categories{}
 	for _, category := range res {
-		categories.Categories = append(categories.Categories, &proto.XCategory{
-			Name: category.Name,
-		})
+		categories.Categories = append(categories.Categories, categoryToProto(category))
 	}
+	categories.Version = models.CategoriesVersion(res)
 	return categories, nil
 }
+
+func (s *CategoryServer) GetCategoryTree(ctx context.Context, dummy *proto.Nothing) (*proto.Categories, error) {
+	res, err := s.cu.GetCategoryTree()
+	if err != nil {
+		s.logger.Errorf("can not get category tree")
+		return nil, internalError.ToGRPCStatus(err)
+	}
+	var categories *proto.Categories = &proto.Categories{}
+	for _, category := range res {
+		categories.Categories = append(categories.Categories, categoryToProto(category))
+	}
+	categories.Version = models.CategoriesVersion(res)
+	return categories, nil
+}
+
+func (s *CategoryServer) GetCategory(ctx context.Context, slug *proto.CategorySlug) (*proto.XCategory, error) {
+	category, err := s.cu.GetCategory(slug.Slug)
+	if err != nil {
+		s.logger.Errorf("can not get category %s", slug.Slug)
+		return nil, internalError.ToGRPCStatus(err)
+	}
+	return categoryToProto(category), nil
+}
+
+func (s *CategoryServer) GetTrendingCategories(ctx context.Context, request *proto.TrendingRequest) (*proto.Categories, error) {
+	res, err := s.cu.GetTrendingCategories(request.Limit)
+	if err != nil {
+		s.logger.Errorf("can not get trending categories")
+		return nil, internalError.ToGRPCStatus(err)
+	}
+	var categories *proto.Categories = &proto.Categories{}
+	for _, category := range res {
+		categories.Categories = append(categories.Categories, categoryToProto(category))
+	}
+	return categories, nil
+}
+
+func (s *CategoryServer) CreateCategory(ctx context.Context, protocategory *proto.XCategory) (*proto.XCategory, error) {
+	category := categoryFromProto(protocategory)
+	err := s.cu.CreateCategory(category)
+	if err != nil {
+		s.logger.Errorf("can not create category %s", category.Name)
+		return nil, internalError.ToGRPCStatus(err)
+	}
+	return categoryToProto(category), nil
+}
+
+func (s *CategoryServer) UpdateCategory(ctx context.Context, protocategory *proto.XCategory) (*proto.XCategory, error) {
+	category := categoryFromProto(protocategory)
+	err := s.cu.UpdateCategory(category)
+	if err != nil {
+		s.logger.Errorf("can not update category %d", category.Id)
+		return nil, internalError.ToGRPCStatus(err)
+	}
+	return categoryToProto(category), nil
+}
+
+func (s *CategoryServer) MoveCategory(ctx context.Context, move *proto.CategoryMove) (*proto.Nothing, error) {
+	err := s.cu.MoveCategory(move.Id, move.ParentId)
+	if err != nil {
+		s.logger.Errorf("can not move category %d", move.Id)
+		return nil, internalError.ToGRPCStatus(err)
+	}
+	return &proto.Nothing{Dummy: true}, nil
+}
+
+func (s *CategoryServer) DeleteCategory(ctx context.Context, id *proto.CategoryId) (*proto.Nothing, error) {
+	err := s.cu.DeleteCategory(id.Id)
+	if err != nil {
+		s.logger.Errorf("can not delete category %d", id.Id)
+		return nil, internalError.ToGRPCStatus(err)
+	}
+	return &proto.Nothing{Dummy: true}, nil
+}
+
+func (s *CategoryServer) GetAttributes(ctx context.Context, name *proto.CategoryName) (*proto.CategoryAttributes, error) {
+	res, err := s.cu.GetAttributes(name.Name)
+	if err != nil {
+		s.logger.Errorf("can not get attributes of category %s", name.Name)
+		return nil, internalError.ToGRPCStatus(err)
+	}
+
+	attributes := &proto.CategoryAttributes{Category: name.Name}
+	for _, attribute := range res {
+		attributes.Attributes = append(attributes.Attributes, &proto.Attribute{
+			Name:     attribute.Name,
+			Type:     attribute.Type,
+			Required: attribute.Required,
+			Values:   attribute.Values,
+			Min:      attribute.Min,
+			Max:      attribute.Max,
+		})
+	}
+	return attributes, nil
+}
+
+func (s *CategoryServer) SetAttributes(ctx context.Context, attributes *proto.CategoryAttributes) (*proto.Nothing, error) {
+	schema := make(models.CategoryAttributeList, 0, len(attributes.Attributes))
+	for _, attribute := range attributes.Attributes {
+		schema = append(schema, &models.CategoryAttribute{
+			Name:     attribute.Name,
+			Type:     attribute.Type,
+			Required: attribute.Required,
+			Values:   attribute.Values,
+			Min:      attribute.Min,
+			Max:      attribute.Max,
+		})
+	}
+
+	err := s.cu.SetAttributes(attributes.Category, schema)
+	if err != nil {
+		s.logger.Errorf("can not set attributes of category %s", attributes.Category)
+		return nil, internalError.ToGRPCStatus(err)
+	}
+	return &proto.Nothing{Dummy: true}, nil
+}
+
+func categoryToProto(category *models.Category) *proto.XCategory {
+	protocategory := &proto.XCategory{
+		Name:         category.Name,
+		Id:           category.Id,
+		ParentId:     category.ParentId,
+		Slug:         category.Slug,
+		Icon:         category.Icon,
+		SortOrder:    category.SortOrder,
+		LifetimeDays: category.LifetimeDays,
+		AdvertsCount: category.AdvertsCount,
+	}
+	for _, child := range category.Children {
+		protocategory.Children = append(protocategory.Children, categoryToProto(child))
+	}
+	return protocategory
+}
+
+func categoryFromProto(protocategory *proto.XCategory) *models.Category {
+	return &models.Category{
+		Name:         protocategory.Name,
+		Id:           protocategory.Id,
+		ParentId:     protocategory.ParentId,
+		Slug:         protocategory.Slug,
+		Icon:         protocategory.Icon,
+		SortOrder:    protocategory.SortOrder,
+		LifetimeDays: protocategory.LifetimeDays,
+	}
+}
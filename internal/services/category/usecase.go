@@ -6,4 +6,15 @@ import "yula/internal/models"
 
 type CategoryUsecase interface {
 	GetCategories() ([]*models.Category, error)
+	GetCategoryTree() ([]*models.Category, error)
+	GetCategory(slug string) (*models.Category, error)
+	GetTrendingCategories(limit int64) ([]*models.Category, error)
+
+	CreateCategory(category *models.Category) error
+	UpdateCategory(category *models.Category) error
+	MoveCategory(categoryId int64, parentId int64) error
+	DeleteCategory(categoryId int64) error
+
+	GetAttributes(categoryName string) (models.CategoryAttributeList, error)
+	SetAttributes(categoryName string, attributes models.CategoryAttributeList) error
 }
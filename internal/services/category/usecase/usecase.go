@@ -1,12 +1,25 @@
 package usecase
 
 import (
+	"sync"
+	"time"
+	internalError "yula/internal/error"
 	"yula/internal/models"
 	"yula/internal/services/category"
 )
 
+// счетчики объявлений и популярные категории считаются запросом по всем объявлениям,
+// поэтому кешируются на это время
+const categoryCacheTTL = time.Minute
+
 type CategoryUsecase struct {
 	categoryRepository category.CategoryRepository
+
+	mu              sync.Mutex
+	counts          map[int64]int64
+	countsUpdated   time.Time
+	trending        []int64
+	trendingUpdated time.Time
 }
 
 func NewCategoryUsecase(categoryRepository category.CategoryRepository) category.CategoryUsecase {
@@ -16,6 +29,177 @@ func NewCategoryUsecase(categoryRepository category.CategoryRepository) category
 }
 
 func (cu *CategoryUsecase) GetCategories() ([]*models.Category, error) {
+	return cu.countedCategories()
+}
+
+func (cu *CategoryUsecase) GetCategoryTree() ([]*models.Category, error) {
+	categories, err := cu.countedCategories()
+	if err != nil {
+		return nil, err
+	}
+	return models.BuildCategoryTree(categories), nil
+}
+
+// GetCategory возвращает категорию вместе с ее поддеревом
+func (cu *CategoryUsecase) GetCategory(slug string) (*models.Category, error) {
+	categories, err := cu.countedCategories()
+	if err != nil {
+		return nil, err
+	}
+
+	models.BuildCategoryTree(categories)
+	for _, category := range categories {
+		if category.Slug == slug {
+			return category, nil
+		}
+	}
+	return nil, internalError.NotExist
+}
+
+// GetTrendingCategories возвращает категории по убыванию популярности за последние дни
+func (cu *CategoryUsecase) GetTrendingCategories(limit int64) ([]*models.Category, error) {
+	if limit <= 0 {
+		limit = models.DefaultTrendingCategories
+	}
+	if limit > models.MaxTrendingCategories {
+		limit = models.MaxTrendingCategories
+	}
+
+	categoryIds, err := cu.trendingIds()
+	if err != nil {
+		return nil, err
+	}
+	categories, err := cu.countedCategories()
+	if err != nil {
+		return nil, err
+	}
+
+	byId := make(map[int64]*models.Category, len(categories))
+	for _, category := range categories {
+		byId[category.Id] = category
+	}
+
+	trending := make([]*models.Category, 0, limit)
+	for _, categoryId := range categoryIds {
+		// категорию могли удалить после обновления кеша
+		category, ok := byId[categoryId]
+		if !ok {
+			continue
+		}
+		trending = append(trending, category)
+		if int64(len(trending)) == limit {
+			break
+		}
+	}
+	return trending, nil
+}
+
+// countedCategories возвращает плоский список категорий с числом объявлений
+func (cu *CategoryUsecase) countedCategories() ([]*models.Category, error) {
 	categories, err := cu.categoryRepository.SelectCategories()
-	return categories, err
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := cu.advertCounts()
+	if err != nil {
+		return nil, err
+	}
+	models.CountCategoryAdverts(categories, counts)
+	return categories, nil
+}
+
+func (cu *CategoryUsecase) advertCounts() (map[int64]int64, error) {
+	cu.mu.Lock()
+	defer cu.mu.Unlock()
+
+	if cu.counts != nil && time.Since(cu.countsUpdated) < categoryCacheTTL {
+		return cu.counts, nil
+	}
+
+	counts, err := cu.categoryRepository.SelectAdvertCounts()
+	if err != nil {
+		return nil, err
+	}
+	cu.counts = counts
+	cu.countsUpdated = time.Now()
+	return counts, nil
+}
+
+// trendingIds кеширует максимально возможный топ, разные limit берут из него префикс
+func (cu *CategoryUsecase) trendingIds() ([]int64, error) {
+	cu.mu.Lock()
+	defer cu.mu.Unlock()
+
+	if cu.trending != nil && time.Since(cu.trendingUpdated) < categoryCacheTTL {
+		return cu.trending, nil
+	}
+
+	since := time.Now().AddDate(0, 0, -int(models.TrendingCategoriesDays))
+	categoryIds, err := cu.categoryRepository.SelectTrending(since, models.MaxTrendingCategories)
+	if err != nil {
+		return nil, err
+	}
+	cu.trending = categoryIds
+	cu.trendingUpdated = time.Now()
+	return categoryIds, nil
+}
+
+func (cu *CategoryUsecase) CreateCategory(category *models.Category) error {
+	if category.LifetimeDays == 0 {
+		category.LifetimeDays = models.DefaultCategoryLifetimeDays
+	}
+
+	err := cu.categoryRepository.Insert(category)
+	if err == internalError.EmptyQuery {
+		return internalError.NotExist
+	}
+	return err
+}
+
+func (cu *CategoryUsecase) UpdateCategory(category *models.Category) error {
+	err := cu.categoryRepository.Update(category)
+	if err == internalError.EmptyQuery {
+		return internalError.NotExist
+	}
+	return err
+}
+
+func (cu *CategoryUsecase) MoveCategory(categoryId int64, parentId int64) error {
+	err := cu.categoryRepository.UpdateParent(categoryId, parentId)
+	if err == internalError.EmptyQuery {
+		return internalError.NotExist
+	}
+	return err
+}
+
+func (cu *CategoryUsecase) DeleteCategory(categoryId int64) error {
+	err := cu.categoryRepository.Delete(categoryId)
+	if err == internalError.EmptyQuery {
+		return internalError.NotExist
+	}
+	return err
+}
+
+func (cu *CategoryUsecase) GetAttributes(categoryName string) (models.CategoryAttributeList, error) {
+	attributes, err := cu.categoryRepository.SelectAttributes(categoryName)
+	if err == internalError.EmptyQuery {
+		return nil, internalError.NotExist
+	}
+	return attributes, err
+}
+
+// SetAttributes целиком заменяет схему категории, уже сохраненные значения у объявлений не меняются
+// и проверяются по новой схеме при следующем изменении объявления
+func (cu *CategoryUsecase) SetAttributes(categoryName string, attributes models.CategoryAttributeList) error {
+	schema := models.CategoryAttributes{Attributes: attributes}
+	if !schema.CheckSchema() {
+		return internalError.BadRequest
+	}
+
+	err := cu.categoryRepository.UpdateAttributes(categoryName, attributes)
+	if err == internalError.EmptyQuery {
+		return internalError.NotExist
+	}
+	return err
 }
@@ -2,17 +2,156 @@ package usecase
 
 import (
 	"testing"
+	internalError "yula/internal/error"
+	"yula/internal/models"
 	"yula/internal/services/category/mocks"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func Test(t *testing.T) {
 	mocksRepository := mocks.CategoryRepository{}
 	categoryUsecase := NewCategoryUsecase(&mocksRepository)
 	mocksRepository.On("SelectCategories").Return(nil, nil)
+	mocksRepository.On("SelectAdvertCounts").Return(map[int64]int64{}, nil)
 	categories, err := categoryUsecase.GetCategories()
 
 	assert.Nil(t, categories)
 	assert.Nil(t, err)
 }
+
+func TestGetAttributesNotExist(t *testing.T) {
+	mocksRepository := mocks.CategoryRepository{}
+	categoryUsecase := NewCategoryUsecase(&mocksRepository)
+	mocksRepository.On("SelectAttributes", "одежда").Return(nil, internalError.EmptyQuery)
+
+	_, err := categoryUsecase.GetAttributes("одежда")
+	assert.Equal(t, internalError.NotExist, err)
+}
+
+func TestSetAttributesSuccess(t *testing.T) {
+	mocksRepository := mocks.CategoryRepository{}
+	categoryUsecase := NewCategoryUsecase(&mocksRepository)
+	attributes := models.CategoryAttributeList{
+		{Name: "size", Type: models.AttributeEnum, Values: []string{"S", "M"}},
+		{Name: "year", Type: models.AttributeRange, Min: 1950, Max: 2030},
+	}
+	mocksRepository.On("UpdateAttributes", "одежда", attributes).Return(nil)
+
+	err := categoryUsecase.SetAttributes("одежда", attributes)
+	assert.Nil(t, err)
+}
+
+func TestSetAttributesInvalidSchema(t *testing.T) {
+	mocksRepository := mocks.CategoryRepository{}
+	categoryUsecase := NewCategoryUsecase(&mocksRepository)
+	attributes := models.CategoryAttributeList{{Name: "size", Type: models.AttributeEnum}}
+
+	err := categoryUsecase.SetAttributes("одежда", attributes)
+	assert.Equal(t, internalError.BadRequest, err)
+	mocksRepository.AssertNotCalled(t, "UpdateAttributes", "одежда", attributes)
+}
+
+func TestGetCategoryTree(t *testing.T) {
+	mocksRepository := mocks.CategoryRepository{}
+	categoryUsecase := NewCategoryUsecase(&mocksRepository)
+	mocksRepository.On("SelectCategories").Return([]*models.Category{
+		{Id: 1, Name: "одежда", Slug: "clothes"},
+		{Id: 2, ParentId: 1, Name: "обувь", Slug: "shoes"},
+		{Id: 3, ParentId: 2, Name: "кроссовки", Slug: "sneakers"},
+		{Id: 4, Name: "животные", Slug: "animals"},
+	}, nil)
+	mocksRepository.On("SelectAdvertCounts").Return(map[int64]int64{1: 2, 2: 3, 3: 5}, nil)
+
+	tree, err := categoryUsecase.GetCategoryTree()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(tree))
+	assert.Equal(t, "shoes", tree[0].Children[0].Slug)
+	assert.Equal(t, "sneakers", tree[0].Children[0].Children[0].Slug)
+	assert.Equal(t, int64(10), tree[0].AdvertsCount)
+	assert.Equal(t, int64(8), tree[0].Children[0].AdvertsCount)
+	assert.Equal(t, int64(0), tree[1].AdvertsCount)
+}
+
+func TestGetCategoryBySlug(t *testing.T) {
+	mocksRepository := mocks.CategoryRepository{}
+	categoryUsecase := NewCategoryUsecase(&mocksRepository)
+	mocksRepository.On("SelectCategories").Return([]*models.Category{
+		{Id: 1, Name: "одежда", Slug: "clothes"},
+		{Id: 2, ParentId: 1, Name: "обувь", Slug: "shoes"},
+	}, nil)
+	mocksRepository.On("SelectAdvertCounts").Return(map[int64]int64{}, nil)
+
+	category, err := categoryUsecase.GetCategory("clothes")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(category.Children))
+
+	_, err = categoryUsecase.GetCategory("cars")
+	assert.Equal(t, internalError.NotExist, err)
+}
+
+func TestCreateCategoryDefaultLifetime(t *testing.T) {
+	mocksRepository := mocks.CategoryRepository{}
+	categoryUsecase := NewCategoryUsecase(&mocksRepository)
+	mocksRepository.On("Insert", mock.MatchedBy(func(c *models.Category) bool {
+		return c.LifetimeDays == models.DefaultCategoryLifetimeDays
+	})).Return(nil)
+
+	err := categoryUsecase.CreateCategory(&models.Category{Name: "одежда", Slug: "clothes"})
+	assert.Nil(t, err)
+	mocksRepository.AssertExpectations(t)
+}
+
+func TestMoveCategoryNotExist(t *testing.T) {
+	mocksRepository := mocks.CategoryRepository{}
+	categoryUsecase := NewCategoryUsecase(&mocksRepository)
+	mocksRepository.On("UpdateParent", int64(2), int64(100)).Return(internalError.EmptyQuery)
+
+	err := categoryUsecase.MoveCategory(2, 100)
+	assert.Equal(t, internalError.NotExist, err)
+}
+
+func TestGetCategoriesCountsCached(t *testing.T) {
+	mocksRepository := mocks.CategoryRepository{}
+	categoryUsecase := NewCategoryUsecase(&mocksRepository)
+	mocksRepository.On("SelectCategories").Return(func() []*models.Category {
+		return []*models.Category{{Id: 1, Name: "одежда", Slug: "clothes"}}
+	}, nil)
+	mocksRepository.On("SelectAdvertCounts").Return(map[int64]int64{1: 1243}, nil).Once()
+
+	for i := 0; i < 2; i++ {
+		categories, err := categoryUsecase.GetCategories()
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1243), categories[0].AdvertsCount)
+	}
+	mocksRepository.AssertNumberOfCalls(t, "SelectAdvertCounts", 1)
+}
+
+func TestGetTrendingCategories(t *testing.T) {
+	mocksRepository := mocks.CategoryRepository{}
+	categoryUsecase := NewCategoryUsecase(&mocksRepository)
+	mocksRepository.On("SelectCategories").Return(func() []*models.Category {
+		return []*models.Category{
+			{Id: 1, Name: "одежда", Slug: "clothes"},
+			{Id: 2, Name: "обувь", Slug: "shoes"},
+			{Id: 3, Name: "животные", Slug: "animals"},
+		}
+	}, nil)
+	mocksRepository.On("SelectAdvertCounts").Return(map[int64]int64{3: 7}, nil)
+	// категория 5 удалена после подсчета и пропускается
+	mocksRepository.On("SelectTrending", mock.AnythingOfType("time.Time"), models.MaxTrendingCategories).
+		Return([]int64{3, 5, 1, 2}, nil).Once()
+
+	trending, err := categoryUsecase.GetTrendingCategories(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(trending))
+	assert.Equal(t, "animals", trending[0].Slug)
+	assert.Equal(t, int64(7), trending[0].AdvertsCount)
+	assert.Equal(t, "clothes", trending[1].Slug)
+
+	trending, err = categoryUsecase.GetTrendingCategories(0)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(trending))
+	mocksRepository.AssertNumberOfCalls(t, "SelectTrending", 1)
+}
@@ -20,8 +20,9 @@ func NewChatRepository(db *sql.DB) chat.ChatRepository {
 }
 
 func (cr *ChatRepository) SelectMessages(iMessage *models.IMessage, offset int64, limit int64) ([]*models.Message, error) {
-	query := `SELECT user_from, user_to, adv_id, msg, created_at FROM messages
-			  WHERE user_from IN ($1, $2) AND user_to IN ($1, $2) AND adv_id = $3
+	// скрытые по жалобам сообщения в историю не попадают
+	query := `SELECT id, user_from, user_to, adv_id, msg, created_at FROM messages
+			  WHERE user_from IN ($1, $2) AND user_to IN ($1, $2) AND adv_id = $3 AND NOT is_hidden
 			  ORDER BY created_at
 			  OFFSET $4 LIMIT $5;`
 
@@ -35,7 +36,7 @@ func (cr *ChatRepository) SelectMessages(iMessage *models.IMessage, offset int64
 	for rows.Next() {
 		message := &models.Message{}
 		var adId sql.NullInt64
-		err := rows.Scan(&message.MI.IdFrom, &message.MI.IdTo, &adId, &message.Msg, &message.CreatedAt)
+		err := rows.Scan(&message.Id, &message.MI.IdFrom, &message.MI.IdTo, &adId, &message.Msg, &message.CreatedAt)
 
 		if err != nil {
 			return nil, internalError.GenInternalError(err)
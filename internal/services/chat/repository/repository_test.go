@@ -27,8 +27,8 @@ func TestSelectMessagesOk(t *testing.T) {
 
 	message := models.Message{MI: models.IMessage{IdFrom: 0, IdTo: 1, IdAdv: 1}, Msg: "qwerty", CreatedAt: ParseTime()}
 	repo := NewChatRepository(db)
-	rows := sqlmock.NewRows([]string{"user_from", "user_to", "adv_id", "msg", "created_at"})
-	rows.AddRow(message.MI.IdFrom, message.MI.IdTo, message.MI.IdAdv, message.Msg, message.CreatedAt)
+	rows := sqlmock.NewRows([]string{"id", "user_from", "user_to", "adv_id", "msg", "created_at"})
+	rows.AddRow(message.Id, message.MI.IdFrom, message.MI.IdTo, message.MI.IdAdv, message.Msg, message.CreatedAt)
 	mock.ExpectQuery("SELECT").WithArgs(message.MI.IdFrom, message.MI.IdTo, message.MI.IdAdv, int64(0), int64(10)).WillReturnRows(rows)
 
 	_, err = repo.SelectMessages(&message.MI, int64(0), int64(10))
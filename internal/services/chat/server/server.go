@@ -56,6 +56,7 @@ func (s *ChatServer) GetHistory(ctx context.Context, arg *proto.GetHistoryArg) (
 	var messages *proto.Messages = &proto.Messages{}
 	for _, message := range res {
 		messages.M = append(messages.M, &proto.Message{
+			Id: message.Id,
 			MI: &proto.MessageIdentifier{
 				IdFrom: message.MI.IdFrom,
 				IdTo:   message.MI.IdTo,
@@ -19,6 +19,15 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CategoryClient interface {
 	GetCategories(ctx context.Context, in *Nothing, opts ...grpc.CallOption) (*Categories, error)
+	GetCategoryTree(ctx context.Context, in *Nothing, opts ...grpc.CallOption) (*Categories, error)
+	GetCategory(ctx context.Context, in *CategorySlug, opts ...grpc.CallOption) (*XCategory, error)
+	GetTrendingCategories(ctx context.Context, in *TrendingRequest, opts ...grpc.CallOption) (*Categories, error)
+	CreateCategory(ctx context.Context, in *XCategory, opts ...grpc.CallOption) (*XCategory, error)
+	UpdateCategory(ctx context.Context, in *XCategory, opts ...grpc.CallOption) (*XCategory, error)
+	MoveCategory(ctx context.Context, in *CategoryMove, opts ...grpc.CallOption) (*Nothing, error)
+	DeleteCategory(ctx context.Context, in *CategoryId, opts ...grpc.CallOption) (*Nothing, error)
+	GetAttributes(ctx context.Context, in *CategoryName, opts ...grpc.CallOption) (*CategoryAttributes, error)
+	SetAttributes(ctx context.Context, in *CategoryAttributes, opts ...grpc.CallOption) (*Nothing, error)
 }
 
 type categoryClient struct {
@@ -38,11 +47,101 @@ func (c *categoryClient) GetCategories(ctx context.Context, in *Nothing, opts ..
 	return out, nil
 }
 
+func (c *categoryClient) GetCategoryTree(ctx context.Context, in *Nothing, opts ...grpc.CallOption) (*Categories, error) {
+	out := new(Categories)
+	err := c.cc.Invoke(ctx, "/category.Category/GetCategoryTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) GetCategory(ctx context.Context, in *CategorySlug, opts ...grpc.CallOption) (*XCategory, error) {
+	out := new(XCategory)
+	err := c.cc.Invoke(ctx, "/category.Category/GetCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) GetTrendingCategories(ctx context.Context, in *TrendingRequest, opts ...grpc.CallOption) (*Categories, error) {
+	out := new(Categories)
+	err := c.cc.Invoke(ctx, "/category.Category/GetTrendingCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) CreateCategory(ctx context.Context, in *XCategory, opts ...grpc.CallOption) (*XCategory, error) {
+	out := new(XCategory)
+	err := c.cc.Invoke(ctx, "/category.Category/CreateCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) UpdateCategory(ctx context.Context, in *XCategory, opts ...grpc.CallOption) (*XCategory, error) {
+	out := new(XCategory)
+	err := c.cc.Invoke(ctx, "/category.Category/UpdateCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) MoveCategory(ctx context.Context, in *CategoryMove, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/category.Category/MoveCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) DeleteCategory(ctx context.Context, in *CategoryId, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/category.Category/DeleteCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) GetAttributes(ctx context.Context, in *CategoryName, opts ...grpc.CallOption) (*CategoryAttributes, error) {
+	out := new(CategoryAttributes)
+	err := c.cc.Invoke(ctx, "/category.Category/GetAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryClient) SetAttributes(ctx context.Context, in *CategoryAttributes, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/category.Category/SetAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CategoryServer is the server API for Category service.
 // All implementations should embed UnimplementedCategoryServer
 // for forward compatibility
 type CategoryServer interface {
 	GetCategories(context.Context, *Nothing) (*Categories, error)
+	GetCategoryTree(context.Context, *Nothing) (*Categories, error)
+	GetCategory(context.Context, *CategorySlug) (*XCategory, error)
+	GetTrendingCategories(context.Context, *TrendingRequest) (*Categories, error)
+	CreateCategory(context.Context, *XCategory) (*XCategory, error)
+	UpdateCategory(context.Context, *XCategory) (*XCategory, error)
+	MoveCategory(context.Context, *CategoryMove) (*Nothing, error)
+	DeleteCategory(context.Context, *CategoryId) (*Nothing, error)
+	GetAttributes(context.Context, *CategoryName) (*CategoryAttributes, error)
+	SetAttributes(context.Context, *CategoryAttributes) (*Nothing, error)
 }
 
 // UnimplementedCategoryServer should be embedded to have forward compatible implementations.
@@ -52,6 +151,33 @@ type UnimplementedCategoryServer struct {
 func (UnimplementedCategoryServer) GetCategories(context.Context, *Nothing) (*Categories, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCategories not implemented")
 }
+func (UnimplementedCategoryServer) GetCategoryTree(context.Context, *Nothing) (*Categories, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryTree not implemented")
+}
+func (UnimplementedCategoryServer) GetCategory(context.Context, *CategorySlug) (*XCategory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategory not implemented")
+}
+func (UnimplementedCategoryServer) GetTrendingCategories(context.Context, *TrendingRequest) (*Categories, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingCategories not implemented")
+}
+func (UnimplementedCategoryServer) CreateCategory(context.Context, *XCategory) (*XCategory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedCategoryServer) UpdateCategory(context.Context, *XCategory) (*XCategory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedCategoryServer) MoveCategory(context.Context, *CategoryMove) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCategory not implemented")
+}
+func (UnimplementedCategoryServer) DeleteCategory(context.Context, *CategoryId) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedCategoryServer) GetAttributes(context.Context, *CategoryName) (*CategoryAttributes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttributes not implemented")
+}
+func (UnimplementedCategoryServer) SetAttributes(context.Context, *CategoryAttributes) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttributes not implemented")
+}
 
 // UnsafeCategoryServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to CategoryServer will
@@ -82,6 +208,168 @@ func _Category_GetCategories_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Category_GetCategoryTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Nothing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).GetCategoryTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/GetCategoryTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).GetCategoryTree(ctx, req.(*Nothing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategorySlug)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).GetCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/GetCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).GetCategory(ctx, req.(*CategorySlug))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_GetTrendingCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).GetTrendingCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/GetTrendingCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).GetTrendingCategories(ctx, req.(*TrendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XCategory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/CreateCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).CreateCategory(ctx, req.(*XCategory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XCategory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/UpdateCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).UpdateCategory(ctx, req.(*XCategory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_MoveCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryMove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).MoveCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/MoveCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).MoveCategory(ctx, req.(*CategoryMove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/DeleteCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).DeleteCategory(ctx, req.(*CategoryId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_GetAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).GetAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/GetAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).GetAttributes(ctx, req.(*CategoryName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Category_SetAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryAttributes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).SetAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/category.Category/SetAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).SetAttributes(ctx, req.(*CategoryAttributes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Category_ServiceDesc is the grpc.ServiceDesc for Category service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -93,6 +381,42 @@ var Category_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCategories",
 			Handler:    _Category_GetCategories_Handler,
 		},
+		{
+			MethodName: "GetCategoryTree",
+			Handler:    _Category_GetCategoryTree_Handler,
+		},
+		{
+			MethodName: "GetCategory",
+			Handler:    _Category_GetCategory_Handler,
+		},
+		{
+			MethodName: "GetTrendingCategories",
+			Handler:    _Category_GetTrendingCategories_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _Category_CreateCategory_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _Category_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "MoveCategory",
+			Handler:    _Category_MoveCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _Category_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "GetAttributes",
+			Handler:    _Category_GetAttributes_Handler,
+		},
+		{
+			MethodName: "SetAttributes",
+			Handler:    _Category_SetAttributes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "category.proto",